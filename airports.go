@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"backend.travel.intercogni.com/packages/airports"
+	"backend.travel.intercogni.com/packages/cities"
+)
+
+var airportStore *airports.Store
+
+func initAirports() error {
+	path, err := filepath.Abs(envOr("AIRPORTS_CSV", "large_airports.csv"))
+	if err != nil {
+		return err
+	}
+
+	airportStore, err = airports.NewStore(path)
+	if err != nil {
+		return err
+	}
+
+	interval, err := time.ParseDuration(envOr("AIRPORTS_RELOAD_INTERVAL", "30s"))
+	if err != nil {
+		return err
+	}
+	if interval > 0 {
+		go airportStore.Watch(context.Background(), interval, func(err error) {
+			slog.Error("reload airports", "path", airportStore.Path(), "error", err)
+		})
+	}
+	return nil
+}
+
+// checkMetroCodes rejects booking places that are metro codes none of whose
+// member airports are in the loaded dataset, since they cannot be expanded.
+// Airport codes and city names pass unchecked.
+func checkMetroCodes(values ...string) error {
+	dataset := airportStore.Current()
+	for _, value := range values {
+		if _, ok := dataset.ByIATA(value); ok {
+			continue
+		}
+		if metro, ok := airports.MetroByCode(value); ok {
+			if _, ok := dataset.ResolveCode(metro.Code); !ok {
+				return fmt.Errorf("metro code %q has no airports in the loaded dataset", metro.Code)
+			}
+		}
+	}
+	return nil
+}
+
+// metroAirports maps every metro code among values to its member airports,
+// so booking responses can show what a code like LON covers.
+func metroAirports(values ...string) map[string][]string {
+	expanded := make(map[string][]string)
+	for _, value := range values {
+		if _, ok := airportStore.Current().ByIATA(value); ok {
+			continue
+		}
+		metro, ok := airports.MetroByCode(value)
+		if !ok {
+			continue
+		}
+		members, _ := airportStore.Current().ResolveCode(metro.Code)
+		codes := make([]string, len(members))
+		for i, member := range members {
+			codes[i] = member.IATACode
+		}
+		expanded[metro.Code] = codes
+	}
+	return expanded
+}
+
+// placeTimezone resolves the IANA timezone of a free-text booking place: a
+// city name that ranks clearly ahead of its namesakes, an airport or metro
+// code, or a metro area name.
+func placeTimezone(place string) string {
+	if city, ok := cities.Rank(place, cities.Bias{}).Best(); ok {
+		return city.Timezone()
+	}
+	if members, ok := airportStore.Current().ResolveCode(place); ok {
+		return members[0].Timezone()
+	}
+	if metro, ok := airports.MetroForCity(place); ok {
+		if members, ok := airportStore.Current().ResolveCode(metro.Code); ok {
+			return members[0].Timezone()
+		}
+	}
+	return ""
+}
+
+func reloadAirports(w http.ResponseWriter, r *http.Request) {
+	dataset, err := airportStore.Reload()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	loggerFromContext(r.Context()).Info("airports reloaded", "path", airportStore.Path(), "count", dataset.Len())
+
+	response := struct {
+		Path     string    `json:"path"`
+		Count    int       `json:"count"`
+		LoadedAt time.Time `json:"loaded_at"`
+	}{
+		Path:     airportStore.Path(),
+		Count:    dataset.Len(),
+		LoadedAt: dataset.LoadedAt(),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+type airportDetail struct {
+	ID               int      `json:"id"`
+	Ident            string   `json:"ident"`
+	Type             string   `json:"type"`
+	Name             string   `json:"name"`
+	Lat              float64  `json:"lat"`
+	Long             float64  `json:"long"`
+	ElevationFt      *float64 `json:"elevation_ft"`
+	Continent        string   `json:"continent"`
+	Country          string   `json:"iso_country"`
+	CountryName      string   `json:"country_name,omitempty"`
+	Timezone         string   `json:"timezone,omitempty"`
+	Region           string   `json:"iso_region"`
+	City             string   `json:"municipality"`
+	ScheduledService bool     `json:"scheduled_service"`
+	GPSCode          string   `json:"gps_code"`
+	IATACode         string   `json:"iata_code"`
+	LocalCode        string   `json:"local_code"`
+	HomeLink         string   `json:"home_link"`
+	WikipediaLink    string   `json:"wikipedia_link"`
+	Keywords         []string `json:"keywords"`
+}
+
+func newAirportDetail(airport airports.Airport) airportDetail {
+	keywords := airport.Keywords
+	if keywords == nil {
+		keywords = []string{}
+	}
+	detail := airportDetail{
+		ID:               airport.ID,
+		Ident:            airport.Ident,
+		Type:             string(airport.Type),
+		Name:             airport.Name,
+		Lat:              airport.Lat,
+		Long:             airport.Long,
+		ElevationFt:      airport.ElevationFt,
+		Continent:        airport.Continent,
+		Country:          airport.Country,
+		Region:           airport.Region,
+		City:             airport.City,
+		ScheduledService: airport.ScheduledService,
+		GPSCode:          airport.GPSCode,
+		IATACode:         airport.IATACode,
+		LocalCode:        airport.LocalCode,
+		HomeLink:         airport.HomeLink,
+		WikipediaLink:    airport.WikipediaLink,
+		Keywords:         keywords,
+		Timezone:         airport.Timezone(),
+	}
+	if country, ok := airport.ResolveCountry(); ok {
+		detail.CountryName = country.Name
+	}
+	return detail
+}
+
+func getAirport(w http.ResponseWriter, r *http.Request) {
+	airport, ok := airportStore.Current().ByIATA(r.PathValue("iata"))
+	if !ok {
+		http.Error(w, "Airport not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(newAirportDetail(airport)); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func searchAirports(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("q")
+	if query == "" {
+		http.Error(w, "q is required", http.StatusBadRequest)
+		return
+	}
+
+	limit := 10
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit <= 0 {
+			http.Error(w, "limit must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		if limit > 50 {
+			limit = 50
+		}
+	}
+
+	results := airportStore.Current().Search(query, limit, airports.DefaultFilter)
+
+	response := make([]struct {
+		IATACode  string  `json:"iata_code"`
+		Ident     string  `json:"ident"`
+		Name      string  `json:"name"`
+		City      string  `json:"municipality"`
+		Country   string  `json:"iso_country"`
+		Type      string  `json:"type"`
+		Lat       float64 `json:"lat"`
+		Long      float64 `json:"long"`
+		Score     int     `json:"score"`
+		MatchedOn string  `json:"matched_on"`
+	}, len(results))
+	for i, result := range results {
+		response[i].IATACode = result.Airport.IATACode
+		response[i].Ident = result.Airport.Ident
+		response[i].Name = result.Airport.Name
+		response[i].City = result.Airport.City
+		response[i].Country = result.Airport.Country
+		response[i].Type = string(result.Airport.Type)
+		response[i].Lat = result.Airport.Lat
+		response[i].Long = result.Airport.Long
+		response[i].Score = result.Score
+		response[i].MatchedOn = result.MatchedOn
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,250 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	auditActionCreate = "create"
+	auditActionDelete = "delete"
+)
+
+var errAuditLogAppendOnly = errors.New("audit log entries cannot be modified")
+
+// trustedProxies holds the networks allowed to report the client address in
+// X-Forwarded-For, from TRUSTED_PROXIES. When empty the header is ignored.
+var trustedProxies []netip.Prefix
+
+type AuditLog struct {
+	ID         uint   `gorm:"primaryKey"`
+	TenantID   uint   `gorm:"not null;default:1;index"`
+	Actor      string `gorm:"type:varchar(100);index:idx_audit_logs_actor"`
+	ClientIP   string `gorm:"type:varchar(64)"`
+	Endpoint   string `gorm:"type:varchar(200);not null"`
+	Action     string `gorm:"type:varchar(20);not null"`
+	EntityType string `gorm:"type:varchar(50);not null;index:idx_audit_logs_entity"`
+	EntityID   uint   `gorm:"not null;index:idx_audit_logs_entity"`
+	Before     string `gorm:"type:text"`
+	After      string `gorm:"type:text"`
+	CreatedAt  time.Time
+}
+
+func (AuditLog) BeforeUpdate(*gorm.DB) error {
+	return errAuditLogAppendOnly
+}
+
+func (AuditLog) BeforeDelete(*gorm.DB) error {
+	return errAuditLogAppendOnly
+}
+
+// createAuditTriggers makes audit_logs append-only in the database itself, so
+// raw SQL cannot rewrite history either. AutoMigrate does not create triggers.
+func createAuditTriggers(db *gorm.DB) error {
+	for _, statement := range []string{
+		`CREATE TRIGGER IF NOT EXISTS audit_logs_no_update BEFORE UPDATE ON audit_logs
+BEGIN
+	SELECT RAISE(ABORT, 'audit_logs is append-only');
+END`,
+		`CREATE TRIGGER IF NOT EXISTS audit_logs_no_delete BEFORE DELETE ON audit_logs
+BEGIN
+	SELECT RAISE(ABORT, 'audit_logs is append-only');
+END`,
+	} {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseTrustedProxies reads a comma-separated list of IP addresses and CIDR
+// ranges.
+func parseTrustedProxies(value string) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if prefix, err := netip.ParsePrefix(field); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", field)
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+	}
+	return prefixes, nil
+}
+
+func isTrustedProxy(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func recordAudit(tx *gorm.DB, r *http.Request, action, entityType string, entityID uint, before, after interface{}) error {
+	entry := AuditLog{
+		Actor:      auditActor(r),
+		ClientIP:   clientIP(r),
+		Endpoint:   r.Method + " " + r.URL.Path,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+	}
+
+	var err error
+	if entry.Before, err = auditSnapshot(before); err != nil {
+		return err
+	}
+	if entry.After, err = auditSnapshot(after); err != nil {
+		return err
+	}
+
+	return tx.Create(&entry).Error
+}
+
+func auditSnapshot(v interface{}) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func auditActor(r *http.Request) string {
+	if session, ok := sessionFromContext(r.Context()); ok {
+		return session.Email
+	}
+	return "anonymous"
+}
+
+// clientIP returns the address of the client. X-Forwarded-For is only
+// honoured when the connection comes from a trusted proxy, and is read from
+// the right, skipping further trusted proxies, since clients can prepend
+// anything they like.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !isTrustedProxy(host) {
+		return host
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		if _, err := netip.ParseAddr(hop); err != nil {
+			break
+		}
+		if !isTrustedProxy(hop) {
+			return hop
+		}
+		host = hop
+	}
+	return host
+}
+
+// getAuditLogs lists entries of the caller's tenant only: the query goes
+// through the tenant callbacks, so an admin token never reveals another
+// tenant's history.
+func getAuditLogs(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		EntityType string `json:"entity_type"`
+		EntityID   uint   `json:"entity_id"`
+		Actor      string `json:"actor"`
+		Limit      int    `json:"limit"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if request.EntityType == "" && request.Actor == "" {
+		http.Error(w, "entity_type or actor is required", http.StatusBadRequest)
+		return
+	}
+
+	if request.Limit <= 0 || request.Limit > 500 {
+		request.Limit = 100
+	}
+
+	query := db.WithContext(r.Context()).Order("id desc").Limit(request.Limit)
+	if request.EntityType != "" {
+		query = query.Where("entity_type = ?", request.EntityType)
+		if request.EntityID != 0 {
+			query = query.Where("entity_id = ?", request.EntityID)
+		}
+	}
+	if request.Actor != "" {
+		query = query.Where("actor = ?", request.Actor)
+	}
+
+	var logs []AuditLog
+	if err := query.Find(&logs).Error; err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := make([]struct {
+		ID         uint            `json:"id"`
+		Actor      string          `json:"actor"`
+		ClientIP   string          `json:"client_ip"`
+		Endpoint   string          `json:"endpoint"`
+		Action     string          `json:"action"`
+		EntityType string          `json:"entity_type"`
+		EntityID   uint            `json:"entity_id"`
+		Before     json.RawMessage `json:"before,omitempty"`
+		After      json.RawMessage `json:"after,omitempty"`
+		CreatedAt  time.Time       `json:"created_at"`
+	}, len(logs))
+	for i, entry := range logs {
+		response[i].ID = entry.ID
+		response[i].Actor = entry.Actor
+		response[i].ClientIP = entry.ClientIP
+		response[i].Endpoint = entry.Endpoint
+		response[i].Action = entry.Action
+		response[i].EntityType = entry.EntityType
+		response[i].EntityID = entry.EntityID
+		if entry.Before != "" {
+			response[i].Before = json.RawMessage(entry.Before)
+		}
+		if entry.After != "" {
+			response[i].After = json.RawMessage(entry.After)
+		}
+		response[i].CreatedAt = entry.CreatedAt
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
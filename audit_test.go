@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func newTestAuditLog(t *testing.T) (context.Context, AuditLog) {
+	t.Helper()
+	var tenant Tenant
+	if err := db.First(&tenant, defaultTenantID).Error; err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.WithValue(context.Background(), tenantContextKey{}, tenant)
+	entry := AuditLog{Actor: "user@example.com", Endpoint: "POST /api/bookings/create", Action: auditActionCreate, EntityType: "booking", EntityID: 1}
+	if err := db.WithContext(ctx).Create(&entry).Error; err != nil {
+		t.Fatal(err)
+	}
+	return ctx, entry
+}
+
+func TestAuditLogHooksRejectChanges(t *testing.T) {
+	newTestApp(t)
+	ctx, entry := newTestAuditLog(t)
+
+	entry.Actor = "someone-else@example.com"
+	if err := db.WithContext(ctx).Save(&entry).Error; !errors.Is(err, errAuditLogAppendOnly) {
+		t.Errorf("save: %v, want %v", err, errAuditLogAppendOnly)
+	}
+	if err := db.WithContext(ctx).Delete(&entry).Error; !errors.Is(err, errAuditLogAppendOnly) {
+		t.Errorf("delete: %v, want %v", err, errAuditLogAppendOnly)
+	}
+}
+
+func TestAuditLogTriggersRejectRawSQL(t *testing.T) {
+	newTestApp(t)
+	_, entry := newTestAuditLog(t)
+
+	if err := db.Exec("UPDATE audit_logs SET actor = ? WHERE id = ?", "someone-else@example.com", entry.ID).Error; err == nil {
+		t.Error("raw UPDATE of audit_logs succeeded")
+	}
+	if err := db.Exec("DELETE FROM audit_logs WHERE id = ?", entry.ID).Error; err == nil {
+		t.Error("raw DELETE of audit_logs succeeded")
+	}
+
+	var actor string
+	if err := db.Table("audit_logs").Where("id = ?", entry.ID).Select("actor").Row().Scan(&actor); err != nil {
+		t.Fatal(err)
+	}
+	if actor != entry.Actor {
+		t.Errorf("actor is %q after rejected changes, want %q", actor, entry.Actor)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name    string
+		proxies string
+		remote  string
+		xff     []string
+		want    string
+	}{
+		{"no proxies ignores header", "", "203.0.113.7:5000", []string{"198.51.100.1"}, "203.0.113.7"},
+		{"untrusted peer ignores header", "10.0.0.0/8", "203.0.113.7:5000", []string{"198.51.100.1"}, "203.0.113.7"},
+		{"trusted proxy", "10.0.0.0/8", "10.0.0.2:5000", []string{"198.51.100.1"}, "198.51.100.1"},
+		{"spoofed leftmost hop", "10.0.0.0/8", "10.0.0.2:5000", []string{"1.2.3.4, 198.51.100.1"}, "198.51.100.1"},
+		{"chained trusted proxies", "10.0.0.0/8,127.0.0.1", "127.0.0.1:5000", []string{"1.2.3.4", "198.51.100.1, 10.0.0.3"}, "198.51.100.1"},
+		{"garbage stops the walk", "10.0.0.0/8", "10.0.0.2:5000", []string{"198.51.100.1, not-an-ip, 10.0.0.3"}, "10.0.0.3"},
+		{"only trusted hops", "10.0.0.0/8", "10.0.0.2:5000", []string{"10.0.0.3"}, "10.0.0.3"},
+		{"ipv4-mapped peer", "10.0.0.0/8", "[::ffff:10.0.0.2]:5000", []string{"198.51.100.1"}, "198.51.100.1"},
+	}
+	defer func(saved []netip.Prefix) { trustedProxies = saved }(trustedProxies)
+	for _, test := range tests {
+		proxies, err := parseTrustedProxies(test.proxies)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		trustedProxies = proxies
+
+		r := httptest.NewRequest(http.MethodPost, "/api/bookings/create", nil)
+		r.RemoteAddr = test.remote
+		for _, value := range test.xff {
+			r.Header.Add("X-Forwarded-For", value)
+		}
+		if got := clientIP(r); got != test.want {
+			t.Errorf("%s: clientIP = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestParseTrustedProxiesRejectsInvalidEntries(t *testing.T) {
+	for _, value := range []string{"10.0.0.0/33", "proxy.internal", "10.0.0.1,nope"} {
+		if _, err := parseTrustedProxies(value); err == nil {
+			t.Errorf("parseTrustedProxies(%q) succeeded", value)
+		}
+	}
+}
+
+func TestAuditLogsAreScopedToTenant(t *testing.T) {
+	handler := newTestApp(t)
+	t.Setenv("ADMIN_TOKEN", "admin")
+	newTestTenant(t, "tenant-b", "key-b")
+	id := createBooking(t, handler, exampleBooking(t), nil)
+
+	query := map[string]interface{}{"entity_type": "booking", "entity_id": id}
+	for name, test := range map[string]struct {
+		headers map[string]string
+		want    int
+	}{
+		"default tenant": {map[string]string{"X-Admin-Token": "admin"}, 1},
+		"tenant b":       {map[string]string{"X-Admin-Token": "admin", "X-API-Key": "key-b"}, 0},
+	} {
+		w := call(t, handler, http.MethodPost, "/api/admin/audit-logs", query, test.headers)
+		var logs []struct {
+			Action string `json:"action"`
+		}
+		decode(t, w, &logs)
+		if len(logs) != test.want {
+			t.Errorf("%s sees %d audit entries for the booking, want %d", name, len(logs), test.want)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"os"
+	"strings"
+	"time"
+
+	"backend.travel.intercogni.com/packages/mailer"
+	"gorm.io/gorm"
+)
+
+const (
+	magicLinkTTL = 15 * time.Minute
+	sessionTTL   = 30 * 24 * time.Hour
+)
+
+var appMailer mailer.Mailer
+
+type sessionContextKey struct{}
+
+type User struct {
+	Email        string `gorm:"column:github_email;primaryKey;type:varchar(100)"`
+	Name         string `gorm:"type:varchar(100)"`
+	RegisteredAt time.Time
+	LastLogin    time.Time
+}
+
+type MagicLinkToken struct {
+	ID        uint      `gorm:"primaryKey"`
+	TokenHash string    `gorm:"type:varchar(64);uniqueIndex;not null"`
+	Email     string    `gorm:"type:varchar(100);not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type Session struct {
+	ID        uint      `gorm:"primaryKey"`
+	TokenHash string    `gorm:"type:varchar(64);uniqueIndex;not null"`
+	Email     string    `gorm:"type:varchar(100);not null;index"`
+	ExpiresAt time.Time `gorm:"not null"`
+	CreatedAt time.Time
+}
+
+func newMailerFromEnv() mailer.Mailer {
+	from := envOr("MAIL_FROM", "no-reply@travel.intercogni.com")
+	if os.Getenv("MAILER") == "smtp" {
+		return mailer.SMTPMailer{
+			Host:     os.Getenv("SMTP_HOST"),
+			Port:     envOr("SMTP_PORT", "587"),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     from,
+		}
+	}
+	return mailer.FileMailer{Dir: envOr("MAIL_OUTBOX_DIR", "outbox"), From: from}
+}
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func newToken() (token, hash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(buf)
+	return token, hashToken(token), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func withSession(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if ok && token != "" {
+			var session Session
+			if err := db.Where("token_hash = ? AND expires_at > ?", hashToken(token), time.Now()).First(&session).Error; err == nil {
+				r = r.WithContext(context.WithValue(r.Context(), sessionContextKey{}, session))
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requireSession rejects requests without a valid session token.
+func requireSession(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := sessionFromContext(r.Context()); !ok {
+			http.Error(w, "not logged in", http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func sessionFromContext(ctx context.Context) (Session, bool) {
+	session, ok := ctx.Value(sessionContextKey{}).(Session)
+	return session, ok
+}
+
+func requestMagicLink(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Email string `json:"email"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	address, err := mail.ParseAddress(request.Email)
+	if err != nil {
+		http.Error(w, "invalid email address", http.StatusBadRequest)
+		return
+	}
+	email := strings.ToLower(address.Address)
+
+	token, hash, err := newToken()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	loginToken := MagicLinkToken{
+		TokenHash: hash,
+		Email:     email,
+		ExpiresAt: time.Now().Add(magicLinkTTL),
+	}
+	if err := db.Create(&loginToken).Error; err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	link := envOr("MAGIC_LINK_BASE_URL", "http://localhost:3000/login/verify") + "?token=" + token
+	message := mailer.Message{
+		To:      email,
+		Subject: "Your travel.intercogni.com login link",
+		Body: fmt.Sprintf("Use the link below to log in. It expires in %d minutes and can only be used once.\n\n%s\n\nIf you did not request this, you can ignore this email.\n",
+			int(magicLinkTTL.Minutes()), link),
+	}
+	if err := appMailer.Send(r.Context(), message); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func verifyMagicLink(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Token string `json:"token"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		http.Error(w, tx.Error.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	hash := hashToken(request.Token)
+
+	result := tx.Model(&MagicLinkToken{}).
+		Where("token_hash = ? AND used_at IS NULL AND expires_at > ?", hash, now).
+		Update("used_at", now)
+	if result.Error != nil {
+		http.Error(w, result.Error.Error(), http.StatusInternalServerError)
+		return
+	}
+	if result.RowsAffected != 1 {
+		http.Error(w, "invalid or expired token", http.StatusUnauthorized)
+		return
+	}
+
+	var loginToken MagicLinkToken
+	if err := tx.Where("token_hash = ?", hash).First(&loginToken).Error; err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var user User
+	err := tx.First(&user, "github_email = ?", loginToken.Email).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		user = User{Email: loginToken.Email, RegisteredAt: now}
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	user.LastLogin = now
+	if err := tx.Save(&user).Error; err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	token, sessionHash, err := newToken()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	session := Session{
+		TokenHash: sessionHash,
+		Email:     user.Email,
+		ExpiresAt: now.Add(sessionTTL),
+	}
+	if err := tx.Create(&session).Error; err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := struct {
+		SessionToken string    `json:"session_token"`
+		Email        string    `json:"email"`
+		ExpiresAt    time.Time `json:"expires_at"`
+	}{
+		SessionToken: token,
+		Email:        session.Email,
+		ExpiresAt:    session.ExpiresAt,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func logout(w http.ResponseWriter, r *http.Request) {
+	session, ok := sessionFromContext(r.Context())
+	if !ok {
+		http.Error(w, "not logged in", http.StatusUnauthorized)
+		return
+	}
+
+	if err := db.Delete(&Session{}, session.ID).Error; err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"backend.travel.intercogni.com/packages/mailer"
+)
+
+var magicLinkToken = regexp.MustCompile(`\?token=([A-Za-z0-9_-]+)`)
+
+// requestLoginLink asks for a magic link for email and returns the token
+// from the message the file mailer wrote.
+func requestLoginLink(t *testing.T, handler http.Handler, email string) string {
+	t.Helper()
+	outbox := appMailer.(mailer.FileMailer).Dir
+	os.RemoveAll(outbox)
+
+	w := call(t, handler, http.MethodPost, "/api/auth/magic-link/request", map[string]string{"email": email}, nil)
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("request magic link: %d %s", w.Code, w.Body.String())
+	}
+	entries, err := os.ReadDir(outbox)
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("outbox holds %d messages (%v), want 1", len(entries), err)
+	}
+	message, err := os.ReadFile(filepath.Join(outbox, entries[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	match := magicLinkToken.FindSubmatch(message)
+	if match == nil {
+		t.Fatalf("no token in message:\n%s", message)
+	}
+	return string(match[1])
+}
+
+func verifyLoginLink(t *testing.T, handler http.Handler, token string) (int, string) {
+	t.Helper()
+	w := call(t, handler, http.MethodPost, "/api/auth/magic-link/verify", map[string]string{"token": token}, nil)
+	if w.Code != http.StatusOK {
+		return w.Code, ""
+	}
+	var response struct {
+		SessionToken string `json:"session_token"`
+		Email        string `json:"email"`
+	}
+	decode(t, w, &response)
+	if response.Email != "traveler@example.com" {
+		t.Errorf("logged in as %q, want traveler@example.com", response.Email)
+	}
+	return w.Code, response.SessionToken
+}
+
+func TestMagicLinkWorksOnce(t *testing.T) {
+	handler := newTestApp(t)
+	token := requestLoginLink(t, handler, "Traveler@Example.com")
+
+	if code, session := verifyLoginLink(t, handler, token); code != http.StatusOK || session == "" {
+		t.Fatalf("first verify: %d, want 200 with a session", code)
+	}
+	if code, _ := verifyLoginLink(t, handler, token); code != http.StatusUnauthorized {
+		t.Errorf("second verify: %d, want 401", code)
+	}
+
+	var users int64
+	if err := db.Model(&User{}).Where("github_email = ?", "traveler@example.com").Count(&users).Error; err != nil || users != 1 {
+		t.Errorf("%d users for the login (%v), want 1", users, err)
+	}
+}
+
+func TestExpiredMagicLinkIsRejected(t *testing.T) {
+	handler := newTestApp(t)
+	token := requestLoginLink(t, handler, "traveler@example.com")
+	if err := db.Model(&MagicLinkToken{}).Where("token_hash = ?", hashToken(token)).
+		Update("expires_at", time.Now().Add(-time.Minute)).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	if code, _ := verifyLoginLink(t, handler, token); code != http.StatusUnauthorized {
+		t.Errorf("verify expired token: %d, want 401", code)
+	}
+	if code, _ := verifyLoginLink(t, handler, "not-a-token"); code != http.StatusUnauthorized {
+		t.Errorf("verify unknown token: %d, want 401", code)
+	}
+}
+
+func TestLogoutEndsSession(t *testing.T) {
+	handler := newTestApp(t)
+	_, session := verifyLoginLink(t, handler, requestLoginLink(t, handler, "traveler@example.com"))
+	bearer := map[string]string{"Authorization": "Bearer " + session}
+
+	var sessions int64
+	if err := db.Model(&Session{}).Where("token_hash = ?", hashToken(session)).Count(&sessions).Error; err != nil || sessions != 1 {
+		t.Fatalf("%d sessions stored after login (%v), want 1", sessions, err)
+	}
+
+	if w := call(t, handler, http.MethodPost, "/api/auth/logout", nil, bearer); w.Code != http.StatusNoContent {
+		t.Fatalf("logout: %d %s, want 204", w.Code, w.Body.String())
+	}
+	if err := db.Model(&Session{}).Where("token_hash = ?", hashToken(session)).Count(&sessions).Error; err != nil || sessions != 0 {
+		t.Errorf("%d sessions stored after logout (%v), want 0", sessions, err)
+	}
+	if w := call(t, handler, http.MethodPost, "/api/auth/logout", nil, bearer); w.Code != http.StatusUnauthorized {
+		t.Errorf("logout with the old token: %d, want 401", w.Code)
+	}
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"backend.travel.intercogni.com/packages/airports"
+	"backend.travel.intercogni.com/packages/cities"
+)
+
+const (
+	defaultCitySearchLimit = 20
+	maxCitySearchLimit     = 100
+)
+
+type cityAirport struct {
+	IATACode   string  `json:"iata_code"`
+	Name       string  `json:"name"`
+	DistanceKm float64 `json:"distance_km"`
+}
+
+type cityResult struct {
+	ID             int          `json:"id"`
+	Name           string       `json:"name"`
+	Country        string       `json:"country"`
+	CountryCode    string       `json:"country_code,omitempty"`
+	Lat            float64      `json:"lat"`
+	Long           float64      `json:"long"`
+	Altitude       float64      `json:"altitude"`
+	Score          int          `json:"score"`
+	MatchedOn      string       `json:"matched_on"`
+	NearestAirport *cityAirport `json:"nearest_airport"`
+}
+
+func nearestCityAirport(dataset *airports.Dataset, lat, long float64) *cityAirport {
+	match, ok := dataset.Nearest(lat, long, airports.DefaultFilter)
+	if !ok {
+		return nil
+	}
+	return &cityAirport{
+		IATACode:   match.Airport.IATACode,
+		Name:       match.Airport.Name,
+		DistanceKm: match.DistanceKm,
+	}
+}
+
+func newCityResult(city cities.City, dataset *airports.Dataset) cityResult {
+	result := cityResult{
+		ID:       city.ID,
+		Name:     city.City,
+		Country:  city.Country,
+		Lat:      city.Latitude,
+		Long:     city.Longitude,
+		Altitude: city.Altitude,
+	}
+	if country, ok := city.ResolveCountry(); ok {
+		result.CountryCode = country.Alpha2
+	}
+	result.NearestAirport = nearestCityAirport(dataset, city.Latitude, city.Longitude)
+	return result
+}
+
+// encodeCursor and decodeCursor turn a result offset into an opaque page
+// token. The cities dataset is embedded and never changes while the server
+// runs, so offsets stay valid between pages.
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, bool) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, false
+	}
+	offset, err := strconv.Atoi(string(raw))
+	if err != nil || offset < 0 {
+		return 0, false
+	}
+	return offset, true
+}
+
+func searchCities(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("q")
+	country := r.URL.Query().Get("country")
+	if query == "" && country == "" {
+		http.Error(w, "q or country is required", http.StatusBadRequest)
+		return
+	}
+
+	limit := defaultCitySearchLimit
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit <= 0 {
+			http.Error(w, "limit must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		if limit > maxCitySearchLimit {
+			limit = maxCitySearchLimit
+		}
+	}
+
+	offset := 0
+	if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+		var ok bool
+		if offset, ok = decodeCursor(cursor); !ok {
+			http.Error(w, "invalid cursor", http.StatusBadRequest)
+			return
+		}
+	}
+
+	matches := cities.Search(query, country)
+	if offset > len(matches) {
+		offset = len(matches)
+	}
+	page := matches[offset:]
+	if len(page) > limit {
+		page = page[:limit]
+	}
+
+	dataset := airportStore.Current()
+	response := struct {
+		Results    []cityResult `json:"results"`
+		Total      int          `json:"total"`
+		NextCursor string       `json:"next_cursor,omitempty"`
+	}{
+		Results: make([]cityResult, len(page)),
+		Total:   len(matches),
+	}
+	for i, match := range page {
+		response.Results[i] = newCityResult(match.City, dataset)
+		response.Results[i].Score = match.Score
+		response.Results[i].MatchedOn = match.MatchedOn
+	}
+	if next := offset + len(page); next < len(matches) {
+		response.NextCursor = encodeCursor(next)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// coordinateParam parses a required lat or long query parameter and checks it
+// is within limit degrees of zero.
+func coordinateParam(r *http.Request, name string, limit float64) (float64, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, fmt.Errorf("%s is required", name)
+	}
+	coordinate, err := strconv.ParseFloat(value, 64)
+	if err != nil || !(coordinate >= -limit && coordinate <= limit) {
+		return 0, fmt.Errorf("%s must be a number between %g and %g", name, -limit, limit)
+	}
+	return coordinate, nil
+}
+
+func reverseGeocode(w http.ResponseWriter, r *http.Request) {
+	lat, err := coordinateParam(r, "lat", 90)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	long, err := coordinateParam(r, "long", 180)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	match, ok := cities.Nearest(lat, long)
+	if !ok {
+		http.Error(w, "No city found", http.StatusNotFound)
+		return
+	}
+
+	type reverseCity struct {
+		ID          int     `json:"id"`
+		Name        string  `json:"name"`
+		Country     string  `json:"country"`
+		CountryCode string  `json:"country_code,omitempty"`
+		CountryName string  `json:"country_name,omitempty"`
+		Lat         float64 `json:"lat"`
+		Long        float64 `json:"long"`
+		DistanceKm  float64 `json:"distance_km"`
+	}
+	response := struct {
+		Lat            float64      `json:"lat"`
+		Long           float64      `json:"long"`
+		City           reverseCity  `json:"city"`
+		NearestAirport *cityAirport `json:"nearest_airport"`
+	}{
+		Lat:  lat,
+		Long: long,
+		City: reverseCity{
+			ID:         match.City.ID,
+			Name:       match.City.City,
+			Country:    match.City.Country,
+			Lat:        match.City.Latitude,
+			Long:       match.City.Longitude,
+			DistanceKm: match.DistanceKm,
+		},
+		NearestAirport: nearestCityAirport(airportStore.Current(), lat, long),
+	}
+	if country, ok := match.City.ResolveCountry(); ok {
+		response.City.CountryCode = country.Alpha2
+		response.City.CountryName = country.Name
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,16 @@
+package main
+
+import "fmt"
+
+func runCommand(name string, args []string) error {
+	switch name {
+	case "import-airports":
+		return runImportAirports(args)
+	case "generate-cities":
+		return runGenerateCities(args)
+	case "validate-cities":
+		return runValidateCities(args)
+	default:
+		return fmt.Errorf("unknown command %q", name)
+	}
+}
@@ -1,3 +1,17 @@
+CREATE TABLE tenants (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	slug VARCHAR NOT NULL UNIQUE,
+	name VARCHAR NOT NULL,
+	host VARCHAR,
+	api_key_hash VARCHAR,
+	currency VARCHAR NOT NULL DEFAULT 'USD',
+	contact_email VARCHAR,
+	created_at TIMESTAMP,
+	updated_at TIMESTAMP
+);
+
+INSERT INTO tenants (id, slug, name, currency) VALUES (1, 'default', 'Intercogni Travel', 'USD');
+
 CREATE TABLE users (
 	github_email VARCHAR PRIMARY KEY,
 	name VARCHAR,
@@ -7,6 +21,7 @@ CREATE TABLE users (
 
 CREATE TABLE bookings (
 	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	tenant_id INTEGER NOT NULL DEFAULT 1,
 	registrar_email VARCHAR,
 	outbound_trip_id INTEGER,
 	vacation_id INTEGER,
@@ -18,14 +33,18 @@ CREATE TABLE bookings (
 	end_date VARCHAR,
 	origin VARCHAR,
 	destination VARCHAR,
+	destination_city_id INTEGER NOT NULL DEFAULT 0,
 	created_at TIMESTAMP,
 	updated_at TIMESTAMP,
+	FOREIGN KEY (tenant_id) REFERENCES tenants(id),
 	FOREIGN KEY (registrar_email) REFERENCES users(github_email),
 	FOREIGN KEY (outbound_trip_id) REFERENCES trip(id),
 	FOREIGN KEY (inbound_trip_id) REFERENCES trip(id),
 	FOREIGN KEY (vacation_id) REFERENCES vacations(id)
 );
 
+CREATE INDEX idx_bookings_destination_city_id ON bookings (destination_city_id);
+
 CREATE TABLE bookings_people (
 	booking_id INTEGER,
 	person_id INTEGER,
@@ -36,6 +55,7 @@ CREATE TABLE bookings_people (
 
 CREATE TABLE people (
 	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	tenant_id INTEGER NOT NULL DEFAULT 1,
 	nationality VARCHAR,
 	passport_number VARCHAR,
 	first_name VARCHAR,
@@ -46,6 +66,7 @@ CREATE TABLE people (
 
 CREATE TABLE vacations (
 	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	tenant_id INTEGER NOT NULL DEFAULT 1,
 	city VARCHAR,
 	hotel_budget VARCHAR,
 	sightseeing_budget VARCHAR,
@@ -56,6 +77,7 @@ CREATE TABLE vacations (
 
 CREATE TABLE trips (
 	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	tenant_id INTEGER NOT NULL DEFAULT 1,
 	departure_feeder INTEGER,
 	trunk INTEGER,
 	arrival_feeder INTEGER,
@@ -69,6 +91,7 @@ CREATE TABLE trips (
 
 CREATE TABLE legs (
 	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	tenant_id INTEGER NOT NULL DEFAULT 1,
 	type VARCHAR,
 	budget VARCHAR,
 	origin_city VARCHAR,
@@ -78,4 +101,71 @@ CREATE TABLE legs (
 	price INTEGER,
 	created_at TIMESTAMP,
 	updated_at TIMESTAMP
-);
\ No newline at end of file
+);
+CREATE TABLE audit_logs (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	tenant_id INTEGER NOT NULL DEFAULT 1,
+	actor VARCHAR,
+	client_ip VARCHAR,
+	endpoint VARCHAR NOT NULL,
+	action VARCHAR NOT NULL,
+	entity_type VARCHAR NOT NULL,
+	entity_id INTEGER NOT NULL,
+	before TEXT,
+	after TEXT,
+	created_at TIMESTAMP
+);
+
+CREATE INDEX idx_audit_logs_entity ON audit_logs (entity_type, entity_id);
+CREATE INDEX idx_audit_logs_actor ON audit_logs (actor);
+
+CREATE TRIGGER audit_logs_no_update BEFORE UPDATE ON audit_logs
+BEGIN
+	SELECT RAISE(ABORT, 'audit_logs is append-only');
+END;
+
+CREATE TRIGGER audit_logs_no_delete BEFORE DELETE ON audit_logs
+BEGIN
+	SELECT RAISE(ABORT, 'audit_logs is append-only');
+END;
+
+CREATE TABLE magic_link_tokens (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	token_hash VARCHAR NOT NULL UNIQUE,
+	email VARCHAR NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	used_at TIMESTAMP,
+	created_at TIMESTAMP
+);
+
+CREATE TABLE sessions (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	token_hash VARCHAR NOT NULL UNIQUE,
+	email VARCHAR NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	created_at TIMESTAMP,
+	FOREIGN KEY (email) REFERENCES users(github_email)
+);
+
+CREATE TABLE airports (
+	id INTEGER PRIMARY KEY,
+	ident VARCHAR,
+	type VARCHAR NOT NULL,
+	name VARCHAR NOT NULL,
+	lat REAL,
+	long REAL,
+	elevation_ft REAL,
+	continent VARCHAR,
+	iso_country VARCHAR,
+	iso_region VARCHAR,
+	municipality VARCHAR,
+	scheduled_service NUMERIC,
+	gps_code VARCHAR,
+	iata_code VARCHAR,
+	local_code VARCHAR,
+	home_link VARCHAR,
+	wikipedia_link VARCHAR,
+	keywords TEXT
+);
+
+CREATE INDEX idx_airports_iata_code ON airports (iata_code);
@@ -0,0 +1,279 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"backend.travel.intercogni.com/packages/airports"
+	"backend.travel.intercogni.com/packages/cities"
+	"backend.travel.intercogni.com/packages/geo"
+	"gorm.io/gorm"
+)
+
+const (
+	// highAltitudeM is the altitude from which travellers are commonly
+	// advised to allow time to acclimatise.
+	highAltitudeM = 2500
+	// airportCityRadiusKm bounds how far from an airport the city it serves
+	// is looked for when its municipality is not a city name.
+	airportCityRadiusKm = 50
+	// unresolvedCityID marks a booking whose destination was looked up and
+	// matched no city, so the startup backfill does not look it up again.
+	// Bookings that were never looked up keep the column default, 0.
+	unresolvedCityID = -1
+)
+
+// placePoint locates a free-text place the way placeTimezone resolves it: a
+// city name that ranks clearly ahead of its namesakes, an airport or metro
+// code, or a metro area name.
+func placePoint(dataset *airports.Dataset, place string) *geo.Point {
+	if city, ok := cities.Rank(place, cities.Bias{}).Best(); ok {
+		return &geo.Point{Lat: city.Latitude, Long: city.Longitude}
+	}
+	if point := endpointPoint(dataset, place, 0, 0); point != nil {
+		return point
+	}
+	if metro, ok := airports.MetroForCity(place); ok {
+		return endpointPoint(dataset, metro.Code, 0, 0)
+	}
+	return nil
+}
+
+// destinationCityID resolves a booking destination to the city it refers
+// to, so bookings can be counted per city: a city name that ranks clearly
+// ahead of its namesakes, an airport by the city it serves, or a metro area
+// or code by the city it is named after. It returns unresolvedCityID when
+// none applies.
+func destinationCityID(dataset *airports.Dataset, place string) int {
+	if city, ok := cities.Rank(place, cities.Bias{}).Best(); ok {
+		return city.ID
+	}
+	if airport, ok := dataset.ByIATA(place); ok {
+		if city, ok := servedCity(airport); ok {
+			return city.ID
+		}
+		return unresolvedCityID
+	}
+	metro, ok := airports.MetroForCity(place)
+	if !ok {
+		metro, ok = airports.MetroByCode(place)
+	}
+	if ok {
+		if city, ok := cities.Rank(metro.Name, cities.Bias{Country: metro.Country}).Best(); ok {
+			return city.ID
+		}
+	}
+	return unresolvedCityID
+}
+
+// servedCity finds the city airport serves: its municipality or, when the
+// municipality is not a city name (CDG's is "Paris (Roissy-en-France,
+// Val-d'Oise)"), the most important city near the airport.
+func servedCity(airport airports.Airport) (cities.City, bool) {
+	point := &geo.Point{Lat: airport.Lat, Long: airport.Long}
+	if city, ok := cities.Rank(airport.City, cities.Bias{Country: airport.Country, Origin: point}).Best(); ok {
+		return city, true
+	}
+
+	var served cities.City
+	best := -1.0
+	for _, match := range cities.WithinRadius(airport.Lat, airport.Long, airportCityRadiusKm) {
+		if importance := cities.Importance(match.City); importance > best {
+			served, best = match.City, importance
+		}
+	}
+	return served, best >= 0
+}
+
+// backfillDestinationCities resolves the destination city of bookings
+// stored before the destination_city_id column existed. Destinations that
+// match no city are marked with unresolvedCityID, so each booking is looked
+// up at most once.
+//
+// It deliberately runs across all tenants: db.Table has no model schema, so
+// the tenant callbacks (see registerTenantCallbacks) leave it unscoped, and
+// startup has no tenant to scope to anyway.
+func backfillDestinationCities(db *gorm.DB) error {
+	var bookings []struct {
+		ID          uint
+		Destination string
+	}
+	if err := db.Table("bookings").Select("id, destination").Where("destination_city_id = 0").Scan(&bookings).Error; err != nil {
+		return err
+	}
+
+	dataset := airportStore.Current()
+	for _, booking := range bookings {
+		id := destinationCityID(dataset, booking.Destination)
+		if err := db.Table("bookings").Where("id = ?", booking.ID).Update("destination_city_id", id).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// destinationCity finds the city a profile is for, by id or by name. A name
+// shared by several cities is ranked with origin as the bias; when none
+// clearly wins the ranked candidates are returned instead.
+func destinationCity(r *http.Request, origin *geo.Point) (cities.City, []cityCandidate, bool) {
+	if value := r.URL.Query().Get("id"); value != "" {
+		id, _ := strconv.Atoi(value)
+		city, ok := cities.ByID(id)
+		return city, nil, ok
+	}
+
+	resolution := cities.Rank(r.URL.Query().Get("city"), cities.Bias{Country: r.URL.Query().Get("country"), Origin: origin})
+	if len(resolution.Candidates) == 0 {
+		return cities.City{}, nil, false
+	}
+	if city, ok := resolution.Best(); ok {
+		return city, nil, true
+	}
+	return cities.City{}, cityCandidates(resolution), true
+}
+
+func getDestinationProfile(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("id") == "" && strings.TrimSpace(r.URL.Query().Get("city")) == "" {
+		http.Error(w, "city or id is required", http.StatusBadRequest)
+		return
+	}
+	if value := r.URL.Query().Get("id"); value != "" {
+		if _, err := strconv.Atoi(value); err != nil {
+			http.Error(w, "id must be an integer", http.StatusBadRequest)
+			return
+		}
+	}
+
+	count := defaultCandidateCount
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		if count, err = strconv.Atoi(value); err != nil || count <= 0 {
+			http.Error(w, "limit must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		if count > maxCandidateCount {
+			count = maxCandidateCount
+		}
+	}
+	radiusKm := float64(defaultMaxRadiusKm)
+	if value := r.URL.Query().Get("radius_km"); value != "" {
+		var err error
+		if radiusKm, err = strconv.ParseFloat(value, 64); err != nil || !(radiusKm > 0) {
+			http.Error(w, "radius_km must be a positive number", http.StatusBadRequest)
+			return
+		}
+	}
+
+	dataset := airportStore.Current()
+
+	var origin *geo.Point
+	switch {
+	case r.URL.Query().Get("origin_lat") != "" || r.URL.Query().Get("origin_long") != "":
+		lat, err := coordinateParam(r, "origin_lat", 90)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		long, err := coordinateParam(r, "origin_long", 180)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		origin = &geo.Point{Lat: lat, Long: long}
+	case strings.TrimSpace(r.URL.Query().Get("origin")) != "":
+		if origin = placePoint(dataset, r.URL.Query().Get("origin")); origin == nil {
+			http.Error(w, "unknown origin", http.StatusUnprocessableEntity)
+			return
+		}
+	}
+
+	city, candidates, ok := destinationCity(r, origin)
+	if !ok {
+		http.Error(w, "City not found", http.StatusNotFound)
+		return
+	}
+	if len(candidates) > 0 {
+		response := struct {
+			Error      string          `json:"error"`
+			Candidates []cityCandidate `json:"city_candidates"`
+		}{
+			Error:      "city name is ambiguous; set country, id or origin",
+			Candidates: candidates,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	var bookingCount int64
+	if err := db.WithContext(r.Context()).Model(&Booking{}).
+		Where("destination_city_id = ?", city.ID).Count(&bookingCount).Error; err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type profileCountry struct {
+		Code        string `json:"code"`
+		Alpha3      string `json:"alpha3"`
+		Name        string `json:"name"`
+		Continent   string `json:"continent"`
+		Currency    string `json:"currency,omitempty"`
+		CallingCode string `json:"calling_code,omitempty"`
+	}
+	response := struct {
+		City struct {
+			ID           int     `json:"id"`
+			Name         string  `json:"name"`
+			Country      string  `json:"country"`
+			Lat          float64 `json:"lat"`
+			Long         float64 `json:"long"`
+			AltitudeM    float64 `json:"altitude_m"`
+			HighAltitude bool    `json:"high_altitude"`
+			Population   int     `json:"population,omitempty"`
+			Importance   float64 `json:"importance"`
+		} `json:"city"`
+		Country              *profileCountry    `json:"country,omitempty"`
+		Timezone             string             `json:"timezone,omitempty"`
+		Airports             []airportCandidate `json:"airports"`
+		DistanceFromOriginKm *float64           `json:"distance_from_origin_km,omitempty"`
+		BookingCount         int64              `json:"booking_count"`
+	}{
+		Timezone:     city.Timezone(),
+		Airports:     airportCandidates(dataset.KNearestWithin(city.Latitude, city.Longitude, count, radiusKm, airports.DefaultFilter)),
+		BookingCount: bookingCount,
+	}
+	response.City.ID = city.ID
+	response.City.Name = city.City
+	response.City.Country = city.Country
+	response.City.Lat = city.Latitude
+	response.City.Long = city.Longitude
+	response.City.AltitudeM = city.Altitude
+	response.City.HighAltitude = city.Altitude >= highAltitudeM
+	response.City.Population, _ = cities.Population(city)
+	response.City.Importance = cities.Importance(city)
+	if country, ok := city.ResolveCountry(); ok {
+		response.Country = &profileCountry{
+			Code:        country.Alpha2,
+			Alpha3:      country.Alpha3,
+			Name:        country.Name,
+			Continent:   country.ContinentName(),
+			Currency:    country.Currency,
+			CallingCode: country.CallingCode,
+		}
+	}
+	if origin != nil {
+		distance := geo.Haversine(origin.Lat, origin.Long, city.Latitude, city.Longitude)
+		response.DistanceFromOriginKm = &distance
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,517 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"backend.travel.intercogni.com/packages/airports"
+	"backend.travel.intercogni.com/packages/cities"
+	"backend.travel.intercogni.com/packages/countries"
+	"backend.travel.intercogni.com/packages/geo"
+	"backend.travel.intercogni.com/packages/timezones"
+)
+
+const (
+	defaultCandidateCount = 5
+	maxCandidateCount     = 20
+	defaultMaxRadiusKm    = 300
+
+	defaultGroundOnlyThresholdKm = 150
+
+	airportSelectionNearest = "nearest"
+	airportSelectionOptimal = "optimal"
+
+	defaultGroundCostPerKm = 0.25
+	defaultAirCostPerKm    = 0.10
+
+	itineraryModeAir    = "air"
+	itineraryModeGround = "ground"
+)
+
+var (
+	errNoEligibleAirport = errors.New("no eligible airport")
+	errUnknownCity       = errors.New("unknown city")
+)
+
+type generalInfoAirport struct {
+	City     string  `json:"city"`
+	Name     string  `json:"name"`
+	Lat      float64 `json:"lat"`
+	Long     float64 `json:"long"`
+	IATACode string  `json:"iata_code"`
+	Timezone string  `json:"timezone,omitempty"`
+	ToBefore float64 `json:"to_before"`
+	ToNext   float64 `json:"to_next"`
+}
+
+type generalInfoItinerary struct {
+	Mode             string            `json:"mode"`
+	GroundOnlyReason string            `json:"ground_only_reason,omitempty"`
+	DirectDistanceKm float64           `json:"direct_distance_km"`
+	TrunkDistanceKm  float64           `json:"trunk_distance_km,omitempty"`
+	Score            *airportPairScore `json:"score,omitempty"`
+	GroundLeg        *struct {
+		DistanceKm float64 `json:"distance_km"`
+	} `json:"ground_leg,omitempty"`
+}
+
+type airportPairScore struct {
+	OriginGroundKm        float64 `json:"origin_ground_km"`
+	TrunkKm               float64 `json:"trunk_km"`
+	DestinationGroundKm   float64 `json:"destination_ground_km"`
+	OriginGroundCost      float64 `json:"origin_ground_cost"`
+	TrunkCost             float64 `json:"trunk_cost"`
+	DestinationGroundCost float64 `json:"destination_ground_cost"`
+	TotalCost             float64 `json:"total_cost"`
+}
+
+func newAirportPairScore(score airports.PairScore) *airportPairScore {
+	return &airportPairScore{
+		OriginGroundKm:        score.OriginGroundKm,
+		TrunkKm:               score.TrunkKm,
+		DestinationGroundKm:   score.DestinationGroundKm,
+		OriginGroundCost:      score.OriginGroundCost,
+		TrunkCost:             score.TrunkCost,
+		DestinationGroundCost: score.DestinationGroundCost,
+		TotalCost:             score.TotalCost,
+	}
+}
+
+type airportFilterRequest struct {
+	RequireScheduledService *bool    `json:"require_scheduled_service,omitempty"`
+	RequireIATACode         *bool    `json:"require_iata_code,omitempty"`
+	MinType                 string   `json:"min_type,omitempty"`
+	Countries               []string `json:"countries,omitempty"`
+}
+
+func (f airportFilterRequest) filter() (airports.Filter, error) {
+	filter := airports.DefaultFilter
+	if f.RequireScheduledService != nil {
+		filter.RequireScheduledService = *f.RequireScheduledService
+	}
+	if f.RequireIATACode != nil {
+		filter.RequireIATACode = *f.RequireIATACode
+	}
+	if f.MinType != "" {
+		minType, err := airports.ParseType(f.MinType)
+		if err != nil {
+			return filter, err
+		}
+		filter.MinType = minType
+	}
+	for _, name := range f.Countries {
+		country, ok := countries.Lookup(name)
+		if !ok {
+			return filter, fmt.Errorf("unknown country %q", name)
+		}
+		filter.Countries = append(filter.Countries, country.Alpha2)
+	}
+	return filter, nil
+}
+
+type airportCandidate struct {
+	IATACode   string  `json:"iata_code"`
+	Name       string  `json:"name"`
+	City       string  `json:"city"`
+	Country    string  `json:"country"`
+	Lat        float64 `json:"lat"`
+	Long       float64 `json:"long"`
+	DistanceKm float64 `json:"distance_km"`
+}
+
+func airportCandidates(matches []airports.Match) []airportCandidate {
+	candidates := make([]airportCandidate, len(matches))
+	for i, match := range matches {
+		candidates[i] = airportCandidate{
+			IATACode:   match.Airport.IATACode,
+			Name:       match.Airport.Name,
+			City:       match.Airport.City,
+			Country:    match.Airport.Country,
+			Lat:        match.Airport.Lat,
+			Long:       match.Airport.Long,
+			DistanceKm: match.DistanceKm,
+		}
+	}
+	return candidates
+}
+
+type cityCandidate struct {
+	ID          int     `json:"id"`
+	City        string  `json:"city"`
+	Country     string  `json:"country"`
+	CountryCode string  `json:"country_code,omitempty"`
+	Lat         float64 `json:"lat"`
+	Long        float64 `json:"long"`
+	Importance  float64 `json:"importance"`
+	DistanceKm  float64 `json:"distance_km,omitempty"`
+}
+
+func cityCandidates(resolution cities.Resolution) []cityCandidate {
+	candidates := make([]cityCandidate, len(resolution.Candidates))
+	for i, candidate := range resolution.Candidates {
+		candidates[i] = cityCandidate{
+			ID:         candidate.City.ID,
+			City:       candidate.City.City,
+			Country:    candidate.City.Country,
+			Lat:        candidate.City.Latitude,
+			Long:       candidate.City.Longitude,
+			Importance: candidate.Importance,
+			DistanceKm: candidate.DistanceKm,
+		}
+		if country, ok := candidate.City.ResolveCountry(); ok {
+			candidates[i].CountryCode = country.Alpha2
+		}
+	}
+	return candidates
+}
+
+// endpointPoint returns where an endpoint is, from its coordinates or else
+// its airport or metro code, to bias city lookups at the other end.
+func endpointPoint(dataset *airports.Dataset, code string, lat, long float64) *geo.Point {
+	if lat != 0 || long != 0 {
+		return &geo.Point{Lat: lat, Long: long}
+	}
+	if members, ok := dataset.ResolveCode(code); ok {
+		return &geo.Point{Lat: members[0].Lat, Long: members[0].Long}
+	}
+	return nil
+}
+
+// geocodeEndpoint fills in lat/long from the cities dataset for an endpoint
+// that names a city but gives neither coordinates nor an airport code. Cities
+// sharing the name are ranked by importance and nearness to origin (nil when
+// unknown); when no candidate clearly wins nothing is filled in and the
+// ranked candidates are returned for the caller to choose from. Cities that
+// are metro areas are left to endpointAirports unless country points
+// elsewhere.
+func geocodeEndpoint(code, city, country string, origin *geo.Point, lat, long *float64) ([]cityCandidate, error) {
+	if strings.TrimSpace(code) != "" || strings.TrimSpace(city) == "" || *lat != 0 || *long != 0 {
+		return nil, nil
+	}
+
+	resolution := cities.Rank(city, cities.Bias{Country: country, Origin: origin})
+	switch {
+	case len(resolution.Candidates) == 1:
+		*lat, *long = resolution.Candidates[0].City.Latitude, resolution.Candidates[0].City.Longitude
+		return nil, nil
+	case len(resolution.Candidates) > 1:
+		if _, ok := cityMetro(city, country); ok && country == "" {
+			return nil, nil
+		}
+		if best, ok := resolution.Best(); ok {
+			*lat, *long = best.Latitude, best.Longitude
+			return nil, nil
+		}
+		return cityCandidates(resolution), nil
+	}
+	if _, ok := cityMetro(city, country); ok {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("%w %q", errUnknownCity, city)
+}
+
+// endpointCountryCode resolves which country an endpoint is in: the given
+// country when it is recognised, otherwise the country of the city it most
+// likely names.
+func endpointCountryCode(city, country string, origin *geo.Point) string {
+	if resolved, ok := countries.Lookup(country); ok {
+		return resolved.Alpha2
+	}
+	if strings.TrimSpace(country) != "" {
+		return ""
+	}
+	if best, ok := cities.Rank(city, cities.Bias{Origin: origin}).Best(); ok {
+		if resolved, ok := best.ResolveCountry(); ok {
+			return resolved.Alpha2
+		}
+	}
+	return ""
+}
+
+// endpointTimezone picks the IANA timezone for an endpoint. Without a known
+// country the nearest airport's country constrains the lookup instead.
+func endpointTimezone(countryCode string, lat, long float64, nearest airports.Match) string {
+	if countryCode == "" {
+		countryCode = nearest.Airport.Country
+	}
+	return timezones.Name(lat, long, countryCode)
+}
+
+// cityMetro returns the metro area a city name refers to, unless country
+// names a different country.
+func cityMetro(city, country string) (airports.Metro, bool) {
+	metro, ok := airports.MetroForCity(city)
+	if !ok || (strings.TrimSpace(country) != "" && !countries.Same(country, metro.Country)) {
+		return airports.Metro{}, false
+	}
+	return metro, true
+}
+
+// endpointAirports finds the airports serving one end of a trip. An explicit
+// IATA or metro code limits the search to those airports, as does a city
+// that is a known metro area when the endpoint lies within radiusKm of one
+// of its airports; otherwise the nearest eligible airports are used.
+func endpointAirports(dataset *airports.Dataset, code, city, country string, lat, long *float64, count int, radiusKm float64, filter airports.Filter) (airports.Match, []airports.Match, string, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		if metro, ok := cityMetro(city, country); ok {
+			members, _ := dataset.ResolveCode(metro.Code)
+			nearby := *lat == 0 && *long == 0
+			for _, member := range members {
+				if geo.Haversine(*lat, *long, member.Lat, member.Long) <= radiusKm {
+					nearby = true
+				}
+			}
+			if nearby {
+				code = metro.Code
+			}
+		}
+	}
+
+	if code == "" {
+		closest, ok := dataset.Nearest(*lat, *long, filter)
+		if !ok {
+			return airports.Match{}, nil, "", errNoEligibleAirport
+		}
+		return closest, dataset.KNearestWithin(*lat, *long, count, radiusKm, filter), "", nil
+	}
+
+	members, ok := dataset.ResolveCode(code)
+	if !ok {
+		return airports.Match{}, nil, "", fmt.Errorf("unknown airport or metro code %q", code)
+	}
+	if *lat == 0 && *long == 0 {
+		for _, member := range members {
+			*lat += member.Lat / float64(len(members))
+			*long += member.Long / float64(len(members))
+		}
+	}
+
+	matches := airports.RankByDistance(members, *lat, *long, filter)
+	if len(matches) == 0 {
+		return airports.Match{}, nil, "", errNoEligibleAirport
+	}
+
+	var metroCode string
+	if metro, ok := airports.MetroByCode(code); ok {
+		metroCode = metro.Code
+	}
+	return matches[0], matches, metroCode, nil
+}
+
+func endpointErrorStatus(err error) int {
+	if errors.Is(err, errNoEligibleAirport) || errors.Is(err, errUnknownCity) {
+		return http.StatusUnprocessableEntity
+	}
+	return http.StatusBadRequest
+}
+
+func updateGeneralInfo(w http.ResponseWriter, r *http.Request) {
+	var generalInfo struct {
+		Origin struct {
+			Country     string  `json:"country"`
+			CountryCode string  `json:"country_code,omitempty"`
+			State       string  `json:"state"`
+			City        string  `json:"city"`
+			AirportCode string  `json:"airport_code,omitempty"`
+			Metro       string  `json:"metro,omitempty"`
+			Timezone    string  `json:"timezone,omitempty"`
+			Lat         float64 `json:"lat"`
+			Long        float64 `json:"long"`
+			ToNext      float64 `json:"to_next"`
+		} `json:"origin"`
+		OriginAirport      generalInfoAirport `json:"origin_airport"`
+		DestinationAirport generalInfoAirport `json:"destination_airport"`
+		Destination        struct {
+			Country     string  `json:"country"`
+			CountryCode string  `json:"country_code,omitempty"`
+			State       string  `json:"state"`
+			City        string  `json:"city"`
+			AirportCode string  `json:"airport_code,omitempty"`
+			Metro       string  `json:"metro,omitempty"`
+			Timezone    string  `json:"timezone,omitempty"`
+			Lat         float64 `json:"lat"`
+			Long        float64 `json:"long"`
+			ToBefore    float64 `json:"to_before"`
+		} `json:"destination"`
+		AirportFilter         airportFilterRequest `json:"airport_filter"`
+		CandidateCount        int                  `json:"candidate_count"`
+		MaxRadiusKm           float64              `json:"max_radius_km"`
+		GroundOnlyThresholdKm float64              `json:"ground_only_threshold_km"`
+		AirportSelection      string               `json:"airport_selection"`
+		CostPerKm             struct {
+			Ground float64 `json:"ground"`
+			Air    float64 `json:"air"`
+		} `json:"cost_per_km"`
+		Itinerary                    generalInfoItinerary `json:"itinerary"`
+		OriginAirportCandidates      []airportCandidate   `json:"origin_airport_candidates"`
+		DestinationAirportCandidates []airportCandidate   `json:"destination_airport_candidates"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&generalInfo); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if generalInfo.CandidateCount <= 0 {
+		generalInfo.CandidateCount = defaultCandidateCount
+	}
+	if generalInfo.CandidateCount > maxCandidateCount {
+		generalInfo.CandidateCount = maxCandidateCount
+	}
+	if generalInfo.MaxRadiusKm <= 0 {
+		generalInfo.MaxRadiusKm = defaultMaxRadiusKm
+	}
+	if generalInfo.GroundOnlyThresholdKm == 0 {
+		generalInfo.GroundOnlyThresholdKm = defaultGroundOnlyThresholdKm
+	}
+	if generalInfo.AirportSelection == "" {
+		generalInfo.AirportSelection = airportSelectionNearest
+	}
+	if generalInfo.AirportSelection != airportSelectionNearest && generalInfo.AirportSelection != airportSelectionOptimal {
+		http.Error(w, `airport_selection must be "nearest" or "optimal"`, http.StatusBadRequest)
+		return
+	}
+	if generalInfo.CostPerKm.Ground <= 0 {
+		generalInfo.CostPerKm.Ground = defaultGroundCostPerKm
+	}
+	if generalInfo.CostPerKm.Air <= 0 {
+		generalInfo.CostPerKm.Air = defaultAirCostPerKm
+	}
+
+	filter, err := generalInfo.AirportFilter.filter()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	dataset := airportStore.Current()
+
+	originCities, err := geocodeEndpoint(generalInfo.Origin.AirportCode, generalInfo.Origin.City, generalInfo.Origin.Country, nil,
+		&generalInfo.Origin.Lat, &generalInfo.Origin.Long)
+	if err != nil {
+		http.Error(w, "origin: "+err.Error(), endpointErrorStatus(err))
+		return
+	}
+	origin := endpointPoint(dataset, generalInfo.Origin.AirportCode, generalInfo.Origin.Lat, generalInfo.Origin.Long)
+	destinationCities, err := geocodeEndpoint(generalInfo.Destination.AirportCode, generalInfo.Destination.City, generalInfo.Destination.Country, origin,
+		&generalInfo.Destination.Lat, &generalInfo.Destination.Long)
+	if err != nil {
+		http.Error(w, "destination: "+err.Error(), endpointErrorStatus(err))
+		return
+	}
+	if len(originCities) > 0 || len(destinationCities) > 0 {
+		response := struct {
+			Error                 string          `json:"error"`
+			OriginCandidates      []cityCandidate `json:"origin_city_candidates,omitempty"`
+			DestinationCandidates []cityCandidate `json:"destination_city_candidates,omitempty"`
+		}{
+			Error:                 "city name is ambiguous; set country or lat/long",
+			OriginCandidates:      originCities,
+			DestinationCandidates: destinationCities,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	generalInfo.Origin.CountryCode = endpointCountryCode(generalInfo.Origin.City, generalInfo.Origin.Country, nil)
+	generalInfo.Destination.CountryCode = endpointCountryCode(generalInfo.Destination.City, generalInfo.Destination.Country, origin)
+
+	closestOriginAirport, originMatches, originMetro, err := endpointAirports(dataset, generalInfo.Origin.AirportCode, generalInfo.Origin.City, generalInfo.Origin.Country,
+		&generalInfo.Origin.Lat, &generalInfo.Origin.Long, generalInfo.CandidateCount, generalInfo.MaxRadiusKm, filter)
+	if err != nil {
+		http.Error(w, "origin: "+err.Error(), endpointErrorStatus(err))
+		return
+	}
+	closestDestinationAirport, destinationMatches, destinationMetro, err := endpointAirports(dataset, generalInfo.Destination.AirportCode, generalInfo.Destination.City, generalInfo.Destination.Country,
+		&generalInfo.Destination.Lat, &generalInfo.Destination.Long, generalInfo.CandidateCount, generalInfo.MaxRadiusKm, filter)
+	if err != nil {
+		http.Error(w, "destination: "+err.Error(), endpointErrorStatus(err))
+		return
+	}
+	generalInfo.Origin.Metro = originMetro
+	generalInfo.Destination.Metro = destinationMetro
+	generalInfo.Origin.Timezone = endpointTimezone(generalInfo.Origin.CountryCode, generalInfo.Origin.Lat, generalInfo.Origin.Long, closestOriginAirport)
+	generalInfo.Destination.Timezone = endpointTimezone(generalInfo.Destination.CountryCode, generalInfo.Destination.Lat, generalInfo.Destination.Long, closestDestinationAirport)
+	generalInfo.OriginAirportCandidates = airportCandidates(originMatches)
+	generalInfo.DestinationAirportCandidates = airportCandidates(destinationMatches)
+
+	costs := airports.Costs{GroundPerKm: generalInfo.CostPerKm.Ground, AirPerKm: generalInfo.CostPerKm.Air}
+	score := airports.ScorePair(closestOriginAirport, closestDestinationAirport, costs)
+	if generalInfo.AirportSelection == airportSelectionOptimal {
+		if len(originMatches) == 0 {
+			originMatches = []airports.Match{closestOriginAirport}
+		}
+		if len(destinationMatches) == 0 {
+			destinationMatches = []airports.Match{closestDestinationAirport}
+		}
+		if pair, ok := airports.BestPair(originMatches, destinationMatches, costs); ok {
+			closestOriginAirport, closestDestinationAirport, score = pair.Origin, pair.Destination, pair.Score
+		}
+	}
+
+	directDist := geo.Haversine(generalInfo.Origin.Lat, generalInfo.Origin.Long, generalInfo.Destination.Lat, generalInfo.Destination.Long)
+	generalInfo.Itinerary = generalInfoItinerary{DirectDistanceKm: directDist}
+
+	switch {
+	case closestOriginAirport.Airport.SameAs(closestDestinationAirport.Airport):
+		generalInfo.Itinerary.GroundOnlyReason = "origin and destination share the nearest airport"
+	case directDist < generalInfo.GroundOnlyThresholdKm:
+		generalInfo.Itinerary.GroundOnlyReason = fmt.Sprintf("trip is shorter than %g km", generalInfo.GroundOnlyThresholdKm)
+	}
+
+	originToAirportDist := score.OriginGroundKm
+	airportToAirportDist := score.TrunkKm
+	destinationToAirportDist := score.DestinationGroundKm
+
+	generalInfo.OriginAirport = generalInfoAirport{
+		City:     closestOriginAirport.Airport.City,
+		Name:     closestOriginAirport.Airport.Name,
+		Lat:      closestOriginAirport.Airport.Lat,
+		Long:     closestOriginAirport.Airport.Long,
+		IATACode: closestOriginAirport.Airport.IATACode,
+		Timezone: closestOriginAirport.Airport.Timezone(),
+		ToBefore: originToAirportDist,
+		ToNext:   airportToAirportDist,
+	}
+	generalInfo.DestinationAirport = generalInfoAirport{
+		City:     closestDestinationAirport.Airport.City,
+		Name:     closestDestinationAirport.Airport.Name,
+		Lat:      closestDestinationAirport.Airport.Lat,
+		Long:     closestDestinationAirport.Airport.Long,
+		IATACode: closestDestinationAirport.Airport.IATACode,
+		Timezone: closestDestinationAirport.Airport.Timezone(),
+		ToBefore: airportToAirportDist,
+		ToNext:   destinationToAirportDist,
+	}
+
+	// Ground-only trips keep the airport fields for existing clients;
+	// itinerary.mode tells them the airports are not on the route.
+	if generalInfo.Itinerary.GroundOnlyReason != "" {
+		generalInfo.Itinerary.Mode = itineraryModeGround
+		generalInfo.Itinerary.GroundLeg = &struct {
+			DistanceKm float64 `json:"distance_km"`
+		}{DistanceKm: directDist}
+		generalInfo.Origin.ToNext = directDist
+		generalInfo.Destination.ToBefore = directDist
+	} else {
+		generalInfo.Itinerary.Mode = itineraryModeAir
+		generalInfo.Itinerary.TrunkDistanceKm = airportToAirportDist
+		generalInfo.Itinerary.Score = newAirportPairScore(score)
+		generalInfo.Origin.ToNext = originToAirportDist
+		generalInfo.Destination.ToBefore = destinationToAirportDist
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(generalInfo); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+type generalInfoEndpoint struct {
+	CountryCode string  `json:"country_code"`
+	Metro       string  `json:"metro"`
+	Timezone    string  `json:"timezone"`
+	Lat         float64 `json:"lat"`
+	Long        float64 `json:"long"`
+	ToNext      float64 `json:"to_next"`
+	ToBefore    float64 `json:"to_before"`
+}
+
+type generalInfoResponse struct {
+	Origin             generalInfoEndpoint  `json:"origin"`
+	OriginAirport      generalInfoAirport   `json:"origin_airport"`
+	DestinationAirport generalInfoAirport   `json:"destination_airport"`
+	Destination        generalInfoEndpoint  `json:"destination"`
+	Itinerary          generalInfoItinerary `json:"itinerary"`
+}
+
+// postGeneralInfo posts body to the general info endpoint and decodes a
+// successful response.
+func postGeneralInfo(t *testing.T, handler http.Handler, body string) generalInfoResponse {
+	t.Helper()
+	w := call(t, handler, http.MethodPost, "/api/set-general-info", body, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("set general info: %d %s, want 200", w.Code, w.Body.String())
+	}
+	var response generalInfoResponse
+	decode(t, w, &response)
+	return response
+}
+
+func TestGeneralInfoGroundOnly(t *testing.T) {
+	handler := newTestApp(t)
+	tests := []struct {
+		name   string
+		body   string
+		reason string
+	}{
+		{
+			"shared airport",
+			`{"origin": {"city": "Amsterdam", "country": "Netherlands"}, "destination": {"city": "Den Haag", "country": "Netherlands"}}`,
+			"origin and destination share the nearest airport",
+		},
+		{
+			"below threshold",
+			`{"origin": {"city": "New York", "country": "United States"}, "destination": {"city": "Boston", "country": "United States"}, "ground_only_threshold_km": 400}`,
+			"trip is shorter than 400 km",
+		},
+	}
+	for _, test := range tests {
+		response := postGeneralInfo(t, handler, test.body)
+		itinerary := response.Itinerary
+		if itinerary.Mode != itineraryModeGround || itinerary.GroundOnlyReason != test.reason {
+			t.Errorf("%s: mode %q (%q), want %q (%q)", test.name, itinerary.Mode, itinerary.GroundOnlyReason, itineraryModeGround, test.reason)
+		}
+		if itinerary.Score != nil || itinerary.TrunkDistanceKm != 0 {
+			t.Errorf("%s: ground-only itinerary has a trunk: %+v", test.name, itinerary)
+		}
+		if itinerary.GroundLeg == nil || itinerary.GroundLeg.DistanceKm != itinerary.DirectDistanceKm || itinerary.DirectDistanceKm == 0 {
+			t.Errorf("%s: ground leg %+v, want the direct distance %g", test.name, itinerary.GroundLeg, itinerary.DirectDistanceKm)
+		}
+		if response.Origin.ToNext != itinerary.DirectDistanceKm || response.Destination.ToBefore != itinerary.DirectDistanceKm {
+			t.Errorf("%s: endpoint distances %g and %g, want %g", test.name, response.Origin.ToNext, response.Destination.ToBefore, itinerary.DirectDistanceKm)
+		}
+		// Existing clients still read the airport fields.
+		if response.OriginAirport.IATACode == "" || response.DestinationAirport.IATACode == "" {
+			t.Errorf("%s: airports missing: %q, %q", test.name, response.OriginAirport.IATACode, response.DestinationAirport.IATACode)
+		}
+	}
+}
+
+func TestGeneralInfoOptimalPair(t *testing.T) {
+	handler := newTestApp(t)
+	response := postGeneralInfo(t, handler,
+		`{"origin": {"city": "Paris", "country": "France"}, "destination": {"city": "Madrid", "country": "Spain"}, "airport_selection": "optimal"}`)
+
+	itinerary := response.Itinerary
+	if itinerary.Mode != itineraryModeAir || itinerary.GroundLeg != nil || itinerary.Score == nil {
+		t.Fatalf("itinerary %+v, want an air itinerary with a score", itinerary)
+	}
+	if response.Origin.Metro != "PAR" {
+		t.Errorf("origin metro %q, want PAR", response.Origin.Metro)
+	}
+	if response.OriginAirport.IATACode != "ORY" || response.DestinationAirport.IATACode != "MAD" {
+		t.Errorf("pair %s-%s, want ORY-MAD", response.OriginAirport.IATACode, response.DestinationAirport.IATACode)
+	}
+	score := itinerary.Score
+	if itinerary.TrunkDistanceKm != score.TrunkKm || response.OriginAirport.ToNext != score.TrunkKm {
+		t.Errorf("trunk distances %g and %g, want the score's %g", itinerary.TrunkDistanceKm, response.OriginAirport.ToNext, score.TrunkKm)
+	}
+	if response.Origin.ToNext != score.OriginGroundKm || response.Destination.ToBefore != score.DestinationGroundKm {
+		t.Errorf("ground distances %g and %g, want %g and %g", response.Origin.ToNext, response.Destination.ToBefore, score.OriginGroundKm, score.DestinationGroundKm)
+	}
+	if want := score.OriginGroundCost + score.TrunkCost + score.DestinationGroundCost; score.TotalCost != want {
+		t.Errorf("total cost %g, want %g", score.TotalCost, want)
+	}
+}
+
+func TestGeneralInfoMetroCode(t *testing.T) {
+	handler := newTestApp(t)
+	response := postGeneralInfo(t, handler, `{"origin": {"city": "Paris", "country": "France"}, "destination": {"airport_code": "LON"}}`)
+
+	if response.Destination.Metro != "LON" {
+		t.Errorf("destination metro %q, want LON", response.Destination.Metro)
+	}
+	switch response.DestinationAirport.IATACode {
+	case "LHR", "LGW", "LCY", "STN", "LTN", "SEN":
+	default:
+		t.Errorf("destination airport %q is not a London airport", response.DestinationAirport.IATACode)
+	}
+	if response.Itinerary.Mode != itineraryModeAir {
+		t.Errorf("mode %q, want %q", response.Itinerary.Mode, itineraryModeAir)
+	}
+}
+
+func TestGeneralInfoDegradesWhenCitiesDoNotResolve(t *testing.T) {
+	handler := newTestApp(t)
+	// Valencia is ambiguous without a country and Nowhere Springs is not in
+	// the dataset; the coordinates still place both ends.
+	response := postGeneralInfo(t, handler,
+		`{"origin": {"city": "Valencia", "lat": 39.47, "long": -0.376}, "destination": {"city": "Nowhere Springs", "lat": 52.37, "long": 4.90}}`)
+
+	for name, test := range map[string]struct {
+		endpoint  generalInfoEndpoint
+		lat, long float64
+		timezone  string
+	}{
+		"origin":      {response.Origin, 39.47, -0.376, "Europe/Madrid"},
+		"destination": {response.Destination, 52.37, 4.90, "Europe/Amsterdam"},
+	} {
+		if test.endpoint.Lat != test.lat || test.endpoint.Long != test.long {
+			t.Errorf("%s moved to %g, %g, want the given %g, %g", name, test.endpoint.Lat, test.endpoint.Long, test.lat, test.long)
+		}
+		if test.endpoint.CountryCode != "" {
+			t.Errorf("%s country_code %q, want none", name, test.endpoint.CountryCode)
+		}
+		// Without a country the nearest airport's country picks the zone.
+		if test.endpoint.Timezone != test.timezone {
+			t.Errorf("%s timezone %q, want %q", name, test.endpoint.Timezone, test.timezone)
+		}
+	}
+	if response.OriginAirport.IATACode == "" || response.DestinationAirport.IATACode == "" || response.Itinerary.Mode != itineraryModeAir {
+		t.Errorf("itinerary %s-%s (%s), want an air itinerary", response.OriginAirport.IATACode, response.DestinationAirport.IATACode, response.Itinerary.Mode)
+	}
+}
+
+func TestGeneralInfoAmbiguousCityReturnsCandidates(t *testing.T) {
+	handler := newTestApp(t)
+	w := call(t, handler, http.MethodPost, "/api/set-general-info",
+		`{"origin": {"city": "Valencia"}, "destination": {"city": "Madrid", "country": "Spain"}}`, nil)
+	if w.Code != http.StatusConflict {
+		t.Fatalf("ambiguous origin: %d %s, want 409", w.Code, w.Body.String())
+	}
+	var response struct {
+		OriginCandidates []struct {
+			CountryCode string `json:"country_code"`
+		} `json:"origin_city_candidates"`
+		DestinationCandidates []struct{} `json:"destination_city_candidates"`
+	}
+	decode(t, w, &response)
+	found := map[string]bool{}
+	for _, candidate := range response.OriginCandidates {
+		found[candidate.CountryCode] = true
+	}
+	if !found["ES"] || !found["VE"] {
+		t.Errorf("origin candidates in %v, want Spain and Venezuela among them", found)
+	}
+	if len(response.DestinationCandidates) != 0 {
+		t.Errorf("%d destination candidates for Madrid, Spain, want none", len(response.DestinationCandidates))
+	}
+}
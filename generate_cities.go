@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"backend.travel.intercogni.com/packages/cities"
+)
+
+func runGenerateCities(args []string) error {
+	flags := flag.NewFlagSet("generate-cities", flag.ContinueOnError)
+	in := flags.String("in", "packages/cities/cities.csv", "source CSV with id,country,city,latitude,longitude,altitude columns")
+	out := flags.String("out", "packages/cities/cities.csv.gz", "embedded dataset to write")
+	strict := flags.Bool("strict", true, "refuse to write a dataset that has validation errors; -strict=false writes it anyway")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	file, err := os.Open(*in)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	source, err := cities.ReadCSV(file)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *in, err)
+	}
+
+	errorCount := 0
+	for _, issue := range cities.Validate(source) {
+		if issue.Severity == cities.SeverityError {
+			errorCount++
+		}
+	}
+	if errorCount > 0 {
+		if *strict {
+			return fmt.Errorf("%s has %d validation errors; run validate-cities for details", *in, errorCount)
+		}
+		fmt.Printf("warning: %s has %d validation errors\n", *in, errorCount)
+	}
+
+	if err := writeCitiesDataset(*out, source); err != nil {
+		return err
+	}
+	fmt.Printf("wrote %d cities to %s\n", len(source), *out)
+	return nil
+}
+
+func writeCitiesDataset(path string, source []cities.City) error {
+	tmp := path + ".tmp"
+	file, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if err := cities.WriteDataset(file, source); err != nil {
+		file.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"backend.travel.intercogni.com/packages/airports"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type AirportRecord struct {
+	ID               uint   `gorm:"primaryKey"`
+	Ident            string `gorm:"type:varchar(10);index"`
+	Type             string `gorm:"type:varchar(20);not null"`
+	Name             string `gorm:"type:varchar(200);not null"`
+	Lat              float64
+	Long             float64
+	ElevationFt      *float64
+	Continent        string `gorm:"type:varchar(2)"`
+	ISOCountry       string `gorm:"type:varchar(2);index"`
+	ISORegion        string `gorm:"type:varchar(10)"`
+	Municipality     string `gorm:"type:varchar(100)"`
+	ScheduledService bool
+	GPSCode          string `gorm:"type:varchar(10)"`
+	IATACode         string `gorm:"type:varchar(3);index"`
+	LocalCode        string `gorm:"type:varchar(10)"`
+	HomeLink         string `gorm:"type:varchar(300)"`
+	WikipediaLink    string `gorm:"type:varchar(300)"`
+	Keywords         string `gorm:"type:text"`
+}
+
+func (AirportRecord) TableName() string {
+	return "airports"
+}
+
+func runImportAirports(args []string) error {
+	flags := flag.NewFlagSet("import-airports", flag.ContinueOnError)
+	in := flags.String("in", "airport_data.csv", "full OurAirports airports.csv to import")
+	types := flags.String("types", "large_airport", "comma-separated airport types to keep")
+	scheduled := flags.Bool("scheduled", true, "keep only airports with scheduled service")
+	requireIATA := flags.Bool("require-iata", true, "keep only airports with an IATA code")
+	out := flags.String("out", "csv", "output format: csv or sqlite")
+	csvPath := flags.String("csv", "large_airports.csv", "CSV file to write when -out=csv")
+	dbPath := flags.String("db", "database.sqlite", "SQLite database to write when -out=sqlite")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	options := airports.ImportOptions{
+		RequireScheduledService: *scheduled,
+		RequireIATACode:         *requireIATA,
+	}
+	for _, name := range strings.Split(*types, ",") {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+		t, err := airports.ParseType(name)
+		if err != nil {
+			return err
+		}
+		options.Types = append(options.Types, t)
+	}
+
+	file, err := os.Open(*in)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	kept, summary, err := airports.Import(file, options)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *in, err)
+	}
+
+	switch *out {
+	case "csv":
+		err = writeAirportsCSV(*csvPath, kept)
+	case "sqlite":
+		err = writeAirportsSQLite(*dbPath, kept)
+	default:
+		err = fmt.Errorf("unknown output format %q", *out)
+	}
+	if err != nil {
+		return err
+	}
+
+	printImportSummary(summary)
+	return nil
+}
+
+func writeAirportsCSV(path string, kept []airports.Airport) error {
+	tmp := path + ".tmp"
+	file, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if err := airports.WriteCSV(file, kept); err != nil {
+		file.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func writeAirportsSQLite(path string, kept []airports.Airport) error {
+	database, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		return err
+	}
+	if err := database.AutoMigrate(&AirportRecord{}); err != nil {
+		return err
+	}
+
+	records := make([]AirportRecord, len(kept))
+	for i, airport := range kept {
+		records[i] = AirportRecord{
+			ID:               uint(airport.ID),
+			Ident:            airport.Ident,
+			Type:             string(airport.Type),
+			Name:             airport.Name,
+			Lat:              airport.Lat,
+			Long:             airport.Long,
+			ElevationFt:      airport.ElevationFt,
+			Continent:        airport.Continent,
+			ISOCountry:       airport.Country,
+			ISORegion:        airport.Region,
+			Municipality:     airport.City,
+			ScheduledService: airport.ScheduledService,
+			GPSCode:          airport.GPSCode,
+			IATACode:         airport.IATACode,
+			LocalCode:        airport.LocalCode,
+			HomeLink:         airport.HomeLink,
+			WikipediaLink:    airport.WikipediaLink,
+			Keywords:         strings.Join(airport.Keywords, ", "),
+		}
+	}
+
+	return database.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&AirportRecord{}).Error; err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(records, 500).Error
+	})
+}
+
+func printImportSummary(summary airports.ImportSummary) {
+	fmt.Printf("rows read: %d\n", summary.Rows)
+	fmt.Printf("kept:      %d\n", summary.Kept)
+	for _, t := range sortedKeys(summary.KeptByType) {
+		fmt.Printf("  %-20s %d\n", t, summary.KeptByType[airports.Type(t)])
+	}
+	dropped := 0
+	for _, n := range summary.Dropped {
+		dropped += n
+	}
+	fmt.Printf("dropped:   %d\n", dropped)
+	for _, reason := range sortedKeys(summary.Dropped) {
+		fmt.Printf("  %-20s %d\n", reason, summary.Dropped[reason])
+	}
+}
+
+func sortedKeys[K ~string, V any](m map[K]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, string(k))
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -1,16 +1,16 @@
 package main
 
 import (
-	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
-	"math"
+	"log/slog"
 	"net/http"
 	"os"
-	"strconv"
+	"strings"
 	"time"
 
+	"backend.travel.intercogni.com/packages/countries"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/rs/cors"
 	"gorm.io/driver/sqlite"
@@ -20,25 +20,31 @@ import (
 var db *gorm.DB
 
 type Booking struct {
-	ID               uint     `gorm:"primaryKey"`
-	RegistrarEmail   string   `gorm:"type:varchar(100);not null"`
-	VacationDayCount float64  `gorm:"not null"`
-	TotalPrice       float64  `gorm:"not null"`
-	PricePerPax      float64  `gorm:"not null"`
-	StartDate        string   `gorm:"type:varchar(20);not null"`
-	EndDate          string   `gorm:"type:varchar(20);not null"`
-	OutboundTripID   uint     `gorm:"not null"`
-	InboundTripID    uint     `gorm:"not null"`
-	VacationID       uint     `gorm:"not null"`
-	Origin           string   `gorm:"type:varchar(100);not null"`
-	Destination      string   `gorm:"type:varchar(100);not null"`
-	People           []Person `gorm:"many2many:bookings_people;"`
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
+	ID               uint    `gorm:"primaryKey"`
+	TenantID         uint    `gorm:"not null;default:1;index"`
+	RegistrarEmail   string  `gorm:"type:varchar(100);not null"`
+	VacationDayCount float64 `gorm:"not null"`
+	TotalPrice       float64 `gorm:"not null"`
+	PricePerPax      float64 `gorm:"not null"`
+	StartDate        string  `gorm:"type:varchar(20);not null"`
+	EndDate          string  `gorm:"type:varchar(20);not null"`
+	OutboundTripID   uint    `gorm:"not null"`
+	InboundTripID    uint    `gorm:"not null"`
+	VacationID       uint    `gorm:"not null"`
+	Origin           string  `gorm:"type:varchar(100);not null"`
+	Destination      string  `gorm:"type:varchar(100);not null"`
+	// DestinationCityID is the cities dataset ID Destination resolved to
+	// when the booking was created, unresolvedCityID when it matched no city,
+	// or 0 for bookings not looked up yet (see backfillDestinationCities).
+	DestinationCityID int      `gorm:"not null;default:0;index"`
+	People            []Person `gorm:"many2many:bookings_people;"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
 }
 
 type Person struct {
 	ID             uint   `gorm:"primaryKey"`
+	TenantID       uint   `gorm:"not null;default:1;index"`
 	Nationality    string `gorm:"type:varchar(50);not null"`
 	PassportNumber string `gorm:"type:varchar(50);not null"`
 	FirstName      string `gorm:"type:varchar(50);not null"`
@@ -49,6 +55,7 @@ type Person struct {
 
 type Trip struct {
 	ID              uint    `gorm:"primaryKey"`
+	TenantID        uint    `gorm:"not null;default:1;index"`
 	DepartureFeeder uint    `gorm:"not null"`
 	Trunk           uint    `gorm:"not null"`
 	ArrivalFeeder   uint    `gorm:"not null"`
@@ -59,6 +66,7 @@ type Trip struct {
 
 type Leg struct {
 	ID              uint    `gorm:"primaryKey"`
+	TenantID        uint    `gorm:"not null;default:1;index"`
 	Type            string  `gorm:"type:varchar(50);not null"`
 	Budget          string  `gorm:"type:varchar(50);not null"`
 	OriginCity      string  `gorm:"type:varchar(100);not null"`
@@ -72,6 +80,7 @@ type Leg struct {
 
 type Vacation struct {
 	ID                uint    `gorm:"primaryKey"`
+	TenantID          uint    `gorm:"not null;default:1;index"`
 	City              string  `gorm:"type:varchar(100);not null"`
 	HotelBudget       string  `gorm:"type:varchar(50);not null"`
 	SightseeingBudget string  `gorm:"type:varchar(50);not null"`
@@ -81,72 +90,100 @@ type Vacation struct {
 }
 
 func main() {
-	var err error
-	db, err = gorm.Open(sqlite.Open("database.sqlite"), &gorm.Config{})
-	if err != nil {
-		panic("failed to connect database")
+	if len(os.Args) > 1 {
+		if err := runCommand(os.Args[1], os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
 	}
 
-	db.AutoMigrate(&Booking{}, &Person{}, &Trip{}, &Leg{}, &Vacation{})
+	slog.SetDefault(newLoggerFromEnv())
 
-	fmt.Printf("starting...\n")
+	var err error
+	if db, err = openDatabase("database.sqlite"); err != nil {
+		panic(err.Error())
+	}
 
-	mux := http.NewServeMux()
+	if trustedProxies, err = parseTrustedProxies(os.Getenv("TRUSTED_PROXIES")); err != nil {
+		panic(err.Error())
+	}
 
-	mux.HandleFunc("/api/bookings/create-complex", createComplexBooking)
-	mux.HandleFunc("/api/bookings/get-complex", getComplexBooking)
-	mux.HandleFunc("/api/bookings/delete", deleteBooking)
-	mux.HandleFunc("/api/set-general-info", updateGeneralInfo)
-	mux.HandleFunc("/api/bookings/get-all", getAllBookings)
+	if err := initAirports(); err != nil {
+		panic("failed to load airports: " + err.Error())
+	}
 
-	handler := cors.Default().Handler(mux)
-	http.ListenAndServe(":8080", handler)
-}
+	if err := backfillDestinationCities(db); err != nil {
+		panic("failed to backfill booking destination cities: " + err.Error())
+	}
 
-type Airport struct {
-	City     string
-	Name     string
-	Lat      float64
-	Long     float64
-	IATACode string
-}
+	appMailer = newMailerFromEnv()
 
-func haversine(lat1, lon1, lat2, lon2 float64) float64 {
-	const R = 6371 // Earth radius in kilometers
-	dLat := (lat2 - lat1) * math.Pi / 180.0
-	dLon := (lon2 - lon1) * math.Pi / 180.0
-	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1*math.Pi/180.0)*math.Cos(lat2*math.Pi/180.0)*math.Sin(dLon/2)*math.Sin(dLon/2)
-	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
-	return R * c
+	handler := cors.New(cors.Options{
+		AllowedMethods: []string{http.MethodGet, http.MethodPost, http.MethodDelete},
+		AllowedHeaders: []string{"Content-Type", "Authorization", "X-Admin-Token", "X-API-Key", "X-Request-ID"},
+		ExposedHeaders: []string{"X-Request-ID"},
+	}).Handler(newRouter())
+
+	slog.Info("starting server", "addr", ":8080")
+	if err := http.ListenAndServe(":8080", withRequestLogging(handler)); err != nil {
+		slog.Error("server stopped", "error", err)
+		os.Exit(1)
+	}
 }
 
-func loadAirports(filePath string) ([]Airport, error) {
-	file, err := os.Open(filePath)
+// openDatabase opens the SQLite database at path and brings it up to date:
+// tenant scoping, migrations, the audit log triggers and the default tenant.
+func openDatabase(path string) (*gorm.DB, error) {
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{Logger: newGormLogger()})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to connect database: %w", err)
 	}
-	defer file.Close()
 
-	reader := csv.NewReader(file)
-	records, err := reader.ReadAll()
-	if err != nil {
-		return nil, err
-	}
-
-	var airports []Airport
-	for _, record := range records[1:] {
-		lat, _ := strconv.ParseFloat(record[4], 64)
-		long, _ := strconv.ParseFloat(record[5], 64)
-		// fmt.Printf("Lat: %f, Long: %f\n", lat, long)
-		airports = append(airports, Airport{
-			City:     record[10],
-			Name:     record[3],
-			Lat:      lat,
-			Long:     long,
-			IATACode: record[13],
-		})
-	}
-	return airports, nil
+	if err := registerTenantCallbacks(db); err != nil {
+		return nil, fmt.Errorf("failed to register tenant callbacks: %w", err)
+	}
+
+	// A schema created from db_setup.sql does not always migrate cleanly; the
+	// server has always started regardless, so this stays a warning.
+	if err := db.AutoMigrate(&Tenant{}, &Booking{}, &Person{}, &Trip{}, &Leg{}, &Vacation{}, &AuditLog{}, &User{}, &MagicLinkToken{}, &Session{}); err != nil {
+		slog.Warn("migrate database", "error", err)
+	}
+
+	if err := createAuditTriggers(db); err != nil {
+		return nil, fmt.Errorf("failed to create audit triggers: %w", err)
+	}
+
+	if err := seedDefaultTenant(db); err != nil {
+		return nil, fmt.Errorf("failed to seed default tenant: %w", err)
+	}
+	return db, nil
+}
+
+// newRouter registers every API route behind the tenant and session
+// middleware.
+func newRouter() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api/bookings/create-complex", createComplexBooking)
+	mux.HandleFunc("/api/bookings/get-complex", getComplexBooking)
+	mux.HandleFunc("/api/bookings/delete", requireSession(deleteBooking))
+	mux.HandleFunc("/api/set-general-info", updateGeneralInfo)
+	mux.HandleFunc("/api/bookings/get-all", getAllBookings)
+	mux.HandleFunc("/api/admin/audit-logs", requireAdmin(getAuditLogs))
+	mux.HandleFunc("/api/auth/magic-link/request", requestMagicLink)
+	mux.HandleFunc("/api/auth/magic-link/verify", verifyMagicLink)
+	mux.HandleFunc("/api/auth/logout", logout)
+	mux.HandleFunc("/api/tenant", getTenantSettings)
+	mux.HandleFunc("/api/admin/tenants/create", requireAdmin(createTenant))
+	mux.HandleFunc("/api/admin/airports/reload", requireAdmin(reloadAirports))
+	mux.HandleFunc("GET /api/airports/search", searchAirports)
+	mux.HandleFunc("GET /api/airports/{iata}", getAirport)
+	mux.HandleFunc("GET /api/cities", searchCities)
+	mux.HandleFunc("GET /api/geo/reverse", reverseGeocode)
+	mux.HandleFunc("GET /api/destinations/profile", getDestinationProfile)
+
+	return withTenant(withSession(mux))
 }
 
 func deleteBooking(w http.ResponseWriter, r *http.Request) {
@@ -159,138 +196,45 @@ func deleteBooking(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := db.Delete(&Booking{}, request.BookingID).Error; err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	w.WriteHeader(http.StatusNoContent)
-}
-
-func updateGeneralInfo(w http.ResponseWriter, r *http.Request) {
-	var generalInfo struct {
-		Origin struct {
-			Country string  `json:"country"`
-			State   string  `json:"state"`
-			City    string  `json:"city"`
-			Lat     float64 `json:"lat"`
-			Long    float64 `json:"long"`
-			ToNext  float64 `json:"to_next"`
-		} `json:"origin"`
-		OriginAirport struct {
-			City     string  `json:"city"`
-			Name     string  `json:"name"`
-			Lat      float64 `json:"lat"`
-			Long     float64 `json:"long"`
-			IATACode string  `json:"iata_code"`
-			ToBefore float64 `json:"to_before"`
-			ToNext   float64 `json:"to_next"`
-		} `json:"origin_airport"`
-		DestinationAirport struct {
-			City     string  `json:"city"`
-			Name     string  `json:"name"`
-			Lat      float64 `json:"lat"`
-			Long     float64 `json:"long"`
-			IATACode string  `json:"iata_code"`
-			ToBefore float64 `json:"to_before"`
-			ToNext   float64 `json:"to_next"`
-		} `json:"destination_airport"`
-		Destination struct {
-			Country  string  `json:"country"`
-			State    string  `json:"state"`
-			City     string  `json:"city"`
-			Lat      float64 `json:"lat"`
-			Long     float64 `json:"long"`
-			ToBefore float64 `json:"to_before"`
-		} `json:"destination"`
-	}
-
-	if err := json.NewDecoder(r.Body).Decode(&generalInfo); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+	tx := db.WithContext(r.Context()).Begin()
+	if tx.Error != nil {
+		http.Error(w, tx.Error.Error(), http.StatusInternalServerError)
 		return
 	}
+	defer tx.Rollback()
 
-	fmt.Printf("%+v\n", generalInfo)
-
-	generalInfo.OriginAirport.Name = "lorem ipsum"
-	generalInfo.DestinationAirport.Name = "lorem ipsum"
-
-	airports, err := loadAirports("large_airports.csv")
-	if err != nil {
+	var booking Booking
+	if err := tx.Preload("People").First(&booking, request.BookingID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			http.Error(w, "Booking not found", http.StatusNotFound)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	originLat := generalInfo.Origin.Lat
-	originLong := generalInfo.Origin.Long
-	var closestOriginAirport Airport
-	minOriginDistance := math.MaxFloat64
-	for _, airport := range airports {
-		fmt.Printf("Checking airport: %s, %s\n", airport.City, airport.Name)
-		distance := haversine(originLat, originLong, airport.Lat, airport.Long)
-		if distance < minOriginDistance {
-			minOriginDistance = distance
-			closestOriginAirport = airport
-		}
+	session, _ := sessionFromContext(r.Context())
+	if !strings.EqualFold(booking.RegistrarEmail, session.Email) {
+		http.Error(w, "only the registrar can delete a booking", http.StatusForbidden)
+		return
 	}
 
-	destinationLat := generalInfo.Destination.Lat
-	destinationLong := generalInfo.Destination.Long
-	var closestDestinationAirport Airport
-	minDestinationDistance := math.MaxFloat64
-	for _, airport := range airports {
-		fmt.Printf("Checking airport: %s, %s\n", airport.City, airport.Name)
-		distance := haversine(destinationLat, destinationLong, airport.Lat, airport.Long)
-		if distance < minDestinationDistance {
-			minDestinationDistance = distance
-			closestDestinationAirport = airport
-		}
+	if err := tx.Delete(&Booking{}, request.BookingID).Error; err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
 
-	originToAirportDist := haversine(generalInfo.Origin.Lat, generalInfo.Origin.Long, closestOriginAirport.Lat, closestOriginAirport.Long)
-	airportToAirportDist := haversine(closestOriginAirport.Lat, closestOriginAirport.Long, closestDestinationAirport.Lat, closestDestinationAirport.Long)
-	destinationToAirportDist := haversine(generalInfo.Destination.Lat, generalInfo.Destination.Long, closestDestinationAirport.Lat, closestDestinationAirport.Long)
-
-	generalInfo.OriginAirport = struct {
-		City     string  `json:"city"`
-		Name     string  `json:"name"`
-		Lat      float64 `json:"lat"`
-		Long     float64 `json:"long"`
-		IATACode string  `json:"iata_code"`
-		ToBefore float64 `json:"to_before"`
-		ToNext   float64 `json:"to_next"`
-	}{
-		City:     closestOriginAirport.City,
-		Name:     closestOriginAirport.Name,
-		Lat:      closestOriginAirport.Lat,
-		Long:     closestOriginAirport.Long,
-		IATACode: closestOriginAirport.IATACode,
-		ToBefore: originToAirportDist,
-		ToNext:   airportToAirportDist,
-	}
-	generalInfo.DestinationAirport = struct {
-		City     string  `json:"city"`
-		Name     string  `json:"name"`
-		Lat      float64 `json:"lat"`
-		Long     float64 `json:"long"`
-		IATACode string  `json:"iata_code"`
-		ToBefore float64 `json:"to_before"`
-		ToNext   float64 `json:"to_next"`
-	}{
-		City:     closestDestinationAirport.City,
-		Name:     closestDestinationAirport.Name,
-		Lat:      closestDestinationAirport.Lat,
-		Long:     closestDestinationAirport.Long,
-		IATACode: closestDestinationAirport.IATACode,
-		ToBefore: airportToAirportDist,
-		ToNext:   destinationToAirportDist,
+	if err := recordAudit(tx, r, auditActionDelete, "booking", booking.ID, booking, nil); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(generalInfo); err != nil {
+	if err := tx.Commit().Error; err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
 
 func getAllBookings(w http.ResponseWriter, r *http.Request) {
@@ -304,7 +248,7 @@ func getAllBookings(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var bookings []Booking
-	if err := db.Where("registrar_email = ?", request.Email).Find(&bookings).Error; err != nil {
+	if err := db.WithContext(r.Context()).Where("registrar_email = ?", request.Email).Find(&bookings).Error; err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -401,12 +345,29 @@ func createComplexBooking(w http.ResponseWriter, r *http.Request) {
 		} `json:"persons"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&booking); err != nil {
-		log.Println("Error decoding booking:", err)
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	tx := db.Begin()
+	// Unknown nationalities are stored as sent, as they always were, and
+	// only reported back so clients can correct them.
+	var warnings []string
+	for _, person := range booking.Persons {
+		if nationalityCode(person.Nationality) == "" {
+			loggerFromContext(r.Context()).Warn("unknown nationality", "nationality", person.Nationality)
+			warnings = append(warnings, fmt.Sprintf("unknown nationality %q", person.Nationality))
+		}
+	}
+
+	places := []string{booking.Origin, booking.Destination,
+		booking.OutboundTrip.Trunk.OriginCity, booking.OutboundTrip.Trunk.DestinationCity,
+		booking.InboundTrip.Trunk.OriginCity, booking.InboundTrip.Trunk.DestinationCity}
+	if err := checkMetroCodes(places...); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tx := db.WithContext(r.Context()).Begin()
 	if tx.Error != nil {
 		http.Error(w, tx.Error.Error(), http.StatusInternalServerError)
 		return
@@ -421,14 +382,15 @@ func createComplexBooking(w http.ResponseWriter, r *http.Request) {
 	}
 
 	newBooking := Booking{
-		RegistrarEmail:   booking.RegistrarEmail,
-		VacationDayCount: booking.VacationDayCount,
-		TotalPrice:       booking.TotalPrice,
-		PricePerPax:      booking.PricePerPax,
-		StartDate:        booking.StartDate,
-		EndDate:          booking.EndDate,
-		Origin:           booking.Origin,
-		Destination:      booking.Destination,
+		RegistrarEmail:    booking.RegistrarEmail,
+		VacationDayCount:  booking.VacationDayCount,
+		TotalPrice:        booking.TotalPrice,
+		PricePerPax:       booking.PricePerPax,
+		StartDate:         booking.StartDate,
+		EndDate:           booking.EndDate,
+		Origin:            booking.Origin,
+		Destination:       booking.Destination,
+		DestinationCityID: destinationCityID(airportStore.Current(), booking.Destination),
 	}
 
 	if err := tx.Create(&newBooking).Error; err != nil {
@@ -436,8 +398,6 @@ func createComplexBooking(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	fmt.Printf("testing now\n")
-
 	for _, person := range booking.Persons {
 		var existingPerson Person
 		if err := tx.Where("nationality = ? AND passport_number = ? AND first_name = ? AND last_name = ?",
@@ -452,17 +412,19 @@ func createComplexBooking(w http.ResponseWriter, r *http.Request) {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 				return
 			}
+			if err := recordAudit(tx, r, auditActionCreate, "person", newPerson.ID, nil, newPerson); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
 			existingPerson = newPerson
 		}
 		if err := tx.Model(&newBooking).Association("People").Append(&existingPerson); err != nil {
+			loggerFromContext(r.Context()).Error("append person to booking", "booking_id", newBooking.ID, "error", err)
 			http.Error(w, err.Error(), http.StatusInternalServerError)
-			log.Println("Error appending person to booking:", err)
 			return
 		}
 	}
 
-	fmt.Printf("testing more\n")
-
 	var vacation Vacation
 	if err := tx.Where("city = ? AND hotel_budget = ? AND sightseeing_budget = ? AND total_price = ?",
 		booking.Vacation.City, booking.Vacation.HotelBudget, booking.Vacation.SightseeingBudget, booking.Vacation.TotalPrice).First(&vacation).Error; err != nil {
@@ -476,6 +438,10 @@ func createComplexBooking(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
+		if err := recordAudit(tx, r, auditActionCreate, "vacation", vacation.ID, nil, vacation); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
 	}
 
 	createLeg := func(legData struct {
@@ -502,6 +468,9 @@ func createComplexBooking(w http.ResponseWriter, r *http.Request) {
 			if err := tx.Create(&leg).Error; err != nil {
 				return leg, err
 			}
+			if err := recordAudit(tx, r, auditActionCreate, "leg", leg.ID, nil, leg); err != nil {
+				return leg, err
+			}
 		}
 		return leg, nil
 	}
@@ -548,6 +517,10 @@ func createComplexBooking(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if err := recordAudit(tx, r, auditActionCreate, "trip", outboundTrip.ID, nil, outboundTrip); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 
 	inboundTrip := Trip{
 		DepartureFeeder: inboundDepartureFeeder.ID,
@@ -559,6 +532,10 @@ func createComplexBooking(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if err := recordAudit(tx, r, auditActionCreate, "trip", inboundTrip.ID, nil, inboundTrip); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 
 	newBooking.OutboundTripID = outboundTrip.ID
 	newBooking.InboundTripID = inboundTrip.ID
@@ -569,12 +546,40 @@ func createComplexBooking(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := recordAudit(tx, r, auditActionCreate, "booking", newBooking.ID, nil, newBooking); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	response := struct {
+		BookingID     uint                `json:"booking_id"`
+		MetroAirports map[string][]string `json:"metro_airports,omitempty"`
+		Warnings      []string            `json:"warnings,omitempty"`
+	}{
+		BookingID:     newBooking.ID,
+		MetroAirports: metroAirports(places...),
+		Warnings:      warnings,
+	}
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// nationalityCode resolves a stored nationality such as "british" to its
+// ISO 3166-1 alpha-2 country code, or "" when it is not recognised.
+func nationalityCode(nationality string) string {
+	country, ok := countries.Nationality(nationality)
+	if !ok {
+		return ""
+	}
+	return country.Alpha2
 }
 
 func getComplexBooking(w http.ResponseWriter, r *http.Request) {
@@ -582,33 +587,38 @@ func getComplexBooking(w http.ResponseWriter, r *http.Request) {
 		BookingID uint `json:"booking_id"`
 	}
 
-	log.Printf("Request: %+v\n", request)
-
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
+	ctxDB := db.WithContext(r.Context())
+	tenant, _ := tenantFromContext(r.Context())
+
 	var booking Booking
-	if err := db.Preload("People").First(&booking, request.BookingID).Error; err != nil {
+	if err := ctxDB.Preload("People").First(&booking, request.BookingID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			http.Error(w, "Booking not found", http.StatusNotFound)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
 	var outboundTrip Trip
-	if err := db.First(&outboundTrip, booking.OutboundTripID).Error; err != nil {
+	if err := ctxDB.First(&outboundTrip, booking.OutboundTripID).Error; err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
 	var inboundTrip Trip
-	if err := db.First(&inboundTrip, booking.InboundTripID).Error; err != nil {
+	if err := ctxDB.First(&inboundTrip, booking.InboundTripID).Error; err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
 	var vacation Vacation
-	if err := db.First(&vacation, booking.VacationID).Error; err != nil {
+	if err := ctxDB.First(&vacation, booking.VacationID).Error; err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -623,7 +633,7 @@ func getComplexBooking(w http.ResponseWriter, r *http.Request) {
 		ToNext          float64 `json:"to_next"`
 	}, error) {
 		var leg Leg
-		if err := db.First(&leg, legID).Error; err != nil {
+		if err := ctxDB.First(&leg, legID).Error; err != nil {
 			return struct {
 				Type            string  `json:"type"`
 				Budget          string  `json:"budget"`
@@ -754,19 +764,24 @@ func getComplexBooking(w http.ResponseWriter, r *http.Request) {
 			} `json:"arrival_feeder"`
 			TotalPrice float64 `json:"total_price"`
 		} `json:"inbound_trip"`
-		TotalDays   float64 `json:"total_days"`
-		TotalPrice  float64 `json:"total_price"`
-		PricePerPax float64 `json:"price_per_pax"`
-		StartDate   string  `json:"start_date"`
-		EndDate     string  `json:"end_date"`
-		Origin      string  `json:"origin"`
-		Destination string  `json:"destination"`
-		Persons     []struct {
-			Nationality    string `json:"nationality"`
-			PassportNumber string `json:"passport_number"`
-			FirstName      string `json:"first_name"`
-			LastName       string `json:"last_name"`
+		TotalDays           float64 `json:"total_days"`
+		TotalPrice          float64 `json:"total_price"`
+		PricePerPax         float64 `json:"price_per_pax"`
+		Currency            string  `json:"currency"`
+		StartDate           string  `json:"start_date"`
+		EndDate             string  `json:"end_date"`
+		Origin              string  `json:"origin"`
+		OriginTimezone      string  `json:"origin_timezone,omitempty"`
+		Destination         string  `json:"destination"`
+		DestinationTimezone string  `json:"destination_timezone,omitempty"`
+		Persons             []struct {
+			Nationality     string `json:"nationality"`
+			NationalityCode string `json:"nationality_code,omitempty"`
+			PassportNumber  string `json:"passport_number"`
+			FirstName       string `json:"first_name"`
+			LastName        string `json:"last_name"`
 		} `json:"persons"`
+		MetroAirports map[string][]string `json:"metro_airports,omitempty"`
 	}{
 		RegistrarEmail: booking.RegistrarEmail,
 		OutboundTrip: struct {
@@ -851,40 +866,49 @@ func getComplexBooking(w http.ResponseWriter, r *http.Request) {
 			ArrivalFeeder:   inboundArrivalFeeder,
 			TotalPrice:      inboundTrip.TotalPrice,
 		},
-		TotalDays:   booking.VacationDayCount,
-		TotalPrice:  booking.TotalPrice,
-		PricePerPax: booking.PricePerPax,
-		StartDate:   booking.StartDate,
-		EndDate:     booking.EndDate,
-		Origin:      booking.Origin,
-		Destination: booking.Destination,
+		TotalDays:           booking.VacationDayCount,
+		TotalPrice:          booking.TotalPrice,
+		PricePerPax:         booking.PricePerPax,
+		Currency:            tenant.Currency,
+		StartDate:           booking.StartDate,
+		EndDate:             booking.EndDate,
+		Origin:              booking.Origin,
+		OriginTimezone:      placeTimezone(booking.Origin),
+		Destination:         booking.Destination,
+		DestinationTimezone: placeTimezone(booking.Destination),
 		Persons: func() []struct {
-			Nationality    string `json:"nationality"`
-			PassportNumber string `json:"passport_number"`
-			FirstName      string `json:"first_name"`
-			LastName       string `json:"last_name"`
+			Nationality     string `json:"nationality"`
+			NationalityCode string `json:"nationality_code,omitempty"`
+			PassportNumber  string `json:"passport_number"`
+			FirstName       string `json:"first_name"`
+			LastName        string `json:"last_name"`
 		} {
 			var persons []struct {
-				Nationality    string `json:"nationality"`
-				PassportNumber string `json:"passport_number"`
-				FirstName      string `json:"first_name"`
-				LastName       string `json:"last_name"`
+				Nationality     string `json:"nationality"`
+				NationalityCode string `json:"nationality_code,omitempty"`
+				PassportNumber  string `json:"passport_number"`
+				FirstName       string `json:"first_name"`
+				LastName        string `json:"last_name"`
 			}
 			for _, person := range booking.People {
 				persons = append(persons, struct {
-					Nationality    string `json:"nationality"`
-					PassportNumber string `json:"passport_number"`
-					FirstName      string `json:"first_name"`
-					LastName       string `json:"last_name"`
+					Nationality     string `json:"nationality"`
+					NationalityCode string `json:"nationality_code,omitempty"`
+					PassportNumber  string `json:"passport_number"`
+					FirstName       string `json:"first_name"`
+					LastName        string `json:"last_name"`
 				}{
-					Nationality:    person.Nationality,
-					PassportNumber: person.PassportNumber,
-					FirstName:      person.FirstName,
-					LastName:       person.LastName,
+					Nationality:     person.Nationality,
+					NationalityCode: nationalityCode(person.Nationality),
+					PassportNumber:  person.PassportNumber,
+					FirstName:       person.FirstName,
+					LastName:        person.LastName,
 				})
 			}
 			return persons
 		}(),
+		MetroAirports: metroAirports(booking.Origin, booking.Destination,
+			outboundTrunk.OriginCity, outboundTrunk.DestinationCity, inboundTrunk.OriginCity, inboundTrunk.DestinationCity),
 	}
 
 	w.Header().Set("Content-Type", "application/json")
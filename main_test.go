@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"backend.travel.intercogni.com/packages/airports"
+	"backend.travel.intercogni.com/packages/mailer"
+)
+
+// newTestApp points the package globals at a fresh database, the bundled
+// airports and a file mailer in a temporary directory, and returns the API
+// router. Tests using it must not run in parallel.
+func newTestApp(t *testing.T) http.Handler {
+	t.Helper()
+	dir := t.TempDir()
+
+	var err error
+	if db, err = openDatabase(filepath.Join(dir, "test.sqlite")); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	if airportStore, err = airports.NewStore("large_airports.csv"); err != nil {
+		t.Fatal(err)
+	}
+	appMailer = mailer.FileMailer{Dir: filepath.Join(dir, "outbox"), From: "test@example.com"}
+	trustedProxies = nil
+	return newRouter()
+}
+
+// call sends body, encoded as JSON unless it is already a string, and
+// returns the recorded response.
+func call(t *testing.T, handler http.Handler, method, path string, body interface{}, headers map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+	var reader bytes.Buffer
+	switch body := body.(type) {
+	case nil:
+	case string:
+		reader.WriteString(body)
+	default:
+		if err := json.NewEncoder(&reader).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := httptest.NewRequest(method, path, &reader)
+	for key, value := range headers {
+		r.Header.Set(key, value)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func decode(t *testing.T, w *httptest.ResponseRecorder, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(w.Body.Bytes(), v); err != nil {
+		t.Fatalf("decode %q: %v", w.Body.String(), err)
+	}
+}
+
+// exampleBooking loads booking_example.json as a map, so tests can change
+// single fields before posting it.
+func exampleBooking(t *testing.T) map[string]interface{} {
+	t.Helper()
+	data, err := os.ReadFile("booking_example.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var booking map[string]interface{}
+	if err := json.Unmarshal(data, &booking); err != nil {
+		t.Fatal(err)
+	}
+	return booking
+}
+
+// createBooking posts booking and returns the new booking's ID.
+func createBooking(t *testing.T, handler http.Handler, booking map[string]interface{}, headers map[string]string) uint {
+	t.Helper()
+	w := call(t, handler, http.MethodPost, "/api/bookings/create-complex", booking, headers)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create booking: %d %s", w.Code, w.Body.String())
+	}
+	var response struct {
+		BookingID uint `json:"booking_id"`
+	}
+	decode(t, w, &response)
+	return response.BookingID
+}
+
+// newTestSession stores a session for email and returns its bearer token.
+func newTestSession(t *testing.T, email string) string {
+	t.Helper()
+	token, hash, err := newToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Create(&Session{TokenHash: hash, Email: email, ExpiresAt: time.Now().Add(sessionTTL)}).Error; err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestCreateBookingWarnsOnUnknownNationality(t *testing.T) {
+	handler := newTestApp(t)
+	booking := exampleBooking(t)
+	persons := booking["persons"].([]interface{})
+	persons[0].(map[string]interface{})["nationality"] = "martian"
+
+	w := call(t, handler, http.MethodPost, "/api/bookings/create-complex", booking, nil)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create booking: %d %s, want 201", w.Code, w.Body.String())
+	}
+	var response struct {
+		BookingID uint     `json:"booking_id"`
+		Warnings  []string `json:"warnings"`
+	}
+	decode(t, w, &response)
+	if response.BookingID == 0 {
+		t.Error("no booking_id in the response")
+	}
+	if len(response.Warnings) != 1 || response.Warnings[0] != `unknown nationality "martian"` {
+		t.Errorf("warnings = %q, want only the unknown nationality", response.Warnings)
+	}
+}
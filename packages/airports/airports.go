@@ -0,0 +1,343 @@
+package airports
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"backend.travel.intercogni.com/packages/countries"
+	"backend.travel.intercogni.com/packages/geo"
+	"backend.travel.intercogni.com/packages/timezones"
+)
+
+type Airport struct {
+	ID               int
+	Ident            string
+	Type             Type
+	Name             string
+	Lat              float64
+	Long             float64
+	ElevationFt      *float64
+	Continent        string
+	Country          string
+	Region           string
+	City             string
+	ScheduledService bool
+	GPSCode          string
+	IATACode         string
+	LocalCode        string
+	HomeLink         string
+	WikipediaLink    string
+	Keywords         []string
+}
+
+// ResolveCountry returns the reference country for the airport's
+// iso_country code.
+func (a Airport) ResolveCountry() (countries.Country, bool) {
+	return countries.ByCode(a.Country)
+}
+
+// Timezone returns the IANA timezone name for the airport, or "" when none
+// is known.
+func (a Airport) Timezone() string {
+	return timezones.Name(a.Lat, a.Long, a.Country)
+}
+
+// SameAs reports whether a and b are the same airport. IATA codes can't
+// decide this, since every airport without one has an empty code; the
+// dataset id is used, then the ident, then name and position for files that
+// carry neither.
+func (a Airport) SameAs(b Airport) bool {
+	switch {
+	case a.ID != 0 && b.ID != 0:
+		return a.ID == b.ID
+	case a.Ident != "" && b.Ident != "":
+		return a.Ident == b.Ident
+	default:
+		return a.Name == b.Name && a.Lat == b.Lat && a.Long == b.Long
+	}
+}
+
+var requiredColumns = []string{"name", "latitude_deg", "longitude_deg"}
+
+// RowError reports a data row that could not be parsed.
+type RowError struct {
+	Line int
+	Err  error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+type rowReader struct {
+	csv     *csv.Reader
+	columns map[string]int
+}
+
+func newRowReader(r io.Reader) (*rowReader, error) {
+	reader := csv.NewReader(r)
+	// Ragged rows are left to parseAirport, which reads missing trailing
+	// fields as empty, so one short row can't abort a whole import.
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("empty airport dataset")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range requiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+	return &rowReader{csv: reader, columns: columns}, nil
+}
+
+// next returns io.EOF after the last row and a *RowError for a row that
+// doesn't parse; any other error means the file itself is unreadable.
+func (rr *rowReader) next() (Airport, error) {
+	record, err := rr.csv.Read()
+	if err != nil {
+		return Airport{}, err
+	}
+	airport, err := parseAirport(rr.columns, record)
+	if err != nil {
+		line, _ := rr.csv.FieldPos(0)
+		return Airport{}, &RowError{Line: line, Err: err}
+	}
+	return airport, nil
+}
+
+// Load reads an OurAirports-format CSV. Columns are matched by header name,
+// so trimmed or reordered exports load as long as name, latitude_deg and
+// longitude_deg are present.
+func Load(filePath string) ([]Airport, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	rows, err := newRowReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filePath, err)
+	}
+
+	var airports []Airport
+	for {
+		airport, err := rows.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filePath, err)
+		}
+		airports = append(airports, airport)
+	}
+	return airports, nil
+}
+
+func parseAirport(columns map[string]int, record []string) (Airport, error) {
+	field := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	lat, err := strconv.ParseFloat(field("latitude_deg"), 64)
+	if err != nil {
+		return Airport{}, fmt.Errorf("invalid latitude_deg: %w", err)
+	}
+	long, err := strconv.ParseFloat(field("longitude_deg"), 64)
+	if err != nil {
+		return Airport{}, fmt.Errorf("invalid longitude_deg: %w", err)
+	}
+
+	airport := Airport{
+		Ident:            field("ident"),
+		Type:             Type(field("type")),
+		Name:             field("name"),
+		Lat:              lat,
+		Long:             long,
+		Continent:        field("continent"),
+		Country:          field("iso_country"),
+		Region:           field("iso_region"),
+		City:             field("municipality"),
+		ScheduledService: field("scheduled_service") == "yes",
+		GPSCode:          field("gps_code"),
+		IATACode:         field("iata_code"),
+		LocalCode:        field("local_code"),
+		HomeLink:         field("home_link"),
+		WikipediaLink:    field("wikipedia_link"),
+	}
+	// Exports that went through pandas lose "NA" (North America) to an
+	// empty cell, so fall back to the country's continent.
+	if airport.Continent == "" {
+		if country, ok := airport.ResolveCountry(); ok {
+			airport.Continent = country.Continent
+		}
+	}
+	if id := field("id"); id != "" {
+		if airport.ID, err = strconv.Atoi(id); err != nil {
+			return Airport{}, fmt.Errorf("invalid id: %w", err)
+		}
+	}
+	if elevation := field("elevation_ft"); elevation != "" {
+		elevationFt, err := strconv.ParseFloat(elevation, 64)
+		if err != nil {
+			return Airport{}, fmt.Errorf("invalid elevation_ft: %w", err)
+		}
+		airport.ElevationFt = &elevationFt
+	}
+	for _, keyword := range strings.Split(field("keywords"), ",") {
+		if keyword = strings.TrimSpace(keyword); keyword != "" {
+			airport.Keywords = append(airport.Keywords, keyword)
+		}
+	}
+	return airport, nil
+}
+
+// Dataset is an immutable snapshot of the airports loaded from one file.
+type Dataset struct {
+	airports []Airport
+	index    *geo.Index
+	byIATA   map[string]int
+	search   []searchEntry
+	path     string
+	modTime  time.Time
+	loadedAt time.Time
+}
+
+func newDataset(path string) (*Dataset, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	airports, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+	byIATA := make(map[string]int, len(airports))
+	for i, airport := range airports {
+		if airport.IATACode == "" {
+			continue
+		}
+		if _, ok := byIATA[airport.IATACode]; !ok {
+			byIATA[airport.IATACode] = i
+		}
+	}
+	return &Dataset{
+		airports: airports,
+		index:    newIndex(airports),
+		byIATA:   byIATA,
+		search:   newSearchEntries(airports),
+		path:     path,
+		modTime:  info.ModTime(),
+		loadedAt: time.Now(),
+	}, nil
+}
+
+// All returns the airports in file order. The slice is shared between
+// readers and must not be modified.
+func (d *Dataset) All() []Airport {
+	return d.airports
+}
+
+func (d *Dataset) ByIATA(code string) (Airport, bool) {
+	i, ok := d.byIATA[strings.ToUpper(strings.TrimSpace(code))]
+	if !ok {
+		return Airport{}, false
+	}
+	return d.airports[i], true
+}
+
+func (d *Dataset) Len() int {
+	return len(d.airports)
+}
+
+func (d *Dataset) LoadedAt() time.Time {
+	return d.loadedAt
+}
+
+// Store holds the current Dataset and swaps it atomically on reload, so
+// readers never observe a partially loaded file.
+type Store struct {
+	path    string
+	current atomic.Pointer[Dataset]
+	mu      sync.Mutex
+}
+
+func NewStore(path string) (*Store, error) {
+	s := &Store{path: path}
+	if _, err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Store) Current() *Dataset {
+	return s.current.Load()
+}
+
+func (s *Store) Path() string {
+	return s.path
+}
+
+func (s *Store) Reload() (*Dataset, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dataset, err := newDataset(s.path)
+	if err != nil {
+		return nil, err
+	}
+	s.current.Store(dataset)
+	return dataset, nil
+}
+
+// Watch polls the dataset file every interval and reloads it when its
+// modification time changes, until ctx is cancelled. Reload failures are
+// passed to onError and the previous dataset stays in place.
+func (s *Store) Watch(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			info, err := os.Stat(s.path)
+			if err != nil {
+				onError(err)
+				continue
+			}
+			if info.ModTime().Equal(s.Current().modTime) {
+				continue
+			}
+			if _, err := s.Reload(); err != nil {
+				onError(err)
+			}
+		}
+	}
+}
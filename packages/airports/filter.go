@@ -0,0 +1,82 @@
+package airports
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Type string
+
+const (
+	TypeClosed        Type = "closed"
+	TypeBalloonport   Type = "balloonport"
+	TypeHeliport      Type = "heliport"
+	TypeSeaplaneBase  Type = "seaplane_base"
+	TypeSmallAirport  Type = "small_airport"
+	TypeMediumAirport Type = "medium_airport"
+	TypeLargeAirport  Type = "large_airport"
+)
+
+var typeRanks = map[Type]int{
+	TypeClosed:        0,
+	TypeBalloonport:   1,
+	TypeHeliport:      2,
+	TypeSeaplaneBase:  3,
+	TypeSmallAirport:  4,
+	TypeMediumAirport: 5,
+	TypeLargeAirport:  6,
+}
+
+func ParseType(s string) (Type, error) {
+	t := Type(strings.ToLower(strings.TrimSpace(s)))
+	if _, ok := typeRanks[t]; !ok {
+		return "", fmt.Errorf("unknown airport type %q", s)
+	}
+	return t, nil
+}
+
+// AtLeast reports whether t is as large as min. Unknown types rank below
+// every known one.
+func (t Type) AtLeast(min Type) bool {
+	rank, ok := typeRanks[t]
+	if !ok {
+		return false
+	}
+	return rank >= typeRanks[min]
+}
+
+type Filter struct {
+	RequireScheduledService bool
+	RequireIATACode         bool
+	MinType                 Type
+	Countries               []string
+}
+
+// DefaultFilter only accepts airports a trunk flight can actually be booked
+// to: an IATA code, scheduled passenger service and a real runway.
+var DefaultFilter = Filter{
+	RequireScheduledService: true,
+	RequireIATACode:         true,
+	MinType:                 TypeSmallAirport,
+}
+
+func (f Filter) Accepts(airport Airport) bool {
+	if f.RequireScheduledService && !airport.ScheduledService {
+		return false
+	}
+	if f.RequireIATACode && airport.IATACode == "" {
+		return false
+	}
+	if f.MinType != "" && !airport.Type.AtLeast(f.MinType) {
+		return false
+	}
+	if len(f.Countries) > 0 {
+		for _, country := range f.Countries {
+			if strings.EqualFold(country, airport.Country) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
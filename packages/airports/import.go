@@ -0,0 +1,170 @@
+package airports
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	DropUnparsable         = "unparsable row"
+	DropInvalidCoordinates = "invalid coordinates"
+	DropType               = "type not selected"
+	DropNoScheduledService = "no scheduled service"
+	DropMissingIATACode    = "missing iata_code"
+	DropInvalidIATACode    = "invalid iata_code"
+	DropDuplicateIATACode  = "duplicate iata_code"
+)
+
+var iataCodeFormat = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Columns is the OurAirports airports.csv header, in order.
+var Columns = []string{
+	"id", "ident", "type", "name", "latitude_deg", "longitude_deg", "elevation_ft",
+	"continent", "iso_country", "iso_region", "municipality", "scheduled_service",
+	"gps_code", "iata_code", "local_code", "home_link", "wikipedia_link", "keywords",
+}
+
+type ImportOptions struct {
+	Types                   []Type
+	RequireScheduledService bool
+	RequireIATACode         bool
+}
+
+type ImportSummary struct {
+	Rows       int
+	Kept       int
+	KeptByType map[Type]int
+	Dropped    map[string]int
+}
+
+// Import reads a full OurAirports CSV and keeps the rows that pass opts and
+// basic validation. Rows are never rejected silently: every dropped row is
+// counted under its reason in the summary.
+func Import(r io.Reader, opts ImportOptions) ([]Airport, ImportSummary, error) {
+	summary := ImportSummary{
+		KeptByType: make(map[Type]int),
+		Dropped:    make(map[string]int),
+	}
+
+	rows, err := newRowReader(r)
+	if err != nil {
+		return nil, summary, err
+	}
+
+	types := make(map[Type]bool, len(opts.Types))
+	for _, t := range opts.Types {
+		types[t] = true
+	}
+	seenIATA := make(map[string]bool)
+
+	var kept []Airport
+	for {
+		airport, err := rows.next()
+		if err == io.EOF {
+			break
+		}
+		var rowErr *RowError
+		if errors.As(err, &rowErr) {
+			summary.Rows++
+			summary.Dropped[DropUnparsable]++
+			continue
+		}
+		if err != nil {
+			return nil, summary, err
+		}
+		summary.Rows++
+
+		airport.IATACode = strings.ToUpper(airport.IATACode)
+		if reason := dropReason(airport, opts, types, seenIATA); reason != "" {
+			summary.Dropped[reason]++
+			continue
+		}
+
+		if airport.IATACode != "" {
+			seenIATA[airport.IATACode] = true
+		}
+		kept = append(kept, airport)
+		summary.Kept++
+		summary.KeptByType[airport.Type]++
+	}
+	return kept, summary, nil
+}
+
+func dropReason(airport Airport, opts ImportOptions, types map[Type]bool, seenIATA map[string]bool) string {
+	if !validCoordinates(airport.Lat, airport.Long) {
+		return DropInvalidCoordinates
+	}
+	if len(types) > 0 && !types[airport.Type] {
+		return DropType
+	}
+	if opts.RequireScheduledService && !airport.ScheduledService {
+		return DropNoScheduledService
+	}
+	if airport.IATACode == "" {
+		if opts.RequireIATACode {
+			return DropMissingIATACode
+		}
+		return ""
+	}
+	if !iataCodeFormat.MatchString(airport.IATACode) {
+		return DropInvalidIATACode
+	}
+	if seenIATA[airport.IATACode] {
+		return DropDuplicateIATACode
+	}
+	return ""
+}
+
+// validCoordinates rejects out-of-range values and the 0,0 placeholder some
+// rows carry instead of a real position.
+func validCoordinates(lat, long float64) bool {
+	if math.IsNaN(lat) || math.IsNaN(long) {
+		return false
+	}
+	if lat < -90 || lat > 90 || long < -180 || long > 180 {
+		return false
+	}
+	return lat != 0 || long != 0
+}
+
+// WriteCSV writes airports in the OurAirports column layout that Load reads.
+func WriteCSV(w io.Writer, airports []Airport) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(Columns); err != nil {
+		return err
+	}
+
+	for _, airport := range airports {
+		var id, elevation, scheduled string
+		if airport.ID != 0 {
+			id = strconv.Itoa(airport.ID)
+		}
+		if airport.ElevationFt != nil {
+			elevation = strconv.FormatFloat(*airport.ElevationFt, 'f', -1, 64)
+		}
+		scheduled = "no"
+		if airport.ScheduledService {
+			scheduled = "yes"
+		}
+
+		record := []string{
+			id, airport.Ident, string(airport.Type), airport.Name,
+			strconv.FormatFloat(airport.Lat, 'f', -1, 64),
+			strconv.FormatFloat(airport.Long, 'f', -1, 64),
+			elevation, airport.Continent, airport.Country, airport.Region, airport.City, scheduled,
+			airport.GPSCode, airport.IATACode, airport.LocalCode, airport.HomeLink, airport.WikipediaLink,
+			strings.Join(airport.Keywords, ", "),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
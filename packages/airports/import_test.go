@@ -0,0 +1,37 @@
+package airports
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestImportCountsRaggedRowsAsUnparsable(t *testing.T) {
+	in := "id,ident,type,name,latitude_deg,longitude_deg,iso_country,scheduled_service,iata_code\n" +
+		"1,EGLL,large_airport,London Heathrow Airport,51.4706,-0.461941,GB,yes,LHR\n" +
+		"2,XXXX,large_airport,Truncated Row\n" +
+		"3,KJFK,large_airport,John F Kennedy International Airport,40.639447,-73.779317,US,yes,JFK,trailing,fields\n" +
+		"4,KLAX,large_airport,Los Angeles International Airport,33.942501,-118.407997,US,yes,LAX\n"
+
+	kept, summary, err := Import(strings.NewReader(in), ImportOptions{
+		Types:                   []Type{TypeLargeAirport},
+		RequireScheduledService: true,
+		RequireIATACode:         true,
+	})
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	if summary.Rows != 4 {
+		t.Errorf("Rows = %d, want 4", summary.Rows)
+	}
+	if summary.Dropped[DropUnparsable] != 1 {
+		t.Errorf("Dropped[%q] = %d, want 1", DropUnparsable, summary.Dropped[DropUnparsable])
+	}
+
+	var codes []string
+	for _, airport := range kept {
+		codes = append(codes, airport.IATACode)
+	}
+	if got := strings.Join(codes, ","); got != "LHR,JFK,LAX" {
+		t.Errorf("kept %s, want LHR,JFK,LAX", got)
+	}
+}
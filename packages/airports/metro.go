@@ -0,0 +1,109 @@
+package airports
+
+import (
+	"sort"
+	"strings"
+
+	"backend.travel.intercogni.com/packages/geo"
+	"backend.travel.intercogni.com/packages/textnorm"
+)
+
+// Metro groups the airports that serve one metropolitan area under an IATA
+// city code, e.g. LON for Heathrow, Gatwick, Stansted, Luton and City. Only
+// city codes that are not also an airport's code are listed: IATA reuses IST,
+// BKK, DXB and others for both, and those must keep meaning the airport.
+type Metro struct {
+	Code     string
+	Name     string
+	Country  string
+	Airports []string
+	Aliases  []string
+}
+
+var metros = []Metro{
+	{Code: "LON", Name: "London", Country: "GB", Airports: []string{"LHR", "LGW", "STN", "LTN", "LCY", "SEN"}},
+	{Code: "NYC", Name: "New York", Country: "US", Airports: []string{"JFK", "LGA", "EWR"}, Aliases: []string{"New York City", "NYC"}},
+	{Code: "TYO", Name: "Tokyo", Country: "JP", Airports: []string{"HND", "NRT"}},
+	{Code: "PAR", Name: "Paris", Country: "FR", Airports: []string{"CDG", "ORY", "BVA"}},
+	{Code: "CHI", Name: "Chicago", Country: "US", Airports: []string{"ORD", "MDW"}},
+	{Code: "WAS", Name: "Washington", Country: "US", Airports: []string{"IAD", "DCA", "BWI"}, Aliases: []string{"Washington DC", "Washington D.C."}},
+	{Code: "MIL", Name: "Milan", Country: "IT", Airports: []string{"MXP", "LIN", "BGY"}, Aliases: []string{"Milano"}},
+	{Code: "ROM", Name: "Rome", Country: "IT", Airports: []string{"FCO", "CIA"}, Aliases: []string{"Roma"}},
+	{Code: "MOW", Name: "Moscow", Country: "RU", Airports: []string{"SVO", "DME", "VKO"}, Aliases: []string{"Moskva"}},
+	{Code: "STO", Name: "Stockholm", Country: "SE", Airports: []string{"ARN", "BMA", "NYO"}},
+	{Code: "OSA", Name: "Osaka", Country: "JP", Airports: []string{"KIX", "ITM", "UKB"}},
+	{Code: "SEL", Name: "Seoul", Country: "KR", Airports: []string{"ICN", "GMP"}},
+	{Code: "BJS", Name: "Beijing", Country: "CN", Airports: []string{"PEK", "PKX"}, Aliases: []string{"Peking"}},
+	{Code: "SAO", Name: "Sao Paulo", Country: "BR", Airports: []string{"GRU", "CGH", "VCP"}},
+	{Code: "RIO", Name: "Rio de Janeiro", Country: "BR", Airports: []string{"GIG", "SDU"}},
+	{Code: "BUE", Name: "Buenos Aires", Country: "AR", Airports: []string{"EZE", "AEP"}},
+	{Code: "YTO", Name: "Toronto", Country: "CA", Airports: []string{"YYZ", "YTZ"}},
+	{Code: "JKT", Name: "Jakarta", Country: "ID", Airports: []string{"CGK", "HLP"}},
+	{Code: "REK", Name: "Reykjavik", Country: "IS", Airports: []string{"KEF", "RKV"}},
+	{Code: "BUH", Name: "Bucharest", Country: "RO", Airports: []string{"OTP", "BBU"}},
+}
+
+var (
+	metrosByCode = make(map[string]Metro, len(metros))
+	metrosByName = make(map[string]Metro)
+)
+
+func init() {
+	for _, metro := range metros {
+		metrosByCode[metro.Code] = metro
+		metrosByName[textnorm.Fold(metro.Name)] = metro
+		for _, alias := range metro.Aliases {
+			metrosByName[textnorm.Fold(alias)] = metro
+		}
+	}
+}
+
+func Metros() []Metro {
+	return metros
+}
+
+func MetroByCode(code string) (Metro, bool) {
+	metro, ok := metrosByCode[strings.ToUpper(strings.TrimSpace(code))]
+	return metro, ok
+}
+
+// MetroForCity resolves a city name such as "London" or "Milano" to its
+// metro area, ignoring case and accents.
+func MetroForCity(name string) (Metro, bool) {
+	metro, ok := metrosByName[textnorm.Fold(name)]
+	return metro, ok
+}
+
+// ResolveCode looks code up as a single IATA code or else expands it as a
+// metro code into the member airports present in the dataset. A real airport
+// always wins, so a metro code can never hide one.
+func (d *Dataset) ResolveCode(code string) ([]Airport, bool) {
+	if airport, ok := d.ByIATA(code); ok {
+		return []Airport{airport}, true
+	}
+	if metro, ok := MetroByCode(code); ok {
+		var members []Airport
+		for _, iata := range metro.Airports {
+			if airport, ok := d.ByIATA(iata); ok {
+				members = append(members, airport)
+			}
+		}
+		return members, len(members) > 0
+	}
+	return nil, false
+}
+
+// RankByDistance returns the airports that pass filter ordered by distance
+// from lat/long, with ties kept in input order.
+func RankByDistance(candidates []Airport, lat, long float64, filter Filter) []Match {
+	var matches []Match
+	for _, airport := range candidates {
+		if filter.Accepts(airport) {
+			matches = append(matches, Match{Airport: airport, DistanceKm: geo.Haversine(lat, long, airport.Lat, airport.Long)})
+		}
+	}
+	sort.SliceStable(matches, func(a, b int) bool {
+		return matches[a].DistanceKm < matches[b].DistanceKm
+	})
+	return matches
+}
@@ -0,0 +1,30 @@
+package airports
+
+import "testing"
+
+func TestMetroCodesDoNotShadowAirports(t *testing.T) {
+	dataset, err := newDataset("../../large_airports.csv")
+	if err != nil {
+		t.Fatalf("load dataset: %v", err)
+	}
+	for _, metro := range Metros() {
+		if airport, ok := dataset.ByIATA(metro.Code); ok {
+			t.Errorf("metro %s shadows airport %s", metro.Code, airport.Name)
+		}
+	}
+
+	for _, code := range []string{"IST", "BKK", "DXB", "MEX", "TPE", "HOU", "DFW", "SHA"} {
+		if _, ok := dataset.ByIATA(code); !ok {
+			continue
+		}
+		members, ok := dataset.ResolveCode(code)
+		if !ok || len(members) != 1 || members[0].IATACode != code {
+			t.Errorf("ResolveCode(%s) = %v, want only the %s airport", code, members, code)
+		}
+	}
+
+	members, ok := dataset.ResolveCode("LON")
+	if !ok || len(members) < 2 {
+		t.Errorf("ResolveCode(LON) = %v, want the London airports", members)
+	}
+}
@@ -0,0 +1,50 @@
+package airports
+
+import "backend.travel.intercogni.com/packages/geo"
+
+type Match struct {
+	Airport    Airport
+	DistanceKm float64
+}
+
+func newIndex(airports []Airport) *geo.Index {
+	points := make([]geo.Point, len(airports))
+	for i, airport := range airports {
+		points[i] = geo.Point{Lat: airport.Lat, Long: airport.Long}
+	}
+	return geo.NewIndex(points)
+}
+
+func (d *Dataset) keep(filter Filter) func(int) bool {
+	return func(i int) bool {
+		return filter.Accepts(d.airports[i])
+	}
+}
+
+func (d *Dataset) matches(neighbors []geo.Neighbor) []Match {
+	matches := make([]Match, len(neighbors))
+	for i, neighbor := range neighbors {
+		matches[i] = Match{Airport: d.airports[neighbor.Index], DistanceKm: neighbor.DistanceKm}
+	}
+	return matches
+}
+
+func (d *Dataset) Nearest(lat, long float64, filter Filter) (Match, bool) {
+	neighbor, ok := d.index.Nearest(lat, long, d.keep(filter))
+	if !ok {
+		return Match{}, false
+	}
+	return Match{Airport: d.airports[neighbor.Index], DistanceKm: neighbor.DistanceKm}, true
+}
+
+func (d *Dataset) KNearest(lat, long float64, k int, filter Filter) []Match {
+	return d.matches(d.index.KNearest(lat, long, k, d.keep(filter)))
+}
+
+func (d *Dataset) KNearestWithin(lat, long float64, k int, radiusKm float64, filter Filter) []Match {
+	return d.matches(d.index.KNearestWithin(lat, long, k, radiusKm, d.keep(filter)))
+}
+
+func (d *Dataset) WithinRadius(lat, long, radiusKm float64, filter Filter) []Match {
+	return d.matches(d.index.WithinRadius(lat, long, radiusKm, d.keep(filter)))
+}
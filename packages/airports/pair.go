@@ -0,0 +1,60 @@
+package airports
+
+import "backend.travel.intercogni.com/packages/geo"
+
+// Costs weights each kilometre of a trip by how it is travelled.
+type Costs struct {
+	GroundPerKm float64
+	AirPerKm    float64
+}
+
+type PairScore struct {
+	OriginGroundKm        float64
+	TrunkKm               float64
+	DestinationGroundKm   float64
+	OriginGroundCost      float64
+	TrunkCost             float64
+	DestinationGroundCost float64
+	TotalCost             float64
+}
+
+type Pair struct {
+	Origin      Match
+	Destination Match
+	Score       PairScore
+}
+
+func ScorePair(origin, destination Match, costs Costs) PairScore {
+	trunkKm := geo.Haversine(origin.Airport.Lat, origin.Airport.Long, destination.Airport.Lat, destination.Airport.Long)
+	score := PairScore{
+		OriginGroundKm:        origin.DistanceKm,
+		TrunkKm:               trunkKm,
+		DestinationGroundKm:   destination.DistanceKm,
+		OriginGroundCost:      origin.DistanceKm * costs.GroundPerKm,
+		TrunkCost:             trunkKm * costs.AirPerKm,
+		DestinationGroundCost: destination.DistanceKm * costs.GroundPerKm,
+	}
+	score.TotalCost = score.OriginGroundCost + score.TrunkCost + score.DestinationGroundCost
+	return score
+}
+
+// BestPair scores every origin/destination combination and returns the
+// cheapest one. Pairs that fly from an airport to itself are skipped, and
+// ties go to the pair whose airports come first in the candidate lists.
+func BestPair(origins, destinations []Match, costs Costs) (Pair, bool) {
+	var best Pair
+	found := false
+	for _, origin := range origins {
+		for _, destination := range destinations {
+			if origin.Airport.SameAs(destination.Airport) {
+				continue
+			}
+			score := ScorePair(origin, destination, costs)
+			if !found || score.TotalCost < best.Score.TotalCost {
+				best = Pair{Origin: origin, Destination: destination, Score: score}
+				found = true
+			}
+		}
+	}
+	return best, found
+}
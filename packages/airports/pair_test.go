@@ -0,0 +1,41 @@
+package airports
+
+import "testing"
+
+func TestBestPairComparesAirportIdentity(t *testing.T) {
+	// Two small airfields without IATA codes, 90 km apart.
+	west := Match{Airport: Airport{ID: 1, Ident: "XW01", Name: "West Field", Lat: 50, Long: 10}, DistanceKm: 5}
+	east := Match{Airport: Airport{ID: 2, Ident: "XE01", Name: "East Field", Lat: 50, Long: 11.25}, DistanceKm: 5}
+	costs := Costs{GroundPerKm: 0.25, AirPerKm: 0.10}
+
+	pair, ok := BestPair([]Match{west}, []Match{east}, costs)
+	if !ok {
+		t.Fatal("BestPair found no pair between two different airports without IATA codes")
+	}
+	if pair.Origin.Airport.ID != 1 || pair.Destination.Airport.ID != 2 {
+		t.Errorf("BestPair = %d -> %d, want 1 -> 2", pair.Origin.Airport.ID, pair.Destination.Airport.ID)
+	}
+
+	if _, ok := BestPair([]Match{west}, []Match{west}, costs); ok {
+		t.Error("BestPair paired an airport with itself")
+	}
+}
+
+func TestSameAs(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Airport
+		want bool
+	}{
+		{"same id", Airport{ID: 7, IATACode: "AAA"}, Airport{ID: 7, IATACode: "AAA"}, true},
+		{"different ids without IATA", Airport{ID: 7}, Airport{ID: 8}, false},
+		{"ident without ids", Airport{Ident: "EGLL"}, Airport{Ident: "EGLL"}, true},
+		{"different idents without ids", Airport{Ident: "EGLL"}, Airport{Ident: "EGKK"}, false},
+		{"name and position only", Airport{Name: "Strip", Lat: 1, Long: 2}, Airport{Name: "Strip", Lat: 1, Long: 3}, false},
+	}
+	for _, test := range tests {
+		if got := test.a.SameAs(test.b); got != test.want {
+			t.Errorf("%s: SameAs = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
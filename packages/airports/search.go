@@ -0,0 +1,163 @@
+package airports
+
+import (
+	"sort"
+	"strings"
+
+	"backend.travel.intercogni.com/packages/textnorm"
+)
+
+const (
+	scoreExactIATA    = 1000
+	scoreExactIdent   = 950
+	scoreExactCity    = 900
+	scoreExactName    = 850
+	scorePrefixCode   = 700
+	scorePrefixCity   = 650
+	scorePrefixName   = 600
+	scoreWordPrefix   = 550
+	scoreContains     = 400
+	scoreFuzzy        = 300
+	scoreFuzzyPerEdit = 100
+)
+
+type SearchResult struct {
+	Airport   Airport
+	Score     int
+	MatchedOn string
+}
+
+// searchEntry holds the folded fields of one airport so searches don't
+// normalise the whole dataset on every keystroke.
+type searchEntry struct {
+	codes    []searchCode
+	city     string
+	name     string
+	keywords []string
+	words    []string
+	// fuzzy holds the strings a misspelt query is compared with.
+	fuzzy []string
+}
+
+type searchCode struct {
+	code  string
+	field string
+}
+
+func newSearchEntries(airports []Airport) []searchEntry {
+	entries := make([]searchEntry, len(airports))
+	for i, airport := range airports {
+		entry := searchEntry{
+			city: textnorm.Fold(airport.City),
+			name: textnorm.Fold(airport.Name),
+		}
+		for _, code := range []searchCode{
+			{airport.IATACode, "iata_code"},
+			{airport.Ident, "ident"},
+			{airport.GPSCode, "gps_code"},
+		} {
+			if code.code = textnorm.Fold(code.code); code.code != "" {
+				entry.codes = append(entry.codes, code)
+			}
+		}
+		for _, keyword := range airport.Keywords {
+			entry.keywords = append(entry.keywords, textnorm.Fold(keyword))
+		}
+		entry.words = strings.Fields(entry.city + " " + entry.name + " " + strings.Join(entry.keywords, " "))
+		entry.fuzzy = append([]string{entry.city, entry.name}, entry.words...)
+		entries[i] = entry
+	}
+	return entries
+}
+
+// Search matches query against IATA codes, ICAO idents, names,
+// municipalities and keywords, ignoring case and accents. Exact matches rank
+// above prefix matches, prefix above substring, and substring above fuzzy
+// matches within one or two edits. Ties go to larger airports.
+func (d *Dataset) Search(query string, limit int, filter Filter) []SearchResult {
+	q := textnorm.Fold(query)
+	if q == "" || limit <= 0 {
+		return nil
+	}
+
+	var results []SearchResult
+	for i, entry := range d.search {
+		if !filter.Accepts(d.airports[i]) {
+			continue
+		}
+		if score, matchedOn := entry.score(q); score > 0 {
+			results = append(results, SearchResult{Airport: d.airports[i], Score: score, MatchedOn: matchedOn})
+		}
+	}
+
+	sort.Slice(results, func(a, b int) bool {
+		ra, rb := results[a], results[b]
+		if ra.Score != rb.Score {
+			return ra.Score > rb.Score
+		}
+		if ra.Airport.Type != rb.Airport.Type {
+			return typeRanks[ra.Airport.Type] > typeRanks[rb.Airport.Type]
+		}
+		return ra.Airport.Name < rb.Airport.Name
+	})
+
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+func (e searchEntry) score(q string) (int, string) {
+	for _, code := range e.codes {
+		if code.code != q {
+			continue
+		}
+		if code.field == "iata_code" {
+			return scoreExactIATA, code.field
+		}
+		return scoreExactIdent, code.field
+	}
+	if q == e.city {
+		return scoreExactCity, "municipality"
+	}
+	if q == e.name {
+		return scoreExactName, "name"
+	}
+	for _, keyword := range e.keywords {
+		if q == keyword {
+			return scoreExactName, "keywords"
+		}
+	}
+
+	for _, code := range e.codes {
+		if strings.HasPrefix(code.code, q) {
+			return scorePrefixCode, code.field
+		}
+	}
+	if strings.HasPrefix(e.city, q) {
+		return scorePrefixCity, "municipality"
+	}
+	if strings.HasPrefix(e.name, q) {
+		return scorePrefixName, "name"
+	}
+	if textnorm.WordPrefixes(q, e.words) {
+		return scoreWordPrefix, "name"
+	}
+
+	if strings.Contains(e.city, q) {
+		return scoreContains, "municipality"
+	}
+	if strings.Contains(e.name, q) {
+		return scoreContains, "name"
+	}
+	for _, keyword := range e.keywords {
+		if strings.Contains(keyword, q) {
+			return scoreContains, "keywords"
+		}
+	}
+
+	if edits := textnorm.FewestEdits(q, e.fuzzy...); edits >= 0 {
+		return scoreFuzzy - scoreFuzzyPerEdit*edits, "fuzzy"
+	}
+	return 0, ""
+}
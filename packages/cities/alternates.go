@@ -0,0 +1,73 @@
+package cities
+
+import "strings"
+
+// alternateNames lists other names people use for cities in the dataset:
+// exonyms, local spellings and former names. Entries are matched to cities by
+// name and country, and Validate reports entries that match nothing.
+var alternateNames = []struct {
+	City    string
+	Country string
+	Names   []string
+}{
+	{"The Hague", "Netherlands", []string{"Den Haag", "'s-Gravenhage", "Hague"}},
+	{"Muenchen", "Germany", []string{"Munich", "München"}},
+	{"Koeln", "Germany", []string{"Cologne", "Köln"}},
+	{"Nuernberg", "Germany", []string{"Nuremberg", "Nürnberg"}},
+	{"Frankfurt am Main", "Germany", []string{"Frankfurt"}},
+	{"Hannover", "Germany", []string{"Hanover"}},
+	{"Wien", "Austria", []string{"Vienna"}},
+	{"Praha", "Czech Republic", []string{"Prague"}},
+	{"Warsaw", "Poland", []string{"Warszawa"}},
+	{"Krakow", "Poland", []string{"Cracow"}},
+	{"Rome", "Italy", []string{"Roma"}},
+	{"Milano", "Italy", []string{"Milan"}},
+	{"Napoli", "Italy", []string{"Naples"}},
+	{"Florence", "Italy", []string{"Firenze"}},
+	{"Venice", "Italy", []string{"Venezia"}},
+	{"Torino", "Italy", []string{"Turin"}},
+	{"Genova", "Italy", []string{"Genoa"}},
+	{"Lisbon", "Portugal", []string{"Lisboa"}},
+	{"Sevilla", "Spain", []string{"Seville"}},
+	{"Brussels", "Belgium", []string{"Bruxelles", "Brussel"}},
+	{"Antwerp", "Belgium", []string{"Antwerpen", "Anvers"}},
+	{"Gent", "Belgium", []string{"Ghent", "Gand"}},
+	{"Geneve", "Switzerland", []string{"Geneva", "Genf"}},
+	{"Luzern", "Switzerland", []string{"Lucerne"}},
+	{"Copenhagen", "Denmark", []string{"København", "Kobenhavn"}},
+	{"Arhus", "Denmark", []string{"Aarhus"}},
+	{"Goteborg", "Sweden", []string{"Gothenburg"}},
+	{"Moscow", "Russia", []string{"Moskva"}},
+	{"Saint Petersburg", "Russia", []string{"St Petersburg", "Sankt-Peterburg", "Leningrad"}},
+	{"Kiev", "Ukraine", []string{"Kyiv"}},
+	{"Bucharest", "Romania", []string{"Bucuresti"}},
+	{"Athens", "Greece", []string{"Athina", "Athinai"}},
+	{"Mumbai", "India", []string{"Bombay"}},
+	{"Calcutta", "India", []string{"Kolkata"}},
+	{"Chennai", "India", []string{"Madras"}},
+	{"Bengaluru", "India", []string{"Bangalore"}},
+	{"Beijing", "China", []string{"Peking"}},
+	{"Rangoon", "Myanmar", []string{"Yangon"}},
+	{"Mazar-e Sharif", "Afghanistan", []string{"Mazar-i-Sharif"}},
+	{"Mexico City", "Mexico", []string{"Ciudad de Mexico", "CDMX"}},
+}
+
+// Alternates returns the alternate names known for c.
+func Alternates(c City) []string {
+	idx := loadIndexes()
+	pos, ok := idx.byID[c.ID]
+	if !ok {
+		return nil
+	}
+	return idx.alternates[pos]
+}
+
+var transliterationReplacer = strings.NewReplacer("ae", "a", "oe", "o", "ue", "u")
+
+// transliterationKey collapses the ae/oe/ue spellings the dataset uses for
+// umlauts ("Muenchen", "Malmoe"), so they meet their folded accented forms
+// ("München" folds to "munchen"). It is only a fallback key, because it also
+// merges unrelated names.
+func transliterationKey(folded string) string {
+	return transliterationReplacer.Replace(folded)
+}
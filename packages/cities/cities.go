@@ -1,5 +1,17 @@
 package cities
 
+import (
+	"bytes"
+	"compress/gzip"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
 type City struct {
 	ID        int
 	Country   string
@@ -9,10601 +21,135 @@ type City struct {
 	Altitude  float64
 }
 
-var Cities = []City{
-	{1, "Afghanistan", "Kabul", 34.5166667, 69.1833344, 1808.0},
-	{2, "Afghanistan", "Kandahar", 31.6100000, 65.6999969, 1015.0},
-	{3, "Afghanistan", "Mazar-e Sharif", 36.7069444, 67.1122208, 369.0},
-	{4, "Afghanistan", "Herat", 34.3400000, 62.1899986, 927.0},
-	{5, "Afghanistan", "Jalalabad", 34.4200000, 70.4499969, 573.0},
-	{6, "Afghanistan", "Konduz", 36.7200000, 68.8600006, 394.0},
-	{7, "Afghanistan", "Ghazni", 33.5535554, 68.4268875, 2175.0},
-	{8, "Afghanistan", "Balkh", 36.7586111, 66.8961105, 328.0},
-	{9, "Afghanistan", "Baghlan", 36.1200000, 68.6999969, 565.0},
-	{10, "Afghanistan", "Gardez", 33.5900000, 69.2200012, 2279.0},
-	{11, "Afghanistan", "Khost", 33.3380556, 69.9202805, 1178.0},
-	{12, "Afghanistan", "Khanabad", 36.6800000, 69.1100006, 490.0},
-	{13, "Afghanistan", "Tashqorghan", 36.6952778, 67.6980591, 460.0},
-	{14, "Afghanistan", "Taloqan", 36.7360511, 69.5345078, 788.0},
-	{15, "Afghanistan", "Cool urhajo", 34.2654452, 67.3451614, 2733.0},
-	{16, "Afghanistan", "Pol-e Khomri", 35.9425000, 68.7191696, 832.0},
-	{17, "Afghanistan", "Sheberghan", 36.6672222, 65.7536087, 362.0},
-	{18, "Afghanistan", "Charikar", 35.0136110, 69.1713867, 1534.0},
-	{19, "Afghanistan", "Sar-e Pol", 36.2155556, 65.9363861, 629.0},
-	{20, "Afghanistan", "Paghman", 34.5875000, 68.9533310, 2276.0},
-	{21, "Afghanistan", "Samangan", 36.2652778, 68.0166702, 960.0},
-	{22, "Afghanistan", "Nawab City", 31.5800000, 64.3600006, 775.0},
-	{23, "Afghanistan", "Gereshk", 31.8188889, 64.5647202, 844.0},
-	{24, "Afghanistan", "Farakh", 32.3700000, 62.1100006, 653.0},
-	{25, "Afghanistan", "Feyzabad", 37.1175000, 70.5797195, 1235.0},
-	{26, "Afghanistan", "Sindand", 33.3000000, 62.1399994, 1071.0},
-	{27, "Afghanistan", "Andkhvoy", 36.9525000, 65.1236115, 319.0},
-	{28, "Afghanistan", "Rostaq", 37.1275000, 69.8305588, 1323.0},
-	{29, "Afghanistan", "Qaravol", 37.2211111, 68.7799988, 327.0},
-	{30, "Afghanistan", "Nahrin", 36.0652778, 69.1338882, 1156.0},
-	{31, "Afghanistan", "Baraki Barak", 33.9675000, 68.9486084, 1937.0},
-	{32, "Afghanistan", "At Khvajeh", 37.0841667, 69.4805527, 474.0},
-	{33, "Afghanistan", "Eslam Qal`eh", 34.6666667, 61.0666656, 721.0},
-	{34, "Afghanistan", "Karokh", 34.4791667, 62.5869446, 1309.0},
-	{35, "Afghanistan", "Mehtar Lam", 34.6713889, 70.2094421, 768.0},
-	{36, "Afghanistan", "Kushk", 33.2961111, 61.9527779, 1126.0},
-	{37, "Afghanistan", "Shahrag", 34.1083333, 64.3033371, 2393.0},
-	{38, "Afghanistan", "Asmar", 35.0300000, 71.3499985, 934.0},
-	{39, "Afghanistan", "Sang-e Charak", 35.8497222, 66.4369431, 1547.0},
-	{40, "Afghanistan", "Khash", 31.5291667, 62.7924995, 578.0},
-	{41, "Afghanistan", "Achin", 34.1200000, 70.6999969, 997.0},
-	{42, "Afghanistan", "Jabal os Saraj", 35.1183330, 69.2377777, 1575.0},
-	{43, "Afghanistan", "Qarqin", 37.4127778, 66.0472260, 250.0},
-	{44, "Afghanistan", "Mirabad", 30.4352778, 61.8347206, 499.0},
-	{45, "Afghanistan", "Zarghun Shahr", 32.8472222, 68.4458313, 2114.0},
-	{46, "Afghanistan", "Sangin", 32.0733333, 64.8338852, 892.0},
-	{47, "Afghanistan", "Panjab", 34.3875000, 67.0233307, 2707.0},
-	{48, "Afghanistan", "Uruzgan", 32.9200000, 66.6299973, 2010.0},
-	{49, "Afghanistan", "Pul-i-Alam", 33.9800000, 69.0299988, 1916.0},
-	{50, "Afghanistan", "Chiras", 35.4166667, 65.9833298, 2828.0},
-	{51, "Afghanistan", "Hokumati Azraw", 34.1700000, 69.6399994, 2278.0},
-	{52, "Afghanistan", "Qareh Bagh", 34.9405556, 61.7775002, 950.0},
-	{53, "Afghanistan", "Kalat-i-Ghilzai", 32.1000000, 66.9100037, 1535.0},
-	{54, "Afghanistan", "Ashkasham", 36.6833333, 71.5333328, 3037.0},
-	{55, "Afghanistan", "Jorm", 36.8666667, 70.8344421, 1508.0},
-	{56, "Afghanistan", "Kuhestan", 34.6538889, 61.1977768, 734.0},
-	{57, "Afghanistan", "Tokzar", 35.9488889, 66.4199982, 1253.0},
-	{58, "Afghanistan", "Char Burj", 34.2400000, 62.1899986, 967.0},
-	{59, "Afghanistan", "Lar Gerd", 35.4897222, 66.6636124, 1867.0},
-	{60, "Afghanistan", "Rudbar", 30.1500000, 62.5999985, 573.0},
-	{61, "Afghanistan", "Farkhar", 36.5722222, 69.8580551, 1135.0},
-	{62, "Afghanistan", "Zendeh Jan", 34.3455556, 61.7316666, 844.0},
-	{63, "Afghanistan", "Anar Darreh", 32.7622222, 61.6525002, 801.0},
-	{64, "Afghanistan", "Emam Saheb", 37.1822222, 68.9124985, 340.0},
-	{65, "Afghanistan", "Darzab", 35.9783333, 65.3791656, 1224.0},
-	{66, "Afghanistan", "`Alaqehdari Deh-e Shu", 30.4347222, 63.3072205, 584.0},
-	{67, "Afghanistan", "Tagow Bay", 35.7016667, 66.0613861, 1845.0},
-	{68, "Afghanistan", "Darvishan", 31.1333333, 64.1924973, 719.0},
-	{69, "Afghanistan", "Qarchi Gak", 37.0355556, 66.7888870, 298.0},
-	{70, "Afghanistan", "Dasht-e Archi", 37.1333333, 69.1666641, 372.0},
-	{71, "Afghanistan", "Qal`eh Shahr", 35.5483333, 65.5677795, 2159.0},
-	{72, "Afghanistan", "Zareh Sharan", 33.1466667, 68.7916641, 2141.0},
-	{73, "Afghanistan", "Tir Pol", 34.5952778, 61.2669449, 768.0},
-	{74, "Afghanistan", "Mohmand Darrah", 34.2400000, 70.8700027, 481.0},
-	{75, "Afghanistan", "Yengi Qal`eh", 37.4672222, 69.6091690, 481.0},
-	{76, "Afghanistan", "Tagao", 34.8500000, 69.6399994, 1453.0},
-	{77, "Afghanistan", "Khandud", 36.9488889, 72.3169479, 2918.0},
-	{78, "Afghanistan", "Mir Bachcheh Kowt", 34.7488889, 69.1191635, 1691.0},
-	{79, "Afghanistan", "Dorufchah", 36.4397222, 70.0838852, 4290.0},
-	{80, "Afghanistan", "Dahaneh-ye Ghowri", 35.9075000, 68.4938889, 758.0},
-	{81, "Afghanistan", "Lab-Sar", 36.0261111, 66.8386078, 1043.0},
-	{82, "Afghanistan", "Darwazkay", 35.0200000, 71.0400009, 2508.0},
-	{83, "Afghanistan", "Munay", 34.9800000, 71.2500000, 995.0},
-	{84, "Afghanistan", "Mulkanah", 34.9400000, 71.0599976, 1181.0},
-	{85, "Albania", "Tirana", 41.3275000, 19.8188896, 103.0},
-	{86, "Albania", "Durres", 41.3230556, 19.4413891, -9999.0},
-	{87, "Albania", "Elbasan", 41.1125000, 20.0822220, 126.0},
-	{88, "Albania", "Vlore", 40.4666667, 19.4897213, 58.0},
-	{89, "Albania", "Shkoder", 42.0682829, 19.5125771, 17.0},
-	{90, "Albania", "Fier-Cifci", 40.7166667, 19.5666676, 7.0},
-	{91, "Albania", "Korce", 40.6186111, 20.7808342, 876.0},
-	{92, "Albania", "Berat", 40.7058333, 19.9522228, 69.0},
-	{93, "Albania", "Lushnje", 40.9419444, 19.7049999, 81.0},
-	{94, "Albania", "Kavaje", 41.1855556, 19.5569439, 10.0},
-	{95, "Albania", "Lac", 41.6355556, 19.7130547, 89.0},
-	{96, "Albania", "Gjirokaster", 40.0758333, 20.1388893, 213.0},
-	{97, "Albania", "Patos Fshat", 40.6427778, 19.6508331, 118.0},
-	{98, "Albania", "Kruje", 41.5091667, 19.7927780, 870.0},
-	{99, "Albania", "Lezhe", 41.7836111, 19.6436119, 82.0},
-	{100, "Albania", "Kucove", 40.8002778, 19.9166660, 95.0},
-	{101, "Albania", "Kukes", 42.0769444, 20.4219437, 339.0},
-	{102, "Albania", "Burrel", 41.6102778, 20.0088882, 196.0},
-	{103, "Albania", "Sarande", 39.8755556, 20.0052776, 170.0},
-	{104, "Albania", "Peshkopi", 41.6850000, 20.4288883, 718.0},
-	{105, "Albania", "Cerrik", 41.0316667, 19.9758339, 70.0},
-	{106, "Albania", "Shijak", 41.3455556, 19.5672226, 57.0},
-	{107, "Albania", "Corovode", 40.5041667, 20.2272224, 539.0},
-	{108, "Albania", "Librazhd-Qender", 41.1969444, 20.3355560, 436.0},
-	{109, "Albania", "Tepelene", 40.2958333, 20.0191669, 112.0},
-	{110, "Albania", "Gramsh", 40.8697222, 20.1844444, 342.0},
-	{111, "Albania", "Bulqize", 41.4916667, 20.2219448, 729.0},
-	{112, "Albania", "Kamez", 41.3816667, 19.7602787, 73.0},
-	{113, "Albania", "Permet", 40.2336111, 20.3516674, 281.0},
-	{114, "Albania", "Polican", 40.6122222, 20.0980549, 407.0},
-	{115, "Albania", "Fushe-Kruje", 41.4783333, 19.7177773, 41.0},
-	{116, "Albania", "Ballsh", 40.5988889, 19.7347221, 232.0},
-	{117, "Albania", "Rreshen", 41.7675000, 19.8755550, 129.0},
-	{118, "Albania", "Mamurras", 41.5775000, 19.6922226, 79.0},
-	{119, "Albania", "Bajram Curri", 42.3572222, 20.0730553, 313.0},
-	{120, "Albania", "Erseke", 40.3377778, 20.6788883, 1024.0},
-	{121, "Albania", "Peqin", 41.0461111, 19.7511120, 37.0},
-	{122, "Albania", "Bilisht", 40.6275000, 20.9899998, 946.0},
-	{123, "Albania", "Selenice", 40.5305556, 19.6358337, 113.0},
-	{124, "Albania", "Roskovec", 40.7375000, 19.7022228, 24.0},
-	{125, "Albania", "Puke", 42.0444444, 19.8997231, 795.0},
-	{126, "Albania", "Rrogozhine", 41.0763889, 19.6652775, 48.0},
-	{127, "Albania", "Vore", 41.3908333, 19.6550007, 74.0},
-	{128, "Albania", "Memaliaj", 40.3516667, 19.9802780, 141.0},
-	{129, "Albania", "Ura Vajgurore", 40.7688889, 19.8777771, 30.0},
-	{130, "Albania", "Himare", 40.1016667, 19.7447224, 60.0},
-	{131, "Albania", "Koplik", 42.2136111, 19.4363880, 71.0},
-	{132, "Albania", "Perrenjas-Fshat", 41.0666667, 20.5352783, 562.0},
-	{133, "Albania", "Delvine", 39.9511111, 20.0977783, 255.0},
-	{134, "Albania", "Maliq", 40.7058333, 20.6997223, 824.0},
-	{135, "Albania", "Libohove", 40.0311111, 20.2630558, 536.0},
-	{136, "Albania", "Shengjin", 41.8136111, 19.5938892, -9999.0},
-	{137, "Albania", "Leskovik", 40.1513889, 20.5972214, 908.0},
-	{138, "Albania", "Orikum", 40.3252778, 19.4713898, 8.0},
-	{139, "Albania", "Kelcyre", 40.3130556, 20.1894436, 171.0},
-	{140, "Albania", "Fushe-Arrez", 42.0622222, 20.0166664, 590.0},
-	{141, "Albania", "Rubik", 41.7744444, 19.7861118, 262.0},
-	{142, "Albania", "Milot", 41.6838889, 19.7155552, 49.0},
-	{143, "Albania", "Kurbnesh", 41.7797222, 20.0836105, 833.0},
-	{144, "Albania", "Konispol", 39.6588889, 20.1813889, 478.0},
-	{145, "Albania", "Krrabe", 41.2155556, 19.9713898, 542.0},
-	{146, "Albania", "Fierze", 42.2647222, 20.0269451, 474.0},
-	{147, "Albania", "Klos", 41.5069444, 20.0866661, 275.0},
-	{148, "Albania", "Kutreq", 41.3966667, 19.9616661, 524.0},
-	{149, "Albania", "Collak", 41.3152778, 19.8661118, 217.0},
-	{150, "Albania", "Ballaj", 41.3222222, 19.8802776, 276.0},
-	{151, "Albania", "Kubes", 41.4166667, 19.8511105, 573.0},
-	{152, "Albania", "Kurte", 41.4930556, 19.9972229, 1328.0},
-	{153, "Albania", "Melcize", 41.3952778, 19.8222218, 130.0},
-	{154, "Albania", "Livadhet", 41.4080556, 19.9047222, 395.0},
-	{155, "Albania", "Gurra e Vogel", 41.2863889, 19.8883324, 240.0},
-	{156, "Albania", "Celiku", 41.2761111, 19.8522224, 157.0},
-	{157, "Albania", "Kamere", 41.4719444, 19.9708328, 1286.0},
-	{158, "Albania", "Mal Pjec", 41.4786111, 19.9547215, 1155.0},
-	{159, "Albania", "Dallashi", 41.2830556, 19.8763885, 241.0},
-	{160, "Albania", "Shejleret", 41.3072222, 19.8858337, 253.0},
-	{161, "Albania", "Kokli", 41.3091667, 19.9286118, 665.0},
-	{162, "Albania", "Shugyrk", 41.4400000, 19.9302769, 429.0},
-	{163, "Albania", "Metaj", 41.4933333, 19.9052773, 695.0},
-	{164, "Albania", "Marice", 41.4216667, 19.9591675, 585.0},
-	{165, "Albania", "Panec", 41.2847222, 20.0063896, 516.0},
-	{166, "Albania", "Balajt e Poshtem", 41.4205556, 20.1397228, 1080.0},
-	{167, "Albania", "Blishte", 41.4430556, 20.1466675, 613.0},
-	{168, "Albania", "Shengjun", 41.4891667, 20.1375008, 577.0},
-	{169, "Albania", "Rucaj", 41.4661111, 20.1555557, 815.0},
-	{170, "Albania", "Kotaj", 41.4333333, 20.2008324, 1102.0},
-	{171, "Albania", "Peshk", 41.4055556, 20.1980553, 829.0},
-	{172, "Albania", "Bize", 41.3408333, 20.1980553, 1314.0},
-	{173, "Albania", "Berberaj", 41.3144444, 20.2027779, 1087.0},
-	{174, "Albania", "Panjet", 41.3208333, 20.1561108, 1323.0},
-	{175, "Albania", "Ruc", 41.3219444, 20.1105556, 912.0},
-	{176, "Albania", "Marice", 41.4380556, 20.0222225, 1096.0},
-	{177, "Albania", "Xhyre", 41.2869444, 20.0283337, 862.0},
-	{178, "Albania", "Vakumona i Siperme", 41.2816667, 20.0697231, 1060.0},
-	{179, "Albania", "Varros", 41.3025000, 20.0933342, 973.0},
-	{180, "Albania", "Tejlumaj", 41.4783333, 20.0258331, 686.0},
-	{181, "Albania", "Bic", 41.2825000, 20.1458340, 1011.0},
-	{182, "Albania", "Gjorkaj", 41.2780556, 20.1705551, 784.0},
-	{183, "Albania", "Rinas", 41.2861111, 20.1661110, 760.0},
-	{184, "Albania", "Ballgjin", 41.3086111, 20.1933327, 967.0},
-	{185, "Algeria", "Algiers", 36.7630556, 3.0505557, 1.0},
-	{186, "Algeria", "Oran", 35.6911111, -0.6416667, 101.0},
-	{187, "Algeria", "Constantine", 36.3650000, 6.6147223, 501.0},
-	{188, "Algeria", "Batna", 35.5559710, 6.1741447, 1035.0},
-	{189, "Algeria", "Bab Ezzouar", 36.7166667, 3.1833334, 32.0},
-	{190, "Algeria", "Annaba", 36.9000000, 7.7666669, 1.0},
-	{191, "Algeria", "Sidi Bel Abbes", 35.1938889, -0.6413889, 476.0},
-	{192, "Algeria", "Ech Chettia", 36.1956637, 1.2584496, 168.0},
-	{193, "Algeria", "Bejaia", 36.7500000, 5.0833335, 1.0},
-	{194, "Algeria", "Skikda", 36.8791667, 6.9066668, 94.0},
-	{195, "Algeria", "El Achir", 36.0666667, 4.6305556, 1003.0},
-	{196, "Algeria", "Medea", 36.2675000, 2.7500000, 1005.0},
-	{197, "Algeria", "Bechar", 31.6166667, -2.2166667, 785.0},
-	{198, "Algeria", "Tlemcen", 34.8783333, -1.3150001, 843.0},
-	{199, "Algeria", "Mostaganem", 35.9333333, 0.0902778, 85.0},
-	{200, "Algeria", "El Eulma", 36.1500000, 5.6833334, 941.0},
-	{201, "Algeria", "Saida", 34.8333333, 0.1500000, 869.0},
-	{202, "Algeria", "Guelma", 36.4661111, 7.4338889, 257.0},
-	{203, "Algeria", "Bordj el Kiffan", 36.7475000, 3.1930556, 33.0},
-	{204, "Algeria", "Ain Oussera", 35.4513889, 2.9058332, 694.0},
-	{205, "Algeria", "Laghouat", 33.8000000, 2.8833332, 776.0},
-	{206, "Algeria", "Ain Beida", 35.7963889, 7.3927779, 1021.0},
-	{207, "Algeria", "Baraki", 36.6691667, 3.0952778, 30.0},
-	{208, "Algeria", "Oum el Bouaghi", 35.8775000, 7.1136112, 955.0},
-	{209, "Algeria", "Messaad", 34.1666667, 3.5000000, 768.0},
-	{210, "Algeria", "Barika", 35.3897222, 5.3766665, 451.0},
-	{211, "Algeria", "Beni Mered", 36.5230556, 2.8625000, 149.0},
-	{212, "Algeria", "Aflou", 34.1088889, 2.1019444, 1372.0},
-	{213, "Algeria", "El Khroub", 36.2633333, 6.6936111, 597.0},
-	{214, "Algeria", "Rouissat", 31.9272222, 5.3497224, 151.0},
-	{215, "Algeria", "Berrouaghia", 36.1366667, 2.9130557, 913.0},
-	{216, "Algeria", "Ksar el Boukhari", 35.8855556, 2.7508333, 728.0},
-	{217, "Algeria", "Khemis Miliana", 36.2622222, 2.2180555, 365.0},
-	{218, "Algeria", "Azzaba", 36.7394444, 7.1052780, 105.0},
-	{219, "Algeria", "Tamanrasset", 22.7850000, 5.5227780, 1319.0},
-	{220, "Algeria", "Ain Touta", 35.3766667, 5.8961110, 894.0},
-	{221, "Algeria", "Cheria", 35.2730556, 7.7519445, 1093.0},
-	{222, "Algeria", "Birkhadem", 36.7127778, 3.0616667, 134.0},
-	{223, "Algeria", "Chelghoum el Aid", 36.1594444, 6.1650000, 773.0},
-	{224, "Algeria", "Sidi Aissa", 35.8913889, 3.7711110, 672.0},
-	{225, "Algeria", "Larba", 36.5666667, 3.1500001, 109.0},
-	{226, "Algeria", "Mila", 36.4502778, 6.2644444, 488.0},
-	{227, "Algeria", "Bir el Ater", 34.7333333, 8.0500002, 844.0},
-	{228, "Algeria", "Sougueur", 35.1913889, 1.4983333, 1101.0},
-	{229, "Algeria", "Ain Fakroun", 35.9763889, 6.8755555, 933.0},
-	{230, "Algeria", "Tolga", 34.7166667, 5.3833332, 249.0},
-	{231, "Algeria", "Es Senia", 35.6511111, -0.6341667, 91.0},
-	{232, "Algeria", "Bir el Djir", 35.7200000, -0.5450000, 178.0},
-	{233, "Algeria", "Akbou", 36.4611111, 4.5333333, 278.0},
-	{234, "Algeria", "Besbes", 36.7022222, 7.8472223, 10.0},
-	{235, "Algeria", "Sidi Khaled", 34.3833333, 5.0000000, 251.0},
-	{236, "Algeria", "Ain Sefra", 32.7500000, -0.5833333, 1082.0},
-	{237, "Algeria", "Frenda", 35.0619444, 1.0422221, 1083.0},
-	{238, "Algeria", "Bougara", 36.5405556, 3.0852778, 142.0},
-	{239, "Algeria", "Hamma Bouziane", 36.4130556, 6.5974998, 410.0},
-	{240, "Algeria", "Reguiba", 33.5666667, 6.7166667, 32.0},
-	{241, "Algeria", "Meftah", 36.6194444, 3.2252779, 120.0},
-	{242, "Algeria", "Oued Rhiou", 35.9633333, 0.9136111, 99.0},
-	{243, "Algeria", "Boufarik", 36.5705556, 2.9108334, 65.0},
-	{244, "Algeria", "Sig", 35.5277778, -0.1952778, 32.0},
-	{245, "Algeria", "Ksar Chellala", 35.2122222, 2.3188889, 839.0},
-	{246, "Algeria", "Sidi Moussa", 36.6044444, 3.0902779, 57.0},
-	{247, "Algeria", "Ras el Oued", 35.9461111, 5.0327778, 1070.0},
-	{248, "Algeria", "Lakhdaria", 36.5622222, 3.5944445, 203.0},
-	{249, "Algeria", "Drean", 36.6838889, 7.7463889, 35.0},
-	{250, "Algeria", "Reghaia", 36.7422222, 3.3413889, 16.0},
-	{251, "Algeria", "Boghni", 36.5422222, 3.9530556, 344.0},
-	{252, "Algeria", "Dar Chioukh", 34.9000000, 3.4833333, 1114.0},
-	{253, "Algeria", "Sedrata", 36.1288889, 7.5280557, 821.0},
-	{254, "Algeria", "Djamaa", 33.5333333, 6.0000000, 121.0},
-	{255, "Algeria", "Mansoura", 34.8650000, -1.3466667, 835.0},
-	{256, "Algeria", "Telerghma", 36.1152778, 6.3641667, 753.0},
-	{257, "Algeria", "Remchi", 35.0611111, -1.4261111, 217.0},
-	{258, "Algeria", "Birine", 35.6350000, 3.2249999, 766.0},
-	{259, "Algeria", "Sour el Ghozlane", 36.1502778, 3.6891668, 899.0},
-	{260, "Algeria", "Metlili Chaamba", 32.2666667, 3.6333332, 489.0},
-	{261, "Algeria", "Khemis el Khechna", 36.6486111, 3.3305557, 60.0},
-	{262, "Algeria", "Timimoun", 29.2500000, 0.2500000, 323.0},
-	{263, "Algeria", "I-n-Salah", 27.2166667, 2.4666667, 282.0},
-	{264, "Algeria", "Touggourt", 33.1000000, 6.0666666, 167.0},
-	{265, "Algeria", "Merouana", 35.6286111, 5.9116669, 996.0},
-	{266, "Algeria", "Kerkera", 36.9291667, 6.5855556, 12.0},
-	{267, "Algeria", "El Hadjar", 36.8047222, 7.7383332, 3.0},
-	{268, "Algeria", "Beni Saf", 35.3036111, -1.3808333, 1.0},
-	{269, "Algeria", "Boudouaou", 36.7305556, 3.4102778, 1.0},
-	{270, "Algeria", "Berriane", 32.8333333, 3.7666667, 515.0},
-	{271, "Algeria", "Oued Fodda", 36.1841667, 1.5327778, 186.0},
-	{272, "Algeria", "Bou Arfa", 36.4666667, 2.8166666, 239.0},
-	{273, "Algeria", "El Attaf", 36.2247222, 1.6727778, 141.0},
-	{274, "Algeria", "El Affroun", 36.4669444, 2.6252778, 89.0},
-	{275, "Algeria", "`Ain el Hadjel", 35.6758333, 3.8822222, 533.0},
-	{276, "Algeria", "Hennaya", 34.9513889, -1.3680556, 440.0},
-	{277, "Algeria", "Tazoult-Lambese", 35.4863889, 6.2552776, 1184.0},
-	{278, "Algeria", "Draa el Mizan", 36.5350000, 3.8336110, 444.0},
-	{279, "Algeria", "Timizart", 36.8000000, 4.2666669, 372.0},
-	{280, "Algeria", "Sebdou", 34.6425000, -1.3330556, 886.0},
-	{281, "Algeria", "Tebesbest", 33.1166667, 6.0833335, 170.0},
-	{282, "Algeria", "Souma", 36.5183333, 2.9052777, 135.0},
-	{283, "United States", "New York City", 40.7142691, -74.0059738, 2.0},
-	{284, "United States", "Los Angeles", 34.0522342, -118.2436829, 115.0},
-	{285, "United States", "Chicago", 41.8500330, -87.6500549, 181.0},
-	{286, "United States", "Houston", 29.7632836, -95.3632736, 15.0},
-	{287, "United States", "Philadelphia", 39.9523350, -75.1637878, 8.0},
-	{288, "United States", "Phoenix", 33.4483771, -112.0740356, 331.0},
-	{289, "United States", "San Antonio", 29.4241219, -98.4936295, 196.0},
-	{290, "United States", "San Diego", 32.7153292, -117.1572571, 26.0},
-	{291, "United States", "Dallas", 32.7830556, -96.8066635, 137.0},
-	{292, "United States", "Detroit", 42.3314270, -83.0457535, 181.0},
-	{293, "United States", "San Jose", 37.3393857, -121.8949585, 24.0},
-	{294, "United States", "Jacksonville", 30.3321838, -81.6556473, 7.0},
-	{295, "United States", "Indianapolis", 39.7683765, -86.1580429, 222.0},
-	{296, "United States", "Columbus", 39.9611755, -82.9987946, 237.0},
-	{297, "United States", "San Francisco", 37.7749295, -122.4194183, 60.0},
-	{298, "United States", "Austin", 30.2671530, -97.7430573, 163.0},
-	{299, "United States", "Memphis", 35.1495343, -90.0489807, 75.0},
-	{300, "United States", "New South Memphis", 35.0867579, -90.0567551, 74.0},
-	{301, "United States", "Fort Worth", 32.7254090, -97.3208466, 199.0},
-	{302, "United States", "Baltimore", 39.2903848, -76.6121902, 2.0},
-	{303, "United States", "Charlotte", 35.2270869, -80.8431244, 229.0},
-	{304, "United States", "Boston", 42.3584308, -71.0597763, 11.0},
-	{305, "United States", "Milwaukee", 43.0389025, -87.9064713, 195.0},
-	{306, "United States", "South Boston", 42.3334312, -71.0494919, 10.0},
-	{307, "United States", "Seattle", 47.6062095, -122.3320694, 60.0},
-	{308, "United States", "El Paso", 31.7587198, -106.4869308, 1147.0},
-	{309, "United States", "Denver", 39.7391536, -104.9847031, 1598.0},
-	{310, "United States", "Washington", 38.8951118, -77.0363693, 11.0},
-	{311, "United States", "Portland", 45.5234515, -122.6762085, 61.0},
-	{312, "United States", "Oklahoma City", 35.4675602, -97.5164261, 364.0},
-	{313, "United States", "Nashville", 36.1658899, -86.7844467, 151.0},
-	{314, "United States", "Tucson", 32.2217429, -110.9264755, 751.0},
-	{315, "United States", "Albuquerque", 35.0844909, -106.6511383, 1510.0},
-	{316, "United States", "New Orleans", 29.9546482, -90.0750732, 1.0},
-	{317, "United States", "Long Beach", 33.7669623, -118.1892319, 1.0},
-	{318, "United States", "Las Vegas", 36.1749705, -115.1372223, 609.0},
-	{319, "United States", "Sacramento", 38.5815719, -121.4944000, 5.0},
-	{320, "United States", "Fresno", 36.7477272, -119.7723694, 91.0},
-	{321, "United States", "Cleveland", 41.4994954, -81.6954117, 185.0},
-	{322, "United States", "North Kansas City", 39.1300040, -94.5621796, 223.0},
-	{323, "United States", "Kansas City", 39.0997266, -94.5785675, 271.0},
-	{324, "United States", "Virginia Beach", 36.8529263, -75.9779816, 3.0},
-	{325, "United States", "Atlanta", 33.7489954, -84.3879852, 305.0},
-	{326, "United States", "Oakland", 37.8043722, -122.2708054, 13.0},
-	{327, "United States", "Mesa", 33.4222685, -111.8226395, 386.0},
-	{328, "United States", "Tulsa", 36.1539816, -95.9927750, 213.0},
-	{329, "United States", "Omaha", 41.2586096, -95.9377899, 332.0},
-	{330, "United States", "Miami", 25.7742658, -80.1936569, 2.0},
-	{331, "United States", "Honolulu", 21.3069444, -157.8583374, 6.0},
-	{332, "United States", "Minneapolis", 44.9799654, -93.2638397, 259.0},
-	{333, "United States", "Colorado Springs", 38.8338816, -104.8213654, 1829.0},
-	{334, "United States", "Wichita", 37.6922361, -97.3375473, 402.0},
-	{335, "United States", "Santa Ana", 33.7455731, -117.8678360, 30.0},
-	{336, "United States", "West Raleigh", 35.7868182, -78.6638947, 122.0},
-	{337, "United States", "Arlington", 32.7356870, -97.1080627, 184.0},
-	{338, "United States", "Anaheim", 33.8352932, -117.9145050, 40.0},
-	{339, "United States", "Tampa", 27.9475216, -82.4584274, 1.0},
-	{340, "United States", "Saint Louis", 38.6272733, -90.1978912, 135.0},
-	{341, "United States", "Pittsburgh", 40.4406248, -79.9958878, 235.0},
-	{342, "United States", "Toledo", 41.6639383, -83.5552139, 191.0},
-	{343, "United States", "Cincinnati", 39.1620036, -84.4568863, 203.0},
-	{344, "United States", "Riverside", 33.9533487, -117.3961563, 262.0},
-	{345, "United States", "Bakersfield", 35.3732921, -119.0187149, 122.0},
-	{346, "United States", "Stockton", 37.9577016, -121.2907791, 4.0},
-	{347, "United States", "Newark", 40.7356570, -74.1723633, 16.0},
-	{348, "United States", "Buffalo", 42.8864468, -78.8783722, 183.0},
-	{349, "United States", "Corpus Christi", 27.8005828, -97.3963776, 6.0},
-	{350, "United States", "Aurora", 39.7294319, -104.8319168, 1647.0},
-	{351, "United States", "Raleigh", 35.7720960, -78.6386108, 91.0},
-	{352, "United States", "Saint Paul", 44.9444101, -93.0932770, 235.0},
-	{353, "United States", "Lexington-Fayette", 38.0497996, -84.4585495, 312.0},
-	{354, "United States", "Anchorage", 61.2180556, -149.9002838, 31.0},
-	{355, "United States", "Plano", 33.0198431, -96.6988831, 200.0},
-	{356, "United States", "Saint Petersburg", 27.7708606, -82.6792679, 14.0},
-	{357, "United States", "Louisville", 38.2542376, -85.7594070, 128.0},
-	{358, "United States", "Lincoln", 40.8000011, -96.6669617, 382.0},
-	{359, "United States", "Glendale", 33.5386523, -112.1859894, 336.0},
-	{360, "United States", "Henderson", 36.0396988, -114.9819336, 580.0},
-	{361, "United States", "Jersey City", 40.7281575, -74.0776443, 14.0},
-	{362, "United States", "Norfolk", 36.8468146, -76.2852173, 3.0},
-	{363, "United States", "Chandler", 33.3061605, -111.8412476, 380.0},
-	{364, "United States", "Greensboro", 36.0726355, -79.7919769, 244.0},
-	{365, "United States", "Birmingham", 33.5206608, -86.8024902, 173.0},
-	{366, "United States", "Fort Wayne", 41.1306041, -85.1288605, 243.0},
-	{367, "United States", "Lexington", 37.9886892, -84.4777145, 298.0},
-	{368, "United States", "Hialeah", 25.8575963, -80.2781067, 2.0},
-	{369, "United States", "Madison", 43.0730517, -89.4012299, 270.0},
-	{370, "United States", "Baton Rouge", 30.4507462, -91.1545486, 17.0},
-	{371, "United States", "Garland", 32.9126240, -96.6388855, 165.0},
-	{372, "United States", "Modesto", 37.6390972, -120.9968796, 12.0},
-	{373, "United States", "Paradise", 36.0971945, -115.1466675, 626.0},
-	{374, "United States", "Chula Vista", 32.6400541, -117.0841980, 23.0},
-	{375, "United States", "Lubbock", 33.5778631, -101.8551636, 976.0},
-	{376, "United States", "Rochester", 43.1547845, -77.6155548, 155.0},
-	{377, "United States", "Laredo", 27.5064070, -99.5075455, 115.0},
-	{378, "United States", "Akron", 41.0814447, -81.5190048, 290.0},
-	{379, "United States", "Orlando", 28.5383355, -81.3792343, 34.0},
-	{380, "United States", "Durham", 35.9940329, -78.8986206, 122.0},
-	{381, "United States", "North Glendale", 34.1605626, -118.2645187, 174.0},
-	{382, "United States", "Scottsdale", 33.5092103, -111.8990326, 386.0},
-	{383, "Andorra", "Andorra la Vella", 42.5051514, 1.5150619, 1011.0},
-	{384, "Andorra", "les Escaldes", 42.5072870, 1.5341377, 1350.0},
-	{385, "Andorra", "Encamp", 42.5333333, 1.5833333, 1571.0},
-	{386, "Andorra", "Sant Julia de Loria", 42.4637237, 1.4912868, 1045.0},
-	{387, "Andorra", "la Massana", 42.5449867, 1.5148258, 1257.0},
-	{388, "Andorra", "Canillo", 42.5666667, 1.6000000, 1655.0},
-	{389, "Andorra", "Pas de la Casa", 42.5427734, 1.7336082, 2230.0},
-	{390, "Angola", "Luanda", -8.8383333, 13.2344446, 6.0},
-	{391, "Angola", "Huambo", -12.7761111, 15.7391663, 1723.0},
-	{392, "Angola", "Lobito", -12.3480556, 13.5455561, 14.0},
-	{393, "Angola", "Benguela", -12.5783333, 13.4072218, 30.0},
-	{394, "Angola", "Kuito", -12.3833333, 16.9333324, 1631.0},
-	{395, "Angola", "Lubango", -14.9166667, 13.5000000, 1719.0},
-	{396, "Angola", "Malanje", -9.5447222, 16.3452778, 1156.0},
-	{397, "Angola", "Namibe", -15.1961111, 12.1522226, 9.0},
-	{398, "Angola", "Soyo", -6.1333333, 12.3666668, 6.0},
-	{399, "Angola", "Cabinda", -5.5500000, 12.1999998, 1.0},
-	{400, "Angola", "Uige", -7.6166667, 15.0500002, 859.0},
-	{401, "Angola", "Saurimo", -9.6500000, 20.3999996, 1041.0},
-	{402, "Angola", "Sumbe", -11.2000000, 13.8333330, -9999.0},
-	{403, "Angola", "Caluquembe", -13.7833333, 14.6833334, 1769.0},
-	{404, "Angola", "Caxito", -8.5800000, 13.6641665, 84.0},
-	{405, "Angola", "Longonjo", -12.9066667, 15.2533331, 1402.0},
-	{406, "Angola", "Caala", -12.8525000, 15.5605555, 1747.0},
-	{407, "Angola", "Luena", -11.7833333, 19.9166660, 1330.0},
-	{408, "Angola", "Lucapa", -8.4166667, 20.7500000, 929.0},
-	{409, "Angola", "Camacupa", -12.0166667, 17.4833336, 1475.0},
-	{410, "Angola", "Catabola", -12.1500000, 17.2833328, 1524.0},
-	{411, "Angola", "Luau", -10.7000000, 22.2333336, 1109.0},
-	{412, "Angola", "Catumbela", -12.4313889, 13.5472221, 31.0},
-	{413, "Angola", "Camabatela", -8.1833333, 15.3666668, 1195.0},
-	{414, "Angola", "Waku Kungo", -11.3500000, 15.1166668, 1476.0},
-	{415, "Angola", "Caconda", -13.7333333, 15.0666666, 1724.0},
-	{416, "Angola", "Ondjiva", -17.0666667, 15.7333336, 1099.0},
-	{417, "Angola", "Quibala", -10.7333333, 14.9833336, 1375.0},
-	{418, "Angola", "Chissamba", -12.1666667, 17.3333340, 1373.0},
-	{419, "Angola", "Leua", -11.6500000, 20.4500008, 1214.0},
-	{420, "Angola", "Lumeje", -11.5500000, 20.7833328, 1121.0},
-	{421, "Angola", "Cazage", -11.0666667, 20.7000008, 1143.0},
-	{422, "Argentina", "Buenos Aires", -34.5761256, -58.4088135, 13.0},
-	{423, "Argentina", "Cordoba", -31.4000000, -64.1833344, 384.0},
-	{424, "Argentina", "Rosario", -32.9511111, -60.6663895, 11.0},
-	{425, "Argentina", "Mendoza", -32.8833333, -68.8166656, 824.0},
-	{426, "Argentina", "San Miguel de Tucuman", -26.8166667, -65.2166672, 437.0},
-	{427, "Argentina", "La Plata", -34.9313889, -57.9488907, 29.0},
-	{428, "Argentina", "Mar del Plata", -38.0000000, -57.5499992, 39.0},
-	{429, "Argentina", "Quilmes", -34.7202778, -58.2694435, 19.0},
-	{430, "Argentina", "Salta", -24.7833333, -65.4166641, 1171.0},
-	{431, "Argentina", "Santa Fe", -31.6333333, -60.7000008, 20.0},
-	{432, "Argentina", "San Juan", -31.5375000, -68.5363922, 650.0},
-	{433, "Argentina", "Resistencia", -27.4500000, -58.9833336, 48.0},
-	{434, "Argentina", "Santiago del Estero", -27.7833333, -64.2666702, 180.0},
-	{435, "Argentina", "Corrientes", -27.4666667, -58.8333321, 52.0},
-	{436, "Argentina", "Posadas", -27.3833333, -55.8833351, 54.0},
-	{437, "Argentina", "San Salvador de Jujuy", -24.1833333, -65.3000031, 1206.0},
-	{438, "Argentina", "Bahia Blanca", -38.7166667, -62.2833328, 21.0},
-	{439, "Argentina", "Parana", -31.7333333, -60.5333328, 68.0},
-	{440, "Argentina", "Neuquen", -38.9500000, -68.0666656, 255.0},
-	{441, "Argentina", "Formosa", -26.1833333, -58.1833344, 55.0},
-	{442, "Argentina", "San Fernando del Valle de Catamarca", -28.4666667, -65.7833328, 520.0},
-	{443, "Argentina", "San Luis", -33.3000000, -66.3499985, 709.0},
-	{444, "Argentina", "La Rioja", -29.4333333, -66.8499985, 465.0},
-	{445, "Argentina", "Rio Cuarto", -33.1333333, -64.3499985, 422.0},
-	{446, "Argentina", "Concordia", -31.4000000, -58.0333328, 22.0},
-	{447, "Argentina", "Comodoro Rivadavia", -45.8666667, -67.5000000, 18.0},
-	{448, "Argentina", "San Nicolas de los Arroyos", -33.3302778, -60.2269440, 6.0},
-	{449, "Argentina", "Santa Rosa", -36.6166667, -64.2833328, 176.0},
-	{450, "Argentina", "San Rafael", -34.6000000, -68.3333359, 691.0},
-	{451, "Argentina", "Tandil", -37.3166667, -59.1500015, 189.0},
-	{452, "Argentina", "San Carlos de Bariloche", -41.1500000, -71.3000031, 894.0},
-	{453, "Argentina", "Trelew", -43.2489539, -65.3050537, 10.0},
-	{454, "Argentina", "Villa Maria", -32.4166667, -63.2500000, 187.0},
-	{455, "Argentina", "Reconquista", -29.1500000, -59.6500015, 32.0},
-	{456, "Argentina", "Zarate", -34.0922222, -59.0349998, 27.0},
-	{457, "Argentina", "Rafaela", -31.2666667, -61.4833336, 91.0},
-	{458, "Argentina", "Pergamino", -33.8925000, -60.5724983, 57.0},
-	{459, "Argentina", "Olavarria", -36.9000000, -60.2833328, 163.0},
-	{460, "Argentina", "Rio Gallegos", -51.6333333, -69.2166672, 13.0},
-	{461, "Argentina", "Junin", -34.5850000, -60.9588890, 77.0},
-	{462, "Argentina", "San Martin", -33.0666667, -68.4666672, 656.0},
-	{463, "Argentina", "Presidencia Roque Saenz Pena", -26.7833333, -60.4500008, 87.0},
-	{464, "Argentina", "Lujan", -34.5702778, -59.1049995, 22.0},
-	{465, "Argentina", "Campana", -34.1769444, -58.9208336, 3.0},
-	{466, "Argentina", "Necochea", -38.5500000, -58.7500000, 31.0},
-	{467, "Argentina", "Gualeguaychu", -33.0102778, -58.5141678, 15.0},
-	{468, "Argentina", "San Ramon de la Nueva Oran", -23.1322304, -64.3259811, 357.0},
-	{469, "Argentina", "General Roca", -39.0333333, -67.5833359, 232.0},
-	{470, "Argentina", "Venado Tuerto", -33.7497222, -61.9669456, 109.0},
-	{471, "Argentina", "Goya", -29.1333333, -59.2666664, 23.0},
-	{472, "Argentina", "Villa Carlos Paz", -31.4000000, -64.5166702, 686.0},
-	{473, "Argentina", "Concepcion del Uruguay", -32.4833333, -58.2283325, 3.0},
-	{474, "Argentina", "Puerto Madryn", -42.7666667, -65.0500031, 18.0},
-	{475, "Argentina", "Tartagal", -22.5333333, -63.8166656, 468.0},
-	{476, "Argentina", "San Francisco", -31.4333333, -62.0833321, 123.0},
-	{477, "Argentina", "San Pedro", -24.2333333, -64.8666687, 563.0},
-	{478, "Argentina", "Ushuaia", -54.8000000, -68.3000031, -9999.0},
-	{479, "Argentina", "Punta Alta", -38.8833333, -62.0833321, 3.0},
-	{480, "Argentina", "General Pico", -35.6666667, -63.7333336, 103.0},
-	{481, "Argentina", "Colegiales", -34.5833333, -58.4500008, 21.0},
-	{482, "Argentina", "Obera", -27.4833333, -55.1333351, 302.0},
-	{483, "Argentina", "Chivilcoy", -34.9083333, -60.0305557, 34.0},
-	{484, "Argentina", "Azul", -36.7833333, -59.8499985, 137.0},
-	{485, "Argentina", "Rio Tercero", -32.1833333, -64.0999985, 365.0},
-	{486, "Argentina", "Mercedes", -34.6544444, -59.4344444, 40.0},
-	{487, "Argentina", "Libertador General San Martin", -23.8000000, -64.8000031, 473.0},
-	{488, "Argentina", "Viedma", -40.8000000, -63.0000000, 12.0},
-	{489, "Argentina", "San Lorenzo", -28.1333333, -58.7666664, 70.0},
-	{490, "Argentina", "Cutral-Co", -38.9333333, -69.2333298, 612.0},
-	{491, "Argentina", "Tres Arroyos", -38.3833333, -60.2833328, 100.0},
-	{492, "Argentina", "Villa Constitucion", -33.2333333, -60.3333321, 50.0},
-	{493, "Argentina", "Alta Gracia", -31.6666667, -64.4333344, 516.0},
-	{494, "Argentina", "Canada de Gomez", -32.8169444, -61.4008331, 70.0},
-	{495, "Argentina", "Bell Ville", -32.6166667, -62.7000008, 123.0},
-	{496, "Argentina", "Nueve de Julio", -35.4541667, -60.8913879, 83.0},
-	{497, "Argentina", "Chacabuco", -34.6416667, -60.4738884, 66.0},
-	{498, "Argentina", "Villa Santa Rita", -34.6147735, -58.4797096, 21.0},
-	{499, "Argentina", "Gualeguay", -33.1444444, -59.3291664, 15.0},
-	{500, "Argentina", "Puerto Iguazu", -25.5991157, -54.5735550, 183.0},
-	{501, "Argentina", "Mercedes", -29.2000000, -58.0833321, 85.0},
-	{502, "Argentina", "Coronel Suarez", -37.4666667, -61.9166679, 228.0},
-	{503, "Argentina", "Rawson", -43.3000000, -65.0999985, 4.0},
-	{504, "Argentina", "Dolores", -36.3333333, -57.6666679, 9.0},
-	{505, "Argentina", "Victoria", -32.6184122, -60.1547813, 36.0},
-	{506, "Argentina", "Lincoln", -34.8650000, -61.5319443, 78.0},
-	{507, "Argentina", "Veinticinco de Mayo", -35.4280556, -60.1741676, 59.0},
-	{508, "Argentina", "Clorinda", -25.2833333, -57.7166672, 63.0},
-	{509, "Argentina", "Cafayate", -26.0833333, -65.9666672, 1590.0},
-	{510, "Argentina", "El Calafate", -50.3407497, -72.2768173, 200.0},
-	{511, "Argentina", "Larroque", -33.0333333, -59.0166664, 58.0},
-	{512, "Argentina", "Campo Quijano", -24.9166667, -65.6500015, 1665.0},
-	{513, "Argentina", "San Antonio de los Cobres", -24.1833333, -66.3499985, 3932.0},
-	{514, "Argentina", "Santa Rosa de Tastil", -24.4333333, -65.9499969, 3346.0},
-	{515, "Argentina", "Purmamarca", -23.7333333, -65.4833298, 2222.0},
-	{516, "Argentina", "Villa Catedral", -41.1673478, -71.4387741, 1021.0},
-	{517, "Armenia", "Yerevan", 40.1811111, 44.5136108, 1002.0},
-	{518, "Armenia", "Gyumri", 40.7894444, 43.8474998, 1545.0},
-	{519, "Armenia", "Vanadzor", 40.8127778, 44.4883347, 1320.0},
-	{520, "Armenia", "Ejmiatsin", 40.1727778, 44.2924995, 879.0},
-	{521, "Armenia", "Hrazdan", 40.5016667, 44.7547226, 1735.0},
-	{522, "Armenia", "Abovyan", 40.2691667, 44.6244431, 1408.0},
-	{523, "Armenia", "Kapan", 39.2011111, 46.4150009, 822.0},
-	{524, "Armenia", "Ararat", 39.8302778, 44.7024994, 813.0},
-	{525, "Armenia", "Armavir", 40.1522222, 44.0308342, 878.0},
-	{526, "Armenia", "Gavarr", 40.3588889, 45.1266670, 1982.0},
-	{527, "Armenia", "Artashat", 39.9538889, 44.5505562, 837.0},
-	{528, "Armenia", "Goris", 39.5077778, 46.3386116, 1363.0},
-	{529, "Armenia", "Masis", 40.0672222, 44.4361115, 843.0},
-	{530, "Armenia", "Ashtarak", 40.3016667, 44.3591652, 1190.0},
-	{531, "Armenia", "Sevan", 40.5550000, 44.9536095, 1905.0},
-	{532, "Armenia", "Spitak", 40.8372222, 44.2675018, 1543.0},
-	{533, "Armenia", "Ijevan", 40.8755556, 45.1491661, 755.0},
-	{534, "Armenia", "Dilijan", 40.7408333, 44.8630562, 1345.0},
-	{535, "Armenia", "Alaverdi", 41.1305556, 44.6519432, 1296.0},
-	{536, "Armenia", "Vedi", 39.9105556, 44.7277794, 919.0},
-	{537, "Armenia", "Vardenis", 40.1805556, 45.7283325, 1944.0},
-	{538, "Armenia", "Martuni", 40.1447222, 45.3063889, 1915.0},
-	{539, "Armenia", "Yeghvard", 40.3216667, 44.4813881, 1335.0},
-	{540, "Armenia", "Metsamor", 40.0713889, 44.2891655, 842.0},
-	{541, "Armenia", "Berd", 40.8808333, 45.3916664, 978.0},
-	{542, "Armenia", "Vardenik", 40.1350000, 45.4363899, 2031.0},
-	{543, "Armenia", "Akhuryan", 40.7813889, 43.8963890, 1544.0},
-	{544, "Armenia", "Tashir", 41.1244444, 44.2819443, 1522.0},
-	{545, "Armenia", "Byureghavan", 40.3147222, 44.5936127, 1411.0},
-	{546, "Armenia", "Garrni", 40.1194444, 44.7230568, 1410.0},
-	{547, "Armenia", "Sarukhan", 40.2891667, 45.1341667, 1972.0},
-	{548, "Armenia", "Chambarak", 40.5952778, 45.3474998, 1852.0},
-	{549, "Armenia", "Aparan", 40.5961111, 44.3536110, 1899.0},
-	{550, "Armenia", "Noratus", 40.3822222, 45.1783333, 1935.0},
-	{551, "Armenia", "Hoktember", 40.1361111, 44.0138893, 869.0},
-	{552, "Armenia", "Noyemberyan", 41.1725000, 44.9936104, 857.0},
-	{553, "Armenia", "Karanlukh", 40.1044444, 45.2897224, 2167.0},
-	{554, "Armenia", "Mrgashat", 40.1297222, 44.0705566, 856.0},
-	{555, "Armenia", "Maralik", 40.5722222, 43.8669434, 1717.0},
-	{556, "Armenia", "Nor Geghi", 40.3211111, 44.5797234, 1300.0},
-	{557, "Armenia", "Oshakan", 40.2705556, 44.2691650, 1011.0},
-	{558, "Armenia", "Metsavan", 41.2016667, 44.2288895, 1560.0},
-	{559, "Armenia", "Agarak", 39.2038889, 46.5472221, 892.0},
-	{560, "Armenia", "Jermuk", 39.8416667, 45.6722221, 2135.0},
-	{561, "Armenia", "Meghri", 38.9022222, 46.2383347, 684.0},
-	{562, "Armenia", "Odzun", 41.0538889, 44.6113892, 1100.0},
-	{563, "Armenia", "Zovuni", 40.5108333, 44.4288902, 1852.0},
-	{564, "Armenia", "Azatan", 40.7238889, 43.8205566, 1483.0},
-	{565, "Armenia", "Prroshyan", 40.2480556, 44.4150009, 1185.0},
-	{566, "Armenia", "Kasakh", 40.5333333, 44.4166679, 1811.0},
-	{567, "Armenia", "Yeranos", 40.2080556, 45.1861115, 1963.0},
-	{568, "Armenia", "Avshar", 39.8405556, 44.6836128, 840.0},
-	{569, "Armenia", "Lchashen", 40.5202778, 44.9308319, 1893.0},
-	{570, "Armenia", "Malishka", 39.7369444, 45.3875008, 1187.0},
-	{571, "Armenia", "Verin Getashen", 40.1327778, 45.2486115, 1968.0},
-	{572, "Armenia", "Nalbandyan", 40.0677778, 43.9855537, 870.0},
-	{573, "Armenia", "Tsovinar", 40.1608333, 45.4538879, 1916.0},
-	{574, "Armenia", "Verin Artashat", 40.0005556, 44.5849991, 921.0},
-	{575, "Armenia", "Shahumyan", 39.9308333, 44.5783348, 835.0},
-	{576, "Armenia", "Byurakan", 40.3413889, 44.2736130, 1462.0},
-	{577, "Armenia", "Tsovagyugh", 40.6330556, 44.9522209, 1981.0},
-	{578, "Armenia", "Gandzak", 40.3147222, 45.1113892, 1985.0},
-	{579, "Armenia", "Arshaluys", 40.1688889, 44.2141685, 872.0},
-	{580, "Armenia", "Voskevaz", 40.2797222, 44.2949982, 1035.0},
-	{581, "Armenia", "Aygavan", 39.8647222, 44.6702766, 848.0},
-	{582, "Armenia", "Dzoragyugh", 40.1752778, 45.1749992, 2096.0},
-	{583, "Armenia", "Karbi", 40.3338889, 44.3777771, 1298.0},
-	{584, "Armenia", "Astghadzor", 40.1238889, 45.3566666, 2042.0},
-	{585, "Armenia", "Hovtashat", 40.1019444, 44.3427773, 835.0},
-	{586, "Armenia", "Myasnikyan", 40.1775000, 43.9063873, 913.0},
-	{587, "Armenia", "Haghartsin", 40.7780556, 44.9625015, 1075.0},
-	{588, "Armenia", "Gay", 40.0838889, 44.3055573, 839.0},
-	{589, "Armenia", "Margahovit", 40.7380556, 44.6875000, 1833.0},
-	{590, "Armenia", "Vaghashen", 40.1377778, 45.3291664, 1950.0},
-	{591, "Armenia", "Aramus", 40.2511111, 44.6630554, 1443.0},
-	{592, "Armenia", "Artsvaberd", 40.8336111, 45.4711113, 1167.0},
-	{593, "Armenia", "Aygezard", 39.9458333, 44.6088905, 879.0},
-	{594, "Armenia", "Berdavan", 41.2027778, 45.0033340, 671.0},
-	{595, "Armenia", "Bambakashat", 40.1144444, 44.0122223, 870.0},
-	{596, "Armenia", "Arteni", 40.2983333, 43.7541656, 1225.0},
-	{597, "Armenia", "Dalarik", 40.2183333, 43.8602791, 1020.0},
-	{598, "Armenia", "Jrashen", 40.7875000, 44.1972237, 1737.0},
-	{599, "Armenia", "Norashen", 40.0041667, 44.5947227, 935.0},
-	{600, "Armenia", "Bagratashen", 41.2458333, 44.8211098, 479.0},
-	{601, "Armenia", "Janfida", 40.0455556, 44.0208321, 877.0},
-	{602, "Armenia", "Balahovit", 40.2536111, 44.6038895, 1412.0},
-	{603, "Armenia", "Marmarashen", 40.0575000, 44.4711113, 853.0},
-	{604, "Armenia", "Vostan", 39.9588889, 44.5591660, 849.0},
-	{605, "Armenia", "Aghavnatun", 40.2336111, 44.2513885, 939.0},
-	{606, "Armenia", "Aygehovit", 40.9744444, 45.2519455, 815.0},
-	{607, "Armenia", "Shnogh", 41.1477778, 44.8377762, 684.0},
-	{608, "Australia", "Sydney", -33.8678500, 151.2073212, 1.0},
-	{609, "Australia", "Melbourne", -37.8139966, 144.9633179, 58.0},
-	{610, "Australia", "Perth", -31.9333333, 115.8333359, 32.0},
-	{611, "Australia", "Adelaide", -34.9333333, 138.6000061, 65.0},
-	{612, "Australia", "Brisbane", -27.4679357, 153.0280914, 15.0},
-	{613, "Australia", "Gold Coast", -28.0000000, 153.4333344, 1.0},
-	{614, "Australia", "Newcastle", -32.9271484, 151.7764740, 1.0},
-	{615, "Australia", "Canberra", -35.2834625, 149.1280670, 609.0},
-	{616, "Australia", "Wollongong", -34.4333333, 150.8833313, 26.0},
-	{617, "Australia", "Geelong", -38.1471126, 144.3607025, -9999.0},
-	{618, "Australia", "Cairns", -16.9166667, 145.7666626, 1.0},
-	{619, "Australia", "Geelong West", -38.1333333, 144.3500061, -9999.0},
-	{620, "Australia", "Townsville", -19.2500000, 146.8000031, 1.0},
-	{621, "Australia", "Albury", -36.0833333, 146.9166718, 170.0},
-	{622, "Australia", "Nowra", -34.8833333, 150.6000061, 1.0},
-	{623, "Australia", "Darwin", -12.4611337, 130.8418427, -9999.0},
-	{624, "Australia", "Toowoomba", -27.5500000, 151.9666595, 560.0},
-	{625, "Australia", "Ballarat", -37.5666667, 143.8500061, 465.0},
-	{626, "Australia", "Bendigo", -36.7666667, 144.2833405, 234.0},
-	{627, "Australia", "Mackay", -21.1500000, 149.1999969, -9999.0},
-	{628, "Australia", "Mandurah", -32.5500000, 115.6999969, 1.0},
-	{629, "Australia", "Launceston", -41.4500000, 147.1666718, 105.0},
-	{630, "Australia", "Rockhampton", -23.3833333, 150.5000000, 2.0},
-	{631, "Australia", "Coffs Harbour", -30.3000000, 153.1333313, 8.0},
-	{632, "Australia", "South Brisbane", -27.4833333, 153.0166626, 17.0},
-	{633, "Australia", "Wagga Wagga", -35.1166667, 147.3666687, 148.0},
-	{634, "Australia", "Bundaberg", -24.8500000, 152.3500061, 7.0},
-	{635, "Australia", "Port Macquarie", -31.4333333, 152.9166718, -9999.0},
-	{636, "Australia", "Mildura", -34.2000000, 142.1499939, 60.0},
-	{637, "Australia", "Hobart", -42.9166667, 147.3333282, 151.0},
-	{638, "Australia", "Torquay", -25.2833333, 152.8666687, -9999.0},
-	{639, "Australia", "Mooloolaba", -26.6833333, 153.1166687, -9999.0},
-	{640, "Australia", "Taree", -31.9000000, 152.4666595, 56.0},
-	{641, "Australia", "Cranbourne", -38.1000000, 145.2833405, 1.0},
-	{642, "Australia", "Caloundra", -26.8000000, 153.1333313, 1.0},
-	{643, "Australia", "Tamworth", -31.1000000, 150.9333344, 545.0},
-	{644, "Australia", "Kalgoorlie", -30.7500000, 121.4666672, 469.0},
-	{645, "Australia", "Shepparton", -36.3833333, 145.3999939, 132.0},
-	{646, "Australia", "Melton", -37.6833333, 144.5833282, 128.0},
-	{647, "Australia", "Queanbeyan", -35.3500000, 149.2333374, 609.0},
-	{648, "Australia", "Wodonga", -36.1166667, 146.8833313, 153.0},
-	{649, "Australia", "Lismore", -28.8135438, 153.2772980, 130.0},
-	{650, "Australia", "Gladstone", -23.8500000, 151.2500000, 1.0},
-	{651, "Australia", "Orange", -33.2833333, 149.1000061, 652.0},
-	{652, "Australia", "Warrnambool", -38.3833333, 142.4833374, 1.0},
-	{653, "Australia", "Sunbury", -37.5833333, 144.7333374, 197.0},
-	{654, "Australia", "Dubbo", -32.2500000, 148.6166687, 304.0},
-	{655, "Australia", "Geraldton", -28.7666667, 114.5999985, 2.0},
-	{656, "Australia", "Bunbury", -33.3333333, 115.6333313, 1.0},
-	{657, "Australia", "Alice Springs", -23.7000000, 133.8833313, 609.0},
-	{658, "Australia", "Palmerston", -12.4860237, 130.9832916, 6.0},
-	{659, "Australia", "Albany", -35.0169444, 117.8916702, 85.0},
-	{660, "Australia", "Mount Gambier", -37.8333333, 140.7666626, 82.0},
-	{661, "Australia", "Armidale", -30.5166667, 151.6499939, 1014.0},
-	{662, "Australia", "Mount Isa", -20.7333333, 139.5000000, 438.0},
-	{663, "Australia", "Caboolture", -27.0833333, 152.9499969, 66.0},
-	{664, "Australia", "Goulburn", -34.7500000, 149.7166595, 563.0},
-	{665, "Australia", "Mornington", -38.2166667, 145.0333405, 1.0},
-	{666, "Australia", "Maryborough", -25.5333333, 152.6999969, 4.0},
-	{667, "Australia", "Kwinana", -32.2500000, 115.7666702, 24.0},
-	{668, "Australia", "Burnie", -41.0666667, 145.9166718, 1.0},
-	{669, "Australia", "Brunswick", -37.7666667, 144.9666595, 129.0},
-	{670, "Australia", "Craigieburn", -37.6000000, 144.9499969, 156.0},
-	{671, "Australia", "Whyalla", -33.0333333, 137.5833282, 1.0},
-	{672, "Australia", "Echuca", -36.1333333, 144.7500000, 73.0},
-	{673, "Australia", "Devonport", -41.1666667, 146.3500061, 1.0},
-	{674, "Australia", "Roebourne", -20.7833333, 117.1333313, 11.0},
-	{675, "Australia", "Hornsby", -33.7000000, 151.1000061, 163.0},
-	{676, "Australia", "Katoomba", -33.7000000, 150.3000031, 720.0},
-	{677, "Australia", "Surfers Paradise", -28.0027378, 153.4299927, 1.0},
-	{678, "Australia", "Traralgon", -38.1833333, 146.5333405, 119.0},
-	{679, "Australia", "Murray Bridge", -35.1166667, 139.2666626, 10.0},
-	{680, "Australia", "Nerang", -27.9833333, 153.3333282, 49.0},
-	{681, "Australia", "Forster", -32.1666667, 152.5166626, -9999.0},
-	{682, "Australia", "Broken Hill", -31.9500000, 141.4333344, 305.0},
-	{683, "Australia", "Karratha", -20.7291441, 116.8547058, 1.0},
-	{684, "Australia", "South Grafton", -29.7000000, 152.9499969, 78.0},
-	{685, "Australia", "Cessnock", -32.8333333, 151.3500061, 152.0},
-	{686, "Australia", "Gawler", -34.6000000, 138.7333374, 72.0},
-	{687, "Australia", "Deception Bay", -27.2000000, 153.0333405, 3.0},
-	{688, "Australia", "Griffith", -34.2833333, 146.0333405, 117.0},
-	{689, "Australia", "Buderim", -26.6833333, 153.0500031, 43.0},
-	{690, "Australia", "Nambour", -26.6333333, 152.9499969, 139.0},
-	{691, "Australia", "Crafers", -35.0000000, 138.6999969, 303.0},
-	{692, "Australia", "Sawtell", -30.3833333, 153.1000061, -9999.0},
-	{693, "Australia", "Kingston", -42.9666667, 147.3166656, 23.0},
-	{694, "Australia", "Port Hedland", -20.3166667, 118.5666656, -9999.0},
-	{695, "Australia", "Ballina", -28.8666667, 153.5666656, 1.0},
-	{696, "Australia", "Wangaratta", -36.3666667, 146.3333282, 148.0},
-	{697, "Australia", "Port Augusta", -32.5000000, 137.7666626, 7.0},
-	{698, "Australia", "Singleton", -32.5666667, 151.1833344, 154.0},
-	{699, "Australia", "Cleveland", -27.5333333, 153.2666626, 1.0},
-	{700, "Australia", "Bongaree", -27.0797222, 153.1586151, 1.0},
-	{701, "Australia", "Raymond Terrace", -32.7500000, 151.7500000, 7.0},
-	{702, "Australia", "Bacchus Marsh", -37.6833333, 144.4499969, 129.0},
-	{703, "Australia", "Broome", -17.9666667, 122.2333298, -9999.0},
-	{704, "Australia", "Moe", -38.1666667, 146.2666626, 150.0},
-	{705, "Australia", "Sale", -38.1000000, 147.0666656, 37.0},
-	{706, "Australia", "Horsham", -36.7166667, 142.1999969, 116.0},
-	{707, "Australia", "Warwick", -28.2333333, 152.0166626, 555.0},
-	{708, "Austria", "Wien", 48.2084878, 16.3720760, 164.0},
-	{709, "Austria", "Graz", 47.0666667, 15.4499998, 365.0},
-	{710, "Austria", "Linz", 48.3063889, 14.2861109, 263.0},
-	{711, "Austria", "Salzburg", 47.8000000, 13.0333328, 447.0},
-	{712, "Austria", "Innsbruck", 47.2666667, 11.3999996, 566.0},
-	{713, "Austria", "Klagenfurt", 46.6247222, 14.3052778, 443.0},
-	{714, "Austria", "Villach", 46.6102778, 13.8558331, 473.0},
-	{715, "Austria", "Wels", 48.1666667, 14.0333328, 315.0},
-	{716, "Austria", "Sankt Polten", 48.2000000, 15.6333332, 262.0},
-	{717, "Austria", "Dornbirn", 47.4166667, 9.7330561, 415.0},
-	{718, "Austria", "Steyr", 48.0427423, 14.4212723, 290.0},
-	{719, "Austria", "Wiener Neustadt", 47.8000000, 16.2500000, 262.0},
-	{720, "Austria", "Feldkirch", 47.2330556, 9.6000004, 464.0},
-	{721, "Austria", "Bregenz", 47.5031127, 9.7471046, 433.0},
-	{722, "Austria", "Wolfsberg", 46.8405556, 14.8441668, 555.0},
-	{723, "Austria", "Baden", 48.0166667, 16.2333336, 289.0},
-	{724, "Austria", "Klosterneuburg", 48.3052064, 16.3252220, 153.0},
-	{725, "Austria", "Leoben", 47.3833333, 15.1000004, 552.0},
-	{726, "Austria", "Weinzierl bei Krems", 48.4000000, 15.6000004, 189.0},
-	{727, "Austria", "Traun", 48.2166667, 14.2333336, 271.0},
-	{728, "Austria", "Krems an der Donau", 48.4092063, 15.6141472, 192.0},
-	{729, "Austria", "Amstetten", 48.1229032, 14.8720551, 268.0},
-	{730, "Austria", "Leonding", 48.2666667, 14.2500000, 275.0},
-	{731, "Austria", "Kapfenberg", 47.4333333, 15.3000002, 711.0},
-	{732, "Austria", "Modling", 48.0833333, 16.2833328, 222.0},
-	{733, "Austria", "Lustenau", 47.4330556, 9.6499996, 398.0},
-	{734, "Austria", "Hallein", 47.6833333, 13.1000004, 450.0},
-	{735, "Austria", "Braunau am Inn", 48.2666667, 13.0333328, 360.0},
-	{736, "Austria", "Spittal an der Drau", 46.8000000, 13.5000000, 564.0},
-	{737, "Austria", "Traiskirchen", 48.0166667, 16.2833328, 202.0},
-	{738, "Austria", "Saalfelden am Steinernen Meer", 47.4166667, 12.8500004, 755.0},
-	{739, "Austria", "Kufstein", 47.5833333, 12.1666670, 590.0},
-	{740, "Austria", "Schwechat", 48.1333333, 16.4666672, 167.0},
-	{741, "Austria", "Ternitz", 47.7166667, 16.0333328, 386.0},
-	{742, "Austria", "Ansfelden", 48.2000000, 14.2833328, 342.0},
-	{743, "Austria", "Stockerau", 48.3833333, 16.2166672, 171.0},
-	{744, "Austria", "Feldkirchen in Karnten", 46.7166667, 14.1000004, 594.0},
-	{745, "Austria", "Bad Ischl", 47.7166667, 13.6166668, 638.0},
-	{746, "Austria", "Tulln", 48.3333333, 16.0499992, 171.0},
-	{747, "Austria", "Hohenems", 47.3666667, 9.6830559, 404.0},
-	{748, "Austria", "Perchtoldsdorf", 48.1166667, 16.2666664, 226.0},
-	{749, "Austria", "Telfs", 47.3000000, 11.0666666, 684.0},
-	{750, "Austria", "Bludenz", 47.1497222, 9.8166666, 554.0},
-	{751, "Austria", "Gmunden", 47.9166667, 13.8000002, 479.0},
-	{752, "Austria", "Bruck an der Mur", 47.4166667, 15.2833328, 629.0},
-	{753, "Austria", "Sankt Veit an der Glan", 46.7680556, 14.3602781, 466.0},
-	{754, "Austria", "Knittelfeld", 47.2166667, 14.8166666, 658.0},
-	{755, "Austria", "Schwaz", 47.3349837, 11.7002678, 696.0},
-	{756, "Austria", "Lienz", 46.8311111, 12.7597218, 661.0},
-	{757, "Austria", "Vocklabruck", 48.0166667, 13.6499996, 482.0},
-	{758, "Austria", "Waidhofen an der Ybbs", 47.9666667, 14.7666664, 474.0},
-	{759, "Austria", "Hard", 47.4830556, 9.6830559, 400.0},
-	{760, "Austria", "Zwettl Stift", 48.6166667, 15.1999998, 552.0},
-	{761, "Austria", "Marchtrenk", 48.1833333, 14.1166668, 293.0},
-	{762, "Austria", "Volkermarkt", 46.6622222, 14.6344442, 444.0},
-	{763, "Austria", "Ried im Innkreis", 48.2166667, 13.5000000, 413.0},
-	{764, "Austria", "Korneuburg", 48.3500000, 16.3333340, 162.0},
-	{765, "Austria", "Rankweil", 47.2830556, 9.6499996, 531.0},
-	{766, "Austria", "Neunkirchen", 47.7166667, 16.0833340, 337.0},
-	{767, "Austria", "Worgl", 47.4833333, 12.0666666, 718.0},
-	{768, "Austria", "Bad Voslau", 47.9666667, 16.2000008, 251.0},
-	{769, "Austria", "Hollabrunn", 48.5500000, 16.0833340, 251.0},
-	{770, "Austria", "Sankt Johann im Pongau", 47.3500000, 13.1999998, 712.0},
-	{771, "Austria", "Sankt Andra", 46.7661111, 14.8236113, 397.0},
-	{772, "Austria", "Mistelbach", 48.5700000, 16.5766659, 216.0},
-	{773, "Austria", "Enns", 48.2000000, 14.4666672, 250.0},
-	{774, "Austria", "Koflach", 47.0666667, 15.0833330, 478.0},
-	{775, "Austria", "Gotzis", 47.3330556, 9.6330557, 416.0},
-	{776, "Austria", "Bischofshofen", 47.4166667, 13.2166672, 691.0},
-	{777, "Austria", "Zell am See", 47.3166667, 12.7833328, 946.0},
-	{778, "Austria", "Judenburg", 47.1666667, 14.6666670, 712.0},
-	{779, "Austria", "Voitsberg", 47.0333333, 15.1499996, 544.0},
-	{780, "Austria", "Seekirchen Markt", 47.9000000, 13.1333332, 508.0},
-	{781, "Austria", "Altmunster", 47.9021890, 13.7641525, 436.0},
-	{782, "Austria", "Laakirchen", 47.9818790, 13.8216591, 441.0},
-	{783, "Austria", "Ebreichsdorf", 47.9500000, 16.3999996, 194.0},
-	{784, "Austria", "Murzzuschlag", 47.6000000, 15.6833334, 811.0},
-	{785, "Austria", "Imst", 47.2450371, 10.7397366, 781.0},
-	{786, "Austria", "Lauterach", 47.4830556, 9.7330561, 410.0},
-	{787, "Austria", "Weiz", 47.2166667, 15.6166668, 460.0},
-	{788, "Austria", "Neu-Guntramsdorf", 48.0666667, 16.3166676, 185.0},
-	{789, "Austria", "Sankt Valentin", 48.1666667, 14.5166664, 261.0},
-	{790, "Austria", "Gerasdorf bei Wien", 48.2944721, 16.4676476, 151.0},
-	{791, "Austria", "Kitzbuhel", 47.4463748, 12.3921490, 812.0},
-	{792, "Austria", "Velden am Worthersee", 46.6161111, 14.0458336, 425.0},
-	{793, "Austria", "Berndorf", 47.9500000, 16.1000004, 336.0},
-	{794, "Austria", "Gross-Enzersdorf", 48.2027778, 16.5508327, 148.0},
-	{795, "Austria", "Sierning", 48.0434309, 14.3093491, 361.0},
-	{796, "Austria", "Ganserndorf", 48.3392500, 16.7201614, 153.0},
-	{797, "Austria", "Wiener Neudorf", 48.0666667, 16.3166676, 185.0},
-	{798, "Austria", "Engerwitzdorf", 48.3500000, 14.4499998, 330.0},
-	{799, "Austria", "Rum", 47.2833333, 11.4499998, 556.0},
-	{800, "Austria", "Finkenstein am Faaker See", 46.5655556, 13.8652782, 564.0},
-	{801, "Austria", "Ebensee", 47.8000000, 13.7666664, 485.0},
-	{802, "Austria", "Purkersdorf", 48.2000000, 16.1666660, 372.0},
-	{803, "Austria", "Trofaiach", 47.4166667, 15.0000000, 643.0},
-	{804, "Austria", "Fohnsdorf", 47.2000000, 14.6833334, 723.0},
-	{805, "Austria", "Wolfurt", 47.4666667, 9.7500000, 529.0},
-	{806, "Austria", "Maria Enzersdorf", 48.1000000, 16.2833328, 214.0},
-	{807, "Austria", "Deutschlandsberg", 46.8152778, 15.2222223, 372.0},
-	{808, "Azerbaijan", "Baku", 40.3952778, 49.8822212, 30.0},
-	{809, "Azerbaijan", "Ganca", 40.6827778, 46.3605537, 409.0},
-	{810, "Azerbaijan", "Sumqayit", 40.5897222, 49.6686096, 24.0},
-	{811, "Azerbaijan", "Mingacevir", 40.7700000, 47.0488892, 32.0},
-	{812, "Azerbaijan", "Qaracuxur", 40.3966667, 49.9736099, 61.0},
-	{813, "Azerbaijan", "Ali Bayramli", 39.9319444, 48.9202766, 13.0},
-	{814, "Azerbaijan", "Bakixanov", 40.4216667, 49.9644432, 43.0},
-	{815, "Azerbaijan", "Naxcivan", 39.2088889, 45.4122238, 890.0},
-	{816, "Azerbaijan", "Saki", 41.1919444, 47.1705551, 606.0},
-	{817, "Azerbaijan", "Yevlax", 40.6172222, 47.1500015, 15.0},
-	{818, "Azerbaijan", "Bilacari", 40.4313889, 49.8080559, 73.0},
-	{819, "Azerbaijan", "Mastaga", 40.5327778, 50.0038872, 31.0},
-	{820, "Azerbaijan", "Agdam", 39.9930556, 46.9305573, 363.0},
-	{821, "Azerbaijan", "Barda", 40.3744444, 47.1266670, 82.0},
-	{822, "Azerbaijan", "Xacmaz", 41.4708333, 48.8097229, 25.0},
-	{823, "Azerbaijan", "Salyan", 39.5950000, 48.9791679, -4.0},
-	{824, "Azerbaijan", "Hovsan", 40.3744444, 50.0852776, -18.0},
-	{825, "Azerbaijan", "Calilabad", 39.2088889, 48.4972229, 31.0},
-	{826, "Azerbaijan", "Samkir", 40.8297222, 46.0188904, 433.0},
-	{827, "Azerbaijan", "Goycay", 40.6530556, 47.7405548, 111.0},
-	{828, "Azerbaijan", "Agcabadi", 40.0527778, 47.4613876, 24.0},
-	{829, "Azerbaijan", "Imisli", 39.8697222, 48.0600014, 15.0},
-	{830, "Azerbaijan", "Lokbatan", 40.3272222, 49.7299995, 32.0},
-	{831, "Azerbaijan", "Samaxi", 40.6302778, 48.6413879, 637.0},
-	{832, "Azerbaijan", "Sabirabad", 40.0127778, 48.4788895, -15.0},
-	{833, "Azerbaijan", "Amircan", 40.4263889, 49.9836121, 56.0},
-	{834, "Azerbaijan", "Buzovna", 40.5161111, 50.1163902, -11.0},
-	{835, "Azerbaijan", "Bina", 40.4538889, 50.0763893, 14.0},
-	{836, "Azerbaijan", "Agdas", 40.6500000, 47.4761124, 42.0},
-	{837, "Azerbaijan", "Qazimammad", 40.0433333, 48.9355545, -3.0},
-	{838, "Azerbaijan", "Quba", 41.3597222, 48.5125008, 587.0},
-	{839, "Azerbaijan", "Zabrat", 40.4872222, 49.9524994, 43.0},
-	{840, "Azerbaijan", "Sabuncu", 40.4425000, 49.9480553, 34.0},
-	{841, "Azerbaijan", "Haci Zeynalabdin", 40.6233333, 49.5586128, -10.0},
-	{842, "Azerbaijan", "Qazax", 41.0933333, 45.3661118, 384.0},
-	{843, "Azerbaijan", "Susa", 39.7563889, 46.7511101, 1172.0},
-	{844, "Azerbaijan", "Neftcala", 39.3586111, 49.2469444, -7.0},
-	{845, "Azerbaijan", "Zaqatala", 41.6336111, 46.6433334, 545.0},
-	{846, "Azerbaijan", "Tartar", 40.3450000, 46.9288902, 226.0},
-	{847, "Azerbaijan", "Bilasuvar", 39.4583333, 48.5449982, 15.0},
-	{848, "Azerbaijan", "Xanlar", 40.5869444, 46.3158340, 691.0},
-	{849, "Azerbaijan", "Agsu", 40.5691667, 48.4008331, 114.0},
-	{850, "Azerbaijan", "Qusar", 41.4263889, 48.4355545, 618.0},
-	{851, "Azerbaijan", "Ucar", 40.5183333, 47.6541672, 13.0},
-	{852, "Azerbaijan", "Mardakan", 40.4922222, 50.1388893, -4.0},
-	{853, "Azerbaijan", "Astara", 38.4561111, 48.8786125, -24.0},
-	{854, "Azerbaijan", "Binaqadi", 40.4758333, 49.8222237, 47.0},
-	{855, "Azerbaijan", "Xudat", 41.6280556, 48.6827774, 54.0},
-	{856, "Azerbaijan", "Ismayilli", 40.7900000, 48.1519432, 616.0},
-	{857, "Azerbaijan", "Artyom", 40.4708333, 50.3216667, -3.0},
-	{858, "Azerbaijan", "Qobustan", 40.0841667, 49.4158325, -15.0},
-	{859, "Azerbaijan", "Goytapa", 39.1166667, 48.5952797, 3.0},
-	{860, "Azerbaijan", "Tovuz", 40.9922222, 45.6288872, 413.0},
-	{861, "Azerbaijan", "Agstafa", 41.1188889, 45.4538879, 338.0},
-	{862, "Azerbaijan", "Qax", 41.4225000, 46.9241676, 616.0},
-	{863, "Azerbaijan", "Qabala", 40.9813889, 47.8458328, 789.0},
-	{864, "Azerbaijan", "Badamdar", 40.3394444, 49.8038902, 130.0},
-	{865, "Azerbaijan", "Balaxani", 40.4616667, 49.9199982, 61.0},
-	{866, "Azerbaijan", "Culfa", 38.9500000, 45.6319427, 707.0},
-	{867, "Azerbaijan", "Zardab", 40.2183333, 47.7083321, 7.0},
-	{868, "Azerbaijan", "Saray", 40.5322222, 49.7163887, 68.0},
-	{869, "Azerbaijan", "Agdara", 40.2152778, 46.8127785, 452.0},
-	{870, "Azerbaijan", "Zira", 40.3636111, 50.2902794, -21.0},
-	{871, "Azerbaijan", "Ordubad", 38.9080556, 46.0277786, 907.0},
-	{872, "Azerbaijan", "Turkan", 40.3638889, 50.2150002, -20.0},
-	{873, "Azerbaijan", "Masalli", 39.0341667, 48.6655540, 1.0},
-	{874, "Azerbaijan", "Balakan", 41.7258333, 46.4083328, 388.0},
-	{875, "Azerbaijan", "Aliabad", 41.4783333, 46.6175003, 254.0},
-	{876, "Azerbaijan", "Bilgah", 40.5680556, 50.0377769, 35.0},
-	{877, "Azerbaijan", "Ramana", 40.4422222, 49.9805565, 52.0},
-	{878, "Azerbaijan", "Gadabay", 40.5655556, 45.8161125, 1473.0},
-	{879, "Azerbaijan", "Corat", 40.5725000, 49.7063904, -1.0},
-	{880, "Azerbaijan", "Cabrayil", 39.4000000, 47.0261116, 570.0},
-	{881, "Azerbaijan", "Nardaran", 40.5561111, 50.0055542, 41.0},
-	{882, "Azerbaijan", "Icari", 39.0822222, 46.6549988, 478.0},
-	{883, "Azerbaijan", "Zayam", 40.8994444, 45.8858337, 375.0},
-	{884, "Azerbaijan", "Qobu", 40.4047222, 49.7130547, 74.0},
-	{885, "Azerbaijan", "Goranboy", 40.6102778, 46.7897224, 149.0},
-	{886, "Azerbaijan", "Lerik", 38.7752778, 48.4152794, 1108.0},
-	{887, "Azerbaijan", "Banka", 39.4200000, 49.2475014, -7.0},
-	{888, "Azerbaijan", "Naftalan", 40.5066667, 46.8250008, 199.0},
-	{889, "Azerbaijan", "Qubadli", 39.3438889, 46.5797234, 540.0},
-	{890, "Azerbaijan", "Aran", 40.6252778, 46.9755554, 44.0},
-	{891, "Azerbaijan", "Cinarli", 41.4725000, 46.9111099, 903.0},
-	{892, "Azerbaijan", "Mincivan", 39.0300000, 46.7186127, 335.0},
-	{893, "Azerbaijan", "Qizilhacili", 40.5808333, 46.8502769, 122.0},
-	{894, "Azerbaijan", "Boradigah", 38.9277778, 48.7086105, -9.0},
-	{895, "Azerbaijan", "Xocali", 39.9111111, 46.7891655, 584.0},
-	{896, "Azerbaijan", "Ceyranbatan", 40.5408333, 49.6597214, 45.0},
-	{897, "Azerbaijan", "Qarayeri", 40.7916667, 46.3213882, 239.0},
-	{898, "Azerbaijan", "Xocasan", 40.4122222, 49.7716675, 36.0},
-	{899, "Azerbaijan", "Xocavand", 39.7952778, 47.1130562, 336.0},
-	{900, "Azerbaijan", "Kijoba", 38.5327778, 48.8166656, -11.0},
-	{901, "Azerbaijan", "Mugan", 40.0986111, 48.8188896, -12.0},
-	{902, "Azerbaijan", "Dalilar", 40.8627778, 46.0366669, 333.0},
-	{903, "Azerbaijan", "Hasanabad", 39.3213889, 49.2091675, -8.0},
-	{904, "Azerbaijan", "Qaracala", 39.8077778, 48.9338875, -7.0},
-	{905, "Azerbaijan", "Yardimli", 38.9205556, 48.2372208, 979.0},
-	{906, "Azerbaijan", "Xilli", 39.4266667, 49.1063881, -7.0},
-	{907, "Bahrain", "Manama", 26.2361111, 50.5830574, -9999.0},
-	{908, "Bahrain", "Al Muharraq", 26.2572222, 50.6119461, 13.0},
-	{909, "Bahrain", "Dar Kulayb", 26.0686111, 50.5038872, 2.0},
-	{910, "Bahrain", "Madinat `Isa", 26.1736110, 50.5477791, 5.0},
-	{911, "Bahrain", "Sitrah", 26.1547222, 50.6205559, 6.0},
-	{912, "Bahrain", "Jidd Hafs", 26.2186111, 50.5477791, 1.0},
-	{913, "Bahrain", "Al Hadd", 26.2455556, 50.6541672, 3.0},
-	{914, "Bangladesh", "Dhaka", 23.7230556, 90.4086075, 4.0},
-	{915, "Bangladesh", "Chittagong", 22.3330556, 91.8363876, 7.0},
-	{916, "Bangladesh", "Khulna", 22.8000000, 89.5500031, 9.0},
-	{917, "Bangladesh", "Rajshahi", 24.3666667, 88.5999985, 31.0},
-	{918, "Bangladesh", "Comilla", 23.4577778, 91.2044449, 12.0},
-	{919, "Bangladesh", "Tungi", 23.8900000, 90.4058304, 4.0},
-	{920, "Bangladesh", "Mymensingh", 24.7500000, 90.4000015, 17.0},
-	{921, "Bangladesh", "Rangpur", 25.7500000, 89.2500000, 36.0},
-	{922, "Bangladesh", "Narsingdi", 23.9208333, 90.7180557, 4.0},
-	{923, "Bangladesh", "Jessore", 23.1666667, 89.2166672, 15.0},
-	{924, "Bangladesh", "Sylhet", 24.8966667, 91.8716660, 9.0},
-	{925, "Bangladesh", "Narayanganj", 23.6233333, 90.5011139, 4.0},
-	{926, "Bangladesh", "Dinajpur", 25.6333333, 88.6333313, 35.0},
-	{927, "Bangladesh", "Barisal", 22.7019444, 90.3711090, 2.0},
-	{928, "Bangladesh", "Saidpur", 25.7776918, 88.8916931, 36.0},
-	{929, "Bangladesh", "Par Naogaon", 24.8000000, 88.9499969, 14.0},
-	{930, "Bangladesh", "Tangail", 24.2500000, 89.9166641, 13.0},
-	{931, "Bangladesh", "Jamalpur", 24.9166667, 89.9333344, 12.0},
-	{932, "Bangladesh", "Sirajganj", 24.4500000, 89.7166672, 13.0},
-	{933, "Bangladesh", "Nawabganj", 24.6000000, 88.2833328, 21.0},
-	{934, "Bangladesh", "Pabna", 24.0000000, 89.2500000, 8.0},
-	{935, "Bangladesh", "Kushtia", 23.9166667, 89.1166687, 26.0},
-	{936, "Bangladesh", "Satkhira", 22.7166667, 89.0999985, 14.0},
-	{937, "Bangladesh", "Faridpur", 23.6000000, 89.8333359, 11.0},
-	{938, "Bangladesh", "Sherpur", 25.0166667, 90.0166702, 14.0},
-	{939, "Bangladesh", "Bhairab Bazar", 24.0666667, 90.9666672, 16.0},
-	{940, "Bangladesh", "Shahzadpur", 24.1666667, 89.5999985, 17.0},
-	{941, "Bangladesh", "Bhola", 22.6833333, 90.6500015, 1.0},
-	{942, "Bangladesh", "Kishorganj", 24.4333333, 90.7666702, 10.0},
-	{943, "Bangladesh", "Habiganj", 24.3833333, 91.4166641, 6.0},
-	{944, "Bangladesh", "Madaripur", 23.1752778, 90.2072220, -9999.0},
-	{945, "Bangladesh", "Feni", 23.0186111, 91.4100037, 5.0},
-	{946, "Bangladesh", "Laksham", 23.2472222, 91.1425018, 10.0},
-	{947, "Bangladesh", "Ishurdi", 24.1333333, 89.0833359, 30.0},
-	{948, "Bangladesh", "Sarishabari", 24.7333333, 89.8333359, 9.0},
-	{949, "Bangladesh", "Netrakona", 24.8833333, 90.7166672, 2.0},
-	{950, "Bangladesh", "Jaipur Hat", 25.1000000, 89.0166702, 29.0},
-	{951, "Bangladesh", "Thakurgaon", 26.0333333, 88.4666672, 37.0},
-	{952, "Bangladesh", "Palang", 23.2166667, 90.3499985, 5.0},
-	{953, "Bangladesh", "Lalmanir Hat", 25.9000000, 89.4499969, 32.0},
-	{954, "Bangladesh", "Raipur", 23.0500000, 90.7666702, 3.0},
-	{955, "Bangladesh", "Tungipara", 22.9000000, 89.9166641, 6.0},
-	{956, "Bangladesh", "Lakshmipur", 22.9500000, 90.8333359, 2.0},
-	{957, "Bangladesh", "Kaptaimukh", 22.4713889, 92.2322235, 18.0},
-	{958, "Bangladesh", "Moulvi Bazar", 24.4833333, 91.7833328, 13.0},
-	{959, "Bangladesh", "Ramganj", 23.1000000, 90.8499985, 3.0},
-	{960, "Bangladesh", "Narail", 23.1666667, 89.5000000, 14.0},
-	{961, "Bangladesh", "Pirojpur", 22.5666667, 89.9833298, 3.0},
-	{962, "Bangladesh", "Sandwip", 22.4677778, 91.4561081, 6.0},
-	{963, "Bangladesh", "Satkania", 22.0755556, 92.0513916, 6.0},
-	{964, "Bangladesh", "Patiya", 22.2947222, 91.9791641, 7.0},
-	{965, "Bangladesh", "Khagrachari", 23.1000000, 91.9666672, 48.0},
-	{966, "Bangladesh", "Chilmari", 25.5500000, 89.7166672, 18.0},
-	{967, "Bangladesh", "Nageswari", 25.9666667, 89.7166672, 40.0},
-	{968, "Bangladesh", "Panchagarh", 26.3333333, 88.5666656, 59.0},
-	{969, "Bangladesh", "Dakshin Char Fasson", 22.2166667, 90.7333298, 2.0},
-	{970, "Bangladesh", "Parbatipur", 25.6500000, 88.9166641, 30.0},
-	{971, "Bangladesh", "Barahanuddin", 22.5000000, 90.7166672, 2.0},
-	{972, "Bangladesh", "Kaliganj", 23.4166667, 89.1333313, 24.0},
-	{973, "Bangladesh", "Dohar", 23.5883333, 90.1469421, 4.0},
-	{974, "Bangladesh", "Hajiganj", 23.2511111, 90.8591690, 4.0},
-	{975, "Bangladesh", "Abhaynagar", 23.0166667, 89.4666672, 16.0},
-	{976, "Bangladesh", "Lalmohan", 22.3166667, 90.7166672, 1.0},
-	{977, "Bangladesh", "Jhikergacha", 23.1166667, 89.1166687, 11.0},
-	{978, "Bangladesh", "Mirzapur", 24.1000000, 90.0999985, 16.0},
-	{979, "Bangladesh", "Sakhipur", 24.3000000, 90.1833344, 19.0},
-	{980, "Bangladesh", "Teknaf", 20.8666667, 92.3000031, -9999.0},
-	{981, "Bangladesh", "Gaurnadi", 22.9666667, 90.2333298, 2.0},
-	{982, "Bangladesh", "Kalia", 23.0500000, 89.6333313, 14.0},
-	{983, "Bangladesh", "Bera", 24.0833333, 89.6166687, 20.0},
-	{984, "Bangladesh", "Mehendiganj", 22.8166667, 90.5333328, 2.0},
-	{985, "Bangladesh", "Chhagalnaiya", 23.0300000, 91.5069427, 8.0},
-	{986, "Bangladesh", "Chhatak", 25.0333333, 91.6666641, 6.0},
-	{987, "Bangladesh", "Nalchiti", 22.6333333, 90.2833328, 2.0},
-	{988, "Bangladesh", "Bheramara", 24.0333333, 88.9666672, 8.0},
-	{989, "Bangladesh", "Phultala", 22.7000000, 89.5166702, 9.0},
-	{990, "Bangladesh", "Baniachang", 24.5166667, 91.3666687, 4.0},
-	{991, "Bangladesh", "Sarankhola", 22.3000000, 89.7833328, 1.0},
-	{992, "Bangladesh", "Shibganj", 24.6833333, 88.1666641, 17.0},
-	{993, "Bangladesh", "Pirganj", 25.8666667, 88.3666687, 35.0},
-	{994, "Bangladesh", "Bajitpur", 24.2166667, 90.9499969, 10.0},
-	{995, "Bangladesh", "Char Bhadrasan", 23.3166667, 90.2166672, 4.0},
-	{996, "Bangladesh", "Gafargaon", 24.4333333, 90.5666656, 13.0},
-	{997, "Bangladesh", "Fatikchari", 22.6883333, 91.7908325, 9.0},
-	{998, "Bangladesh", "Badarganj", 25.6666667, 89.0500031, 32.0},
-	{999, "Bangladesh", "Bandarban", 22.1980556, 92.2197189, 10.0},
-	{1000, "Bangladesh", "Nabinagar", 23.8916667, 90.9733353, 3.0},
-	{1001, "Bangladesh", "Morrelganj", 22.4666667, 89.8499985, 1.0},
-	{1002, "Bangladesh", "Kesabpur", 22.9166667, 89.2166672, 14.0},
-	{1003, "Bangladesh", "Bhandaria", 22.4833333, 90.0666656, 2.0},
-	{1004, "Bangladesh", "Mathbaria", 22.3000000, 89.9499969, 7.0},
-	{1005, "Bangladesh", "Raojan", 22.5383333, 91.9147186, 7.0},
-	{1006, "Bangladesh", "Manikchhari", 22.8625000, 91.8450012, 42.0},
-	{1007, "Bangladesh", "Muktagacha", 24.7666667, 90.2333298, 23.0},
-	{1008, "Barbados", "Bridgetown", 13.1000000, -59.6166649, 1.0},
-	{1009, "Barbados", "Speightstown", 13.2500000, -59.6500015, 1.0},
-	{1010, "Barbados", "Oistins", 13.0666667, -59.5333328, 53.0},
-	{1011, "Barbados", "Bathsheba", 13.2166667, -59.5166664, -9999.0},
-	{1012, "Barbados", "Holetown", 13.1833333, -59.6500015, 1.0},
-	{1013, "Barbados", "The Crane", 13.1000000, -59.4500008, -9999.0},
-	{1014, "Barbados", "Crab Hill", 13.3166667, -59.6333351, 39.0},
-	{1015, "Barbados", "Blackmans", 13.1833333, -59.5333328, 219.0},
-	{1016, "Barbados", "Greenland", 13.2500000, -59.5666656, 45.0},
-	{1017, "Barbados", "Hillaby", 13.2166667, -59.5833321, 143.0},
-	{1018, "Belarus", "Minsk", 53.9000000, 27.5666676, 199.0},
-	{1019, "Belarus", "Mahilyow", 53.9138889, 30.3363895, 187.0},
-	{1020, "Belarus", "Vitsyebsk", 55.1925000, 30.1944447, 146.0},
-	{1021, "Belarus", "Hrodna", 53.6813889, 23.8147221, 128.0},
-	{1022, "Belarus", "Brest", 52.1000000, 23.7000008, 148.0},
-	{1023, "Belarus", "Babruysk", 53.1500000, 29.2333336, 134.0},
-	{1024, "Belarus", "Baranavichy", 53.1333333, 26.0333328, 196.0},
-	{1025, "Belarus", "Pinsk", 52.1213889, 26.0727787, 148.0},
-	{1026, "Belarus", "Orsha", 54.5152778, 30.4052773, 174.0},
-	{1027, "Belarus", "Mazyr", 52.0500000, 29.2666664, 119.0},
-	{1028, "Belarus", "Salihorsk", 52.8000000, 27.5333328, 134.0},
-	{1029, "Belarus", "Maladzyechna", 54.3166667, 26.8497219, 164.0},
-	{1030, "Belarus", "Navapolatsk", 55.5333333, 28.6499996, 133.0},
-	{1031, "Belarus", "Barysaw", 54.2255556, 28.4913883, 179.0},
-	{1032, "Belarus", "Lida", 53.8833333, 25.2997227, 145.0},
-	{1033, "Belarus", "Polatsk", 55.4855556, 28.8041668, 118.0},
-	{1034, "Belarus", "Zhlobin", 52.8922222, 30.0280552, 133.0},
-	{1035, "Belarus", "Svyetlahorsk", 52.6291667, 29.7338886, 136.0},
-	{1036, "Belarus", "Slutsk", 53.0272222, 27.5619450, 142.0},
-	{1037, "Belarus", "Zhodzina", 54.0983333, 28.3325005, 181.0},
-	{1038, "Belarus", "Slonim", 53.0866667, 25.3219452, 122.0},
-	{1039, "Belarus", "Kobryn", 52.2161111, 24.3663883, 147.0},
-	{1040, "Belarus", "Vawkavysk", 53.1519444, 24.4555550, 171.0},
-	{1041, "Belarus", "Kalinkavichy", 52.1333333, 29.3288898, 126.0},
-	{1042, "Belarus", "Asipovichy", 53.2933333, 28.6422215, 142.0},
-	{1043, "Belarus", "Horki", 54.2833333, 30.9911118, 185.0},
-	{1044, "Belarus", "Navahrudak", 53.6000000, 25.8333340, 292.0},
-	{1045, "Belarus", "Vilyeyka", 54.4975000, 26.9211102, 149.0},
-	{1046, "Belarus", "Byaroza", 52.5336111, 24.9838886, 153.0},
-	{1047, "Belarus", "Krychaw", 53.6988889, 31.7141666, 154.0},
-	{1048, "Belarus", "Dzyarzhynsk", 53.6850000, 27.1419449, 206.0},
-	{1049, "Belarus", "Hlybokaye", 55.1333333, 27.6833324, 164.0},
-	{1050, "Belarus", "Pastavy", 55.1167595, 26.8326263, 144.0},
-	{1051, "Belarus", "Mar'ina Horka", 53.5166667, 28.1499996, 150.0},
-	{1052, "Belarus", "Pruzhany", 52.5566667, 24.4644451, 161.0},
-	{1053, "Belarus", "Dobrush", 52.4094444, 31.3227787, 116.0},
-	{1054, "Belarus", "Bykhaw", 53.5183333, 30.2461109, 158.0},
-	{1055, "Belarus", "Masty", 53.4077778, 24.5327778, 118.0},
-	{1056, "Belarus", "Klimavichy", 53.6094444, 31.9519444, 173.0},
-	{1057, "Belarus", "Ashmyany", 54.4169444, 25.9327774, 207.0},
-	{1058, "Belarus", "Zhytkavichy", 52.2286111, 27.8636112, 129.0},
-	{1059, "Belarus", "Ivanava", 52.1325000, 25.5461102, 142.0},
-	{1060, "Belarus", "Zaslawye", 54.0000000, 27.2833328, 211.0},
-	{1061, "Belarus", "Shklow", 54.2108333, 30.2919445, 141.0},
-	{1062, "Belarus", "Drahichyn", 52.1897222, 25.1502781, 143.0},
-	{1063, "Belarus", "Haradok", 55.4602778, 30.0000000, 179.0},
-	{1064, "Belarus", "Fanipol", 53.7627166, 27.2962189, 235.0},
-	{1065, "Belarus", "Nyasvizh", 53.2180556, 26.6725006, 179.0},
-	{1066, "Belarus", "Smalyavichy", 54.0333333, 28.0841675, 189.0},
-	{1067, "Belarus", "Braslaw", 55.6338889, 27.0538883, 131.0},
-	{1068, "Belarus", "Kastsyukovichy", 53.3388889, 32.0461121, 170.0},
-	{1069, "Belarus", "Chashniki", 54.8588889, 29.1558342, 154.0},
-	{1070, "Belarus", "Talachyn", 54.4108333, 29.7033329, 197.0},
-	{1071, "Belarus", "Chavusi", 53.8127778, 30.9836102, 153.0},
-	{1072, "Belarus", "Zhabinka", 52.2005556, 24.0233326, 141.0},
-	{1073, "Belarus", "Beloozersk", 52.4711111, 25.1733341, 167.0},
-	{1074, "Belarus", "Miyory", 55.6155556, 27.6286106, 151.0},
-	{1075, "Belarus", "Kostyukovka", 52.5386111, 30.9158325, 144.0},
-	{1076, "Belarus", "Pyetrykaw", 52.1280556, 28.4944439, 125.0},
-	{1077, "Belarus", "Stolin", 51.8833333, 26.8500004, 109.0},
-	{1078, "Belarus", "Staryya Darohi", 53.0391667, 28.2724991, 138.0},
-	{1079, "Belarus", "Myadzyel", 54.8686111, 26.9372215, 172.0},
-	{1080, "Belarus", "Syanno", 54.8041667, 29.7044449, 167.0},
-	{1081, "Belarus", "Valozhyn", 54.0830556, 26.5324993, 211.0},
-	{1082, "Belarus", "Malaryta", 51.7833333, 24.0833340, 163.0},
-	{1083, "Belarus", "Klyetsk", 53.0652778, 26.6416664, 184.0},
-	{1084, "Belarus", "Vyetka", 52.5591667, 31.1808338, 135.0},
-	{1085, "Belarus", "Dzyatlava", 53.4880556, 25.4133339, 152.0},
-	{1086, "Belarus", "Dokshytsy", 54.8961111, 27.7583332, 197.0},
-	{1087, "Belarus", "Cherykaw", 53.5722222, 31.3805561, 154.0},
-	{1088, "Belarus", "Karelichy", 53.5652778, 26.1377773, 156.0},
-	{1089, "Belarus", "David-Gorodok", 52.0500000, 27.2166672, 126.0},
-	{1090, "Belarus", "Sharkowshchyna", 55.3666667, 27.4666672, 136.0},
-	{1091, "Belarus", "Slawharad", 53.4452778, 30.9963894, 169.0},
-	{1092, "Belarus", "Vishnevo", 54.7166667, 26.5333328, 156.0},
-	{1093, "Belarus", "Rakov", 53.9666667, 27.0499992, 200.0},
-	{1094, "Belarus", "Starobin", 52.7333333, 27.4666672, 121.0},
-	{1095, "Belarus", "Ivenets", 53.8833333, 26.7500000, 212.0},
-	{1096, "Belarus", "Vasilevichi", 52.2500000, 29.8333340, 124.0},
-	{1097, "Belarus", "Vysokoye", 52.3730556, 23.3675003, 160.0},
-	{1098, "Belarus", "Konstantinovo", 54.6666667, 29.2666664, 190.0},
-	{1099, "Belarus", "Krevo", 54.3166667, 26.2833328, 272.0},
-	{1100, "Belarus", "Disna", 55.5666667, 28.2166672, 107.0},
-	{1101, "Belarus", "Kossovo", 52.7500000, 25.1499996, 178.0},
-	{1102, "Belarus", "Boruny", 54.3166667, 26.1333332, 251.0},
-	{1103, "Belarus", "Mir", 53.4500000, 26.4666672, 171.0},
-	{1104, "Belarus", "Zhirovichi", 53.0166667, 25.3500004, 121.0},
-	{1105, "Belarus", "Druya", 55.7833333, 27.4500008, 109.0},
-	{1106, "Belarus", "Ratomka", 53.9333333, 27.3500004, 237.0},
-	{1107, "Belarus", "Shelkovo", 55.4333333, 28.8833332, 117.0},
-	{1108, "Belgium", "Brussels", 50.8465975, 4.3527746, 48.0},
-	{1109, "Belgium", "Antwerp", 51.2166667, 4.4166665, 3.0},
-	{1110, "Belgium", "Gent", 51.0500000, 3.7166667, 4.0},
-	{1111, "Belgium", "Charleroi", 50.4166667, 4.4333334, 121.0},
-	{1112, "Belgium", "Liege", 50.6411876, 5.5717850, 63.0},
-	{1113, "Belgium", "Brugge", 51.2097871, 3.2250023, 3.0},
-	{1114, "Belgium", "Namur", 50.4666667, 4.8666668, 120.0},
-	{1115, "Belgium", "Leuven", 50.8795896, 4.7009254, 35.0},
-	{1116, "Belgium", "Mons", 50.4541296, 3.9522886, 35.0},
-	{1117, "Belgium", "Aalst", 50.9333333, 4.0333333, 17.0},
-	{1118, "Belgium", "Mechelen", 51.0333333, 4.4666667, 4.0},
-	{1119, "Belgium", "La Louviere", 50.4865658, 4.1878510, 125.0},
-	{1120, "Belgium", "Kortrijk", 50.8280326, 3.2648706, 13.0},
-	{1121, "Belgium", "Hasselt", 50.9333333, 5.3333335, 37.0},
-	{1122, "Belgium", "Oostende", 51.2166667, 2.9166667, 2.0},
-	{1123, "Belgium", "Sint-Niklaas", 51.1666667, 4.1333332, 13.0},
-	{1124, "Belgium", "Tournai", 50.6000000, 3.3833332, 41.0},
-	{1125, "Belgium", "Genk", 50.9666667, 5.5000000, 81.0},
-	{1126, "Belgium", "Seraing", 50.6000000, 5.4833331, 96.0},
-	{1127, "Belgium", "Roeselare", 50.9465313, 3.1226921, 19.0},
-	{1128, "Belgium", "Verviers", 50.5833333, 5.8666668, 250.0},
-	{1129, "Belgium", "Mouscron", 50.7333333, 3.2166667, 40.0},
-	{1130, "Belgium", "Beveren", 51.2166667, 4.2500000, 5.0},
-	{1131, "Belgium", "Dendermonde", 51.0333333, 4.1166668, 3.0},
-	{1132, "Belgium", "Beringen", 51.0500000, 5.2166667, 30.0},
-	{1133, "Belgium", "Turnhout", 51.3166667, 4.9499998, 23.0},
-	{1134, "Belgium", "Dilbeek", 50.8500000, 4.2666669, 68.0},
-	{1135, "Belgium", "Heist-op-den-Berg", 51.0833333, 4.7166667, 24.0},
-	{1136, "Belgium", "Sint-Truiden", 50.8000000, 5.1999998, 61.0},
-	{1137, "Belgium", "Lokeren", 51.1000000, 3.9833333, 3.0},
-	{1138, "Belgium", "Brasschaat", 51.2833333, 4.4499998, 4.0},
-	{1139, "Belgium", "Vilvoorde", 50.9333333, 4.4333334, 16.0},
-	{1140, "Belgium", "Herstal", 50.6666667, 5.6333332, 59.0},
-	{1141, "Belgium", "Maasmechelen", 50.8166667, 5.6666665, 93.0},
-	{1142, "Belgium", "Waregem", 50.8833333, 3.4166667, 11.0},
-	{1143, "Belgium", "Chatelet", 50.4000000, 4.5166669, 135.0},
-	{1144, "Belgium", "Ieper", 50.8500000, 2.8833332, 19.0},
-	{1145, "Belgium", "Ninove", 50.8333333, 4.0166669, 19.0},
-	{1146, "Belgium", "Geel", 51.1666667, 5.0000000, 19.0},
-	{1147, "Belgium", "Halle", 50.7410179, 4.2324829, 33.0},
-	{1148, "Belgium", "Hoboken", 51.1666667, 4.3499999, 8.0},
-	{1149, "Belgium", "Knokke-Heist", 51.3500000, 3.2666667, 5.0},
-	{1150, "Belgium", "Schoten", 51.2500000, 4.5000000, 4.0},
-	{1151, "Belgium", "Grimbergen", 50.9333333, 4.3833332, 42.0},
-	{1152, "Belgium", "Mol", 51.1833333, 5.0999999, 23.0},
-	{1153, "Belgium", "Wavre", 50.7166667, 4.6166668, 54.0},
-	{1154, "Belgium", "Binche", 50.4000000, 4.1666665, 148.0},
-	{1155, "Belgium", "Lommel", 51.2333333, 5.3000002, 48.0},
-	{1156, "Belgium", "Menen", 50.8000000, 3.1166668, 15.0},
-	{1157, "Belgium", "Tienen", 50.8000000, 4.9499998, 57.0},
-	{1158, "Belgium", "Evergem", 51.1166667, 3.7000000, 3.0},
-	{1159, "Belgium", "Wevelgem", 50.8000000, 3.1666667, 24.0},
-	{1160, "Belgium", "Geraardsbergen", 50.7666667, 3.8666668, 19.0},
-	{1161, "Belgium", "Sint-Pieters-Leeuw", 50.7833333, 4.2333331, 39.0},
-	{1162, "Belgium", "Tongeren", 50.7833333, 5.4666667, 88.0},
-	{1163, "Belgium", "Bilzen", 50.8666667, 5.5166669, 67.0},
-	{1164, "Belgium", "Louvain-la-Neuve", 50.6695923, 4.6119189, 131.0},
-	{1165, "Belgium", "Courcelles", 50.4666667, 4.3666668, 163.0},
-	{1166, "Belgium", "Asse", 50.9166667, 4.1999998, 53.0},
-	{1167, "Belgium", "Waterloo", 50.7166667, 4.3833332, 117.0},
-	{1168, "Belgium", "Zaventem", 50.8833333, 4.4666667, 51.0},
-	{1169, "Belgium", "Oudenaarde", 50.8500000, 3.5999999, 10.0},
-	{1170, "Belgium", "Deinze", 50.9833333, 3.5333333, 10.0},
-	{1171, "Belgium", "Aarschot", 50.9833333, 4.8333335, 50.0},
-	{1172, "Belgium", "Ans", 50.6500000, 5.5333333, 168.0},
-	{1173, "Belgium", "Ath", 50.6293744, 3.7780094, 32.0},
-	{1174, "Belgium", "Kapellen", 51.3166667, 4.4333334, 8.0},
-	{1175, "Belgium", "Izegem", 50.9166667, 3.2000000, 15.0},
-	{1176, "Belgium", "Arlon", 49.6833333, 5.8166666, 385.0},
-	{1177, "Belgium", "Temse", 51.1333333, 4.2166667, 12.0},
-	{1178, "Belgium", "Harelbeke", 50.8500000, 3.3000000, 14.0},
-	{1179, "Belgium", "Herentals", 51.1833333, 4.8333335, 13.0},
-	{1180, "Belgium", "Brecht", 51.3500000, 4.6333332, 23.0},
-	{1181, "Belgium", "Flemalle-Haute", 50.6000000, 5.4666667, 69.0},
-	{1182, "Belgium", "Soignies", 50.5790400, 4.0712929, 90.0},
-	{1183, "Belgium", "Lanaken", 50.8833333, 5.6500001, 60.0},
-	{1184, "Belgium", "Mortsel", 51.1666667, 4.4666667, 13.0},
-	{1185, "Belgium", "Zottegem", 50.8666667, 3.8000000, 60.0},
-	{1186, "Belgium", "Ronse", 50.7500000, 3.5999999, 40.0},
-	{1187, "Belgium", "Nivelles", 50.6000000, 4.3333335, 119.0},
-	{1188, "Belgium", "Andenne", 50.4833333, 5.0999999, 140.0},
-	{1189, "Belgium", "Maaseik", 51.1000000, 5.8000002, 28.0},
-	{1190, "Belgium", "Oupeye", 50.7000000, 5.6500001, 57.0},
-	{1191, "Belgium", "Overijse", 50.7666667, 4.5333333, 69.0},
-	{1192, "Belgium", "Zwevegem", 50.8000000, 3.3333333, 30.0},
-	{1193, "Belgium", "Beersel", 50.7666667, 4.3000002, 80.0},
-	{1194, "Belgium", "Wetteren", 51.0000000, 3.8833332, 13.0},
-	{1195, "Belgium", "Hamme", 51.1000000, 4.1333332, 3.0},
-	{1196, "Belgium", "Willebroek", 51.0666667, 4.3666668, 3.0},
-	{1197, "Belgium", "Saint-Nicolas", 50.6333333, 5.5333333, 140.0},
-	{1198, "Belgium", "Westerlo", 51.0833333, 4.9166665, 13.0},
-	{1199, "Belgium", "Diest", 50.9834243, 5.0501490, 20.0},
-	{1200, "Belgium", "Saint-Ghislain", 50.4481589, 3.8188648, 29.0},
-	{1201, "Belgium", "Manage", 50.5000000, 4.2166667, 150.0},
-	{1202, "Belgium", "Maldegem", 51.2166667, 3.4500000, 3.0},
-	{1203, "Belgium", "Fleurus", 50.4813775, 4.5487690, 160.0},
-	{1204, "Belgium", "Merelbeke", 51.0000000, 3.7500000, 12.0},
-	{1205, "Belgium", "Zedelgem", 51.1500000, 3.1333332, 9.0},
-	{1206, "Belgium", "Tubize", 50.6833333, 4.1999998, 70.0},
-	{1207, "Belgium", "Edegem", 51.1500000, 4.4499998, 18.0},
-	{1208, "Belize", "Belize City", 17.4995177, -88.1975555, 4.0},
-	{1209, "Belize", "San Ignacio", 17.1561111, -89.0713882, 127.0},
-	{1210, "Belize", "Orange Walk", 18.0666667, -88.5500031, 32.0},
-	{1211, "Belize", "Belmopan", 17.2500000, -88.7666702, 63.0},
-	{1212, "Belize", "Dangriga", 16.9666667, -88.2166672, 1.0},
-	{1213, "Belize", "Corozal", 18.3833333, -88.3833313, -9999.0},
-	{1214, "Belize", "San Pedro", 17.9166667, -87.9499969, -9999.0},
-	{1215, "Belize", "Benque Viejo del Carmen", 17.0750000, -89.1391678, 130.0},
-	{1216, "Belize", "Punta Gorda", 16.1000000, -88.8000031, -9999.0},
-	{1217, "Belize", "Valley of Peace", 17.3347222, -88.8347244, 57.0},
-	{1218, "Benin", "Cotonou", 6.3500000, 2.4333334, 53.0},
-	{1219, "Benin", "Abomey-Calavi", 6.4500000, 2.3499999, 55.0},
-	{1220, "Benin", "Porto-Novo", 6.4833333, 2.6166668, 39.0},
-	{1221, "Benin", "Djougou", 9.7050000, 1.6669444, 431.0},
-	{1222, "Benin", "Parakou", 9.3500000, 2.6166668, 325.0},
-	{1223, "Benin", "Bohicon", 7.2000000, 2.0666666, 180.0},
-	{1224, "Benin", "Kandi", 11.1341667, 2.9386110, 304.0},
-	{1225, "Benin", "Lokossa", 6.6333333, 1.7166667, 65.0},
-	{1226, "Benin", "Ouidah", 6.3666667, 2.0833333, 66.0},
-	{1227, "Benin", "Abomey", 7.1833333, 1.9833333, 220.0},
-	{1228, "Benin", "Natitingou", 10.3066667, 1.3750000, 427.0},
-	{1229, "Benin", "Save", 8.0333333, 2.4833333, 152.0},
-	{1230, "Benin", "Nikki", 9.9338889, 3.2044444, 411.0},
-	{1231, "Benin", "Dogbo", 6.8166667, 1.7833333, 71.0},
-	{1232, "Benin", "Cove", 7.2333333, 2.3000000, 71.0},
-	{1233, "Benin", "Malanville", 11.8613889, 3.3858333, 143.0},
-	{1234, "Benin", "Pobe", 6.9666667, 2.6833334, 41.0},
-	{1235, "Benin", "Savalou", 7.9333333, 1.9666667, 228.0},
-	{1236, "Benin", "Sakete", 6.7166667, 2.6666667, 37.0},
-	{1237, "Benin", "Come", 6.4000000, 1.8833333, 64.0},
-	{1238, "Benin", "Bembereke", 10.2288889, 2.6652777, 465.0},
-	{1239, "Benin", "Bassila", 9.0166667, 1.6666667, 300.0},
-	{1240, "Benin", "Banikoara", 11.3000000, 2.4333334, 304.0},
-	{1241, "Benin", "Ketou", 7.3666667, 2.5999999, 117.0},
-	{1242, "Benin", "Dassa-Zoume", 7.7500000, 2.1833334, 163.0},
-	{1243, "Benin", "Tchaourou", 8.8833333, 2.5999999, 304.0},
-	{1244, "Benin", "Allada", 6.6500000, 2.1500001, 78.0},
-	{1245, "Benin", "Aplahoue", 6.9333333, 1.6833333, 131.0},
-	{1246, "Benin", "Tanguieta", 10.6197222, 1.2644444, 274.0},
-	{1247, "Benin", "Beterou", 9.2000000, 2.2666667, 258.0},
-	{1248, "Benin", "Grand-Popo", 6.2833333, 1.8333333, 64.0},
-	{1249, "Bhutan", "Thimphu", 27.4833333, 89.5999985, 2737.0},
-	{1250, "Bhutan", "Phuntsholing", 26.8500000, 89.3833313, 289.0},
-	{1251, "Bhutan", "Pajo", 27.5333333, 89.8833313, 1258.0},
-	{1252, "Bhutan", "Tashi Yangtse", 27.5833333, 91.4666672, 1941.0},
-	{1253, "Bhutan", "Mongar", 27.2500000, 91.1999969, 913.0},
-	{1254, "Bhutan", "Tongsa", 27.5166667, 90.5000000, 2623.0},
-	{1255, "Bhutan", "Daga", 27.0666667, 89.8833313, 1464.0},
-	{1256, "Bhutan", "Paro", 27.4333333, 89.4166641, 2281.0},
-	{1257, "Bhutan", "Ha", 27.3666667, 89.2833328, 3056.0},
-	{1258, "Bhutan", "Panbang", 26.8666667, 90.9833298, 344.0},
-	{1259, "Bhutan", "Tashigang", 27.3166667, 91.5666656, 2020.0},
-	{1260, "Bhutan", "Shemgang", 27.2333333, 90.6500015, 1334.0},
-	{1261, "Bhutan", "Nganglam", 26.7833333, 91.2500000, 133.0},
-	{1262, "Bhutan", "Daifam", 26.9000000, 92.0999985, 288.0},
-	{1263, "Bhutan", "Bhangtar", 26.8166667, 91.7166672, 349.0},
-	{1264, "Bhutan", "Wamrong", 27.0666667, 91.5666656, 1427.0},
-	{1265, "Bolivia", "Santa Cruz de la Sierra", -17.8000000, -63.1666679, 439.0},
-	{1266, "Bolivia", "Cochabamba", -17.3833333, -66.1500015, 2574.0},
-	{1267, "Bolivia", "La Paz", -16.5000000, -68.1500015, 3829.0},
-	{1268, "Bolivia", "Sucre", -19.0430556, -65.2591629, 2759.0},
-	{1269, "Bolivia", "Oruro", -17.9833333, -67.1500015, 3972.0},
-	{1270, "Bolivia", "Tarija", -21.5354857, -64.7295532, 2135.0},
-	{1271, "Bolivia", "Potosi", -19.5836111, -65.7530594, 3975.0},
-	{1272, "Bolivia", "Montero", -17.3333333, -63.2500000, 291.0},
-	{1273, "Bolivia", "Trinidad", -14.8333333, -64.9000015, 155.0},
-	{1274, "Bolivia", "Yacuiba", -22.0333333, -63.6833344, 858.0},
-	{1275, "Bolivia", "Riberalta", -10.9833333, -66.0999985, 134.0},
-	{1276, "Bolivia", "Guayaramerin", -10.8000000, -65.3833313, 120.0},
-	{1277, "Bolivia", "Villazon", -22.1000000, -65.5999985, 3408.0},
-	{1278, "Bolivia", "Llallagua", -18.4166667, -66.6333313, 3877.0},
-	{1279, "Bolivia", "Camiri", -20.0500000, -63.5166664, 740.0},
-	{1280, "Bolivia", "Cobija", -11.0333333, -68.7333298, 274.0},
-	{1281, "Bolivia", "San Ignacio de Velasco", -16.3666667, -60.9500008, 563.0},
-	{1282, "Bolivia", "Tupiza", -21.4500000, -65.7166672, 3097.0},
-	{1283, "Bolivia", "Warnes", -17.5000000, -63.1666679, 331.0},
-	{1284, "Bolivia", "San Borja", -14.8166667, -66.8499985, 229.0},
-	{1285, "Bolivia", "Villamontes", -21.2500000, -63.5000000, 440.0},
-	{1286, "Bolivia", "Cotoca", -17.8166667, -63.0499992, 400.0},
-	{1287, "Bolivia", "Villa Yapacani", -17.4000000, -63.8333321, 286.0},
-	{1288, "Bolivia", "Santiago del Torno", -17.9833333, -63.3833351, 556.0},
-	{1289, "Bolivia", "Huanuni", -18.2666667, -66.8499985, 4180.0},
-	{1290, "Bolivia", "Punata", -17.5500000, -65.8333359, 2553.0},
-	{1291, "Bolivia", "Ascension", -15.7000000, -63.0833321, 259.0},
-	{1292, "Bolivia", "Mineros", -17.1166667, -63.2333336, 254.0},
-	{1293, "Bolivia", "Patacamaya", -17.2333333, -67.9166641, 3884.0},
-	{1294, "Bolivia", "Colchani", -20.3000000, -66.9333344, 3654.0},
-	{1295, "Bolivia", "Rurrenabaque", -14.4412467, -67.5278091, 251.0},
-	{1296, "Bolivia", "Portachuelo", -17.3500000, -63.4000015, 298.0},
-	{1297, "Bolivia", "Puerto Quijarro", -17.7833333, -57.7666664, 94.0},
-	{1298, "Bolivia", "Uyuni", -20.4666667, -66.8333359, 3650.0},
-	{1299, "Bolivia", "Robore", -18.3333333, -59.7500000, 311.0},
-	{1300, "Bolivia", "Pailon", -17.6500000, -62.7500000, 248.0},
-	{1301, "Bolivia", "Cliza", -17.6000000, -65.9333344, 2594.0},
-	{1302, "Bolivia", "Achacachi", -16.0500000, -68.6833344, 3855.0},
-	{1303, "Bolivia", "Vallegrande", -18.4897222, -64.1069412, 1985.0},
-	{1304, "Bolivia", "Monteagudo", -19.8166667, -63.9833336, 1120.0},
-	{1305, "Bolivia", "Aiquile", -18.1666667, -65.1666641, 2186.0},
-	{1306, "Bolivia", "Tarata", -17.6166667, -66.0166702, 2775.0},
-	{1307, "Bolivia", "Challapata", -18.9000000, -66.7666702, 3711.0},
-	{1308, "Bolivia", "San Julian", -17.7833333, -62.8666649, 302.0},
-	{1309, "Bolivia", "Reyes", -14.3166667, -67.3833313, 293.0},
-	{1310, "Bolivia", "Concepcion", -16.4333333, -60.9000015, 582.0},
-	{1311, "Bolivia", "San Ramon", -14.4833333, -63.1500015, 235.0},
-	{1312, "Bolivia", "San Matias", -16.3666667, -58.4000015, 172.0},
-	{1313, "Bolivia", "La Belgica", -17.5500000, -63.2166672, 347.0},
-	{1314, "Bolivia", "Capinota", -17.7166667, -66.2333298, 2409.0},
-	{1315, "Bolivia", "Chimore", -16.9833333, -65.1333313, 255.0},
-	{1316, "Bolivia", "San Pedro", -16.2333333, -68.8499985, 3823.0},
-	{1317, "Bolivia", "Okinawa Numero Uno", -17.2333333, -62.8166656, 234.0},
-	{1318, "Bolivia", "Betanzos", -19.5505556, -65.4533310, 3325.0},
-	{1319, "Bolivia", "Camargo", -20.6500000, -65.2166672, 2698.0},
-	{1320, "Bolivia", "Copacabana", -16.1500000, -69.0833359, 3949.0},
-	{1321, "Bolivia", "Santa Rosa", -14.1666667, -66.8833313, 208.0},
-	{1322, "Bolivia", "Comarapa", -17.9000000, -64.4833298, 1887.0},
-	{1323, "Bolivia", "San Ramon", -13.2833333, -64.7166672, 198.0},
-	{1324, "Bolivia", "Mairana", -18.1166667, -63.9333344, 1533.0},
-	{1325, "Bolivia", "Buena Vista", -17.4500000, -63.6666679, 357.0},
-	{1326, "Bolivia", "San Carlos", -17.4000000, -63.7500000, 311.0},
-	{1327, "Bolivia", "Jorochito", -18.0500000, -63.4333344, 601.0},
-	{1328, "Bolivia", "Colquiri", -17.4166667, -67.1333313, 4330.0},
-	{1329, "Bolivia", "Colomi", -17.3500000, -65.8666687, 3443.0},
-	{1330, "Bolivia", "Arani", -17.5666667, -65.7666702, 2760.0},
-	{1331, "Bolivia", "Sipe Sipe", -17.4500000, -66.3833313, 2686.0},
-	{1332, "Bolivia", "San Juan del Surutu", -17.4833333, -63.7000008, 355.0},
-	{1333, "Bolivia", "Huatajata", -16.2000000, -68.6833344, 3955.0},
-	{1334, "Bolivia", "Limoncito", -18.0166667, -63.4000015, 589.0},
-	{1335, "Bolivia", "Samaipata", -18.1500000, -63.8666649, 1870.0},
-	{1336, "Bolivia", "Urubicha", -15.3833333, -62.9500008, 245.0},
-	{1337, "Bolivia", "San Pablo", -16.2166667, -68.8333359, 3823.0},
-	{1338, "Bolivia", "Lahuachaca", -17.3666667, -67.6666641, 3906.0},
-	{1339, "Bolivia", "Boyuibe", -20.4166667, -63.2833328, 898.0},
-	{1340, "Bolivia", "Los Negros", -17.7333333, -63.4333344, 477.0},
-	{1341, "Bolivia", "Tiahuanaco", -16.5500000, -68.6999969, 3846.0},
-	{1342, "Bolivia", "Charagua", -19.8000000, -63.2166672, 808.0},
-	{1343, "Bolivia", "San Pedro", -18.2833333, -59.8166656, 307.0},
-	{1344, "Bolivia", "Irpa Irpa", -17.7166667, -66.2666702, 2417.0},
-	{1345, "Bolivia", "Chulumani", -16.4096164, -67.5262680, 1691.0},
-	{1346, "Bolivia", "Padilla", -19.3000000, -64.3000031, 2116.0},
-	{1347, "Bolivia", "Mizque", -17.9333333, -65.3166656, 2012.0},
-	{1348, "Bolivia", "Entre Rios", -21.5333333, -64.1999969, 1392.0},
-	{1349, "Bolivia", "Puesto de Pailas", -17.6500000, -62.7999992, 253.0},
-	{1350, "Bolivia", "Mapiri", -15.2500000, -68.1666641, 534.0},
-	{1351, "Bolivia", "Isla del Sol", -16.0166667, -69.1500015, 3827.0},
-	{1352, "Bolivia", "Santa Rita", -17.9666667, -63.3499985, 600.0},
-	{1353, "Bolivia", "Santa Barbara", -20.9166667, -66.0500031, 4824.0},
-	{1354, "Bolivia", "Tarabuco", -19.1666667, -64.9166641, 3294.0},
-	{1355, "Bolivia", "Abapo", -18.8333333, -63.4666672, 910.0},
-	{1356, "Bolivia", "Quime", -16.9833333, -67.2166672, 3335.0},
-	{1357, "Bolivia", "Tomas Barron", -17.5833333, -67.5166702, 3770.0},
-	{1358, "Bolivia", "Coroico", -16.1888920, -67.7271347, 1471.0},
-	{1359, "Bolivia", "Sorata", -15.7666667, -68.6333313, 4391.0},
-	{1360, "Bolivia", "Coripata", -16.3000000, -67.5999985, 1624.0},
-	{1361, "Bolivia", "Machacamarca", -18.1666667, -67.0333328, 3690.0},
-	{1362, "Bolivia", "Viloco", -16.9500000, -67.5500031, 3991.0},
-	{1363, "Bolivia", "Atocha", -20.9333333, -66.2333298, 3733.0},
-	{1364, "Bolivia", "Poopo", -18.3666667, -66.9666672, 3724.0},
-	{1365, "Bosnia and Herzegovina", "Sarajevo", 43.8500000, 18.3833332, 545.0},
-	{1366, "Bosnia and Herzegovina", "Banja Luka", 44.7758333, 17.1855564, 158.0},
-	{1367, "Bosnia and Herzegovina", "Zenica", 44.2013889, 17.9063892, 317.0},
-	{1368, "Bosnia and Herzegovina", "Tuzla", 44.5427778, 18.6694450, 274.0},
-	{1369, "Bosnia and Herzegovina", "Mostar", 43.3433333, 17.8080559, 55.0},
-	{1370, "Bosnia and Herzegovina", "Bihac", 44.8169444, 15.8708334, 218.0},
-	{1371, "Bosnia and Herzegovina", "Bugojno", 44.0572222, 17.4508324, 549.0},
-	{1372, "Bosnia and Herzegovina", "Brcko", 44.8727778, 18.8083324, 93.0},
-	{1373, "Bosnia and Herzegovina", "Bijeljina", 44.7569444, 19.2161102, 91.0},
-	{1374, "Bosnia and Herzegovina", "Prijedor", 44.9813889, 16.7130547, 134.0},
-	{1375, "Bosnia and Herzegovina", "Trebinje", 42.7119444, 18.3441658, 283.0},
-	{1376, "Bosnia and Herzegovina", "Travnik", 44.2266667, 17.6705551, 575.0},
-	{1377, "Bosnia and Herzegovina", "Doboj", 44.7347222, 18.0877781, 140.0},
-	{1378, "Bosnia and Herzegovina", "Cazin", 44.9669444, 15.9430552, 424.0},
-	{1379, "Bosnia and Herzegovina", "Velika Kladusa", 45.1894444, 15.8116665, 141.0},
-	{1380, "Bosnia and Herzegovina", "Visoko", 43.9888889, 18.1780548, 417.0},
-	{1381, "Bosnia and Herzegovina", "Gorazde", 43.6669444, 18.9763889, 343.0},
-	{1382, "Bosnia and Herzegovina", "Konjic", 43.6519444, 17.9613895, 408.0},
-	{1383, "Bosnia and Herzegovina", "Gracanica", 44.7033333, 18.3097229, 277.0},
-	{1384, "Bosnia and Herzegovina", "Gradacac", 44.8808333, 18.4269447, 165.0},
-	{1385, "Bosnia and Herzegovina", "Bosanska Krupa", 44.8825000, 16.1513882, 151.0},
-	{1386, "Bosnia and Herzegovina", "Mrkonjic Grad", 44.4158333, 17.0861111, 573.0},
-	{1387, "Bosnia and Herzegovina", "Foca", 43.5050000, 18.7786102, 443.0},
-	{1388, "Bosnia and Herzegovina", "Zavidovici", 44.4458333, 18.1497231, 217.0},
-	{1389, "Bosnia and Herzegovina", "Zivinice", 44.4494444, 18.6497231, 210.0},
-	{1390, "Bosnia and Herzegovina", "Sanski Most", 44.7652778, 16.6655560, 161.0},
-	{1391, "Bosnia and Herzegovina", "Bosanska Gradiska", 45.1411111, 17.2500000, 94.0},
-	{1392, "Bosnia and Herzegovina", "Bileca", 42.8719444, 18.4297218, 522.0},
-	{1393, "Bosnia and Herzegovina", "Kakanj", 44.1272222, 18.1180553, 461.0},
-	{1394, "Bosnia and Herzegovina", "Livno", 43.8269444, 17.0077782, 815.0},
-	{1395, "Bosnia and Herzegovina", "Odzak", 45.0111111, 18.3266659, 94.0},
-	{1396, "Bosnia and Herzegovina", "Stijena", 44.9352778, 16.0172215, 416.0},
-	{1397, "Bosnia and Herzegovina", "Sipovo", 44.2813889, 17.0838890, 447.0},
-	{1398, "Bosnia and Herzegovina", "Prozor", 43.8222222, 17.6091671, 746.0},
-	{1399, "Bosnia and Herzegovina", "Novi Travnik", 44.1711111, 17.6574993, 518.0},
-	{1400, "Bosnia and Herzegovina", "Ljubuski", 43.1969444, 17.5450001, 111.0},
-	{1401, "Bosnia and Herzegovina", "Bosanska Dubica", 45.1766667, 16.8094444, 104.0},
-	{1402, "Bosnia and Herzegovina", "Derventa", 44.9772222, 17.9102783, 122.0},
-	{1403, "Bosnia and Herzegovina", "Jajce", 44.3422222, 17.2655563, 420.0},
-	{1404, "Bosnia and Herzegovina", "Todorovo", 45.0883333, 15.9308329, 301.0},
-	{1405, "Bosnia and Herzegovina", "Siroki Brijeg", 43.3833333, 17.5927773, 313.0},
-	{1406, "Bosnia and Herzegovina", "Bosanski Brod", 45.1375000, 17.9897213, 82.0},
-	{1407, "Bosnia and Herzegovina", "Bosanski Novi", 45.0458333, 16.3802776, 179.0},
-	{1408, "Bosnia and Herzegovina", "Sokolac", 43.9397222, 18.8016663, 891.0},
-	{1409, "Bosnia and Herzegovina", "Mionica", 44.8672222, 18.4630547, 167.0},
-	{1410, "Bosnia and Herzegovina", "Zepce", 44.4266667, 18.0377769, 232.0},
-	{1411, "Bosnia and Herzegovina", "Kiseljak", 43.9425000, 18.0766659, 509.0},
-	{1412, "Bosnia and Herzegovina", "Potoci", 43.4086111, 17.8786106, 111.0},
-	{1413, "Bosnia and Herzegovina", "Fojnica", 43.9625000, 17.8983326, 583.0},
-	{1414, "Bosnia and Herzegovina", "Milici", 44.1711111, 19.0902786, 390.0},
-	{1415, "Bosnia and Herzegovina", "Vogosca", 43.9019444, 18.3480549, 549.0},
-	{1416, "Bosnia and Herzegovina", "Vitez", 44.1544444, 17.7902775, 406.0},
-	{1417, "Bosnia and Herzegovina", "Zvornik", 44.3869444, 19.1013889, 239.0},
-	{1418, "Bosnia and Herzegovina", "Donji Vakuf", 44.1436111, 17.3999996, 563.0},
-	{1419, "Bosnia and Herzegovina", "Capljina", 43.1213889, 17.6844444, 118.0},
-	{1420, "Bosnia and Herzegovina", "Tomislavgrad", 43.7180556, 17.2266674, 884.0},
-	{1421, "Bosnia and Herzegovina", "Stolac", 43.0844444, 17.9575005, 137.0},
-	{1422, "Bosnia and Herzegovina", "Trn", 44.8597222, 17.2363892, 136.0},
-	{1423, "Bosnia and Herzegovina", "Tesanj", 44.6116667, 17.9872227, 271.0},
-	{1424, "Bosnia and Herzegovina", "Pale", 43.8161111, 18.5694447, 845.0},
-	{1425, "Bosnia and Herzegovina", "Maglaj", 44.5491667, 18.0966663, 216.0},
-	{1426, "Bosnia and Herzegovina", "Srbac", 45.0977778, 17.5249996, 132.0},
-	{1427, "Bosnia and Herzegovina", "Nevesinje", 43.2586111, 18.1133327, 871.0},
-	{1428, "Bosnia and Herzegovina", "Divicani", 44.3666667, 17.3261108, 558.0},
-	{1429, "Bosnia and Herzegovina", "Kljuc", 44.5350000, 16.7722225, 314.0},
-	{1430, "Bosnia and Herzegovina", "Buzim", 45.0547222, 16.0319443, 274.0},
-	{1431, "Bosnia and Herzegovina", "Banovici", 44.4052778, 18.5241661, 368.0},
-	{1432, "Bosnia and Herzegovina", "Vares", 44.1644444, 18.3283329, 1019.0},
-	{1433, "Bosnia and Herzegovina", "Hadzici", 43.8222222, 18.2066669, 584.0},
-	{1434, "Bosnia and Herzegovina", "Prnjavor", 44.8702778, 17.6627769, 174.0},
-	{1435, "Bosnia and Herzegovina", "Gornji Vakuf", 43.9380556, 17.5883331, 694.0},
-	{1436, "Bosnia and Herzegovina", "Skender Vakuf", 44.4908333, 17.3775005, 881.0},
-	{1437, "Bosnia and Herzegovina", "Vrnograc", 45.1636111, 15.9536114, 191.0},
-	{1438, "Bosnia and Herzegovina", "Kladanj", 44.2263889, 18.6913891, 567.0},
-	{1439, "Bosnia and Herzegovina", "Srebrenik", 44.7077778, 18.4880562, 196.0},
-	{1440, "Bosnia and Herzegovina", "Celinac", 44.7227778, 17.3277779, 237.0},
-	{1441, "Bosnia and Herzegovina", "Podzvizd", 45.1741667, 15.8736115, 226.0},
-	{1442, "Bosnia and Herzegovina", "Trzacka Rastela", 44.9733333, 15.7902775, 268.0},
-	{1443, "Bosnia and Herzegovina", "Otoka", 44.9600000, 16.1791668, 138.0},
-	{1444, "Bosnia and Herzegovina", "Tojsici", 44.5011111, 18.7855549, 386.0},
-	{1445, "Bosnia and Herzegovina", "Varoska Rijeka", 45.0847222, 16.0141659, 213.0},
-	{1446, "Bosnia and Herzegovina", "Pecigrad", 45.0544444, 15.8969440, 316.0},
-	{1447, "Bosnia and Herzegovina", "Omarska", 44.8900000, 16.8991661, 156.0},
-	{1448, "Bosnia and Herzegovina", "Laktasi", 44.9080556, 17.3008327, 121.0},
-	{1449, "Bosnia and Herzegovina", "Gromiljak", 43.9666667, 18.0530548, 503.0},
-	{1450, "Bosnia and Herzegovina", "Kovaci", 44.4491667, 18.2049999, 273.0},
-	{1451, "Bosnia and Herzegovina", "Teslic", 44.6063889, 17.8597221, 213.0},
-	{1452, "Bosnia and Herzegovina", "Sekovici", 44.2986111, 18.8527775, 328.0},
-	{1453, "Bosnia and Herzegovina", "Jablanica", 43.6602778, 17.7616673, 279.0},
-	{1454, "Bosnia and Herzegovina", "Visegrad", 43.7847222, 19.2991676, 389.0},
-	{1455, "Bosnia and Herzegovina", "Mala Kladusa", 45.1341667, 15.8586111, 206.0},
-	{1456, "Bosnia and Herzegovina", "Kalenderovci Donji", 44.9450000, 17.8338890, 148.0},
-	{1457, "Bosnia and Herzegovina", "Ilijas", 43.9508333, 18.2708340, 467.0},
-	{1458, "Bosnia and Herzegovina", "Gostovici", 44.4150000, 18.1583328, 305.0},
-	{1459, "Bosnia and Herzegovina", "Podhum", 43.7230556, 17.0011120, 774.0},
-	{1460, "Bosnia and Herzegovina", "Crnici", 43.1288889, 17.8666668, 211.0},
-	{1461, "Bosnia and Herzegovina", "Zeljezno Polje", 44.4083333, 17.9319439, 518.0},
-	{1462, "Bosnia and Herzegovina", "Citluk", 43.2286111, 17.7008324, 242.0},
-	{1463, "Bosnia and Herzegovina", "Blatnica", 44.4863889, 17.8233337, 446.0},
-	{1464, "Bosnia and Herzegovina", "Tesanjka", 44.6730556, 18.0127773, 193.0},
-	{1465, "Brazil", "Sao Paulo", -23.5475000, -46.6361122, 631.0},
-	{1466, "Brazil", "Rio de Janeiro", -22.9027778, -43.2075005, 19.0},
-	{1467, "Brazil", "Salvador", -12.9711111, -38.5108337, 1.0},
-	{1468, "Brazil", "Fortaleza", -3.7172222, -38.5430565, 1.0},
-	{1469, "Brazil", "Belo Horizonte", -19.9208333, -43.9377785, 877.0},
-	{1470, "Brazil", "Brasilia", -15.7797200, -47.9297218, 1092.0},
-	{1471, "Brazil", "Curitiba", -25.4277778, -49.2730560, 913.0},
-	{1472, "Brazil", "Manaus", -3.1019444, -60.0250015, 37.0},
-	{1473, "Brazil", "Recife", -8.0538889, -34.8811111, 1.0},
-	{1474, "Brazil", "Belem", -1.4558333, -48.5044441, 1.0},
-	{1475, "Brazil", "Porto Alegre", -30.0330556, -51.2299995, 1.0},
-	{1476, "Brazil", "Goiania", -16.6786111, -49.2538872, 790.0},
-	{1477, "Brazil", "Guarulhos", -23.4627778, -46.5333328, 704.0},
-	{1478, "Brazil", "Campinas", -22.9055556, -47.0608330, 670.0},
-	{1479, "Brazil", "Nova Iguacu", -22.7591667, -43.4511108, 154.0},
-	{1480, "Brazil", "Maceio", -9.6658333, -35.7352791, 1.0},
-	{1481, "Brazil", "Sao Luis", -2.5297222, -44.3027763, 1.0},
-	{1482, "Brazil", "Duque de Caxias", -22.7855556, -43.3116684, 7.0},
-	{1483, "Brazil", "Natal", -5.7950000, -35.2094460, 2.0},
-	{1484, "Brazil", "Teresina", -5.0891667, -42.8019447, 58.0},
-	{1485, "Brazil", "Sao Bernardo do Campo", -23.6938889, -46.5649986, 760.0},
-	{1486, "Brazil", "Campo Grande", -20.4427778, -54.6463890, 602.0},
-	{1487, "Brazil", "Jaboatao", -8.1802778, -35.0013885, 62.0},
-	{1488, "Brazil", "Osasco", -23.5325000, -46.7916679, 633.0},
-	{1489, "Brazil", "Santo Andre", -23.6638889, -46.5383339, 765.0},
-	{1490, "Brazil", "Joao Pessoa", -7.1150000, -34.8630562, 3.0},
-	{1491, "Brazil", "Jaboatao dos Guararapes", -8.1127778, -35.0147209, 12.0},
-	{1492, "Brazil", "Contagem", -19.9316667, -44.0536118, 878.0},
-	{1493, "Brazil", "Sao Jose dos Campos", -23.1794444, -45.8869438, 572.0},
-	{1494, "Brazil", "Uberlandia", -18.9186111, -48.2772217, 690.0},
-	{1495, "Brazil", "Sorocaba", -23.5016667, -47.4580574, 601.0},
-	{1496, "Brazil", "Ribeirao Preto", -21.1775000, -47.8102760, 593.0},
-	{1497, "Brazil", "Cuiaba", -15.5961111, -56.0966682, 173.0},
-	{1498, "Brazil", "Aracaju", -10.9111111, -37.0716667, 5.0},
-	{1499, "Brazil", "Feira de Santana", -12.2666667, -38.9666672, 166.0},
-	{1500, "Brazil", "Londrina", -23.3102778, -51.1627769, 563.0},
-	{1501, "Brazil", "Juiz de Fora", -21.7641667, -43.3502769, 678.0},
-	{1502, "Brazil", "Belford Roxo", -22.7641667, -43.3994446, 26.0},
-	{1503, "Brazil", "Joinville", -26.3044444, -48.8455544, 167.0},
-	{1504, "Brazil", "Niteroi", -22.8833333, -43.1036110, 8.0},
-	{1505, "Brazil", "Sao Joao de Meriti", -22.8038889, -43.3722229, 27.0},
-	{1506, "Brazil", "Ananindeua", -1.3655556, -48.3722229, 12.0},
-	{1507, "Brazil", "Florianopolis", -27.5966667, -48.5491676, 149.0},
-	{1508, "Brazil", "Santos", -23.9608333, -46.3336105, 2.0},
-	{1509, "Brazil", "Ribeirao das Neves", -19.7669444, -44.0866661, 905.0},
-	{1510, "Brazil", "Vila Velha", -20.3297222, -40.2924995, 1.0},
-	{1511, "Brazil", "Serra", -20.1286111, -40.3077774, 70.0},
-	{1512, "Brazil", "Diadema", -23.6861111, -46.6227760, 723.0},
-	{1513, "Brazil", "Campos", -21.7500000, -41.2999992, 1.0},
-	{1514, "Brazil", "Maua", -23.6677778, -46.4613876, 763.0},
-	{1515, "Brazil", "Betim", -19.9677778, -44.1983337, 800.0},
-	{1516, "Brazil", "Caxias do Sul", -29.1680556, -51.1794434, 737.0},
-	{1517, "Brazil", "Sao Jose do Rio Preto", -20.8197222, -49.3794441, 457.0},
-	{1518, "Brazil", "Olinda", -8.0088889, -34.8552780, 2.0},
-	{1519, "Brazil", "Carapicuiba", -23.5225000, -46.8355560, 630.0},
-	{1520, "Brazil", "Campina Grande", -7.2305556, -35.8811111, 445.0},
-	{1521, "Brazil", "Piracicaba", -22.7252778, -47.6491661, 532.0},
-	{1522, "Brazil", "Macapa", 0.0388889, -51.0663872, 1.0},
-	{1523, "Brazil", "Itaquaquecetuba", -23.4861111, -46.3483315, 674.0},
-	{1524, "Brazil", "Bauru", -22.3147222, -49.0605545, 534.0},
-	{1525, "Brazil", "Montes Claros", -16.7350000, -43.8616676, 750.0},
-	{1526, "Brazil", "Canoas", -29.9177778, -51.1836128, 19.0},
-	{1527, "Brazil", "Moji das Cruzes", -23.5227778, -46.1883316, 710.0},
-	{1528, "Brazil", "Sao Vicente", -23.9630556, -46.3919449, 1.0},
-	{1529, "Brazil", "Jundiai", -23.1863889, -46.8841667, 1006.0},
-	{1530, "Brazil", "Pelotas", -31.7719444, -52.3424988, 7.0},
-	{1531, "Brazil", "Anapolis", -16.3266667, -48.9527779, 917.0},
-	{1532, "Brazil", "Vitoria", -20.3194444, -40.3377762, 12.0},
-	{1533, "Brazil", "Maringa", -23.4252778, -51.9386101, 444.0},
-	{1534, "Brazil", "Guaruja", -23.9930556, -46.2563896, 1.0},
-	{1535, "Brazil", "Porto Velho", -8.7619444, -63.9038887, 86.0},
-	{1536, "Brazil", "Franca", -20.5386111, -47.4008331, 920.0},
-	{1537, "Brazil", "Blumenau", -26.9194444, -49.0661125, 136.0},
-	{1538, "Brazil", "Foz do Iguacu", -25.5477778, -54.5880547, 174.0},
-	{1539, "Brazil", "Ponta Grossa", -25.0950000, -50.1619453, 913.0},
-	{1540, "Brazil", "Paulista", -7.9408333, -34.8730545, 23.0},
-	{1541, "Brazil", "Limeira", -22.5647222, -47.4016685, 610.0},
-	{1542, "Brazil", "Viamao", -30.0811111, -51.0233345, 51.0},
-	{1543, "Brazil", "Suzano", -23.5425000, -46.3108330, 704.0},
-	{1544, "Brazil", "Caucaia", -3.7361111, -38.6530571, 20.0},
-	{1545, "Brazil", "Petropolis", -22.5050000, -43.1786118, 883.0},
-	{1546, "Brazil", "Uberaba", -19.7483333, -47.9319458, 750.0},
-	{1547, "Brazil", "Rio Branco", -9.9747222, -67.8099976, 157.0},
-	{1548, "Brazil", "Cascavel", -24.9558333, -53.4552765, 647.0},
-	{1549, "Brazil", "Novo Hamburgo", -29.6783333, -51.1305542, 97.0},
-	{1550, "Brazil", "Vitoria da Conquista", -14.8661111, -40.8394432, 947.0},
-	{1551, "Brazil", "Barueri", -23.5105556, -46.8761101, 647.0},
-	{1552, "Brazil", "Taubate", -23.0263889, -45.5552788, 578.0},
-	{1553, "Brazil", "Governador Valadares", -18.8511111, -41.9494438, 132.0},
-	{1554, "Brazil", "Praia Grande", -24.0058333, -46.4027786, 8.0},
-	{1555, "Brazil", "Varzea Grande", -15.6466667, -56.1324997, 142.0},
-	{1556, "Brazil", "Volta Redonda", -22.5230556, -44.1041679, 279.0},
-	{1557, "Brazil", "Santa Maria", -29.6841667, -53.8069458, 136.0},
-	{1558, "Brazil", "Santa Luzia", -19.7697222, -43.8513870, 807.0},
-	{1559, "Brazil", "Gravatai", -29.9444444, -50.9919434, 75.0},
-	{1560, "Brazil", "Caruaru", -8.2833333, -35.9761124, 494.0},
-	{1561, "Brazil", "Boa Vista", 2.8197222, -60.6733322, 76.0},
-	{1562, "Brazil", "Ipatinga", -19.4683333, -42.5366669, 213.0},
-	{1563, "Brazil", "Sumare", -22.8219444, -47.2669449, 610.0},
-	{1564, "Brazil", "Juazeiro do Norte", -7.2130556, -39.3152771, 590.0},
-	{1565, "United Kingdom", "London", 51.5084153, -0.1255327, 21.0},
-	{1566, "United Kingdom", "Birmingham", 52.4666667, -1.9166667, 140.0},
-	{1567, "United Kingdom", "Glasgow", 55.8333333, -4.2500000, 66.0},
-	{1568, "United Kingdom", "Liverpool", 53.4166667, -3.0000000, 6.0},
-	{1569, "United Kingdom", "Leeds", 53.7964813, -1.5478492, 67.0},
-	{1570, "United Kingdom", "Sheffield", 53.3829700, -1.4659023, 76.0},
-	{1571, "United Kingdom", "Edinburgh", 55.9500000, -3.2000000, 32.0},
-	{1572, "United Kingdom", "Bristol", 51.4500000, -2.5833333, 47.0},
-	{1573, "United Kingdom", "Manchester", 53.4809464, -2.2374344, 60.0},
-	{1574, "United Kingdom", "Leicester", 52.6333333, -1.1333333, 76.0},
-	{1575, "United Kingdom", "Coventry", 52.4065556, -1.5121651, 76.0},
-	{1576, "United Kingdom", "Kingston upon Hull", 53.7445995, -0.3352547, 1.0},
-	{1577, "United Kingdom", "Cardiff", 51.4800000, -3.1800001, 6.0},
-	{1578, "United Kingdom", "Bradford", 53.7833333, -1.7500000, 152.0},
-	{1579, "United Kingdom", "Belfast", 54.5833333, -5.9333334, 9.0},
-	{1580, "United Kingdom", "Stoke-on-Trent", 53.0000000, -2.1833334, 132.0},
-	{1581, "United Kingdom", "Wolverhampton", 52.5833333, -2.1333332, 152.0},
-	{1582, "United Kingdom", "Plymouth", 50.3715254, -4.1430473, 10.0},
-	{1583, "United Kingdom", "Nottingham", 52.9536023, -1.1504745, 61.0},
-	{1584, "United Kingdom", "Southampton", 50.9000000, -1.4000000, -9999.0},
-	{1585, "United Kingdom", "Reading", 51.4333333, -1.0000000, 51.0},
-	{1586, "United Kingdom", "Derby", 52.9333333, -1.5000000, 67.0},
-	{1587, "United Kingdom", "Dudley", 52.5000000, -2.0833333, 152.0},
-	{1588, "United Kingdom", "Northampton", 52.2500000, -0.8833333, 80.0},
-	{1589, "United Kingdom", "Portsmouth", 50.8090806, -1.0714245, 1.0},
-	{1590, "United Kingdom", "Luton", 51.8833333, -0.4166667, 130.0},
-	{1591, "United Kingdom", "Newcastle upon Tyne", 54.9732787, -1.6139603, 20.0},
-	{1592, "United Kingdom", "Preston", 53.7666667, -2.7166667, 26.0},
-	{1593, "United Kingdom", "Milton Keynes", 52.0417219, -0.7558250, 78.0},
-	{1594, "United Kingdom", "Aberdeen", 57.1333333, -2.0999999, 36.0},
-	{1595, "United Kingdom", "Sunderland", 54.9046457, -1.3822174, 9.0},
-	{1596, "United Kingdom", "Norwich", 52.6278273, 1.2983394, 9.0},
-	{1597, "United Kingdom", "Walsall", 52.6000000, -2.0000000, 142.0},
-	{1598, "United Kingdom", "Swansea", 51.6207877, -3.9432335, 1.0},
-	{1599, "United Kingdom", "Bournemouth", 50.7166667, -1.8833333, 1.0},
-	{1600, "United Kingdom", "Southend-on-Sea", 51.5378207, 0.7143259, 1.0},
-	{1601, "United Kingdom", "Swindon", 51.5166667, -1.7833333, 153.0},
-	{1602, "United Kingdom", "Oxford", 51.7522210, -1.2559605, 66.0},
-	{1603, "United Kingdom", "Dundee", 56.5000000, -2.9666667, 76.0},
-	{1604, "United Kingdom", "Poole", 50.7166667, -2.0000000, 1.0},
-	{1605, "United Kingdom", "Huddersfield", 53.6500000, -1.7833333, 84.0},
-	{1606, "United Kingdom", "York", 53.9666667, -1.0833333, 14.0},
-	{1607, "United Kingdom", "Ipswich", 52.0546544, 1.1569977, 6.0},
-	{1608, "United Kingdom", "Blackpool", 53.8166667, -3.0500000, 1.0},
-	{1609, "United Kingdom", "Middlesbrough", 54.5727778, -1.1627778, 32.0},
-	{1610, "United Kingdom", "Bolton", 53.5833333, -2.4333334, 75.0},
-	{1611, "United Kingdom", "Peterborough", 52.5833333, -0.2500000, 28.0},
-	{1612, "United Kingdom", "Stockport", 53.4097877, -2.1576118, 72.0},
-	{1613, "United Kingdom", "Brighton", 50.8283849, -0.1394749, 22.0},
-	{1614, "United Kingdom", "West Bromwich", 52.5166667, -2.0000000, 154.0},
-	{1615, "United Kingdom", "Slough", 51.5000000, -0.5833333, 34.0},
-	{1616, "United Kingdom", "Gloucester", 51.8656801, -2.2430992, 72.0},
-	{1617, "United Kingdom", "Cambridge", 52.2000000, 0.1166667, 38.0},
-	{1618, "United Kingdom", "Watford", 51.6553059, -0.3960228, 70.0},
-	{1619, "United Kingdom", "Rotherham", 53.4333333, -1.3500000, 71.0},
-	{1620, "United Kingdom", "Newport", 51.5877364, -2.9983504, 1.0},
-	{1621, "United Kingdom", "Exeter", 50.7235979, -3.5275073, 43.0},
-	{1622, "United Kingdom", "Eastbourne", 50.7687108, 0.2845287, 1.0},
-	{1623, "United Kingdom", "Colchester", 51.8833333, 0.9000000, 5.0},
-	{1624, "United Kingdom", "Crawley", 51.1166667, -0.1833333, 75.0},
-	{1625, "United Kingdom", "Sutton Coldfield", 52.5651864, -1.8223572, 128.0},
-	{1626, "United Kingdom", "Blackburn", 53.7500000, -2.4833333, 149.0},
-	{1627, "United Kingdom", "Oldham", 53.5405114, -2.1183014, 184.0},
-	{1628, "United Kingdom", "Woking", 51.3190263, -0.5589294, 40.0},
-	{1629, "United Kingdom", "Cheltenham", 51.9000000, -2.0833333, 72.0},
-	{1630, "United Kingdom", "Chelmsford", 51.7333333, 0.4833333, 38.0},
-	{1631, "United Kingdom", "Saint Helens", 53.4500000, -2.7333333, 22.0},
-	{1632, "United Kingdom", "Basildon", 51.5666667, 0.4666667, 66.0},
-	{1633, "United Kingdom", "Gillingham", 51.3891373, 0.5486298, 24.0},
-	{1634, "United Kingdom", "Worcester", 52.1893517, -2.2200108, 71.0},
-	{1635, "United Kingdom", "Worthing", 50.8114669, -0.3716469, -9999.0},
-	{1636, "United Kingdom", "Rochdale", 53.6166667, -2.1500001, 140.0},
-	{1637, "United Kingdom", "Basingstoke", 51.2666667, -1.0833333, 78.0},
-	{1638, "United Kingdom", "Solihull", 52.4166667, -1.7833333, 109.0},
-	{1639, "United Kingdom", "Harlow", 51.7833333, 0.1666667, 77.0},
-	{1640, "United Kingdom", "Bath", 51.3794444, -2.3655555, 74.0},
-	{1641, "United Kingdom", "Southport", 53.6666667, -3.0000000, 1.0},
-	{1642, "United Kingdom", "Maidstone", 51.2666667, 0.5166667, 56.0},
-	{1643, "United Kingdom", "Lincoln", 53.2333333, -0.5333333, 31.0},
-	{1644, "United Kingdom", "Hastings", 50.8529919, 0.5645943, 2.0},
-	{1645, "United Kingdom", "Darlington", 54.5333333, -1.5666667, 63.0},
-	{1646, "United Kingdom", "Londonderry County Borough", 54.9972135, -7.3091698, 28.0},
-	{1647, "United Kingdom", "Harrogate", 53.9833333, -1.5333333, 97.0},
-	{1648, "United Kingdom", "Hartlepool", 54.6861111, -1.2125000, 1.0},
-	{1649, "United Kingdom", "Bedford", 52.1345944, -0.4663181, 53.0},
-	{1650, "United Kingdom", "Hemel Hempstead", 51.7500000, -0.4666667, 99.0},
-	{1651, "United Kingdom", "Stevenage", 51.9166667, -0.2166667, 79.0},
-	{1652, "United Kingdom", "Saint Albans", 51.7500000, -0.3333333, 77.0},
-	{1653, "United Kingdom", "South Shields", 54.9877778, -1.4213889, 1.0},
-	{1654, "United Kingdom", "Weston-super-Mare", 51.3458333, -2.9677777, 70.0},
-	{1655, "United Kingdom", "Halifax", 53.7166667, -1.8500000, 188.0},
-	{1656, "United Kingdom", "Birkenhead", 53.4000000, -3.0333333, 15.0},
-	{1657, "United Kingdom", "Chester", 53.2000000, -2.9166667, 12.0},
-	{1658, "United Kingdom", "Warrington", 53.3833333, -2.5999999, 18.0},
-	{1659, "United Kingdom", "Wigan", 53.5333333, -2.6166668, 64.0},
-	{1660, "United Kingdom", "High Wycombe", 51.6333333, -0.7666667, 73.0},
-	{1661, "United Kingdom", "Stockton-on-Tees", 54.5833333, -1.4166667, 46.0},
-	{1662, "United Kingdom", "Wakefield", 53.6833141, -1.4976811, 53.0},
-	{1663, "United Kingdom", "Gateshead", 54.9450000, -1.6174999, 43.0},
-	{1664, "United Kingdom", "Lisburn", 54.5233730, -6.0352707, 27.0},
-	{1665, "Brunei", "Bandar Seri Begawan", 4.8833333, 114.9333344, 1.0},
-	{1666, "Brunei", "Kuala Belait", 4.5833333, 114.1833344, 1.0},
-	{1667, "Brunei", "Seria", 4.6166667, 114.3166656, 1.0},
-	{1668, "Brunei", "Tutong", 4.8000000, 114.6500015, 1.0},
-	{1669, "Brunei", "Bangar", 4.7166667, 115.0666656, 45.0},
-	{1670, "Bulgaria", "Sofia", 42.6975135, 23.3241463, 558.0},
-	{1671, "Bulgaria", "Plovdiv", 42.1500000, 24.7500000, 165.0},
-	{1672, "Bulgaria", "Varna", 43.2166667, 27.9166660, 95.0},
-	{1673, "Bulgaria", "Burgas", 42.5000000, 27.4666672, 31.0},
-	{1674, "Bulgaria", "Ruse", 43.8563889, 25.9708328, 30.0},
-	{1675, "Bulgaria", "Stara Zagora", 42.4327778, 25.6419449, 197.0},
-	{1676, "Bulgaria", "Pleven", 43.4166667, 24.6166668, 97.0},
-	{1677, "Bulgaria", "Sliven", 42.6858333, 26.3291664, 281.0},
-	{1678, "Bulgaria", "Dobrich", 43.5666667, 27.8333340, 210.0},
-	{1679, "Bulgaria", "Shumen", 43.2706437, 26.9228554, 228.0},
-	{1680, "Bulgaria", "Pernik", 42.6000000, 23.0333328, 751.0},
-	{1681, "Bulgaria", "Yambol", 42.4833333, 26.5000000, 115.0},
-	{1682, "Bulgaria", "Khaskovo", 41.9402778, 25.5694447, 197.0},
-	{1683, "Bulgaria", "Pazardzhik", 42.2000000, 24.3333340, 219.0},
-	{1684, "Bulgaria", "Gabrovo", 41.8927778, 22.9349995, 812.0},
-	{1685, "Bulgaria", "Blagoevgrad", 42.0166667, 23.1000004, 523.0},
-	{1686, "Bulgaria", "Veliko Turnovo", 43.0812389, 25.6290436, 162.0},
-	{1687, "Bulgaria", "Gabrovo", 42.8747222, 25.3341675, 604.0},
-	{1688, "Bulgaria", "Vratsa", 43.2100000, 23.5625000, 341.0},
-	{1689, "Bulgaria", "Tonchevtsi", 42.9000000, 25.3166676, 441.0},
-	{1690, "Bulgaria", "Kazanluk", 42.6166667, 25.3999996, 388.0},
-	{1691, "Bulgaria", "Vidin", 43.9900000, 22.8724995, 35.0},
-	{1692, "Bulgaria", "Asenovgrad", 42.0166667, 24.8666668, 253.0},
-	{1693, "Bulgaria", "Kyustendil", 42.2838889, 22.6911106, 482.0},
-	{1694, "Bulgaria", "Montana", 43.4125000, 23.2250004, 139.0},
-	{1695, "Bulgaria", "Dimitrovgrad", 42.0500000, 25.6000004, 126.0},
-	{1696, "Bulgaria", "Lovech", 43.1333333, 24.7166672, 201.0},
-	{1697, "Bulgaria", "Silistra", 44.1166667, 27.2666664, 1.0},
-	{1698, "Bulgaria", "Razgrad", 43.5333333, 26.5166664, 190.0},
-	{1699, "Bulgaria", "Turgovishte", 43.2591667, 26.5891666, 140.0},
-	{1700, "Bulgaria", "Dupnitsa", 42.2666667, 23.1166668, 522.0},
-	{1701, "Bulgaria", "Svishtov", 43.6230556, 25.3538895, 1.0},
-	{1702, "Bulgaria", "Smolyan", 41.5852778, 24.6919441, 1131.0},
-	{1703, "Bulgaria", "Petrich", 41.4000000, 23.2166672, 168.0},
-	{1704, "Bulgaria", "Samokov", 42.3333333, 23.5499992, 961.0},
-	{1705, "Bulgaria", "Lom", 43.8138889, 23.2361107, 21.0},
-	{1706, "Bulgaria", "Sandanski", 41.5666667, 23.2833328, 257.0},
-	{1707, "Bulgaria", "Sevlievo", 43.0258333, 25.1136112, 213.0},
-	{1708, "Bulgaria", "Nova Zagora", 42.4833333, 26.0166664, 127.0},
-	{1709, "Bulgaria", "Velingrad", 42.0166667, 24.0000000, 755.0},
-	{1710, "Bulgaria", "Cherven Bryag", 43.2666667, 24.1000004, 166.0},
-	{1711, "Bulgaria", "Troyan", 42.8942650, 24.7158909, 398.0},
-	{1712, "Bulgaria", "Aytos", 42.7000000, 27.2500000, 96.0},
-	{1713, "Bulgaria", "Botevgrad", 42.9000000, 23.7833328, 410.0},
-	{1714, "Bulgaria", "Gotse Delchev", 41.5666667, 23.7333336, 532.0},
-	{1715, "Bulgaria", "Karlovo", 42.6333333, 24.7999992, 387.0},
-	{1716, "Bulgaria", "Karnobat", 42.6500000, 26.9833336, 219.0},
-	{1717, "Bulgaria", "Panagyurishte", 42.5000000, 24.1833324, 535.0},
-	{1718, "Bulgaria", "Svilengrad", 41.7666667, 26.2000008, 51.0},
-	{1719, "Bulgaria", "Kharmanli", 41.9333333, 25.8999996, 61.0},
-	{1720, "Bulgaria", "Peshtera", 42.0333333, 24.2999992, 433.0},
-	{1721, "Bulgaria", "Chirpan", 42.2000000, 25.3333340, 183.0},
-	{1722, "Bulgaria", "Popovo", 43.3500000, 26.2333336, 207.0},
-	{1723, "Bulgaria", "Rakovski", 42.3000000, 24.9666672, 181.0},
-	{1724, "Bulgaria", "Berkovitsa", 43.2361111, 23.1258335, 369.0},
-	{1725, "Bulgaria", "Radomir", 42.5444444, 22.9577770, 661.0},
-	{1726, "Bulgaria", "Kozloduy", 43.7833333, 23.7333336, 31.0},
-	{1727, "Bulgaria", "Radnevo", 42.3000000, 25.9333324, 106.0},
-	{1728, "Bulgaria", "Ikhtiman", 42.4333333, 23.8166676, 659.0},
-	{1729, "Bulgaria", "Tryavna", 42.8666667, 25.5000000, 504.0},
-	{1730, "Bulgaria", "Provadiya", 43.1833333, 27.4333324, 89.0},
-	{1731, "Bulgaria", "Pomorie", 42.5500000, 27.6499996, -9999.0},
-	{1732, "Bulgaria", "Byala Slatina", 43.4666667, 23.9333324, 112.0},
-	{1733, "Bulgaria", "Novi Pazar", 43.3500000, 27.2000008, 157.0},
-	{1734, "Bulgaria", "Balchik", 43.4166667, 28.1666660, 185.0},
-	{1735, "Bulgaria", "Razlog", 41.8863044, 23.4671402, 812.0},
-	{1736, "Bulgaria", "Pavlikeni", 43.2427778, 25.3219452, 179.0},
-	{1737, "Bulgaria", "Kostinbrod", 42.8166667, 23.2166672, 556.0},
-	{1738, "Bulgaria", "Stamboliyski", 42.1333333, 24.5333328, 185.0},
-	{1739, "Bulgaria", "Mezdra", 43.1500000, 23.7000008, 215.0},
-	{1740, "Bulgaria", "Levski", 43.3666667, 25.1333332, 55.0},
-	{1741, "Bulgaria", "Kavarna", 43.4333333, 28.3333340, 140.0},
-	{1742, "Bulgaria", "Elkhovo", 42.1666667, 26.5666676, 107.0},
-	{1743, "Bulgaria", "Etropole", 42.8333333, 24.0000000, 619.0},
-	{1744, "Bulgaria", "Teteven", 42.9166667, 24.2666664, 637.0},
-	{1745, "Bulgaria", "Tutrakan", 44.0500000, 26.6166668, 108.0},
-	{1746, "Bulgaria", "Lukovit", 43.2000000, 24.1666660, 172.0},
-	{1747, "Bulgaria", "Belene", 43.6500000, 25.1166668, 28.0},
-	{1748, "Bulgaria", "Isperikh", 43.7166667, 26.8333340, 253.0},
-	{1749, "Bulgaria", "Sredets", 42.3488889, 27.1941662, 47.0},
-	{1750, "Bulgaria", "Veliki Preslav", 43.1666667, 26.8166676, 102.0},
-	{1751, "Bulgaria", "Bansko", 41.8382986, 23.4885120, 916.0},
-	{1752, "Bulgaria", "Omurtag", 43.1000000, 26.4166660, 526.0},
-	{1753, "Bulgaria", "Kubrat", 43.8000000, 26.5000000, 202.0},
-	{1754, "Bulgaria", "Svoge", 42.9666667, 23.3500004, 669.0},
-	{1755, "Bulgaria", "Septemvri", 42.2166667, 24.1000004, 238.0},
-	{1756, "Bulgaria", "Krichim", 42.0500000, 24.4666672, 254.0},
-	{1757, "Bulgaria", "Lyaskovets", 43.1111111, 25.7283325, 138.0},
-	{1758, "Bulgaria", "Rakitovo", 41.9833333, 24.0833340, 849.0},
-	{1759, "Bulgaria", "Dryanovo", 42.9789697, 25.4784966, 285.0},
-	{1760, "Bulgaria", "Devnya", 43.2222222, 27.5694447, 49.0},
-	{1761, "Bulgaria", "Pirdop", 42.7000000, 24.1833324, 693.0},
-	{1762, "Bulgaria", "Lyubimets", 41.8333333, 26.0833340, 63.0},
-	{1763, "Bulgaria", "Beloslav", 43.1833333, 27.7000008, 75.0},
-	{1764, "Bulgaria", "Khisarya", 42.5000000, 24.7000008, 347.0},
-	{1765, "Bulgaria", "General-Toshevo", 43.7000000, 28.0333328, 235.0},
-	{1766, "Bulgaria", "Slivnitsa", 42.8500000, 23.0333328, 613.0},
-	{1767, "Bulgaria", "Vurshets", 43.1952778, 23.2861118, 408.0},
-	{1768, "Bulgaria", "Devin", 41.7432677, 24.4000340, 884.0},
-	{1769, "Bulgaria", "Simeonovgrad", 42.0333333, 25.8333340, 80.0},
-	{1770, "Myanmar", "Rangoon", 16.8052778, 96.1561127, 13.0},
-	{1771, "Myanmar", "Mandalay", 22.0000000, 96.0833359, 75.0},
-	{1772, "Myanmar", "Mawlamyine", 16.4913889, 97.6255569, 30.0},
-	{1773, "Myanmar", "Bago", 17.3366667, 96.4797211, 3.0},
-	{1774, "Myanmar", "Pathein", 16.7833333, 94.7333298, 12.0},
-	{1775, "Myanmar", "Monywa", 22.1166667, 95.1333313, 81.0},
-	{1776, "Myanmar", "Akyab", 20.1500000, 92.9000015, 1.0},
-	{1777, "Myanmar", "Meiktila", 20.8666667, 95.8666687, 225.0},
-	{1778, "Myanmar", "Myeik", 12.4333333, 98.5999985, 11.0},
-	{1779, "Myanmar", "Taunggyi", 20.7833333, 97.0333328, 1420.0},
-	{1780, "Myanmar", "Myingyan", 21.4666667, 95.3833313, 46.0},
-	{1781, "Myanmar", "Dawei", 14.0833333, 98.1999969, 5.0},
-	{1782, "Myanmar", "Prome", 18.8166667, 95.2166672, 21.0},
-	{1783, "Myanmar", "Hinthada", 17.6333333, 95.4666672, 8.0},
-	{1784, "Myanmar", "Lashio", 22.9333333, 97.7500000, 837.0},
-	{1785, "Myanmar", "Pakokku", 21.3333333, 95.0999985, 40.0},
-	{1786, "Myanmar", "Thaton", 16.9205556, 97.3713913, 20.0},
-	{1787, "Myanmar", "Maymyo", 22.0333333, 96.4666672, 1090.0},
-	{1788, "Myanmar", "Yenangyaung", 20.4666667, 94.8833313, 65.0},
-	{1789, "Myanmar", "Taungoo", 18.9333333, 96.4333344, 28.0},
-	{1790, "Myanmar", "Thayetmyo", 19.3166667, 95.1833344, 52.0},
-	{1791, "Myanmar", "Pyinmana", 19.7333333, 96.2166672, 78.0},
-	{1792, "Myanmar", "Magway", 20.1500000, 94.9166641, 50.0},
-	{1793, "Myanmar", "Myitkyina", 25.3833333, 97.4000015, 158.0},
-	{1794, "Myanmar", "Chauk", 20.8833333, 94.8166656, 62.0},
-	{1795, "Myanmar", "Mogok", 22.9166667, 96.5000000, 1079.0},
-	{1796, "Myanmar", "Nyaunglebin", 17.9500000, 96.7333298, 11.0},
-	{1797, "Myanmar", "Mudon", 16.2577778, 97.7163925, 19.0},
-	{1798, "Myanmar", "Shwebo", 22.5666667, 95.6999969, 89.0},
-	{1799, "Myanmar", "Sagaing", 21.8787039, 95.9796524, 60.0},
-	{1800, "Myanmar", "Taungdwingyi", 20.0166667, 95.5500031, 138.0},
-	{1801, "Myanmar", "Syriam", 16.7722222, 96.2377777, -9999.0},
-	{1802, "Myanmar", "Bogale", 16.2833333, 95.4000015, 21.0},
-	{1803, "Myanmar", "Pyapon", 16.2833333, 95.6833344, 17.0},
-	{1804, "Myanmar", "Yamethin", 20.4333333, 96.1500015, 178.0},
-	{1805, "Myanmar", "Kanbe", 16.7052778, 96.0016632, 17.0},
-	{1806, "Myanmar", "Myaydo", 19.3666667, 95.2166672, 56.0},
-	{1807, "Myanmar", "Minbu", 20.1833333, 94.8833313, 39.0},
-	{1808, "Myanmar", "Tharyarwady", 17.6500000, 95.8000031, 21.0},
-	{1809, "Myanmar", "Thongwa", 16.7619444, 96.5277786, 5.0},
-	{1810, "Myanmar", "Kyaiklat", 16.4333333, 95.7333298, 8.0},
-	{1811, "Myanmar", "Maubin", 16.7333333, 95.6500015, 13.0},
-	{1812, "Myanmar", "Kyaukse", 21.6000000, 96.1333313, 77.0},
-	{1813, "Myanmar", "Kyaikto", 17.3000000, 97.0166702, 11.0},
-	{1814, "Myanmar", "Martaban", 16.5313889, 97.6111145, 43.0},
-	{1815, "Myanmar", "Kyaikkami", 16.0800000, 97.5674973, 24.0},
-	{1816, "Myanmar", "Bhamo", 24.2666667, 97.2333298, 110.0},
-	{1817, "Myanmar", "Twante", 16.7166667, 95.9333344, 13.0},
-	{1818, "Myanmar", "Mawlaik", 23.6333333, 94.4166641, 84.0},
-	{1819, "Myanmar", "Wakema", 16.6000000, 95.1833344, 5.0},
-	{1820, "Myanmar", "Myanaung", 18.2833333, 95.3166656, 27.0},
-	{1821, "Myanmar", "Pyu", 18.4833333, 96.4333344, 40.0},
-	{1822, "Myanmar", "Kayan", 16.9055556, 96.5630569, 5.0},
-	{1823, "Myanmar", "Nyaungdon", 17.0333333, 95.6500015, 16.0},
-	{1824, "Myanmar", "Mawlamyinegyunn", 16.3833333, 95.2666702, 7.0},
-	{1825, "Myanmar", "Thanatpin", 17.3000000, 96.5833359, 6.0},
-	{1826, "Myanmar", "Letpandan", 17.7833333, 95.7500000, 6.0},
-	{1827, "Myanmar", "Paungde", 18.4833333, 95.5000000, 26.0},
-	{1828, "Myanmar", "Loikaw", 19.6741667, 97.2094421, 872.0},
-	{1829, "Myanmar", "Falam", 22.9166667, 93.6833344, 1570.0},
-	{1830, "Myanmar", "Pagan", 21.1666667, 94.8666687, 53.0},
-	{1831, "Cambodia", "Phnom Penh", 11.5500000, 104.9166641, 16.0},
-	{1832, "Cambodia", "Kampong Saom", 10.6333333, 103.5000000, 20.0},
-	{1833, "Cambodia", "Batdambang", 13.1000000, 103.1999969, 40.0},
-	{1834, "Cambodia", "Siemreab", 13.3666667, 103.8499985, 15.0},
-	{1835, "Cambodia", "Kampong Chhnang", 12.2500000, 104.6666641, 17.0},
-	{1836, "Cambodia", "Kampong Cham", 12.0000000, 105.4499969, 21.0},
-	{1837, "Cambodia", "Pouthisat", 12.5333333, 103.9166641, 18.0},
-	{1838, "Cambodia", "Ta Khmau", 11.4833333, 104.9499969, 1.0},
-	{1839, "Cambodia", "Phumi Veal Sre", 10.9833333, 104.7833328, 8.0},
-	{1840, "Cambodia", "Kampong Spoe", 11.4500000, 104.5333328, 38.0},
-	{1841, "Cambodia", "Krong Kaoh Kong", 11.6175000, 102.9805527, 1.0},
-	{1842, "Cambodia", "Phnum Tbeng Meanchey", 13.8166667, 104.9666672, 47.0},
-	{1843, "Cambodia", "Sisophon", 13.5833333, 102.9833298, 17.0},
-	{1844, "Cambodia", "Kampot", 10.6166667, 104.1833344, 7.0},
-	{1845, "Cambodia", "Kracheh", 12.4833333, 106.0166702, 18.0},
-	{1846, "Cambodia", "Kampong Thum", 12.7000000, 104.9000015, 11.0},
-	{1847, "Cambodia", "Lumphat", 13.5000000, 106.9833298, 113.0},
-	{1848, "Cambodia", "Pailin", 12.8505556, 102.6097260, 170.0},
-	{1849, "Cambodia", "Krong Keb", 10.4833333, 104.3166656, -9999.0},
-	{1850, "Cambodia", "Senmonourom", 12.4500000, 107.1999969, 734.0},
-	{1851, "Cameroon", "Douala", 4.0502778, 9.6999998, 1.0},
-	{1852, "Cameroon", "Yaounde", 3.8666667, 11.5166664, 733.0},
-	{1853, "Cameroon", "Garoua", 9.3000000, 13.3999996, 166.0},
-	{1854, "Cameroon", "Kousseri", 12.0783333, 15.0308332, 276.0},
-	{1855, "Cameroon", "Bamenda", 5.9333333, 10.1666670, 1615.0},
-	{1856, "Cameroon", "Maroua", 10.5955556, 14.3247223, 383.0},
-	{1857, "Cameroon", "Bafoussam", 5.4666667, 10.4166670, 1498.0},
-	{1858, "Cameroon", "Mokolo", 10.7402778, 13.8027782, 761.0},
-	{1859, "Cameroon", "Ngaoundere", 7.3166667, 13.5833330, 1205.0},
-	{1860, "Cameroon", "Bertoua", 4.5833333, 13.6833334, 716.0},
-	{1861, "Cameroon", "Edea", 3.8000000, 10.1333332, 22.0},
-	{1862, "Cameroon", "Loum", 4.7161111, 9.7463884, 295.0},
-	{1863, "Cameroon", "Kumba", 4.6438889, 9.4386110, 280.0},
-	{1864, "Cameroon", "Nkongsamba", 4.9533333, 9.9324999, 855.0},
-	{1865, "Cameroon", "Mbouda", 5.6333333, 10.2500000, 1433.0},
-	{1866, "Cameroon", "Dschang", 5.4500000, 10.0666666, 1450.0},
-	{1867, "Cameroon", "Foumban", 5.7166667, 10.9166670, 1123.0},
-	{1868, "Cameroon", "Ebolowa", 2.9000000, 11.1499996, 637.0},
-	{1869, "Cameroon", "Guider", 9.9341667, 13.9486113, 375.0},
-	{1870, "Cameroon", "Foumbot", 5.5000000, 10.6333332, 1135.0},
-	{1871, "Cameroon", "Bafang", 5.1500000, 10.1833334, 1214.0},
-	{1872, "Cameroon", "Yagoua", 10.3427778, 15.2405558, 312.0},
-	{1873, "Cameroon", "Mbalmayo", 3.5166667, 11.5000000, 360.0},
-	{1874, "Cameroon", "Meiganga", 6.5166667, 14.3000002, 987.0},
-	{1875, "Cameroon", "Bali", 5.8833333, 10.0166664, 1416.0},
-	{1876, "Cameroon", "Limbe", 4.0127778, 9.2202778, 137.0},
-	{1877, "Cameroon", "Bafia", 4.7500000, 11.2333336, 473.0},
-	{1878, "Cameroon", "Wum", 6.3833333, 10.0666666, 1110.0},
-	{1879, "Cameroon", "Bangangte", 5.1500000, 10.5166664, 1338.0},
-	{1880, "Cameroon", "Tiko", 4.0786111, 9.3680553, 79.0},
-	{1881, "Cameroon", "Kribi", 2.9500000, 9.9166670, 14.0},
-	{1882, "Cameroon", "Mora", 11.0425000, 14.1447220, 456.0},
-	{1883, "Cameroon", "Sangmelima", 2.9333333, 11.9833336, 542.0},
-	{1884, "Cameroon", "Kumbo", 6.2000000, 10.6666670, 1771.0},
-	{1885, "Cameroon", "Nkoteng", 4.5166667, 12.0333328, 565.0},
-	{1886, "Cameroon", "Mutengene", 4.0994444, 9.3080559, 283.0},
-	{1887, "Cameroon", "Garoua Boulai", 5.8833333, 14.5500002, 997.0},
-	{1888, "Cameroon", "Batouri", 4.4333333, 14.3666668, 618.0},
-	{1889, "Cameroon", "Fundong", 6.2500000, 10.2666664, 1538.0},
-	{1890, "Cameroon", "Fontem", 5.4666667, 9.8833332, 1342.0},
-	{1891, "Cameroon", "Mbanga", 4.5091667, 9.5680552, 154.0},
-	{1892, "Cameroon", "Banyo", 6.7500000, 11.8166666, 1260.0},
-	{1893, "Cameroon", "Manjo", 4.8452778, 9.8247223, 579.0},
-	{1894, "Cameroon", "Melong", 5.1166667, 9.9499998, 762.0},
-	{1895, "Cameroon", "Tibati", 6.4666667, 12.6333332, 840.0},
-	{1896, "Cameroon", "Muyuka", 4.2972222, 9.4055557, 54.0},
-	{1897, "Cameroon", "Obala", 4.1666667, 11.5333328, 528.0},
-	{1898, "Cameroon", "Nanga Eboko", 4.6833333, 12.3666668, 632.0},
-	{1899, "Cameroon", "Penja", 4.6363889, 9.6819448, 151.0},
-	{1900, "Cameroon", "Mbandjok", 4.4500000, 11.8999996, 517.0},
-	{1901, "Cameroon", "Kaele", 10.1091667, 14.4508333, 371.0},
-	{1902, "Cameroon", "Bamusso", 4.4333333, 8.8999996, 1.0},
-	{1903, "Cameroon", "Lagdo", 9.0500000, 13.7333336, 188.0},
-	{1904, "Cameroon", "Tchollire", 8.4000000, 14.1666670, 376.0},
-	{1905, "Cameroon", "Belabo", 4.9333333, 13.3000002, 611.0},
-	{1906, "Cameroon", "Lolodorf", 3.2333333, 10.7333336, 406.0},
-	{1907, "Cameroon", "Eseka", 3.6500000, 10.7666664, 246.0},
-	{1908, "Cameroon", "Mamfe", 5.7666667, 9.2833328, 444.0},
-	{1909, "Cameroon", "Dizangue", 3.7666667, 9.9833336, 1.0},
-	{1910, "Cameroon", "Idenao", 4.2475000, 9.0047226, 127.0},
-	{1911, "Cameroon", "Akonolinga", 3.7666667, 12.2500000, 521.0},
-	{1912, "Cameroon", "Bogo", 10.7361111, 14.6108332, 341.0},
-	{1913, "Cameroon", "Tonga", 4.9666667, 10.6999998, 788.0},
-	{1914, "Cameroon", "Abong Mbang", 3.9833333, 13.1833334, 708.0},
-	{1915, "Cameroon", "Yokadouma", 3.5166667, 15.0500002, 562.0},
-	{1916, "Cameroon", "Bazou", 5.0666667, 10.4666672, 1218.0},
-	{1917, "Cameroon", "Mundemba", 4.9500000, 8.8666668, 322.0},
-	{1918, "Cameroon", "Betare Oya", 5.6000000, 14.0833330, 779.0},
-	{1919, "Cameroon", "Pitoa", 9.3833333, 13.5333328, 201.0},
-	{1920, "Cameroon", "Rey Bouba", 8.6666667, 14.1833334, 224.0},
-	{1921, "Cameroon", "Mindif", 10.4027778, 14.4399996, 531.0},
-	{1922, "Cameroon", "Babanki", 6.1166667, 10.2500000, 1177.0},
-	{1923, "Cameroon", "Mbengwi", 6.0166667, 10.0000000, 1260.0},
-	{1924, "Cameroon", "Akom II", 2.7833333, 10.5666666, 462.0},
-	{1925, "Cameroon", "Batibo", 5.8333333, 9.8666668, 1431.0},
-	{1926, "Cameroon", "Belo", 6.1333333, 10.2500000, 1166.0},
-	{1927, "Cameroon", "Dimako", 4.3833333, 13.5666666, 681.0},
-	{1928, "Cameroon", "Ambam", 2.3833333, 11.2833328, 347.0},
-	{1929, "Cameroon", "Njinikom", 6.2333333, 10.2833328, 1254.0},
-	{1930, "Cameroon", "Ndikinimeki", 4.7666667, 10.8333330, 525.0},
-	{1931, "Cameroon", "Bonaberi", 4.0844444, 9.6661110, 1.0},
-	{1932, "Cameroon", "Kontcha", 7.9666667, 12.2333336, 388.0},
-	{1933, "Cameroon", "Bangou", 5.2000000, 10.3833332, 1489.0},
-	{1934, "Cameroon", "Yabassi", 4.4544444, 9.9655552, 21.0},
-	{1935, "Cameroon", "Bansoa", 5.4500000, 10.3166666, 1532.0},
-	{1936, "Cameroon", "Makari", 12.5744444, 14.4519444, 299.0},
-	{1937, "Cameroon", "Bekondo", 4.6838889, 9.3213892, 411.0},
-	{1938, "Cameroon", "Bandjoun", 5.3500000, 10.3999996, 1563.0},
-	{1939, "Cameroon", "Belel", 7.0500000, 14.4333334, 1373.0},
-	{1940, "Cameroon", "Bamendjou", 5.4000000, 10.3166666, 1533.0},
-	{1941, "Cameroon", "Ngambe", 4.2333333, 10.6166668, 205.0},
-	{1942, "Cameroon", "Ntui", 4.4500000, 11.6333332, 544.0},
-	{1943, "Cameroon", "Mme-Bafumen", 6.3333333, 10.2333336, 1314.0},
-	{1944, "Cameroon", "Bana", 5.1500000, 10.2666664, 1487.0},
-	{1945, "Cameroon", "Saa", 4.3666667, 11.4499998, 575.0},
-	{1946, "Cameroon", "Poli", 8.4833333, 13.2500000, 781.0},
-	{1947, "Cameroon", "Bankim", 6.0830337, 11.4904976, 762.0},
-	{1948, "Cameroon", "Doume", 4.2333333, 13.4499998, 658.0},
-	{1949, "Cameroon", "Ndom", 4.5025000, 9.5616665, 148.0},
-	{1950, "Cameroon", "Nguti", 5.3000000, 9.4333334, 1353.0},
-	{1951, "Canada", "Toronto", 43.7001138, -79.4163055, 173.0},
-	{1952, "Canada", "Montreal", 45.5167792, -73.6491776, 47.0},
-	{1953, "Canada", "Vancouver", 49.2496574, -123.1193390, 63.0},
-	{1954, "Canada", "Calgary", 51.0501123, -114.0852890, 1052.0},
-	{1955, "Canada", "Ottawa", 45.4209405, -75.6902847, 61.0},
-	{1956, "Canada", "Edmonton", 53.5501359, -113.4687119, 640.0},
-	{1957, "Canada", "Winnipeg", 49.8843986, -97.1470413, 230.0},
-	{1958, "Canada", "Quebec", 46.8122791, -71.2145386, 47.0},
-	{1959, "Canada", "Hamilton", 43.2334084, -79.9496384, 208.0},
-	{1960, "Canada", "Kitchener", 43.4500956, -80.4829865, 334.0},
-	{1961, "Canada", "Halifax", 44.6519862, -63.5968475, 45.0},
-	{1962, "Canada", "London", 42.9833893, -81.2330399, 251.0},
-	{1963, "Canada", "Victoria", 48.4329357, -123.3693008, 1.0},
-	{1964, "Canada", "Windsor", 42.3000836, -83.0165405, 183.0},
-	{1965, "Canada", "Oshawa", 43.9001204, -78.8495712, 106.0},
-	{1966, "Canada", "Saskatoon", 52.1167863, -106.6345215, 468.0},
-	{1967, "Canada", "Barrie", 44.4001075, -79.6663361, 252.0},
-	{1968, "Canada", "Richmond", 49.1700323, -123.1368256, 3.0},
-	{1969, "Canada", "Regina", 50.4500801, -104.6177979, 573.0},
-	{1970, "Canada", "Grand Sudbury", 46.4900017, -80.9900055, 274.0},
-	{1971, "Canada", "Abbotsford", 49.0579767, -122.2525711, 128.0},
-	{1972, "Canada", "Sherbrooke", 45.4000830, -71.8990784, 153.0},
-	{1973, "Canada", "Levis", 46.8032615, -71.1779327, 85.0},
-	{1974, "Canada", "Kelowna", 49.8830737, -119.4856720, 352.0},
-	{1975, "Canada", "Trois-Rivieres", 46.3500609, -72.5491180, 25.0},
-	{1976, "Canada", "Guelph", 43.5500968, -80.2496719, 334.0},
-	{1977, "Canada", "Kingston", 44.2297641, -76.4809799, 77.0},
-	{1978, "Canada", "Thunder Bay", 48.4000957, -89.3168259, 215.0},
-	{1979, "Canada", "Waterloo", 43.4667956, -80.5163879, 331.0},
-	{1980, "Canada", "Saint John", 45.2593501, -66.0377426, -9999.0},
-	{1981, "Canada", "Brantford", 43.1334015, -80.2663651, 200.0},
-	{1982, "Canada", "Moncton", 46.1159434, -64.8018646, 15.0},
-	{1983, "Canada", "Nanaimo", 49.1663374, -123.9360123, 19.0},
-	{1984, "Canada", "Sarnia", 42.9667758, -82.3831253, 186.0},
-	{1985, "Canada", "Saint-Laurent", 45.5000795, -73.6658478, 43.0},
-	{1986, "Canada", "Peterborough", 44.3001213, -78.3162308, 199.0},
-	{1987, "Canada", "Red Deer", 52.2668171, -113.8019943, 856.0},
-	{1988, "Canada", "Saint-Jean-sur-Richelieu", 45.3167807, -73.2658539, 29.0},
-	{1989, "Canada", "Lethbridge", 49.6999934, -112.8185577, 907.0},
-	{1990, "Canada", "Brossard", 45.4500795, -73.4658356, 20.0},
-	{1991, "Canada", "Kamloops", 50.6664769, -120.3191986, 457.0},
-	{1992, "Canada", "White Rock", 49.0163644, -122.8026047, -9999.0},
-	{1993, "Canada", "Prince George", 53.9166032, -122.7530136, 568.0},
-	{1994, "Canada", "Medicine Hat", 50.0500596, -110.6683426, 708.0},
-	{1995, "Canada", "Drummondville", 45.8833647, -72.4824142, 82.0},
-	{1996, "Canada", "New Westminster", 49.2067756, -122.9109192, 40.0},
-	{1997, "Canada", "Sherwood Park", 53.5168360, -113.3187027, 718.0},
-	{1998, "Canada", "Saint-Jerome", 45.7803642, -74.0036469, 92.0},
-	{1999, "Canada", "Granby", 45.4000791, -72.7324295, 116.0},
-	{2000, "Canada", "Fredericton", 45.9454148, -66.6655807, 93.0},
-	{2001, "Canada", "Chilliwack", 49.1746816, -121.9442673, 9.0},
-	{2002, "Canada", "Saint-Hyacinthe", 45.6167758, -72.9491348, 26.0},
-	{2003, "Canada", "North Bay", 46.3168021, -79.4663315, 221.0},
-	{2004, "Canada", "Shawinigan", 46.5667534, -72.7491302, 137.0},
-	{2005, "Canada", "Cornwall", 45.0333933, -74.7325821, 60.0},
-	{2006, "Canada", "North Vancouver", 49.3163619, -123.0693436, 46.0},
-	{2007, "Canada", "Vernon", 50.2580886, -119.2690506, 388.0},
-	{2008, "Canada", "Chatham-Kent", 42.4167869, -82.1664963, 180.0},
-	{2009, "Canada", "Charlottetown", 46.2352496, -63.1267128, 6.0},
-	{2010, "Canada", "Grande Prairie", 55.1666735, -118.8027115, 664.0},
-	{2011, "Canada", "Salaberry-de-Valleyfield", 45.2500834, -74.1325378, 43.0},
-	{2012, "Canada", "Penticton", 49.4997646, -119.5856934, 358.0},
-	{2013, "Canada", "Joliette", 46.0167719, -73.4491577, 46.0},
-	{2014, "Canada", "Prince Albert", 53.2000820, -105.7677231, 420.0},
-	{2015, "Canada", "Victoriaville", 46.0500670, -71.9657898, 124.0},
-	{2016, "Canada", "Woodstock", 43.1333947, -80.7497025, 304.0},
-	{2017, "Canada", "Sorel-Tracy", 46.0333622, -73.1158447, 7.0},
-	{2018, "Canada", "Campbell River", 50.0163393, -125.2445908, 72.0},
-	{2019, "Canada", "Timmins", 48.4668636, -81.3331223, 322.0},
-	{2020, "Canada", "Courtenay", 49.6829411, -124.9861298, 5.0},
-	{2021, "Canada", "Orangeville", 43.9168031, -80.0996704, 426.0},
-	{2022, "Canada", "North Cowichan", 48.8413317, -123.6859665, 61.0},
-	{2023, "Canada", "Moose Jaw", 50.4000528, -105.5344467, 553.0},
-	{2024, "Canada", "Midland", 44.7501042, -79.8829651, 202.0},
-	{2025, "Canada", "Leamington", 42.0833864, -82.5665131, 191.0},
-	{2026, "Canada", "Stratford", 43.3667928, -80.9497223, 358.0},
-	{2027, "Canada", "Orillia", 44.6168093, -79.4163208, 219.0},
-	{2028, "Canada", "Alma", 48.5500931, -71.6491013, 93.0},
-	{2029, "Canada", "Brandon", 49.8469210, -99.9530640, 378.0},
-	{2030, "Canada", "Airdrie", 51.3001121, -114.0352859, 1081.0},
-	{2031, "Canada", "Rouyn-Noranda", 48.2398521, -79.0287781, 304.0},
-	{2032, "Canada", "Brockville", 44.5834124, -75.6826401, 75.0},
-	{2033, "Canada", "Whitehorse", 60.7161148, -135.0537415, 630.0},
-	{2034, "Canada", "Lloydminster", 53.2834618, -110.0015717, 646.0},
-	{2035, "Canada", "Owen Sound", 44.5667903, -80.9330215, 226.0},
-	{2036, "Canada", "Sept-Iles", 50.2001125, -66.3820801, 2.0},
-	{2037, "Canada", "Duncan", 48.7829312, -123.7026596, 61.0},
-	{2038, "Canada", "Port Alberni", 49.2413251, -124.8028030, 19.0},
-	{2039, "Canada", "Parksville", 49.3163258, -124.3194504, 46.0},
-	{2040, "Canada", "Truro", 45.3668480, -63.2653770, 30.0},
-	{2041, "Canada", "New Glasgow", 45.5834447, -62.6486359, 12.0},
-	{2042, "Canada", "Glace Bay", 46.1969500, -59.9569817, 1.0},
-	{2043, "Canada", "Terrace", 54.5163400, -128.6034546, 68.0},
-	{2044, "Canada", "North Battleford", 52.7834372, -108.2846527, 539.0},
-	{2045, "Canada", "Bradford West Gwillimbury", 44.1168100, -79.6163254, 267.0},
-	{2046, "Canada", "Corner Brook", 48.9667069, -57.9484024, -9999.0},
-	{2047, "Canada", "Cranbrook", 49.4999124, -115.7687912, 984.0},
-	{2048, "Canada", "Cobourg", 43.9668223, -78.1662216, 91.0},
-	{2049, "Canada", "Spruce Grove", 53.5334352, -113.9187469, 703.0},
-	{2050, "Cape Verde", "Praia", 14.9166667, -23.5166664, -9999.0},
-	{2051, "Cape Verde", "Mindelo", 16.8833333, -25.0000000, 62.0},
-	{2052, "Cape Verde", "Santa Maria", 16.6000000, -22.8999996, 13.0},
-	{2053, "Cape Verde", "Santa Cruz", 15.1333333, -23.5666676, 57.0},
-	{2054, "Cape Verde", "Sao Filipe", 14.9000000, -24.5166664, -9999.0},
-	{2055, "Cape Verde", "Assomada", 15.1000000, -23.6833324, 338.0},
-	{2056, "Cape Verde", "Tarrafal", 15.2833333, -23.7666664, 251.0},
-	{2057, "Cape Verde", "Porto Novo", 17.0196919, -25.0647068, -9999.0},
-	{2058, "Cape Verde", "Vila da Ribeira Brava", 16.6166667, -24.2999992, 208.0},
-	{2059, "Cape Verde", "Ponta do Sol", 17.2000000, -25.1000004, 50.0},
-	{2060, "Cape Verde", "Vila do Maio", 15.1333333, -23.2166672, 2.0},
-	{2061, "Cape Verde", "Sal Rei", 16.1833333, -22.9166660, 1.0},
-	{2062, "Cape Verde", "Pombas", 17.1500000, -25.0166664, 13.0},
-	{2063, "Cape Verde", "Porto dos Mosteiros", 15.0333333, -24.3333340, 1.0},
-	{2064, "Central African Republic", "Bangui", 4.3666667, 18.5833340, 373.0},
-	{2065, "Central African Republic", "Bimbo", 4.3000000, 18.5499992, 339.0},
-	{2066, "Central African Republic", "Mbaiki", 3.8833333, 18.0000000, 515.0},
-	{2067, "Central African Republic", "Berberati", 4.2666667, 15.7833328, 577.0},
-	{2068, "Central African Republic", "Kaga Bandoro", 6.9833333, 19.1833324, 412.0},
-	{2069, "Central African Republic", "Bozoum", 6.3166667, 16.3833332, 702.0},
-	{2070, "Central African Republic", "Carnot", 4.9333333, 15.8666668, 486.0},
-	{2071, "Central African Republic", "Sibut", 5.7333333, 19.0833340, 400.0},
-	{2072, "Central African Republic", "Bambari", 5.7619444, 20.6672230, 465.0},
-	{2073, "Central African Republic", "Bria", 6.5333333, 21.9833336, 558.0},
-	{2074, "Central African Republic", "Bouar", 5.9500000, 15.6000004, 1047.0},
-	{2075, "Central African Republic", "Bossangoa", 6.4833333, 17.4500008, 466.0},
-	{2076, "Central African Republic", "Nola", 3.5333333, 16.0666676, 443.0},
-	{2077, "Central African Republic", "Bangassou", 4.7333333, 22.8166676, 458.0},
-	{2078, "Central African Republic", "Damara", 4.9666667, 18.7000008, 404.0},
-	{2079, "Central African Republic", "Mobaye", 4.3166667, 21.1833324, 434.0},
-	{2080, "Central African Republic", "Paoua", 7.2500000, 16.4333324, 604.0},
-	{2081, "Central African Republic", "Boda", 4.3166667, 17.4666672, 565.0},
-	{2082, "Central African Republic", "Ippy", 6.2500000, 21.2000008, 556.0},
-	{2083, "Central African Republic", "Batangafo", 7.3000000, 18.2999992, 432.0},
-	{2084, "Central African Republic", "Alindao", 5.0333333, 21.2166672, 482.0},
-	{2085, "Central African Republic", "Kabo", 7.6500000, 18.6166668, 398.0},
-	{2086, "Central African Republic", "Rafai", 4.9500000, 23.9166660, 526.0},
-	{2087, "Central African Republic", "Bouca", 6.5000000, 18.2833328, 420.0},
-	{2088, "Central African Republic", "Obo", 5.4000000, 26.5000000, 615.0},
-	{2089, "Central African Republic", "Ndele", 8.4091667, 20.6530552, 587.0},
-	{2090, "Central African Republic", "Kembe", 4.6000000, 21.8999996, 457.0},
-	{2091, "Central African Republic", "Mongoumba", 3.6333333, 18.6000004, 338.0},
-	{2092, "Central African Republic", "Birao", 10.2833333, 22.7833328, 472.0},
-	{2093, "Central African Republic", "Gamboula", 4.1333333, 15.1499996, 609.0},
-	{2094, "Central African Republic", "Kouango", 4.9666667, 19.9833336, 415.0},
-	{2095, "Central African Republic", "Baoro", 5.6666667, 15.9666672, 729.0},
-	{2096, "Central African Republic", "Boali", 4.8000000, 18.1166668, 450.0},
-	{2097, "Central African Republic", "Ouadda", 8.0666667, 22.3999996, 682.0},
-	{2098, "Central African Republic", "Gambo", 4.6500000, 22.2666664, 500.0},
-	{2099, "Central African Republic", "Ouango", 4.3166667, 22.5499992, 454.0},
-	{2100, "Chad", "Moundou", 8.5666667, 16.0833340, 415.0},
-	{2101, "Chad", "Sarh", 9.1500000, 18.3833332, 342.0},
-	{2102, "Chad", "Abeche", 13.8291624, 20.8323956, 542.0},
-	{2103, "Chad", "Kelo", 9.3166667, 15.8000002, 429.0},
-	{2104, "Chad", "Koumra", 8.9166667, 17.5499992, 419.0},
-	{2105, "Chad", "Pala", 9.3666667, 14.8999996, 446.0},
-	{2106, "Chad", "Am Timan", 11.0333333, 20.2833328, 416.0},
-	{2107, "Chad", "Bongor", 10.2805556, 15.3722219, 316.0},
-	{2108, "Chad", "Mongo", 12.1833333, 18.7000008, 395.0},
-	{2109, "Chad", "Doba", 8.6500000, 16.8500004, 398.0},
-	{2110, "Chad", "Ati", 13.2166667, 18.3333340, 297.0},
-	{2111, "Chad", "Lai", 9.4000000, 16.2999992, 362.0},
-	{2112, "Chad", "Oum Hadjer", 13.3000000, 19.6833324, 358.0},
-	{2113, "Chad", "Bitkine", 11.9816667, 18.2127781, 682.0},
-	{2114, "Chad", "Mao", 14.1194444, 15.3133335, 352.0},
-	{2115, "Chad", "Massaguet", 12.4741667, 15.4427776, 289.0},
-	{2116, "Chad", "Dourbali", 11.8094444, 15.8611107, 305.0},
-	{2117, "Chad", "Mboursou Lere", 9.7666667, 14.1499996, 304.0},
-	{2118, "Chad", "Kyabe", 9.4500000, 18.9500008, 367.0},
-	{2119, "Chad", "Benoy", 8.9833333, 16.3166676, 448.0},
-	{2120, "Chad", "Massakory", 12.9980556, 15.7375002, 259.0},
-	{2121, "Chad", "Moussoro", 13.6425000, 16.4888897, 293.0},
-	{2122, "Chad", "Bokoro", 12.3766667, 17.0580559, 300.0},
-	{2123, "Chad", "Bere", 9.3333333, 16.1499996, 383.0},
-	{2124, "Chad", "Bousso", 10.4825000, 16.7161102, 284.0},
-	{2125, "Chad", "Faya", 17.9166667, 19.1166668, 259.0},
-	{2126, "Chad", "Bebedjia", 8.6833333, 16.5666676, 398.0},
-	{2127, "Chad", "Ngama", 11.7833333, 17.1666660, 309.0},
-	{2128, "Chad", "Adre", 13.4666667, 22.2000008, 762.0},
-	{2129, "Chad", "Guelengdeng", 10.9333333, 15.5333328, 295.0},
-	{2130, "Chad", "Moissala", 8.3500000, 17.7666664, 345.0},
-	{2131, "Chad", "Biltine", 14.5333333, 20.9166660, 503.0},
-	{2132, "Chad", "Goundi", 9.3666667, 17.3666668, 486.0},
-	{2133, "Chad", "Gounou-Gaya", 9.6272222, 15.5138893, 461.0},
-	{2134, "Chad", "Beinamar", 8.6666667, 15.3833332, 457.0},
-	{2135, "Chad", "Melfi", 11.0597222, 17.9330559, 409.0},
-	{2136, "Chad", "Beboto", 8.2666667, 16.9333324, 448.0},
-	{2137, "Chad", "Aozou", 21.8375000, 17.4274998, 1075.0},
-	{2138, "Chad", "Goz Beida", 12.2166667, 21.4166660, 594.0},
-	{2139, "Chad", "Iriba", 15.1166667, 22.2500000, 923.0},
-	{2140, "Chile", "Santiago", -33.4500000, -70.6666641, 513.0},
-	{2141, "Chile", "vina causino", -33.6166667, -70.5833359, 673.0},
-	{2142, "Chile", "Antofagasta", -23.6500000, -70.4000015, 276.0},
-	{2143, "Chile", "Vina del Mar", -33.0229137, -71.5525360, 33.0},
-	{2144, "Chile", "Valparaiso", -33.0393202, -71.6272583, 24.0},
-	{2145, "Chile", "Talcahuano", -36.7166667, -73.1166687, 1.0},
-	{2146, "Chile", "San Bernardo", -33.6000000, -70.7166672, 629.0},
-	{2147, "Chile", "Temuco", -38.7333333, -72.5999985, 128.0},
-	{2148, "Chile", "Iquique", -20.2166667, -70.1666641, -9999.0},
-	{2149, "Chile", "Concepcion", -36.8269950, -73.0497665, 136.0},
-	{2150, "Chile", "Rancagua", -34.1666667, -70.7500000, 518.0},
-	{2151, "Chile", "Talca", -35.4333333, -71.6666641, 102.0},
-	{2152, "Chile", "Arica", -18.4833333, -70.3333359, 12.0},
-	{2153, "Chile", "Coquimbo", -29.9533333, -71.3436127, 87.0},
-	{2154, "Chile", "Puerto Montt", -41.4716667, -72.9369431, 20.0},
-	{2155, "Chile", "La Serena", -29.9077778, -71.2541656, 30.0},
-	{2156, "Chile", "Chillan", -36.6000000, -72.1166687, 127.0},
-	{2157, "Chile", "Calama", -22.4666667, -68.9333344, 2248.0},
-	{2158, "Chile", "Osorno", -40.5666667, -73.1500015, 87.0},
-	{2159, "Chile", "Valdivia", -39.8142226, -73.2458954, 10.0},
-	{2160, "Chile", "Quilpue", -33.0450000, -71.4494476, 129.0},
-	{2161, "Chile", "Copiapo", -27.3666667, -70.3333359, 381.0},
-	{2162, "Chile", "Los Angeles", -37.4666667, -72.3499985, 137.0},
-	{2163, "Chile", "Punta Arenas", -53.1500000, -70.9166641, 54.0},
-	{2164, "Chile", "Curico", -34.9833333, -71.2333298, 218.0},
-	{2165, "Chile", "Villa Alemana", -33.0422222, -71.3733368, 155.0},
-	{2166, "Chile", "Coronel", -37.0166667, -73.1333313, 84.0},
-	{2167, "Chile", "San Antonio", -33.5933333, -71.6216660, 5.0},
-	{2168, "Chile", "Chiguayante", -36.9166667, -73.0166702, 184.0},
-	{2169, "Chile", "Ovalle", -30.5983333, -71.2002792, 269.0},
-	{2170, "Chile", "Linares", -35.8500000, -71.5999985, 165.0},
-	{2171, "Chile", "Quillota", -32.8833333, -71.2666702, 133.0},
-	{2172, "Chile", "Penaflor", -33.6166667, -70.9166641, 342.0},
-	{2173, "Chile", "Melipilla", -33.7000000, -71.2166672, 237.0},
-	{2174, "Chile", "San Felipe", -32.7500000, -70.7333298, 637.0},
-	{2175, "Chile", "Los Andes", -32.8336862, -70.5982742, 834.0},
-	{2176, "Chile", "Buin", -33.7333333, -70.7500000, 457.0},
-	{2177, "Chile", "Talagante", -33.6666667, -70.9333344, 313.0},
-	{2178, "Chile", "Lota", -37.0833333, -73.1666641, 71.0},
-	{2179, "Chile", "Hacienda La Calera", -32.7833333, -71.2166672, 202.0},
-	{2180, "Chile", "Tome", -36.6166667, -72.9499969, 120.0},
-	{2181, "Chile", "Penco", -36.7333333, -72.9833298, 84.0},
-	{2182, "Chile", "Coihaique", -45.5752397, -72.0661926, 355.0},
-	{2183, "Chile", "Vallenar", -28.5708333, -70.7580566, 442.0},
-	{2184, "Chile", "Angol", -37.8000000, -72.7166672, 79.0},
-	{2185, "Chile", "Rengo", -34.4166667, -70.8666687, 288.0},
-	{2186, "Chile", "Constitucion", -35.3333333, -72.4166641, 57.0},
-	{2187, "Chile", "Limache", -33.0166667, -71.2666702, 146.0},
-	{2188, "Chile", "Santa Cruz", -34.6333333, -71.3666687, 165.0},
-	{2189, "Chile", "Paine", -33.8166667, -70.7500000, 354.0},
-	{2190, "Chile", "Villarrica", -39.2856855, -72.2278976, 224.0},
-	{2191, "Chile", "San Carlos", -36.4166667, -71.9666672, 160.0},
-	{2192, "Chile", "Cauquenes", -35.9666667, -72.3499985, 136.0},
-	{2193, "Chile", "Curanilahue", -37.4666667, -73.3499985, 195.0},
-	{2194, "Chile", "Las Animas", -39.8086679, -73.2182083, 8.0},
-	{2195, "Chile", "Castro", -42.4720969, -73.7731934, 14.0},
-	{2196, "Chile", "Lampa", -33.2833333, -70.9000015, 623.0},
-	{2197, "Chile", "Molina", -35.1166667, -71.2833328, 274.0},
-	{2198, "Chile", "Ancud", -41.8697222, -73.8202744, 1.0},
-	{2199, "Chile", "Machali", -34.1833333, -70.6666641, 555.0},
-	{2200, "Chile", "Parral", -36.1500000, -71.8333359, 163.0},
-	{2201, "Chile", "La Union", -40.2833333, -73.0833359, 67.0},
-	{2202, "Chile", "Puerto Varas", -41.3166667, -72.9833298, 88.0},
-	{2203, "Chile", "La Ligua", -32.4524177, -71.2310562, 127.0},
-	{2204, "Chile", "Arauco", -37.2463010, -73.3175201, 2.0},
-	{2205, "Chile", "Victoria", -38.2166667, -72.3333359, 335.0},
-	{2206, "Chile", "Tocopilla", -22.0833333, -70.1999969, 67.0},
-	{2207, "Chile", "Graneros", -34.0666667, -70.7333298, 456.0},
-	{2208, "Chile", "El Monte", -33.6833333, -71.0166702, 232.0},
-	{2209, "Chile", "Illapel", -31.6308333, -71.1652756, 320.0},
-	{2210, "Chile", "San Vicente", -34.4333333, -71.0833359, 217.0},
-	{2211, "Chile", "San Javier", -35.6000000, -71.7500000, 53.0},
-	{2212, "Chile", "Lebu", -37.6166667, -73.6500015, 75.0},
-	{2213, "Chile", "Mulchen", -37.7166667, -72.2333298, 117.0},
-	{2214, "Chile", "Lautaro", -38.5166667, -72.4499969, 235.0},
-	{2215, "Chile", "Nacimiento", -37.5000000, -72.6666641, 90.0},
-	{2216, "Chile", "Canete", -37.8000000, -73.4000015, 144.0},
-	{2217, "Chile", "Puerto Natales", -51.7236255, -72.4874496, 41.0},
-	{2218, "Chile", "Nueva Imperial", -38.7333333, -72.9499969, 50.0},
-	{2219, "Chile", "Cabrero", -37.0333333, -72.4000015, 111.0},
-	{2220, "Chile", "Diego de Almagro", -26.3666667, -70.0500031, 923.0},
-	{2221, "Chile", "Chimbarongo", -34.7000000, -71.0500031, 305.0},
-	{2222, "Chile", "Llaillay", -32.8500000, -70.9666672, 365.0},
-	{2223, "Chile", "La Laja", -37.2666667, -72.6999969, 50.0},
-	{2224, "Chile", "Collipulli", -37.9500000, -72.4333344, 242.0},
-	{2225, "Chile", "Panguipulli", -39.6333333, -72.3333359, 268.0},
-	{2226, "Chile", "Loncoche", -39.3666667, -72.6333313, 136.0},
-	{2227, "Chile", "Rio Bueno", -40.3166667, -72.9666672, 40.0},
-	{2228, "Chile", "Traiguen", -38.2500000, -72.6833344, 179.0},
-	{2229, "Chile", "Monte Patria", -30.6919444, -70.9466629, 524.0},
-	{2230, "Chile", "Pitrufquen", -38.9833333, -72.6500015, 81.0},
-	{2231, "Chile", "San Clemente", -35.5500000, -71.4833298, 241.0},
-	{2232, "Chile", "Purranque", -40.9166667, -73.1666641, 236.0},
-	{2233, "Chile", "Vicuna", -30.0319444, -70.7080536, 769.0},
-	{2234, "Chile", "Salamanca", -31.7750000, -70.9722214, 467.0},
-	{2235, "Chile", "Bulnes", -36.7333333, -72.3000031, 92.0},
-	{2236, "Chile", "Calbuco", -41.7666667, -73.1333313, -9999.0},
-	{2237, "Chile", "Carahue", -38.7000000, -73.1666641, 3.0},
-	{2238, "Chile", "Yumbel", -37.0833333, -72.5666656, 94.0},
-	{2239, "Chile", "Taltal", -25.4000000, -70.4833298, 34.0},
-	{2240, "China", "Shanghai", 31.2222222, 121.4580536, 6.0},
-	{2241, "China", "Beijing", 39.9074977, 116.3972244, 63.0},
-	{2242, "China", "Nanchong", 30.8000000, 106.0666656, 280.0},
-	{2243, "China", "Kaifeng", 34.7911111, 114.3483353, 77.0},
-	{2244, "China", "Wuhan", 30.5833333, 114.2666702, 25.0},
-	{2245, "China", "Chongqing", 29.5627778, 106.5527802, 234.0},
-	{2246, "China", "Chengdu", 30.6666667, 104.0666656, 529.0},
-	{2247, "China", "Tianjin", 39.1422222, 117.1766663, 8.0},
-	{2248, "China", "Puyang", 29.4602778, 119.8861084, 63.0},
-	{2249, "China", "Shenyang", 41.7922222, 123.4327774, 48.0},
-	{2250, "China", "Shiyan", 32.6475000, 110.7780533, 247.0},
-	{2251, "China", "Harbin", 45.7500000, 126.6500015, 168.0},
-	{2252, "China", "Lanzhou", 36.0563889, 103.7922211, 1572.0},
-	{2253, "China", "Guangzhou", 23.1166667, 113.2500000, 10.0},
-	{2254, "China", "Nanjing", 32.0616667, 118.7777786, 12.0},
-	{2255, "China", "Taiyuan", 37.8694444, 112.5602798, 794.0},
-	{2256, "China", "Yunfu", 22.9333333, 112.0333328, 77.0},
-	{2257, "China", "Changchun", 43.8800000, 125.3227768, 212.0},
-	{2258, "China", "Changsha", 28.2000000, 112.9666672, 46.0},
-	{2259, "China", "Jinan", 36.6683333, 116.9972229, 29.0},
-	{2260, "China", "Dalian", 38.9122222, 121.6022186, 23.0},
-	{2261, "China", "Zhengzhou", 34.7577778, 113.6486130, 107.0},
-	{2262, "China", "Shijiazhuang", 38.0413889, 114.4786148, 79.0},
-	{2263, "China", "Jilin", 43.8508333, 126.5602798, 186.0},
-	{2264, "China", "Hangzhou", 30.2552778, 120.1688919, 18.0},
-	{2265, "China", "Nanchang", 28.6833333, 115.8833313, 19.0},
-	{2266, "China", "Qingdao", 36.0986111, 120.3719406, 32.0},
-	{2267, "China", "Tangshan", 39.6333333, 118.1833344, 20.0},
-	{2268, "China", "Xinyang", 32.1227778, 114.0655594, 73.0},
-	{2269, "China", "Urumqi", 43.8000000, 87.5833359, 915.0},
-	{2270, "China", "Fushun", 41.8558333, 123.9233322, 90.0},
-	{2271, "China", "Luoyang", 34.6836111, 112.4536133, 133.0},
-	{2272, "China", "Hefei", 31.8638889, 117.2808304, 18.0},
-	{2273, "China", "Handan", 36.6005556, 114.4677811, 69.0},
-	{2274, "China", "Suzhou", 31.3113889, 120.6180573, 4.0},
-	{2275, "China", "Shantou", 23.3600000, 116.6783371, 5.0},
-	{2276, "China", "Baotou", 40.6522222, 109.8222198, 1055.0},
-	{2277, "China", "Anshan", 41.1236111, 122.9899979, 83.0},
-	{2278, "China", "Xuzhou", 34.2669444, 117.1916656, 45.0},
-	{2279, "China", "Fuzhou", 26.0613889, 119.3061142, 13.0},
-	{2280, "China", "Guiyang", 26.5833333, 106.7166672, 1078.0},
-	{2281, "China", "Dayan", 26.8687934, 100.2207184, 2394.0},
-	{2282, "China", "Wuxi", 31.5772222, 120.2938919, 7.0},
-	{2283, "China", "Datong", 40.0936111, 113.2913895, 1041.0},
-	{2284, "China", "Xianyang", 34.3455556, 108.7147217, 405.0},
-	{2285, "China", "Huainan", 32.6263889, 116.9969406, 43.0},
-	{2286, "China", "Kunming", 25.0388889, 102.7183304, 1911.0},
-	{2287, "China", "Shenzhen", 22.5455377, 114.0682983, 3.0},
-	{2288, "China", "Jieyang", 23.5297222, 116.3655548, 5.0},
-	{2289, "China", "Baoding", 38.8511111, 115.4902802, 13.0},
-	{2290, "China", "Benxi", 41.2886111, 123.7649994, 156.0},
-	{2291, "China", "Changzhou", 31.7833333, 119.9666672, 7.0},
-	{2292, "China", "Huaibei", 33.9744444, 116.7916641, 41.0},
-	{2293, "China", "Pingdingshan", 33.7408333, 113.3063889, 63.0},
-	{2294, "China", "Qiqihar", 47.3408333, 123.9672241, 147.0},
-	{2295, "China", "Wenzhou", 28.0191667, 120.6544418, 17.0},
-	{2296, "China", "Nanning", 22.8166667, 108.3166656, 73.0},
-	{2297, "China", "Anyang", 36.0994444, 114.3288879, 87.0},
-	{2298, "China", "Hohhot", 40.8105556, 111.6522217, 1092.0},
-	{2299, "China", "Xining", 36.6166667, 101.7666702, 2252.0},
-	{2300, "China", "Qinhuangdao", 39.9316667, 119.5883331, 5.0},
-	{2301, "China", "Hengyang", 26.8880556, 112.6149979, 36.0},
-	{2302, "China", "Xinxiang", 35.3088889, 113.8672256, 76.0},
-	{2303, "China", "Hegang", 47.4000000, 130.3666687, 207.0},
-	{2304, "China", "Langfang", 39.5097222, 116.6947250, 19.0},
-	{2305, "China", "Ningbo", 29.8750000, 121.5419464, 9.0},
-	{2306, "China", "Yantai", 37.5333333, 121.4000015, 46.0},
-	{2307, "China", "Zhuzhou", 27.8333333, 113.1500015, 31.0},
-	{2308, "China", "Changzhi", 35.2088889, 111.7386093, 405.0},
-	{2309, "China", "Zhangjiakou", 40.8100000, 114.8794479, 757.0},
-	{2310, "China", "Zigong", 29.4000000, 104.7833328, 456.0},
-	{2311, "China", "Fuxin", 42.0155556, 121.6588898, 131.0},
-	{2312, "China", "Huangshi", 30.2166667, 115.0999985, 5.0},
-	{2313, "China", "Liaoyang", 41.2719444, 123.1730576, 24.0},
-	{2314, "China", "Xiangtan", 27.8500000, 112.9000015, 42.0},
-	{2315, "China", "Zibo", 36.7905556, 118.0633316, 47.0},
-	{2316, "China", "Puyang", 35.7027778, 115.0052795, 52.0},
-	{2317, "China", "Nantong", 32.0302778, 120.8747253, 8.0},
-	{2318, "China", "Mudanjiang", 44.5833333, 129.6000061, 246.0},
-	{2319, "China", "Guilin", 25.2819444, 110.2863922, 148.0},
-	{2320, "China", "Zhanjiang", 21.2000000, 110.3833313, 7.0},
-	{2321, "China", "Zhenjiang", 32.2091667, 119.4341660, 13.0},
-	{2322, "China", "Dandong", 40.1291667, 124.3947220, 1.0},
-	{2323, "China", "Shaoguan", 24.8000000, 113.5833359, 61.0},
-	{2324, "China", "Yancheng", 33.3855556, 120.1252747, 3.0},
-	{2325, "China", "Foshan", 23.0333333, 113.1166687, 12.0},
-	{2326, "China", "Panshan", 41.1880556, 122.0494461, 3.0},
-	{2327, "China", "Haikou", 20.0458333, 110.3416672, 8.0},
-	{2328, "China", "Taizhou", 32.4933333, 119.9080582, 6.0},
-	{2329, "China", "Xingtai", 37.0630556, 114.4941635, 71.0},
-	{2330, "China", "Jinzhou", 41.1077778, 121.1416702, 14.0},
-	{2331, "China", "Shuangyashan", 46.6361111, 131.1538849, 176.0},
-	{2332, "China", "Luancheng", 37.8791667, 114.6516647, 53.0},
-	{2333, "China", "Yingkou", 40.6680556, 122.2247238, 2.0},
-	{2334, "China", "Zhangzhou", 24.5133333, 117.6555557, 1.0},
-	{2335, "China", "Xiamen", 24.4675000, 118.0930557, -9999.0},
-	{2336, "China", "Bengbu", 32.9408333, 117.3608322, 31.0},
-	{2337, "China", "Shihezi", 44.3000000, 86.0333328, 461.0},
-	{2338, "Colombia", "Bogota", 4.6000000, -74.0833359, 2620.0},
-	{2339, "Colombia", "Cali", 3.4372222, -76.5224991, 758.0},
-	{2340, "Colombia", "Medellin", 6.2913889, -75.5361099, 2076.0},
-	{2341, "Colombia", "Barranquilla", 10.9638889, -74.7963867, 33.0},
-	{2342, "Colombia", "Cartagena", 10.3997222, -75.5144424, 36.0},
-	{2343, "Colombia", "Cucuta", 7.8833333, -72.5052795, 314.0},
-	{2344, "Colombia", "Bucaramanga", 7.1297222, -73.1258316, 967.0},
-	{2345, "Colombia", "Pereira", 4.8133333, -75.6961136, 1445.0},
-	{2346, "Colombia", "Santa Marta", 11.2472222, -74.2016678, 9.0},
-	{2347, "Colombia", "Ibague", 4.4388889, -75.2322235, 1082.0},
-	{2348, "Colombia", "Bello", 6.3388889, -75.5622253, 1489.0},
-	{2349, "Colombia", "Pasto", 1.2136111, -77.2811127, 2569.0},
-	{2350, "Colombia", "Manizales", 5.0700000, -75.5205536, 1935.0},
-	{2351, "Colombia", "Neiva", 2.9305556, -75.3302765, 507.0},
-	{2352, "Colombia", "Soledad", 10.9172222, -74.7666702, 10.0},
-	{2353, "Colombia", "Villavicencio", 4.1533333, -73.6350021, 529.0},
-	{2354, "Colombia", "Armenia", 4.5338889, -75.6811142, 1349.0},
-	{2355, "Colombia", "Soacha", 4.5872222, -74.2213898, 2413.0},
-	{2356, "Colombia", "Valledupar", 10.4769444, -73.2505569, 163.0},
-	{2357, "Colombia", "Itagui", 6.1719444, -75.6113892, 1637.0},
-	{2358, "Colombia", "Monteria", 8.7575000, -75.8899994, 25.0},
-	{2359, "Colombia", "Sincelejo", 9.3047222, -75.3977814, 219.0},
-	{2360, "Colombia", "Floridablanca", 7.0647222, -73.0897217, 1026.0},
-	{2361, "Colombia", "Palmira", 3.5394444, -76.3036118, 863.0},
-	{2362, "Colombia", "Buenaventura", 3.8933333, -77.0697250, 1.0},
-	{2363, "Colombia", "Barrancabermeja", 7.0652778, -73.8547211, 45.0},
-	{2364, "Colombia", "Dos Quebradas", 4.8347222, -75.6725006, 1500.0},
-	{2365, "Colombia", "Tulua", 4.0866667, -76.1999969, 766.0},
-	{2366, "Colombia", "Envigado", 6.1730556, -75.5638885, 1784.0},
-	{2367, "Colombia", "Cartago", 4.7463889, -75.9116669, 722.0},
-	{2368, "Colombia", "Maicao", 11.3841667, -72.2441635, 44.0},
-	{2369, "Colombia", "Florencia", 1.6175000, -75.6175003, 579.0},
-	{2370, "Colombia", "Girardot", 4.3030556, -74.8008347, 328.0},
-	{2371, "Colombia", "Sogamoso", 5.7205556, -72.9297256, 2419.0},
-	{2372, "Colombia", "Buga", 3.9022222, -76.3027802, 722.0},
-	{2373, "Colombia", "Tunja", 5.5352778, -73.3677750, 2717.0},
-	{2374, "Colombia", "Giron", 7.0708333, -73.1730576, 712.0},
-	{2375, "Colombia", "Malambo", 10.8588889, -74.7730560, 5.0},
-	{2376, "Colombia", "Magangue", 9.2413889, -74.7533340, 18.0},
-	{2377, "Colombia", "Facatativa", 4.8166667, -74.3666687, 2816.0},
-	{2378, "Colombia", "Riohacha", 11.5444444, -72.9072189, 5.0},
-	{2379, "Colombia", "Duitama", 5.8269444, -73.0202789, 2501.0},
-	{2380, "Colombia", "Zipaquira", 5.0283333, -74.0058365, 2656.0},
-	{2381, "Colombia", "Fusagasuga", 4.3438889, -74.3677750, 1745.0},
-	{2382, "Colombia", "Cienaga", 11.0094444, -74.2541656, 12.0},
-	{2383, "Colombia", "Tumaco", 1.7986111, -78.8155594, 1.0},
-	{2384, "Colombia", "Apartado", 7.8855556, -76.6347198, 57.0},
-	{2385, "Colombia", "Piedecuesta", 6.9894444, -73.0536118, 1080.0},
-	{2386, "Colombia", "Ocana", 8.2363889, -73.3577805, 1272.0},
-	{2387, "Colombia", "La Dorada", 5.4522222, -74.6691666, 152.0},
-	{2388, "Colombia", "Ipiales", 0.8302778, -77.6444473, 2954.0},
-	{2389, "Colombia", "Quibdo", 5.6947222, -76.6611099, 67.0},
-	{2390, "Colombia", "Aguachica", 8.3125000, -73.6269455, 151.0},
-	{2391, "Colombia", "Yumbo", 3.5850000, -76.4958344, 991.0},
-	{2392, "Colombia", "Arauca", 7.0902778, -70.7616653, 119.0},
-	{2393, "Colombia", "Sabanalarga", 10.6377778, -74.9227753, 88.0},
-	{2394, "Colombia", "Chinchina", 4.9825000, -75.6036148, 1488.0},
-	{2395, "Colombia", "Caldas", 6.0900000, -75.6374969, 1929.0},
-	{2396, "Colombia", "Villa del Rosario", 7.8338889, -72.4741669, 381.0},
-	{2397, "Colombia", "Chia", 4.8666667, -74.0666656, 2325.0},
-	{2398, "Colombia", "Rionegro", 6.1552778, -75.3888855, 2058.0},
-	{2399, "Colombia", "Calarca", 4.5325000, -75.6436081, 1459.0},
-	{2400, "Colombia", "Yopal", 5.3394444, -72.3941650, 426.0},
-	{2401, "Colombia", "Sahagun", 8.9494444, -75.4477768, 72.0},
-	{2402, "Colombia", "Fundacion", 10.5213889, -74.1866684, 55.0},
-	{2403, "Colombia", "Los Patios", 7.8383333, -72.5133362, 385.0},
-	{2404, "Colombia", "San Andres", 12.5847222, -81.7005539, -9999.0},
-	{2405, "Colombia", "Caucasia", 7.9869444, -75.1972198, 65.0},
-	{2406, "Colombia", "Santa Rosa de Cabal", 4.8680556, -75.6213913, 1827.0},
-	{2407, "Colombia", "Espinal", 4.1527778, -74.8886108, 329.0},
-	{2408, "Colombia", "Turbaco", 10.3294444, -75.4083328, 129.0},
-	{2409, "Colombia", "Cerete", 8.8855556, -75.7966690, 15.0},
-	{2410, "Colombia", "El Banco", 9.0047222, -73.9780579, 21.0},
-	{2411, "Colombia", "Funza", 4.7166667, -74.2166672, 2276.0},
-	{2412, "Colombia", "Pitalito", 1.8675000, -76.0563889, 1319.0},
-	{2413, "Colombia", "Puerto Tejada", 3.2336111, -76.4194412, 847.0},
-	{2414, "Colombia", "Pamplona", 7.3780556, -72.6524963, 2516.0},
-	{2415, "Colombia", "Agustin Codazzi", 10.0358333, -73.2388916, 183.0},
-	{2416, "Colombia", "Turbo", 8.0980556, -76.7316666, 7.0},
-	{2417, "Colombia", "Madrid", 4.7344444, -74.2683334, 2400.0},
-	{2418, "Colombia", "Arjona", 10.2544444, -75.3438873, 58.0},
-	{2419, "Colombia", "La Estrella", 6.1605556, -75.6469421, 1887.0},
-	{2420, "Colombia", "Copacabana", 6.3488889, -75.5130539, 1498.0},
-	{2421, "Colombia", "Plato", 9.7919444, -74.7872238, 18.0},
-	{2422, "Colombia", "Chigorodo", 7.6769444, -76.6863861, 109.0},
-	{2423, "Colombia", "El Carmen de Bolivar", 9.7222222, -75.1330566, 154.0},
-	{2424, "Colombia", "Florida", 3.3275000, -76.2386093, 894.0},
-	{2425, "Colombia", "Chiquinquira", 5.6188889, -73.8199997, 2713.0},
-	{2426, "Colombia", "Jamundi", 3.2638889, -76.5444412, 890.0},
-	{2427, "Colombia", "Pradera", 3.4211111, -76.2447205, 912.0},
-	{2428, "Colombia", "Baranoa", 10.7997222, -74.9183350, 124.0},
-	{2429, "Colombia", "Sevilla", 4.2688889, -75.9361115, 1394.0},
-	{2430, "Colombia", "Santander de Quilichao", 3.0130556, -76.4866638, 1061.0},
-	{2431, "Colombia", "El Bagre", 7.5941667, -74.8119431, 51.0},
-	{2432, "Colombia", "Acacias", 3.9875000, -73.7566681, 579.0},
-	{2433, "Colombia", "Lorica", 9.2316667, -75.8197250, 7.0},
-	{2434, "Colombia", "Segovia", 7.0833333, -74.7036133, 638.0},
-	{2435, "Colombia", "Corozal", 9.3177778, -75.2958298, 154.0},
-	{2436, "Colombia", "El Cerrito", 3.6880556, -76.3166656, 851.0},
-	{2437, "Colombia", "Planeta Rica", 8.4147222, -75.5883331, 113.0},
-	{2438, "Comoros", "Moroni", -11.7041667, 43.2402763, 35.0},
-	{2439, "Comoros", "Moutsamoudou", -12.1627778, 44.3963890, -9999.0},
-	{2440, "Comoros", "Fomboni", -12.2800000, 43.7425003, 8.0},
-	{2441, "Comoros", "Domoni", -12.2569444, 44.5319443, 1.0},
-	{2442, "Comoros", "Sima", -12.1955556, 44.2766685, 50.0},
-	{2443, "Comoros", "Ouani", -12.1322222, 44.4258347, 71.0},
-	{2444, "Comoros", "Mirontsi", -12.1566667, 44.4080544, -9999.0},
-	{2445, "Comoros", "Koni-Djodjo", -12.2297222, 44.4816666, 633.0},
-	{2446, "Comoros", "Moya", -12.3066667, 44.4377785, 83.0},
-	{2447, "Comoros", "Mbeni", -11.5013889, 43.3774986, 292.0},
-	{2448, "Comoros", "Mitsamiouli", -11.3847222, 43.2844429, 48.0},
-	{2449, "Comoros", "Barakani", -12.1355556, 44.4316673, 99.0},
-	{2450, "Comoros", "Chandra", -12.1950000, 44.4647217, 587.0},
-	{2451, "Comoros", "Ouella", -11.6536111, 43.2805557, 459.0},
-	{2452, "Comoros", "Mramani", -12.3463889, 44.5286102, 131.0},
-	{2453, "Comoros", "Mremani", -12.3083333, 44.4986115, 584.0},
-	{2454, "Comoros", "Koki", -12.1713889, 44.4416656, 517.0},
-	{2455, "Comoros", "Mvouni", -11.7161111, 43.2647209, 274.0},
-	{2456, "Comoros", "Tsidje", -11.6980556, 43.2619438, 192.0},
-	{2457, "Comoros", "Foumbouni", -11.8616667, 43.4841652, -9999.0},
-	{2458, "Comoros", "Dembeni", -11.8736111, 43.3977776, 283.0},
-	{2459, "Comoros", "Ntsoudjini", -11.6597222, 43.2613907, 139.0},
-	{2460, "Comoros", "Kangani", -12.3116667, 44.4747238, 344.0},
-	{2461, "Comoros", "Lingoni", -12.2558333, 44.4183350, 359.0},
-	{2462, "Comoros", "Ntsaoueni", -11.4658333, 43.2627792, 26.0},
-	{2463, "Comoros", "Nioumachoua", -12.3613889, 43.7152786, -9999.0},
-	{2464, "Comoros", "Koni-Ngani", -12.2158333, 44.4799995, 620.0},
-	{2465, "Comoros", "Itsandra", -11.6711111, 43.2547226, 26.0},
-	{2466, "Comoros", "Mjimandra", -12.1916667, 44.3702774, 291.0},
-	{2467, "Comoros", "Djoyezi", -12.2975000, 43.7733345, 1.0},
-	{2468, "Comoros", "Daji", -12.3258333, 44.4886093, 264.0},
-	{2469, "Comoros", "Vouani", -12.2441667, 44.3744431, -9999.0},
-	{2470, "Comoros", "Hajoho", -12.1175000, 44.4883347, 103.0},
-	{2471, "Comoros", "Boungoueni", -12.2055556, 44.2994461, 264.0},
-	{2472, "Comoros", "Ouanani", -12.3375000, 43.7975006, 150.0},
-	{2473, "Comoros", "Chironkamba", -12.1911111, 44.3525009, 39.0},
-	{2474, "Comoros", "Chezani", -11.4280556, 43.3875008, 220.0},
-	{2475, "Comoros", "Singani", -11.8455556, 43.3091660, 149.0},
-	{2476, "Comoros", "Magnassini-Nindri", -12.2780556, 44.4158325, 57.0},
-	{2477, "Comoros", "Kyo", -12.3322222, 44.5191650, 264.0},
-	{2478, "Comoros", "Kavani", -12.1919444, 44.2694435, 12.0},
-	{2479, "Comoros", "Ongoni", -12.1702778, 44.5069427, 19.0},
-	{2480, "Comoros", "Bimbini", -12.1883333, 44.2355537, 1.0},
-	{2481, "Comoros", "Mohoro", -11.8258333, 43.4461098, 296.0},
-	{2482, "Comoros", "Hantsindzi", -11.4291667, 43.4022217, 11.0},
-	{2483, "Comoros", "Ouhozi", -11.4050000, 43.2822227, 117.0},
-	{2484, "Comoros", "Paje", -12.1697222, 44.3880539, -9999.0},
-	{2485, "Comoros", "Ouzini", -12.2672222, 44.4797211, 874.0},
-	{2486, "Comoros", "Vanadjou", -11.6075000, 43.2761116, 338.0},
-	{2487, "Comoros", "Bandajou", -12.1916667, 44.2869453, 123.0},
-	{2488, "Comoros", "Douniani", -11.4211111, 43.2888870, 297.0},
-	{2489, "Comoros", "Dziani", -12.1833333, 44.4833336, 569.0},
-	{2490, "Comoros", "Oussivo", -11.5850000, 43.2672234, 197.0},
-	{2491, "Comoros", "Harembo", -12.1436111, 44.4983330, 163.0},
-	{2492, "Comoros", "Selea", -11.7844444, 43.2608337, 39.0},
-	{2493, "Comoros", "Salimani", -11.8080556, 43.2591667, 23.0},
-	{2494, "Comoros", "Vanambouani", -11.6113889, 43.2530556, 76.0},
-	{2495, "Comoros", "Mandza", -11.4180556, 43.2952766, 389.0},
-	{2496, "Comoros", "Patsi", -12.1555556, 44.4372215, 270.0},
-	{2497, "Comoros", "Mjamaoue", -12.1936111, 44.3100014, 284.0},
-	{2498, "Comoros", "Chindini", -11.9208333, 43.4811096, 37.0},
-	{2499, "Comoros", "Heroumbili", -11.5438889, 43.3797226, 274.0},
-	{2500, "Comoros", "Ouroveni", -11.9097222, 43.4886093, 35.0},
-	{2501, "Comoros", "Marahare", -12.2308333, 44.3133316, 92.0},
-	{2502, "Comoros", "Mtakoudja", -12.2611111, 43.7036095, 39.0},
-	{2503, "Comoros", "Koua", -11.4208333, 43.2763901, 196.0},
-	{2504, "Comoros", "Mavingouni", -11.7163889, 43.2524986, 180.0},
-	{2505, "Comoros", "Itsandzeni", -11.4727778, 43.3844452, 151.0},
-	{2506, "Comoros", "Bambadjani", -11.4786111, 43.3772240, 341.0},
-	{2507, "Comoros", "Madjeoueni", -11.8216667, 43.2780571, 121.0},
-	{2508, "Comoros", "Ivouani", -11.3875000, 43.3863907, 6.0},
-	{2509, "Comoros", "Bahani", -11.6397222, 43.2841682, 597.0},
-	{2510, "Comoros", "Ziroudani", -12.3325000, 43.7775002, 170.0},
-	{2511, "Comoros", "Antsahe", -12.3563889, 44.5224991, 166.0},
-	{2512, "Comoros", "Mtsamdou", -11.6069444, 43.3805542, 112.0},
-	{2513, "Comoros", "Hoani", -12.2508333, 43.6747208, 19.0},
-	{2514, "Comoros", "Assimpao", -12.2330556, 44.3197212, 129.0},
-	{2515, "Comoros", "Bouni", -11.4869444, 43.3883324, 127.0},
-	{2516, "Comoros", "Chitrouni", -12.1858333, 44.3355560, 39.0},
-	{2517, "Comoros", "Nioumamilima", -11.8511111, 43.4372215, 600.0},
-	{2518, "Comoros", "Limbi", -12.2394444, 44.5052795, 423.0},
-	{2519, "Comoros", "Mnoungou", -11.5177778, 43.3738899, 466.0},
-	{2520, "Comoros", "Zivandani", -11.6458333, 43.2658348, 158.0},
-	{2521, "Comoros", "Mdjoyezi", -11.8330556, 43.3066673, 312.0},
-	{2522, "Comoros", "Vassy", -12.2319444, 44.3424988, 192.0},
-	{2523, "Comoros", "Panda", -11.8705556, 43.3833351, -9999.0},
-	{2524, "Comoros", "Milembeni", -12.1980556, 44.2638893, 1.0},
-	{2525, "Comoros", "Mirongani", -12.1905556, 44.2524986, 1.0},
-	{2526, "Comoros", "Kangani", -12.3427778, 43.8199997, 133.0},
-	{2527, "Comoros", "Miringoni", -12.2966667, 43.6352768, 20.0},
-	{2528, "Comoros", "Chomoni", -11.6430556, 43.3858337, 1.0},
-	{2529, "Comoros", "Milembeni", -11.6616667, 43.2663879, 139.0},
-	{2530, "Comoros", "Hetsa", -11.8447222, 43.3158340, 149.0},
-	{2531, "Comoros", "Hamavouna", -12.3730556, 43.8383331, 14.0},
-	{2532, "Comoros", "Oungoni", -11.7544444, 43.4361115, 97.0},
-	{2533, "Comoros", "Bibavou", -11.5725000, 43.2772217, 172.0},
-	{2534, "Comoros", "Milevani", -11.5513889, 43.2902794, 223.0},
-	{2535, "Comoros", "Dima", -11.8863889, 43.4108315, 264.0},
-	{2536, "Comoros", "Batsa", -11.6283333, 43.2599983, 168.0},
-	{2537, "Comoros", "Kove", -11.8758333, 43.4722214, 356.0},
-	{2538, "Costa Rica", "San Jose", 9.9333333, -84.0833359, 1147.0},
-	{2539, "Costa Rica", "Puerto Limon", 10.0000000, -83.0333328, 2.0},
-	{2540, "Costa Rica", "San Francisco", 9.9833333, -84.1333313, 1046.0},
-	{2541, "Costa Rica", "Alajuela", 10.0166667, -84.2166672, 928.0},
-	{2542, "Costa Rica", "Liberia", 10.6333333, -85.4333344, 176.0},
-	{2543, "Costa Rica", "Paraiso", 9.8333333, -83.8666687, 1319.0},
-	{2544, "Costa Rica", "Puntarenas", 9.9762473, -84.8383560, 1.0},
-	{2545, "Costa Rica", "San Isidro", 9.3833333, -83.6999969, 752.0},
-	{2546, "Costa Rica", "Curridabat", 9.9166667, -84.0333328, 1229.0},
-	{2547, "Costa Rica", "San Vicente", 9.9666667, -84.0500031, 1201.0},
-	{2548, "Costa Rica", "San Vicente de Moravia", 9.9616439, -84.0487976, 1227.0},
-	{2549, "Costa Rica", "San Jose", 10.9666667, -85.1333313, 42.0},
-	{2550, "Costa Rica", "Purral", 9.9500000, -84.0333328, 1292.0},
-	{2551, "Costa Rica", "Turrialba", 9.9000000, -83.6833344, 639.0},
-	{2552, "Costa Rica", "San Miguel", 9.8833333, -84.0666656, 1180.0},
-	{2553, "Costa Rica", "San Pedro", 9.9333333, -84.0500031, 1229.0},
-	{2554, "Costa Rica", "San Rafael Abajo", 9.8333333, -84.2833328, 675.0},
-	{2555, "Costa Rica", "Quesada", 10.3333333, -84.4333344, 616.0},
-	{2556, "Costa Rica", "Ipis", 9.9666667, -84.0166702, 1318.0},
-	{2557, "Costa Rica", "Cartago", 9.8666667, -83.9166641, 1489.0},
-	{2558, "Costa Rica", "Chacarita", 9.9833333, -84.7833328, 5.0},
-	{2559, "Costa Rica", "San Juan", 9.9666667, -84.0833359, 1099.0},
-	{2560, "Costa Rica", "Mercedes", 10.0166667, -84.1333313, 1152.0},
-	{2561, "Costa Rica", "Guadalupe", 9.9461110, -84.0527878, 1184.0},
-	{2562, "Costa Rica", "Aserri", 9.8666667, -84.0999985, 1331.0},
-	{2563, "Costa Rica", "San Rafael", 9.9333333, -84.1333313, 1059.0},
-	{2564, "Costa Rica", "San Felipe", 9.9000000, -84.0999985, 1144.0},
-	{2565, "Costa Rica", "Patarra", 9.8833333, -84.0333328, 1271.0},
-	{2566, "Costa Rica", "Tejar", 9.7500000, -84.2333298, 999.0},
-	{2567, "Costa Rica", "Heredia", 10.0000000, -84.1166687, 1155.0},
-	{2568, "Costa Rica", "San Pablo", 10.0000000, -84.0833359, 1236.0},
-	{2569, "Costa Rica", "Calle Blancos", 9.9500000, -84.0666656, 1155.0},
-	{2570, "Costa Rica", "Canas", 10.4333333, -85.0999985, 64.0},
-	{2571, "Costa Rica", "Guapiles", 10.2166667, -83.7833328, 248.0},
-	{2572, "Costa Rica", "Siquirres", 10.1000000, -83.5166702, 120.0},
-	{2573, "Costa Rica", "San Rafael", 10.0666667, -84.4666672, 1021.0},
-	{2574, "Costa Rica", "San Diego", 9.9000000, -84.0000000, 1290.0},
-	{2575, "Costa Rica", "Colima", 9.9500000, -84.0833359, 1138.0},
-	{2576, "Costa Rica", "Esparza", 9.9833333, -84.6666641, 145.0},
-	{2577, "Costa Rica", "San Juan de Dios", 9.8833333, -84.0833359, 1176.0},
-	{2578, "Costa Rica", "Nicoya", 10.1500000, -85.4499969, 125.0},
-	{2579, "Costa Rica", "San Rafael Arriba", 9.8833333, -84.0833359, 1176.0},
-	{2580, "Costa Rica", "Desamparados", 9.9500000, -84.5000000, 377.0},
-	{2581, "Costa Rica", "Concepcion", 9.9333333, -84.0000000, 1395.0},
-	{2582, "Costa Rica", "Alajuelita", 9.9000000, -84.0999985, 1144.0},
-	{2583, "Costa Rica", "Sabanilla", 9.9500000, -84.0333328, 1292.0},
-	{2584, "Costa Rica", "Granadilla", 9.9333333, -84.0166702, 1294.0},
-	{2585, "Costa Rica", "Santa Cruz", 10.2666667, -85.5833359, 57.0},
-	{2586, "Costa Rica", "San Josecito", 10.0166667, -84.0999985, 1303.0},
-	{2587, "Costa Rica", "Escazu", 9.9166667, -84.1333313, 1110.0},
-	{2588, "Costa Rica", "Naranjo", 10.1000000, -84.3833313, 1000.0},
-	{2589, "Costa Rica", "Buenos Aires", 9.1666667, -83.3333359, 383.0},
-	{2590, "Costa Rica", "San Antonio", 9.9833333, -84.1833344, 920.0},
-	{2591, "Costa Rica", "San Ramon", 10.0833333, -84.4666672, 1021.0},
-	{2592, "Costa Rica", "Tres Rios", 9.9000000, -83.9833298, 1422.0},
-	{2593, "Costa Rica", "Daniel Flores", 9.3333333, -83.6666641, 646.0},
-	{2594, "Costa Rica", "Rio Segundo", 10.2333333, -84.3000031, 1435.0},
-	{2595, "Costa Rica", "Colon", 9.9149129, -84.2417068, 796.0},
-	{2596, "Costa Rica", "San Rafael", 10.0166667, -84.0999985, 1303.0},
-	{2597, "Costa Rica", "Santiago", 9.8500000, -84.3166656, 1085.0},
-	{2598, "Costa Rica", "Santa Ana", 9.9333333, -84.1833344, 916.0},
-	{2599, "Costa Rica", "Quepos", 9.4500000, -84.1500015, 25.0},
-	{2600, "Costa Rica", "San Juan", 10.1000000, -84.3166656, 1012.0},
-	{2601, "Costa Rica", "Tilaran", 10.4666667, -84.9666672, 526.0},
-	{2602, "Costa Rica", "Corredor", 8.6333333, -82.9499969, 66.0},
-	{2603, "Costa Rica", "Guacimo", 10.2166667, -83.6833344, 91.0},
-	{2604, "Costa Rica", "Atenas", 9.9833333, -84.3833313, 677.0},
-	{2605, "Costa Rica", "Cot", 9.9000000, -83.8666687, 1842.0},
-	{2606, "Costa Rica", "Golfito", 8.6500000, -83.1500015, 279.0},
-	{2607, "Costa Rica", "Llorente", 10.0000000, -84.1500015, 1099.0},
-	{2608, "Costa Rica", "Miramar", 10.1000000, -84.7333298, 342.0},
-	{2609, "Costa Rica", "Orotina", 9.9000000, -84.5333328, 161.0},
-	{2610, "Costa Rica", "Santo Domingo", 10.0666667, -84.1500015, 1399.0},
-	{2611, "Costa Rica", "Salitrillos", 9.8500000, -84.0999985, 1450.0},
-	{2612, "Costa Rica", "Mercedes", 9.2666667, -83.5833359, 598.0},
-	{2613, "Costa Rica", "Pocora", 10.1833333, -83.5999985, 62.0},
-	{2614, "Costa Rica", "Juntas", 10.2833333, -84.9499969, 208.0},
-	{2615, "Costa Rica", "Batan", 10.0833333, -83.3333359, 35.0},
-	{2616, "Costa Rica", "Canoas", 8.5333333, -82.8333359, 107.0},
-	{2617, "Costa Rica", "La Asuncion", 9.9833333, -84.1666641, 953.0},
-	{2618, "Costa Rica", "Orosi", 9.8000000, -83.8499985, 1105.0},
-	{2619, "Costa Rica", "La Cruz", 11.0666667, -85.6333313, 222.0},
-	{2620, "Costa Rica", "Carrillos", 10.0333333, -84.2666702, 850.0},
-	{2621, "Costa Rica", "San Marcos", 9.6666667, -84.0333328, 1536.0},
-	{2622, "Costa Rica", "Upala", 10.9000000, -85.0333328, 52.0},
-	{2623, "Costa Rica", "Bagaces", 10.5333333, -85.2500000, 84.0},
-	{2624, "Costa Rica", "Jaco", 9.6166667, -84.6333313, 12.0},
-	{2625, "Costa Rica", "Tobosi", 9.8333333, -83.9833298, 1580.0},
-	{2626, "Costa Rica", "San Vito", 8.8333333, -82.9666672, 993.0},
-	{2627, "Costa Rica", "Ciudad Cortes", 8.9666667, -83.5333328, 33.0},
-	{2628, "Costa Rica", "Parrita", 9.5166667, -84.3166656, 7.0},
-	{2629, "Costa Rica", "Zarcero", 10.1833333, -84.4000015, 1672.0},
-	{2630, "Costa Rica", "Los Chiles", 11.0333333, -84.7166672, 31.0},
-	{2631, "Costa Rica", "Sardinal", 10.5166667, -85.6500015, 59.0},
-	{2632, "Costa Rica", "San Ignacio", 9.8000000, -84.1666641, 1134.0},
-	{2633, "Costa Rica", "Fortuna", 10.4833333, -84.6500015, 270.0},
-	{2634, "Costa Rica", "Pocosol", 10.3666667, -84.6166687, 485.0},
-	{2635, "Costa Rica", "Salitral", 9.9166667, -84.1833344, 958.0},
-	{2636, "Costa Rica", "Belen", 10.4000000, -85.5999985, 43.0},
-	{2637, "Croatia", "Zagreb - Centar", 45.8131276, 15.9775333, 110.0},
-	{2638, "Croatia", "Zagreb", 45.8000000, 16.0000000, 131.0},
-	{2639, "Croatia", "Split", 43.5089078, 16.4391518, 20.0},
-	{2640, "Croatia", "Rijeka", 45.3430556, 14.4091663, 126.0},
-	{2641, "Croatia", "Osijek", 45.5511111, 18.6938896, 102.0},
-	{2642, "Croatia", "Zadar", 44.1197222, 15.2422218, 39.0},
-	{2643, "Croatia", "Slavonski Brod", 45.1602778, 18.0155563, 83.0},
-	{2644, "Croatia", "Pula", 44.8683333, 13.8480558, 19.0},
-	{2645, "Croatia", "Sesvete", 45.8311111, 16.1163883, 146.0},
-	{2646, "Croatia", "Karlovac", 45.4872222, 15.5477781, 102.0},
-	{2647, "Croatia", "Varazdin", 46.3044444, 16.3377781, 154.0},
-	{2648, "Croatia", "Sibenik", 43.7272222, 15.9058332, 96.0},
-	{2649, "Croatia", "Sisak", 45.4661111, 16.3783340, 116.0},
-	{2650, "Croatia", "Velika Gorica", 45.7125000, 16.0755558, 114.0},
-	{2651, "Croatia", "Vinkovci", 45.2883333, 18.8047218, 87.0},
-	{2652, "Croatia", "Vukovar", 45.3433333, 18.9997215, 103.0},
-	{2653, "Croatia", "Dubrovnik", 42.6480701, 18.0921650, 51.0},
-	{2654, "Croatia", "Bjelovar", 45.8986111, 16.8488884, 111.0},
-	{2655, "Croatia", "Koprivnica", 46.1627778, 16.8274994, 118.0},
-	{2656, "Croatia", "Pozega", 45.3402778, 17.6852779, 154.0},
-	{2657, "Croatia", "Zapresic", 45.8563889, 15.8077774, 128.0},
-	{2658, "Croatia", "Solin", 43.5500000, 16.5000000, 75.0},
-	{2659, "Croatia", "Cakovec", 46.3844444, 16.4338894, 168.0},
-	{2660, "Croatia", "Virovitica", 45.8319444, 17.3838882, 117.0},
-	{2661, "Croatia", "Samobor", 45.8030556, 15.7180557, 138.0},
-	{2662, "Croatia", "Kutina", 45.4750000, 16.7819443, 123.0},
-	{2663, "Croatia", "Metkovic", 43.0541667, 17.6483326, 10.0},
-	{2664, "Croatia", "Petrinja", 45.4375000, 16.2900009, 133.0},
-	{2665, "Croatia", "Zupanja", 45.0775000, 18.6975002, 79.0},
-	{2666, "Croatia", "Rovinj", 45.0800000, 13.6400003, 17.0},
-	{2667, "Croatia", "Makarska", 43.2969444, 17.0177784, 133.0},
-	{2668, "Croatia", "Nova Gradiska", 45.2550000, 17.3830547, 130.0},
-	{2669, "Croatia", "Krizevci", 46.0219444, 16.5424995, 138.0},
-	{2670, "Croatia", "Sinj", 43.7036111, 16.6394444, 320.0},
-	{2671, "Croatia", "Knin", 44.0438889, 16.2000008, 221.0},
-	{2672, "Croatia", "Slatina", 45.7033333, 17.7027779, 107.0},
-	{2673, "Croatia", "Trogir", 43.5125000, 16.2516670, 52.0},
-	{2674, "Croatia", "Porec", 45.2258333, 13.5938892, 17.0},
-	{2675, "Croatia", "Daruvar", 45.5905556, 17.2250004, 191.0},
-	{2676, "Croatia", "Cepin", 45.5236111, 18.5633335, 106.0},
-	{2677, "Croatia", "Ogulin", 45.2661111, 15.2286110, 326.0},
-	{2678, "Croatia", "Beli Manastir", 45.7700000, 18.6036110, 101.0},
-	{2679, "Croatia", "Nasice", 45.4886111, 18.0877781, 150.0},
-	{2680, "Croatia", "Valpovo", 45.6608333, 18.4186115, 87.0},
-	{2681, "Croatia", "Labin", 45.0950000, 14.1197224, 234.0},
-	{2682, "Croatia", "Opatija", 45.3391667, 14.3083334, 1.0},
-	{2683, "Croatia", "Umag", 45.4313889, 13.5238886, -9999.0},
-	{2684, "Croatia", "Podstrana", 43.4833333, 16.5833340, 389.0},
-	{2685, "Croatia", "Novska", 45.3405556, 16.9769440, 150.0},
-	{2686, "Croatia", "Belisce", 45.6802778, 18.4058342, 93.0},
-	{2687, "Croatia", "Visnjevac", 45.5686111, 18.6138897, 99.0},
-	{2688, "Croatia", "Crikvenica", 45.1772222, 14.6927776, 129.0},
-	{2689, "Croatia", "Tenja", 45.4980556, 18.7472229, 103.0},
-	{2690, "Croatia", "Ivankovo", 45.2886111, 18.6838894, 76.0},
-	{2691, "Croatia", "Omis", 43.4447222, 16.6886120, 65.0},
-	{2692, "Croatia", "Vodice", 43.7608333, 15.7827778, 24.0},
-	{2693, "Croatia", "Gospic", 44.5461111, 15.3747225, 561.0},
-	{2694, "Croatia", "Ilok", 45.2222222, 19.3769436, 136.0},
-	{2695, "Croatia", "Otok", 45.1466667, 18.8838882, 86.0},
-	{2696, "Croatia", "Stobrec", 43.5025000, 16.5222225, 1.0},
-	{2697, "Croatia", "Pitomaca", 45.9505556, 17.2294445, 108.0},
-	{2698, "Croatia", "Senj", 44.9894444, 14.9058332, 70.0},
-	{2699, "Croatia", "Ivanec", 46.2230556, 16.1200008, 233.0},
-	{2700, "Croatia", "Jastrebarsko", 45.6683333, 15.6486111, 150.0},
-	{2701, "Croatia", "Darda", 45.6280556, 18.6997223, 97.0},
-	{2702, "Croatia", "Borovo", 45.3763889, 18.9669437, 92.0},
-	{2703, "Croatia", "Biograd na Moru", 43.9433333, 15.4519444, 30.0},
-	{2704, "Croatia", "Gunja", 44.8800000, 18.8533325, 82.0},
-	{2705, "Croatia", "Pazin", 45.2402778, 13.9366665, 278.0},
-	{2706, "Croatia", "Malarm", 45.7575794, 15.9771624, 116.0},
-	{2707, "Croatia", "Vrbovec", 45.8833333, 16.4216671, 116.0},
-	{2708, "Croatia", "Pakrac", 45.4363889, 17.1888885, 184.0},
-	{2709, "Croatia", "Krapina", 46.1608333, 15.8788891, 241.0},
-	{2710, "Croatia", "Dalj", 45.4900000, 18.9847221, 73.0},
-	{2711, "Croatia", "Bosnjaci", 45.0502778, 18.7555561, 76.0},
-	{2712, "Croatia", "Delnice", 45.4008333, 14.7997227, 700.0},
-	{2713, "Croatia", "Nedelisce", 46.3758333, 16.3875008, 172.0},
-	{2714, "Croatia", "Josipovac", 45.5827778, 18.5813885, 104.0},
-	{2715, "Croatia", "Otocac", 44.8694444, 15.2375002, 470.0},
-	{2716, "Croatia", "Imotski", 43.4466667, 17.2166672, 377.0},
-	{2717, "Croatia", "Popovaca", 44.6391667, 15.1791668, 588.0},
-	{2718, "Croatia", "Prelog", 46.3350000, 16.6155548, 152.0},
-	{2719, "Croatia", "Orahovica", 45.5400000, 17.8847218, 195.0},
-	{2720, "Croatia", "Garesnica", 45.5744444, 16.9413891, 121.0},
-	{2721, "Croatia", "Cerna", 45.1819444, 18.6894436, 76.0},
-	{2722, "Croatia", "Trnovec", 46.3858333, 16.3119450, 180.0},
-	{2723, "Croatia", "Lepoglava", 46.2105556, 16.0355549, 234.0},
-	{2724, "Croatia", "Bibinje", 44.0666667, 15.3000002, 123.0},
-	{2725, "Croatia", "Preko", 44.0811111, 15.1875000, 1.0},
-	{2726, "Croatia", "Privlaka", 45.1947222, 18.8374996, 82.0},
-	{2727, "Croatia", "Podvinje", 45.1902778, 18.0269451, 94.0},
-	{2728, "Croatia", "Pleternica", 45.2886111, 17.8063889, 136.0},
-	{2729, "Croatia", "Sracinec", 46.3294444, 16.2788887, 141.0},
-	{2730, "Croatia", "Virje", 46.0672222, 16.9899998, 126.0},
-	{2731, "Croatia", "Hvar", 43.1725000, 16.4427776, 66.0},
-	{2732, "Croatia", "Blato", 42.7625000, 17.4822216, 185.0},
-	{2733, "Croatia", "Nustar", 45.3325000, 18.8413887, 97.0},
-	{2734, "Croatia", "Matulji", 45.3616667, 14.3249998, 196.0},
-	{2735, "Croatia", "Bedekovcina", 46.0411111, 15.9963884, 165.0},
-	{2736, "Croatia", "Ludbreg", 46.2519444, 16.6147213, 127.0},
-	{2737, "Cuba", "Havana", 23.1319444, -82.3641663, 5.0},
-	{2738, "Cuba", "Santiago de Cuba", 20.0247222, -75.8219452, 33.0},
-	{2739, "Cuba", "Camaguey", 21.3808333, -77.9169464, 91.0},
-	{2740, "Cuba", "Holguin", 20.8872222, -76.2630539, 135.0},
-	{2741, "Cuba", "Guantanamo", 20.1444444, -75.2091675, 46.0},
-	{2742, "Cuba", "Santa Clara", 22.4000000, -79.9666672, 131.0},
-	{2743, "Cuba", "Las Tunas", 20.9616667, -76.9511108, 88.0},
-	{2744, "Cuba", "Bayamo", 20.3791667, -76.6433334, 53.0},
-	{2745, "Cuba", "Pinar del Rio", 22.4175000, -83.6980591, 44.0},
-	{2746, "Cuba", "Cienfuegos", 22.1461111, -80.4355545, 22.0},
-	{2747, "Cuba", "Matanzas", 23.0411111, -81.5774994, 4.0},
-	{2748, "Cuba", "Ciego de Avila", 21.8400000, -78.7619476, 43.0},
-	{2749, "Cuba", "Manzanillo", 20.3433333, -77.1166687, 16.0},
-	{2750, "Cuba", "Sancti Spiritus", 21.9297222, -79.4424973, 55.0},
-	{2751, "Cuba", "Palma Soriano", 20.2172222, -75.9988861, 133.0},
-	{2752, "Cuba", "Cardenas", 23.0375000, -81.2047195, 2.0},
-	{2753, "Cuba", "Moa", 20.6569444, -74.9402771, 10.0},
-	{2754, "Cuba", "Puerto Padre", 21.1950000, -76.6027756, 4.0},
-	{2755, "Cuba", "Contramaestre", 20.2977778, -76.2413864, 100.0},
-	{2756, "Cuba", "Guira de Melena", 22.7905556, -82.5052795, 14.0},
-	{2757, "Cuba", "Consolacion del Sur", 22.5047222, -83.5136108, 64.0},
-	{2758, "Cuba", "Guines", 22.8361111, -82.0280533, 60.0},
-	{2759, "Cuba", "Artemisa", 22.8130556, -82.7619476, 44.0},
-	{2760, "Cuba", "San Luis", 20.1875000, -75.8508301, 178.0},
-	{2761, "Cuba", "Moron", 22.1094444, -78.6275024, 7.0},
-	{2762, "Cuba", "Colon", 22.7191667, -80.9058304, 55.0},
-	{2763, "Cuba", "Florida", 21.5247222, -78.2258301, 62.0},
-	{2764, "Cuba", "Sagua la Grande", 22.8066667, -80.0755539, 11.0},
-	{2765, "Cuba", "Trinidad", 21.8019444, -79.9841690, 93.0},
-	{2766, "Cuba", "San Cristobal", 22.7141667, -83.0480576, 41.0},
-	{2767, "Cuba", "Placetas", 22.3119444, -79.6533356, 197.0},
-	{2768, "Cuba", "San Jose de las Lajas", 22.9613889, -82.1511078, 131.0},
-	{2769, "Cuba", "Jaguey Grande", 22.5269444, -81.1286087, 9.0},
-	{2770, "Cuba", "Nuevitas", 21.5452778, -77.2644424, 17.0},
-	{2771, "Cuba", "Banes", 20.9625000, -75.7186127, 48.0},
-	{2772, "Cuba", "Corralillo", 22.9819444, -80.5855560, 36.0},
-	{2773, "Cuba", "Baracoa", 20.3466667, -74.4958344, 1.0},
-	{2774, "Cuba", "Jovellanos", 22.8044444, -81.1944427, 32.0},
-	{2775, "Cuba", "Bauta", 22.9827778, -82.5463867, 66.0},
-	{2776, "Cuba", "Santo Domingo", 22.5866667, -80.2436142, 39.0},
-	{2777, "Cuba", "Cabaiguan", 22.0788889, -79.4991684, 133.0},
-	{2778, "Cuba", "Ranchuelo", 22.3772222, -80.1488876, 100.0},
-	{2779, "Cuba", "San Antonio de los Banos", 22.8911111, -82.4991684, 55.0},
-	{2780, "Cuba", "Yaguajay", 22.3272222, -79.2377777, 22.0},
-	{2781, "Cuba", "Manicaragua", 22.1494444, -79.9736099, 152.0},
-	{2782, "Cuba", "Jiguani", 20.3666667, -76.4272232, 137.0},
-	{2783, "Cuba", "Cifuentes", 22.6450000, -80.0461121, 75.0},
-	{2784, "Cuba", "Guaimaro", 21.0500000, -77.3499985, 74.0},
-	{2785, "Cuba", "Caibarien", 22.5205556, -79.4669418, 3.0},
-	{2786, "Cuba", "Cumanayagua", 22.1494444, -80.2022247, 66.0},
-	{2787, "Cuba", "Condado", 21.8766667, -79.8402786, 25.0},
-	{2788, "Cuba", "Camajuani", 22.4833333, -79.7500000, 97.0},
-	{2789, "Cuba", "Santiago de las Vegas", 22.9700000, -82.3869476, 97.0},
-	{2790, "Cuba", "Santa Cruz del Sur", 20.7147222, -77.9955521, 2.0},
-	{2791, "Cuba", "Remedios", 22.4947222, -79.5458298, 26.0},
-	{2792, "Cuba", "Madruga", 22.9113889, -81.8561096, 135.0},
-	{2793, "Cuba", "Union de Reyes", 22.7952778, -81.5374985, 53.0},
-	{2794, "Cuba", "Vertientes", 21.2594444, -78.1463852, 67.0},
-	{2795, "Cuba", "Mariel", 22.9875000, -82.7536087, 68.0},
-	{2796, "Cuba", "Yara", 20.2752778, -76.9533310, 23.0},
-	{2797, "Cuba", "Gibara", 21.1097222, -76.1316681, 1.0},
-	{2798, "Cuba", "Vinales", 22.6163889, -83.7077789, 174.0},
-	{2799, "Cuba", "Pedro Betancourt", 22.7272222, -81.2900009, 15.0},
-	{2800, "Cuba", "Cueto", 20.6438889, -75.9355545, 68.0},
-	{2801, "Cuba", "Encrucijada", 22.6169444, -79.8711090, 46.0},
-	{2802, "Cuba", "Rio Guayabal de Yateras", 20.3666667, -75.0166702, 456.0},
-	{2803, "Cuba", "Los Palacios", 22.5872222, -83.2486115, 34.0},
-	{2804, "Cuba", "Guanajay", 22.9263889, -82.6875000, 116.0},
-	{2805, "Cuba", "Campechuela", 20.2341667, -77.2788925, 14.0},
-	{2806, "Cuba", "Cruces", 22.3411111, -80.2666702, 93.0},
-	{2807, "Cuba", "Chambas", 22.1927778, -78.9136124, 34.0},
-	{2808, "Cuba", "Perico", 22.7691667, -81.0188904, 33.0},
-	{2809, "Cuba", "Rio Cauto", 20.5602778, -76.9161148, 9.0},
-	{2810, "Cuba", "Aguada de Pasajeros", 22.3850000, -80.8508301, 22.0},
-	{2811, "Cuba", "Calimete", 22.5333333, -80.9061127, 25.0},
-	{2812, "Cuba", "Palmira", 22.2416667, -80.3902740, 65.0},
-	{2813, "Cuba", "Minas", 21.4833333, -77.6166687, 61.0},
-	{2814, "Cuba", "Esmeralda", 21.8525000, -78.1169434, 32.0},
-	{2815, "Cuba", "Nueva Gerona", 21.8833333, -82.8000031, 18.0},
-	{2816, "Cuba", "Jaruco", 23.0430556, -82.0108337, 123.0},
-	{2817, "Cuba", "Colombia", 20.9880556, -77.4297256, 59.0},
-	{2818, "Cuba", "Varadero", 23.1536111, -81.2513885, 3.0},
-	{2819, "Cuba", "Fomento", 22.1038889, -79.7216644, 122.0},
-	{2820, "Cuba", "Bahia Honda", 22.9036111, -83.1591644, 15.0},
-	{2821, "Cuba", "Jatibonico", 21.9419444, -79.1699982, 94.0},
-	{2822, "Cuba", "Rodas", 22.3408333, -80.5572205, 25.0},
-	{2823, "Cuba", "Niquero", 20.0430556, -77.5827789, 8.0},
-	{2824, "Cuba", "Guisa", 20.2550000, -76.5386124, 247.0},
-	{2825, "Cuba", "Santa Cruz del Norte", 23.1538889, -81.9236145, 6.0},
-	{2826, "Cuba", "Sagua de Tanamo", 20.5819444, -75.2413864, 28.0},
-	{2827, "Cuba", "Bejucal", 22.9286111, -82.3886108, 144.0},
-	{2828, "Cuba", "Primero de Enero", 21.9469444, -78.4283371, 23.0},
-	{2829, "Cuba", "Jobabo", 20.9691667, -76.2994461, 117.0},
-	{2830, "Cuba", "Sibanicu", 21.2350000, -77.5263901, 85.0},
-	{2831, "Cuba", "Media Luna", 20.1419444, -77.4349976, 9.0},
-	{2832, "Cuba", "Abreus", 22.2775000, -80.5674973, 12.0},
-	{2833, "Cuba", "Venezuela", 21.7352778, -78.7963867, 22.0},
-	{2834, "Cuba", "Guane", 22.2025000, -84.0875015, 10.0},
-	{2835, "Cuba", "Alquizar", 22.8058333, -82.5841675, 19.0},
-	{2836, "Cuba", "Baragua", 21.6966667, -78.6327744, 3.0},
-	{2837, "Cyprus", "Nicosia", 35.1666667, 33.3666649, 128.0},
-	{2838, "Cyprus", "Limassol", 34.6750000, 33.0333328, 1.0},
-	{2839, "Cyprus", "Larnaca", 34.9166667, 33.6291656, 24.0},
-	{2840, "Cyprus", "Famagusta", 35.1250000, 33.9500008, 1.0},
-	{2841, "Cyprus", "Paphos", 34.7666667, 32.4166679, 32.0},
-	{2842, "Cyprus", "Kyrenia", 35.3416667, 33.3166656, -9999.0},
-	{2843, "Cyprus", "Protaras", 35.0125000, 34.0583344, 1.0},
-	{2844, "Cyprus", "Morphou", 35.1980556, 32.9913902, 52.0},
-	{2845, "Cyprus", "Aradhippou", 34.9500000, 33.5916672, 66.0},
-	{2846, "Cyprus", "Paralimni", 35.0375000, 33.9833336, 65.0},
-	{2847, "Cyprus", "Lefka", 35.1105556, 32.8505554, 147.0},
-	{2848, "Cyprus", "Yeri", 35.1000000, 33.4166679, 150.0},
-	{2849, "Cyprus", "Ypsonas", 34.6833333, 32.9583321, 62.0},
-	{2850, "Cyprus", "Dhali", 35.0250000, 33.4208336, 250.0},
-	{2851, "Cyprus", "Xeri", 35.0750000, 33.3208351, 278.0},
-	{2852, "Cyprus", "Livadhia", 34.9541667, 33.6291656, 19.0},
-	{2853, "Cyprus", "Dhromolaxia", 34.8755102, 33.5868378, 38.0},
-	{2854, "Cyprus", "Lapithos", 35.3375000, 33.1791649, 95.0},
-	{2855, "Cyprus", "Rizokarpaso", 35.6000000, 34.3750000, 129.0},
-	{2856, "Cyprus", "Dherinia", 35.0666667, 33.9583321, 71.0},
-	{2857, "Cyprus", "Emba", 34.8083333, 32.4249992, 176.0},
-	{2858, "Cyprus", "Trikomo", 35.2875000, 33.8916664, 48.0},
-	{2859, "Cyprus", "Sotira", 34.7291667, 32.8624992, 394.0},
-	{2860, "Cyprus", "Athienou", 35.0666667, 33.5416679, 138.0},
-	{2861, "Cyprus", "Avgorou", 35.0375000, 33.8333321, 44.0},
-	{2862, "Cyprus", "Liopetri", 35.0083333, 33.8916664, 53.0},
-	{2863, "Cyprus", "Kolossi", 34.6708333, 32.9291649, 74.0},
-	{2864, "Cyprus", "Pano Polemidhia", 34.7000000, 33.0000000, 76.0},
-	{2865, "Cyprus", "Khlorakas", 34.8041667, 32.4083328, 81.0},
-	{2866, "Cyprus", "Xylotymbou", 35.0166667, 33.7500000, 67.0},
-	{2867, "Cyprus", "Phrenaros", 35.0416667, 33.9249992, 77.0},
-	{2868, "Cyprus", "Voroklini", 34.9833333, 33.6583328, 30.0},
-	{2869, "Cyprus", "Kiti", 34.8500000, 33.5750008, 32.0},
-	{2870, "Cyprus", "Kokkini Trimithia", 35.1541667, 33.2000008, 219.0},
-	{2871, "Cyprus", "Lefkoniko", 35.2583333, 33.7333336, 67.0},
-	{2872, "Cyprus", "Moutayiaka", 34.7208333, 33.0999985, 46.0},
-	{2873, "Cyprus", "Ayia Napa", 34.9821331, 34.0018272, -9999.0},
-	{2874, "Cyprus", "Akaki", 35.1333333, 33.1291656, 197.0},
-	{2875, "Cyprus", "Lythrodhondas", 34.9500000, 33.2958336, 461.0},
-	{2876, "Cyprus", "Astromeritis", 35.1416667, 33.0374985, 163.0},
-	{2877, "Cyprus", "Peyia", 34.8833333, 32.3833351, 128.0},
-	{2878, "Cyprus", "Lympia", 35.0000000, 33.4625015, 252.0},
-	{2879, "Cyprus", "Ayios Tykhonas", 34.7250000, 33.1416664, 46.0},
-	{2880, "Cyprus", "Peristerona", 35.1333333, 33.0791664, 240.0},
-	{2881, "Cyprus", "Athna", 35.0583333, 33.7833328, 66.0},
-	{2882, "Cyprus", "Pano Dheftera", 35.0791667, 33.2625008, 303.0},
-	{2883, "Cyprus", "Polis", 35.0366667, 32.4263878, 7.0},
-	{2884, "Cyprus", "Kornos", 34.9208333, 33.3916664, 316.0},
-	{2885, "Cyprus", "Perivolia", 34.8291667, 33.5833321, 18.0},
-	{2886, "Cyprus", "Akhyritou", 35.1000000, 33.8624992, 32.0},
-	{2887, "Cyprus", "Kato Dheftera", 35.0875000, 33.2791672, 263.0},
-	{2888, "Cyprus", "Ergates", 35.0541667, 33.2458344, 333.0},
-	{2889, "Cyprus", "Tala", 34.8375000, 32.4333344, 240.0},
-	{2890, "Cyprus", "Klirou", 35.0208333, 33.1791649, 474.0},
-	{2891, "Cyprus", "Leonarisso", 35.4708333, 34.1416664, 156.0},
-	{2892, "Cyprus", "Kyperounda", 34.9375000, 32.9749985, 1206.0},
-	{2893, "Cyprus", "Erimi", 34.6791667, 32.9166679, 99.0},
-	{2894, "Cyprus", "Kissonerga", 34.8250000, 32.4000015, 112.0},
-	{2895, "Cyprus", "Pyla", 35.0291667, 33.6916656, 124.0},
-	{2896, "Cyprus", "Psomolophou", 35.0625000, 33.2625008, 340.0},
-	{2897, "Cyprus", "Pyrgos", 34.7416667, 33.1833344, 77.0},
-	{2898, "Cyprus", "Parekklisha", 34.7458333, 33.1583328, 130.0},
-	{2899, "Cyprus", "Kophinou", 34.8250000, 33.3875008, 169.0},
-	{2900, "Cyprus", "Kakopetria", 34.9916667, 32.9041672, 617.0},
-	{2901, "Cyprus", "Mesoyi", 34.8166667, 32.4583321, 295.0},
-	{2902, "Cyprus", "Meneou", 34.8500000, 33.5708351, 33.0},
-	{2903, "Cyprus", "Pelendria", 34.9000000, 32.9666672, 1067.0},
-	{2904, "Cyprus", "Alambra", 34.9916667, 33.4000015, 246.0},
-	{2905, "Cyprus", "Kato Pyrgos", 35.1802778, 32.6824989, 80.0},
-	{2906, "Cyprus", "Mosphiloti", 34.9500000, 33.4291649, 251.0},
-	{2907, "Cyprus", "Aradhiou", 35.0458333, 33.1958351, 403.0},
-	{2908, "Cyprus", "Mammari", 35.1666667, 33.2000008, 241.0},
-	{2909, "Cyprus", "Pissouri", 34.6708333, 32.7083321, 245.0},
-	{2910, "Cyprus", "Troulli", 35.0333333, 33.6166649, 114.0},
-	{2911, "Cyprus", "Psevdhas", 34.9500000, 33.4625015, 163.0},
-	{2912, "Cyprus", "Pera", 35.0333333, 33.2500000, 426.0},
-	{2913, "Cyprus", "Meniko", 35.1083333, 33.1416664, 248.0},
-	{2914, "Cyprus", "Tersephanou", 34.8541667, 33.5458336, 92.0},
-	{2915, "Cyprus", "Pakhna", 34.7791667, 32.7958336, 746.0},
-	{2916, "Cyprus", "Pano Lefkara", 34.8666667, 33.3041649, 615.0},
-	{2917, "Cyprus", "Konia", 34.7833333, 32.4583321, 169.0},
-	{2918, "Cyprus", "Timi", 34.7333333, 32.5166664, 73.0},
-	{2919, "Cyprus", "Agros", 34.9166667, 33.0166664, 1043.0},
-	{2920, "Cyprus", "Evrykhou", 35.0413889, 32.9013901, 451.0},
-	{2921, "Cyprus", "Argaka", 35.0644444, 32.5000000, 233.0},
-	{2922, "Cyprus", "Alethriko", 34.8611111, 33.4958344, 118.0},
-	{2923, "Cyprus", "Mazotos", 34.8083333, 33.4874992, 49.0},
-	{2924, "Cyprus", "Polemi", 34.8875000, 32.5000000, 434.0},
-	{2925, "Cyprus", "Yiolou", 34.9166667, 32.4666672, 332.0},
-	{2926, "Cyprus", "Palodhia", 34.7500000, 33.0041656, 222.0},
-	{2927, "Cyprus", "Mitsero", 35.0458333, 33.1250000, 389.0},
-	{2928, "Cyprus", "Akrotiri", 34.6041667, 32.9583321, 1.0},
-	{2929, "Cyprus", "Tsadha", 34.8416667, 32.4749985, 462.0},
-	{2930, "Cyprus", "Pano Kividhes", 34.7708333, 32.8416672, 469.0},
-	{2931, "Cyprus", "Kouklia", 34.7083333, 32.5750008, 72.0},
-	{2932, "Cyprus", "Orounda", 35.1041667, 33.0916672, 311.0},
-	{2933, "Cyprus", "Anaphotia", 34.8250000, 33.4625015, 53.0},
-	{2934, "Cyprus", "Galata", 34.9958333, 32.9000015, 617.0},
-	{2935, "Cyprus", "Kalavasos", 34.7666667, 33.2999992, 183.0},
-	{2936, "Cyprus", "Evdhimou", 34.6958333, 32.7625008, 75.0},
-	{2937, "Czech Republic", "Praha", 50.0878368, 14.4241323, 245.0},
-	{2938, "Czech Republic", "Brno", 49.1952227, 16.6079597, 226.0},
-	{2939, "Czech Republic", "Ostrava", 49.8346452, 18.2820435, 235.0},
-	{2940, "Czech Republic", "Plzen", 49.7474748, 13.3775873, 315.0},
-	{2941, "Czech Republic", "Olomouc", 49.5955243, 17.2517529, 253.0},
-	{2942, "Czech Republic", "Liberec", 50.7671119, 15.0561914, 386.0},
-	{2943, "Czech Republic", "Ceske Budejovice", 48.9744684, 14.4743404, 378.0},
-	{2944, "Czech Republic", "Hradec Kralove", 50.2092284, 15.8327684, 222.0},
-	{2945, "Czech Republic", "Usti nad Labem", 50.6607038, 14.0322723, 230.0},
-	{2946, "Czech Republic", "Pardubice", 50.0407508, 15.7765903, 219.0},
-	{2947, "Czech Republic", "Havirov", 49.7798448, 18.4368801, 267.0},
-	{2948, "Czech Republic", "Zlin", 49.2166667, 17.6666660, 317.0},
-	{2949, "Czech Republic", "Kladno", 50.1473356, 14.1028509, 359.0},
-	{2950, "Czech Republic", "Most", 50.5030069, 13.6361742, 235.0},
-	{2951, "Czech Republic", "Karvina", 49.8540031, 18.5416889, 274.0},
-	{2952, "Czech Republic", "Frydek-Mistek", 49.6853662, 18.3483772, 297.0},
-	{2953, "Czech Republic", "Opava", 49.9386635, 17.9025707, 273.0},
-	{2954, "Czech Republic", "Frydek-Mistek", 49.6833333, 18.3500004, 304.0},
-	{2955, "Czech Republic", "Decin", 50.7821525, 14.2147818, 155.0},
-	{2956, "Czech Republic", "Karlovy Vary", 50.2327126, 12.8711700, 399.0},
-	{2957, "Czech Republic", "Teplice", 50.6403975, 13.8245077, 240.0},
-	{2958, "Czech Republic", "Chomutov", 50.4604754, 13.4177856, 305.0},
-	{2959, "Czech Republic", "Jihlava", 49.3961001, 15.5912447, 520.0},
-	{2960, "Czech Republic", "Prostejov", 49.4718784, 17.1118393, 229.0},
-	{2961, "Czech Republic", "Prerov", 49.4551090, 17.4509029, 246.0},
-	{2962, "Czech Republic", "Jablonec nad Nisou", 50.7243076, 15.1710768, 509.0},
-	{2963, "Czech Republic", "Mlada Boleslav", 50.4113513, 14.9031849, 232.0},
-	{2964, "Czech Republic", "Ceska Lipa", 50.6855132, 14.5376415, 245.0},
-	{2965, "Czech Republic", "Trebic", 49.2149228, 15.8816576, 431.0},
-	{2966, "Czech Republic", "Trinec", 49.6776323, 18.6707802, 328.0},
-	{2967, "Czech Republic", "Tabor", 49.4144125, 14.6578035, 429.0},
-	{2968, "Czech Republic", "Znojmo", 48.8554987, 16.0487976, 224.0},
-	{2969, "Czech Republic", "Pribram", 49.6898774, 14.0104256, 539.0},
-	{2970, "Czech Republic", "Orlova", 49.8452659, 18.4301109, 251.0},
-	{2971, "Czech Republic", "Cheb", 50.0796346, 12.3739214, 473.0},
-	{2972, "Czech Republic", "Modrany", 50.0111589, 14.4095993, 244.0},
-	{2973, "Czech Republic", "Liben", 50.1081942, 14.4745731, 195.0},
-	{2974, "Czech Republic", "Trutnov", 50.5610067, 15.9127035, 419.0},
-	{2975, "Czech Republic", "Pisek", 49.3087953, 14.1475029, 386.0},
-	{2976, "Czech Republic", "Kolin", 50.0281362, 15.2005682, 194.0},
-	{2977, "Czech Republic", "Kromeriz", 49.2978514, 17.3931160, 215.0},
-	{2978, "Czech Republic", "Sumperk", 49.9652838, 16.9706078, 336.0},
-	{2979, "Czech Republic", "Vsetin", 49.3387089, 17.9961910, 440.0},
-	{2980, "Czech Republic", "Valasske Mezirici", 49.4718053, 17.9711266, 325.0},
-	{2981, "Czech Republic", "Litvinov", 50.6042029, 13.6181154, 300.0},
-	{2982, "Czech Republic", "Novy Jicin", 49.5943755, 18.0102749, 315.0},
-	{2983, "Czech Republic", "Uherske Hradiste", 49.0697498, 17.4596863, 198.0},
-	{2984, "Czech Republic", "Hodonin", 48.8489343, 17.1324444, 175.0},
-	{2985, "Czech Republic", "Cesky Tesin", 49.7461330, 18.6261311, 298.0},
-	{2986, "Czech Republic", "Breclav", 48.7589686, 16.8820305, 158.0},
-	{2987, "Czech Republic", "Krnov", 50.0896685, 17.7038460, 333.0},
-	{2988, "Czech Republic", "Sokolov", 50.1812979, 12.6401024, 416.0},
-	{2989, "Czech Republic", "Litomerice", 50.5334782, 14.1318026, 131.0},
-	{2990, "Czech Republic", "Havlickuv Brod", 49.6078493, 15.5806866, 464.0},
-	{2991, "Czech Republic", "Chrudim", 49.9510923, 15.7955761, 260.0},
-	{2992, "Czech Republic", "Strakonice", 49.2614066, 13.9023714, 403.0},
-	{2993, "Czech Republic", "Koprivnice", 49.5994713, 18.1448002, 356.0},
-	{2994, "Czech Republic", "Klatovy", 49.3955201, 13.2950544, 434.0},
-	{2995, "Czech Republic", "Bohumin", 49.9041062, 18.3575459, 216.0},
-	{2996, "Czech Republic", "Stary Bohumin", 49.9166667, 18.3333340, 205.0},
-	{2997, "Czech Republic", "Jindrichuv Hradec", 49.1440447, 15.0030079, 440.0},
-	{2998, "Czech Republic", "Vyskov", 49.2774693, 16.9989738, 263.0},
-	{2999, "Czech Republic", "Cerny Most", 50.1047537, 14.5797377, 229.0},
-	{3000, "Czech Republic", "Kutna Hora", 49.9483885, 15.2681646, 230.0},
-	{3001, "Czech Republic", "Nachod", 50.4167046, 16.1628876, 358.0},
-	{3002, "Czech Republic", "Jirkov", 50.4997930, 13.4476900, 289.0},
-	{3003, "Czech Republic", "Blansko", 49.3630357, 16.6444588, 323.0},
-	{3004, "Czech Republic", "Zatec", 50.3271690, 13.5457735, 207.0},
-	{3005, "Czech Republic", "Hranice", 49.5479644, 17.7346916, 274.0},
-	{3006, "Czech Republic", "Melnik", 50.3504968, 14.4741077, 182.0},
-	{3007, "Czech Republic", "Louny", 50.3569886, 13.7966747, 228.0},
-	{3008, "Czech Republic", "Otrokovice", 49.2093401, 17.5394421, 219.0},
-	{3009, "Czech Republic", "Otrokovice", 49.2166667, 17.5166664, 207.0},
-	{3010, "Czech Republic", "Branik", 50.0359737, 14.4123459, 247.0},
-	{3011, "Czech Republic", "Bruntal", 49.9884449, 17.4647026, 582.0},
-	{3012, "Czech Republic", "Kadan", 50.3833333, 13.2666664, 298.0},
-	{3013, "Czech Republic", "Kadan", 50.3759833, 13.2713079, 298.0},
-	{3014, "Czech Republic", "Beroun", 49.9638234, 14.0719967, 215.0},
-	{3015, "Czech Republic", "Uhersky Brod", 49.0251299, 17.6471500, 243.0},
-	{3016, "Czech Republic", "Svitavy", 49.7559371, 16.4682922, 426.0},
-	{3017, "Czech Republic", "Kralupy nad Vltavou", 50.2410709, 14.3114948, 179.0},
-	{3018, "Czech Republic", "Roznov pod Radhostem", 49.4585271, 18.1430206, 418.0},
-	{3019, "Czech Republic", "Ostrov", 50.3059247, 12.9390678, 445.0},
-	{3020, "Czech Republic", "Ceska Trebova", 49.9043648, 16.4441299, 372.0},
-	{3021, "Czech Republic", "Pelhrimov", 49.4313373, 15.2233572, 534.0},
-	{3022, "Czech Republic", "Rakovnik", 50.1037005, 13.7333994, 341.0},
-	{3023, "Czech Republic", "Neratovice", 50.2592585, 14.5175943, 147.0},
-	{3024, "Czech Republic", "Jicin", 50.4372262, 15.3516245, 266.0},
-	{3025, "Czech Republic", "Benesov", 49.7816247, 14.6869678, 350.0},
-	{3026, "Czech Republic", "Dvur Kralove nad Labem", 50.4317220, 15.8140211, 286.0},
-	{3027, "Czech Republic", "Varnsdorf", 50.9115440, 14.6182346, 367.0},
-	{3028, "Czech Republic", "Letnany", 50.1333333, 14.5166664, 267.0},
-	{3029, "Czech Republic", "Bilina", 50.5485414, 13.7753468, 371.0},
-	{3030, "Czech Republic", "Bilina Kyselka", 50.5500000, 13.7666664, 227.0},
-	{3031, "Czech Republic", "Klasterec nad Ohri", 50.3844523, 13.1712780, 328.0},
-	{3032, "Czech Republic", "Prosek", 50.1166667, 14.5000000, 272.0},
-	{3033, "Czech Republic", "Brandys nad Labem-Stara Boleslav", 50.1870907, 14.6632566, 205.0},
-	{3034, "Denmark", "Copenhagen", 55.6776812, 12.5709343, 4.0},
-	{3035, "Denmark", "Arhus", 56.1567366, 10.2107620, 1.0},
-	{3036, "Denmark", "Odense", 55.3959381, 10.3883133, 10.0},
-	{3037, "Denmark", "Aalborg", 57.0479990, 9.9187012, 1.0},
-	{3038, "Denmark", "Frederiksberg", 55.6793750, 12.5346279, 6.0},
-	{3039, "Denmark", "Esbjerg", 55.4666667, 8.4499998, 14.0},
-	{3040, "Denmark", "Randers", 56.4666667, 10.0500002, 10.0},
-	{3041, "Denmark", "Kolding", 55.4903995, 9.4721651, 4.0},
-	{3042, "Denmark", "Vejle", 55.7092707, 9.5357037, 5.0},
-	{3043, "Denmark", "Horsens", 55.8606582, 9.8503370, 9.0},
-	{3044, "Denmark", "Hvidovre", 55.6571864, 12.4736395, 5.0},
-	{3045, "Denmark", "Greve", 55.5833333, 12.3000002, 1.0},
-	{3046, "Denmark", "Herning", 56.1393151, 8.9737806, 52.0},
-	{3047, "Denmark", "Roskilde", 55.6415192, 12.0803471, 44.0},
-	{3048, "Denmark", "Silkeborg", 56.1697004, 9.5450811, 19.0},
-	{3049, "Denmark", "Naestved", 55.2299179, 11.7609177, 18.0},
-	{3050, "Denmark", "Ballerup", 55.7316457, 12.3632832, 34.0},
-	{3051, "Denmark", "Charlottenlund", 55.7500000, 12.5833330, 1.0},
-	{3052, "Denmark", "Fredericia", 55.5656763, 9.7525654, -9999.0},
-	{3053, "Denmark", "Horsholm", 55.8833333, 12.5000000, 40.0},
-	{3054, "Denmark", "Helsingor", 56.0360649, 12.6136026, 1.0},
-	{3055, "Denmark", "Viborg", 56.4531891, 9.4020119, 31.0},
-	{3056, "Denmark", "Koge", 55.4580174, 12.1821375, 2.0},
-	{3057, "Denmark", "Holstebro", 56.3600916, 8.6160707, 18.0},
-	{3058, "Denmark", "Slagelse", 55.4027616, 11.3545904, 50.0},
-	{3059, "Denmark", "Taastrup", 55.6517326, 12.2921562, 16.0},
-	{3060, "Denmark", "Albertslund", 55.6569140, 12.3638144, 11.0},
-	{3061, "Denmark", "Rodovre", 55.6806211, 12.4537325, 16.0},
-	{3062, "Denmark", "Hillerod", 55.9266656, 12.3109102, 51.0},
-	{3063, "Denmark", "Svendborg", 55.0598194, 10.6067715, 5.0},
-	{3064, "Denmark", "Sonderborg", 54.9092625, 9.8073654, 14.0},
-	{3065, "Denmark", "Hjorring", 57.4641694, 9.9822912, 41.0},
-	{3066, "Denmark", "Holbaek", 55.7166667, 11.7166672, 1.0},
-	{3067, "Denmark", "Frederikshavn", 57.4407347, 10.5366077, 1.0},
-	{3068, "Denmark", "Haderslev", 55.2537706, 9.4898233, 11.0},
-	{3069, "Denmark", "Skive", 56.5666667, 9.0333328, 5.0},
-	{3070, "Denmark", "Glostrup", 55.6666667, 12.3999996, 20.0},
-	{3071, "Denmark", "Ringsted", 55.4425992, 11.7901058, 55.0},
-	{3072, "Denmark", "Stenlose", 55.7682812, 12.1972275, 17.0},
-	{3073, "Denmark", "Ishoj", 55.6154254, 12.3518190, 2.0},
-	{3074, "Denmark", "Birkerod", 55.8475902, 12.4279079, 45.0},
-	{3075, "Denmark", "Farum", 55.8085815, 12.3606596, 31.0},
-	{3076, "Denmark", "Nykobing Falster", 54.7690581, 11.8742466, 5.0},
-	{3077, "Denmark", "Aabenraa", 55.0443427, 9.4174070, 1.0},
-	{3078, "Denmark", "Kalundborg", 55.6833333, 11.1000004, 5.0},
-	{3079, "Denmark", "Nyborg", 55.3127356, 10.7896366, 4.0},
-	{3080, "Denmark", "Lillerod", 55.8749617, 12.3457899, 49.0},
-	{3081, "Denmark", "Korsor", 55.3299256, 11.1385698, -9999.0},
-	{3082, "Denmark", "Solrod Strand", 55.5328507, 12.2222691, 1.0},
-	{3083, "Denmark", "Ikast", 56.1388309, 9.1576834, 77.0},
-	{3084, "Denmark", "Frederikssund", 55.8395636, 12.0689592, 17.0},
-	{3085, "Denmark", "Grenaa", 56.4157827, 10.8782454, 5.0},
-	{3086, "Denmark", "Nakskov", 54.8333333, 11.1499996, 3.0},
-	{3087, "Denmark", "Ronne", 55.1000000, 14.6999998, 20.0},
-	{3088, "Denmark", "Middelfart", 55.5059099, 9.7305422, 4.0},
-	{3089, "Denmark", "Skanderborg", 56.0399128, 9.9271994, 20.0},
-	{3090, "Denmark", "Vallensbaek", 55.6333333, 12.3666668, 5.0},
-	{3091, "Denmark", "Thisted", 56.9552254, 8.6949062, 5.0},
-	{3092, "Denmark", "Vaerlose", 55.7833333, 12.3833332, 46.0},
-	{3093, "Denmark", "Varde", 55.6211205, 8.4806948, 5.0},
-	{3094, "Denmark", "Frederiksvaerk", 55.9707303, 12.0224981, 37.0},
-	{3095, "Denmark", "Bronderslev", 57.2702091, 9.9410172, 12.0},
-	{3096, "Denmark", "Espergaerde", 56.0000000, 12.5666666, 1.0},
-	{3097, "Denmark", "Struer", 56.4912161, 8.5837555, 17.0},
-	{3098, "Denmark", "Flong", 55.6666667, 12.1833334, 25.0},
-	{3099, "Denmark", "Hobro", 56.6430616, 9.7902880, 43.0},
-	{3100, "Denmark", "Dragor", 55.5945464, 12.6663780, 2.0},
-	{3101, "Denmark", "Odder", 55.9731258, 10.1529980, 11.0},
-	{3102, "Denmark", "Kongens Lyngby", 55.7704419, 12.5037823, 24.0},
-	{3103, "Denmark", "Haslev", 55.3234650, 11.9638910, 54.0},
-	{3104, "Denmark", "Hedensted", 55.7704298, 9.7010994, 59.0},
-	{3105, "Denmark", "Tarnby", 55.6302976, 12.6003532, 5.0},
-	{3106, "Denmark", "Jyllinge", 55.7500000, 12.1166668, 25.0},
-	{3107, "Denmark", "Skagen", 57.7209333, 10.5839376, 1.0},
-	{3108, "Denmark", "Grindsted", 55.7500000, 8.9333334, 42.0},
-	{3109, "Denmark", "Nykobing Mors", 56.7933405, 8.8528242, 1.0},
-	{3110, "Denmark", "Ringkobing", 56.0900620, 8.2440166, 5.0},
-	{3111, "Denmark", "Vordingborg", 55.0080051, 11.9105692, 5.0},
-	{3112, "Denmark", "Lystrup", 56.2333333, 10.2500000, 15.0},
-	{3113, "Denmark", "Smorumnedre", 55.7333333, 12.3000002, 25.0},
-	{3114, "Denmark", "Humlebaek", 55.9618012, 12.5341024, 1.0},
-	{3115, "Denmark", "Hundested", 55.9666667, 11.8666668, 12.0},
-	{3116, "Denmark", "Trorod", 55.8333333, 12.5500002, 25.0},
-	{3117, "Denmark", "Vejen", 55.4833333, 9.1499996, 35.0},
-	{3118, "Denmark", "Malov", 55.7500000, 12.3333330, 34.0},
-	{3119, "Denmark", "Saeby", 57.3333333, 10.5333328, 1.0},
-	{3120, "Denmark", "Fredensborg", 55.9666667, 12.3999996, 35.0},
-	{3121, "Denmark", "Niva", 55.9333333, 12.5166664, 1.0},
-	{3122, "Denmark", "Vojens", 55.2500000, 9.3166666, 40.0},
-	{3123, "Denmark", "Ribe", 55.3500000, 8.7666664, 5.0},
-	{3124, "Denmark", "Tonder", 54.9330598, 8.8667393, 3.0},
-	{3125, "Denmark", "Aars", 56.8039858, 9.5144072, 22.0},
-	{3126, "Denmark", "Ebeltoft", 56.1944212, 10.6821012, -9999.0},
-	{3127, "Denmark", "Nordborg", 55.0500000, 9.7500000, 25.0},
-	{3128, "Denmark", "Bjerringbro", 56.3833333, 9.6666670, 11.0},
-	{3129, "Denmark", "Helsinge", 56.0228342, 12.1975174, 25.0},
-	{3130, "Denmark", "Lemvig", 56.5485608, 8.3101912, 25.0},
-	{3131, "Denmark", "Tranbjerg", 56.1000000, 10.1499996, 69.0},
-	{3132, "Denmark", "Faaborg", 55.0951047, 10.2422647, 5.0},
-	{3133, "Denmark", "Soro", 55.4318396, 11.5554714, 35.0},
-	{3134, "Djibouti", "Djibouti", 11.5950000, 43.1480560, 1.0},
-	{3135, "Djibouti", "`Ali Sabieh", 11.1558333, 42.7125015, 692.0},
-	{3136, "Djibouti", "Tadjoura", 11.7852778, 42.8844452, 69.0},
-	{3137, "Djibouti", "Obock", 11.9630556, 43.2905540, 27.0},
-	{3138, "Djibouti", "Dikhil", 11.1086111, 42.3738899, 562.0},
-	{3139, "Djibouti", "`Arta", 11.5222222, 42.8419456, 741.0},
-	{3140, "Djibouti", "Holhol", 11.3102778, 42.9294434, 463.0},
-	{3141, "Djibouti", "Dorra", 12.1494444, 42.4775009, 295.0},
-	{3142, "Djibouti", "Galafi", 11.7158333, 41.8361130, 129.0},
-	{3143, "Djibouti", "Loyada", 11.4611111, 43.2527771, 1.0},
-	{3144, "Djibouti", "Alaili Dadda`", 12.4216667, 42.8955574, 333.0},
-	{3145, "Netherlands", "Amsterdam", 52.3730840, 4.8999023, -2.0},
-	{3146, "Netherlands", "Rotterdam", 51.9225000, 4.4791670, -4.0},
-	{3147, "Netherlands", "The Hague", 52.0766670, 4.2986112, 1.0},
-	{3148, "Netherlands", "Utrecht", 52.0908330, 5.1222219, 1.0},
-	{3149, "Netherlands", "Eindhoven", 51.4408330, 5.4777780, 19.0},
-	{3150, "Netherlands", "Tilburg", 51.5555149, 5.0913048, 13.0},
-	{3151, "Netherlands", "Groningen", 53.2191670, 6.5666671, 1.0},
-	{3152, "Netherlands", "Almere Stad", 52.3702542, 5.2141285, -3.0},
-	{3153, "Netherlands", "Breda", 51.5865631, 4.7759628, 3.0},
-	{3154, "Netherlands", "Nijmegen", 51.8425000, 5.8527780, 13.0},
-	{3155, "Netherlands", "Enschede", 52.2183330, 6.8958330, 45.0},
-	{3156, "Netherlands", "Haarlem", 52.3808387, 4.6368313, 1.0},
-	{3157, "Netherlands", "Arnhem", 51.9800000, 5.9111109, 13.0},
-	{3158, "Netherlands", "Zaanstad", 52.4500000, 4.8333335, -2.0},
-	{3159, "Netherlands", "Amersfoort", 52.1550000, 5.3874998, 4.0},
-	{3160, "Netherlands", "Apeldoorn", 52.2100000, 5.9694438, 12.0},
-	{3161, "Netherlands", "Hoofddorp", 52.3025000, 4.6888890, -5.0},
-	{3162, "Netherlands", "Maastricht", 50.8483330, 5.6888890, 64.0},
-	{3163, "Netherlands", "Leiden", 52.1583330, 4.4930558, -1.0},
-	{3164, "Netherlands", "Dordrecht", 51.8100000, 4.6736112, 1.0},
-	{3165, "Netherlands", "Zoetermeer", 52.0575000, 4.4930558, -4.0},
-	{3166, "Netherlands", "Zwolle", 52.5125000, 6.0944438, 1.0},
-	{3167, "Netherlands", "Deventer", 52.2550000, 6.1638889, 5.0},
-	{3168, "Netherlands", "Born", 51.0316670, 5.8097219, 35.0},
-	{3169, "Netherlands", "Delft", 52.0066670, 4.3555560, -2.0},
-	{3170, "Netherlands", "Alkmaar", 52.6316670, 4.7486110, -2.0},
-	{3171, "Netherlands", "Heerlen", 50.8836510, 5.9815407, 130.0},
-	{3172, "Netherlands", "Venlo", 51.3700000, 6.1680560, 22.0},
-	{3173, "Netherlands", "Leeuwarden", 53.2000000, 5.7833333, 1.0},
-	{3174, "Netherlands", "Amsterdam-Zuidoost", 52.3075000, 4.9722219, -2.0},
-	{3175, "Netherlands", "Hilversum", 52.2233330, 5.1763892, 5.0},
-	{3176, "Netherlands", "Amstelveen", 52.3008330, 4.8638892, -5.0},
-	{3177, "Netherlands", "Roosendaal", 51.5308330, 4.4652781, 5.0},
-	{3178, "Netherlands", "Purmerend", 52.5050000, 4.9597220, -2.0},
-	{3179, "Netherlands", "Oss", 51.7650000, 5.5180559, 6.0},
-	{3180, "Netherlands", "Schiedam", 51.9191670, 4.3888888, -1.0},
-	{3181, "Netherlands", "Spijkenisse", 51.8450000, 4.3291669, -2.0},
-	{3182, "Netherlands", "Vlaardingen", 51.9125000, 4.3416672, 1.0},
-	{3183, "Netherlands", "Almelo", 52.3566670, 6.6624999, 12.0},
-	{3184, "Netherlands", "Gouda", 52.0166670, 4.7083330, -2.0},
-	{3185, "Netherlands", "Zaandam", 52.4400000, 4.8249998, -1.0},
-	{3186, "Netherlands", "Lelystad", 52.5083330, 5.4749999, -4.0},
-	{3187, "Netherlands", "Alphen aan den Rijn", 52.1291677, 4.6554565, -2.0},
-	{3188, "Netherlands", "Hoorn", 52.6425000, 5.0597219, -1.0},
-	{3189, "Netherlands", "Velsen-Zuid", 52.4600000, 4.6500001, 1.0},
-	{3190, "Netherlands", "Ede", 52.0333330, 5.6583328, 18.0},
-	{3191, "Netherlands", "Bergen op Zoom", 51.4950000, 4.2916670, 7.0},
-	{3192, "Netherlands", "Capelle-West", 51.9166667, 4.5666666, -3.0},
-	{3193, "Netherlands", "Assen", 52.9966670, 6.5625000, 10.0},
-	{3194, "Netherlands", "Nieuwegein", 52.0291670, 5.0805559, 1.0},
-	{3195, "Netherlands", "Veenendaal", 52.0286272, 5.5589104, 6.0},
-	{3196, "Netherlands", "Zeist", 52.0900000, 5.2333331, 4.0},
-	{3197, "Netherlands", "Katwijk", 51.7500000, 5.8708329, 10.0},
-	{3198, "Netherlands", "Den Helder", 52.9533330, 4.7597222, 2.0},
-	{3199, "Netherlands", "Hardenberg", 52.5758330, 6.6194439, 10.0},
-	{3200, "Netherlands", "Emmen", 52.7791670, 6.9069438, 20.0},
-	{3201, "Netherlands", "Oosterhout", 51.6450000, 4.8597221, 3.0},
-	{3202, "Netherlands", "Doetinchem", 51.9650000, 6.2888889, 13.0},
-	{3203, "Netherlands", "Kerkrade", 50.8658330, 6.0625000, 160.0},
-	{3204, "Netherlands", "Kampen", 52.5550000, 5.9111109, 1.0},
-	{3205, "Netherlands", "Weert", 51.2516670, 5.7069440, 31.0},
-	{3206, "Netherlands", "Woerden", 52.0850000, 4.8833332, 1.0},
-	{3207, "Netherlands", "Sittard", 50.9983330, 5.8694439, 53.0},
-	{3208, "Netherlands", "Heerhugowaard", 52.6666667, 4.8499999, -2.0},
-	{3209, "Netherlands", "Rijswijk", 52.0363367, 4.3250084, 1.0},
-	{3210, "Netherlands", "Middelburg", 51.5000000, 3.6138890, 1.0},
-	{3211, "Netherlands", "Emmeloord", 52.7108330, 5.7486110, -3.0},
-	{3212, "Netherlands", "Zwijndrecht", 51.8175000, 4.6333332, -1.0},
-	{3213, "Netherlands", "Waalwijk", 51.6825000, 5.0708332, 3.0},
-	{3214, "Netherlands", "Vlissingen", 51.4425000, 3.5736110, -9999.0},
-	{3215, "Netherlands", "Ridderkerk", 51.8725000, 4.6027780, 1.0},
-	{3216, "Netherlands", "Soest", 52.1733330, 5.2916670, 13.0},
-	{3217, "Netherlands", "Roermond", 51.1941670, 5.9875002, 21.0},
-	{3218, "Netherlands", "Rheden", 52.0050000, 6.0291672, 14.0},
-	{3219, "Netherlands", "Drachten", 53.1000000, 6.0999999, 2.0},
-	{3220, "Netherlands", "Heerenveen", 52.9592900, 5.9185410, 1.0},
-	{3221, "Netherlands", "De Bilt", 52.1100000, 5.1805558, 2.0},
-	{3222, "Netherlands", "Tiel", 51.8866670, 5.4291668, 5.0},
-	{3223, "Netherlands", "Harderwijk", 52.3416670, 5.6208329, 3.0},
-	{3224, "Netherlands", "Maarssen", 52.1391670, 5.0416670, 1.0},
-	{3225, "Netherlands", "Venray", 51.5250000, 5.9749999, 25.0},
-	{3226, "Netherlands", "Hoogeveen", 52.7225000, 6.4763889, 11.0},
-	{3227, "Netherlands", "Barendrecht", 51.8566670, 4.5347219, -1.0},
-	{3228, "Netherlands", "Dronten", 52.5250000, 5.7180562, -3.0},
-	{3229, "Netherlands", "Nijkerk", 52.2200000, 5.4861112, 3.0},
-	{3230, "Netherlands", "Beverwijk", 52.4833330, 4.6569438, 1.0},
-	{3231, "Netherlands", "Goes", 51.5041670, 3.8888891, 1.0},
-	{3232, "Netherlands", "Coevorden", 52.6625000, 6.7416668, 10.0},
-	{3233, "Netherlands", "Zutphen", 52.1383330, 6.2013888, 7.0},
-	{3234, "Netherlands", "Heemskerk", 52.5166667, 4.6666665, 1.0},
-	{3235, "Netherlands", "Wageningen", 51.9700000, 5.6666670, 25.0},
-	{3236, "Netherlands", "Castricum", 52.5483330, 4.6694441, 1.0},
-	{3237, "Netherlands", "Gorinchem", 51.8325000, 4.9749999, 1.0},
-	{3238, "Netherlands", "Uden", 51.6608330, 5.6194439, 18.0},
-	{3239, "Netherlands", "Mijdrecht", 52.2066670, 4.8625002, -5.0},
-	{3240, "Netherlands", "IJsselstein", 52.0200000, 5.0430560, 1.0},
-	{3241, "Netherlands", "Epe", 52.3475000, 5.9833331, 12.0},
-	{3242, "Netherlands", "Sneek", 53.0333333, 5.6666665, -1.0},
-	{3243, "Netherlands", "Maassluis", 51.9233330, 4.2500000, 1.0},
-	{3244, "Ecuador", "Guayaquil", -2.1666667, -79.9000015, 47.0},
-	{3245, "Ecuador", "Quito", -0.2166667, -78.5000000, 2764.0},
-	{3246, "Ecuador", "Cuenca", -2.8833333, -78.9833298, 2461.0},
-	{3247, "Ecuador", "Santo Domingo de los Colorados", -0.2500000, -79.1500015, 605.0},
-	{3248, "Ecuador", "Machala", -3.2666667, -79.9666672, 12.0},
-	{3249, "Ecuador", "Manta", -0.9500000, -80.7333298, 18.0},
-	{3250, "Ecuador", "Portoviejo", -1.0500000, -80.4499969, 118.0},
-	{3251, "Ecuador", "Duran", -2.2000000, -79.8333359, 1.0},
-	{3252, "Ecuador", "Ambato", -1.2490809, -78.6167450, 2438.0},
-	{3253, "Ecuador", "Riobamba", -1.6666667, -78.6333313, 2757.0},
-	{3254, "Ecuador", "Quevedo", -1.0333333, -79.4499969, 113.0},
-	{3255, "Ecuador", "Loja", -3.9931262, -79.2042160, 2119.0},
-	{3256, "Ecuador", "Ibarra", 0.3500000, -78.1166687, 2192.0},
-	{3257, "Ecuador", "Propicia", 0.9333333, -79.6833344, 9.0},
-	{3258, "Ecuador", "Babahoyo", -1.8166667, -79.5166702, 55.0},
-	{3259, "Ecuador", "La Libertad", -2.2333333, -80.9000015, 3.0},
-	{3260, "Ecuador", "Latacunga", -0.9333333, -78.6166687, 2759.0},
-	{3261, "Ecuador", "Velasco Ibarra", -1.0500000, -79.6166687, 103.0},
-	{3262, "Ecuador", "Ventanas", -1.4500000, -79.4666672, 358.0},
-	{3263, "Ecuador", "Pasaje", -3.3333333, -79.8166656, 100.0},
-	{3264, "Ecuador", "Chone", -0.6833333, -80.0999985, 127.0},
-	{3265, "Ecuador", "Salinas", -2.2166667, -80.9666672, 1.0},
-	{3266, "Ecuador", "Santa Elena", -2.2333333, -80.8499985, 24.0},
-	{3267, "Ecuador", "Rosa Zarate", 0.3333333, -79.4666672, 176.0},
-	{3268, "Ecuador", "Santa Rosa", -3.4500000, -79.9666672, 78.0},
-	{3269, "Ecuador", "Balzar", -1.3666667, -79.9000015, 60.0},
-	{3270, "Ecuador", "Huaquillas", -3.4833333, -80.2333298, 16.0},
-	{3271, "Ecuador", "Bahia de Caraquez", -0.6000000, -80.4166641, -9999.0},
-	{3272, "Ecuador", "La Troncal", -2.4000000, -79.3333359, 465.0},
-	{3273, "Ecuador", "Jipijapa", -1.3333333, -80.5833359, 327.0},
-	{3274, "Ecuador", "Azogues", -2.7333333, -78.8333359, 2966.0},
-	{3275, "Ecuador", "Naranjito", -2.2166667, -79.4833298, 161.0},
-	{3276, "Ecuador", "Vinces", -1.5500000, -79.7333298, 38.0},
-	{3277, "Ecuador", "Otavalo", 0.2333333, -78.2666702, 2666.0},
-	{3278, "Ecuador", "El Triunfo", -1.9333333, -79.9666672, 20.0},
-	{3279, "Ecuador", "Naranjal", -2.6666667, -79.6166687, 54.0},
-	{3280, "Ecuador", "Playas", -2.6333333, -80.3833313, 14.0},
-	{3281, "Ecuador", "Yaguachi", -2.1166667, -79.6833344, 44.0},
-	{3282, "Ecuador", "Cayambe", 0.0500000, -78.1333313, 3011.0},
-	{3283, "Ecuador", "Machachi", -0.5000000, -78.5666656, 2886.0},
-	{3284, "Ecuador", "Puyo", -1.4666667, -77.9833298, 981.0},
-	{3285, "Ecuador", "Nueva Loja", 0.0847222, -76.8827744, 406.0},
-	{3286, "Ecuador", "Samborondon", -1.9500000, -79.7333298, 18.0},
-	{3287, "Ecuador", "Macas", -2.3166667, -78.1166687, 1217.0},
-	{3288, "Ecuador", "Pedro Carbo", -1.8333333, -80.2333298, 98.0},
-	{3289, "Ecuador", "Guaranda", -1.6000000, -79.0000000, 2739.0},
-	{3290, "Ecuador", "Boca Suno", -0.7166667, -77.1333313, 302.0},
-	{3291, "Ecuador", "San Lorenzo de Esmeraldas", 1.2883333, -78.8369446, 1.0},
-	{3292, "Ecuador", "Catamayo", -3.9833333, -79.3499985, 1513.0},
-	{3293, "Ecuador", "Montecristi", -1.0500000, -80.6666641, 358.0},
-	{3294, "Ecuador", "Atuntaqui", 0.3333333, -78.2166672, 2391.0},
-	{3295, "Ecuador", "Calceta", -0.8500000, -80.1666641, 114.0},
-	{3296, "Ecuador", "Tena", -0.9833333, -77.8166656, 599.0},
-	{3297, "Ecuador", "Gualaceo", -2.9000000, -78.7833328, 2430.0},
-	{3298, "Ecuador", "Pinas", -3.6666667, -79.6500015, 877.0},
-	{3299, "Ecuador", "Cariamanga", -4.3333333, -79.5500031, 2189.0},
-	{3300, "Ecuador", "Pelileo", -1.3166667, -78.5333328, 2192.0},
-	{3301, "Ecuador", "La Mana", -0.9333333, -79.2166672, 818.0},
-	{3302, "Ecuador", "Pujili", -0.9500000, -78.6833344, 2848.0},
-	{3303, "Ecuador", "Montalvo", -1.8000000, -79.3333359, 144.0},
-	{3304, "Ecuador", "Sucre", -1.2666667, -80.4333344, 155.0},
-	{3305, "Ecuador", "Zamora", -4.0691667, -78.9566650, 984.0},
-	{3306, "Ecuador", "San Gabriel", 0.6000000, -77.8166656, 2908.0},
-	{3307, "Ecuador", "Tosagua", -0.7833333, -80.2500000, 69.0},
-	{3308, "Ecuador", "Alausi", -2.2000000, -78.8333359, 3324.0},
-	{3309, "Ecuador", "Muisne", 0.6000000, -80.0333328, 1.0},
-	{3310, "Ecuador", "Macara", -4.3833333, -79.9499969, 549.0},
-	{3311, "Ecuador", "Santa Ana", -1.2166667, -80.3833313, 150.0},
-	{3312, "Ecuador", "Guano", -1.5833333, -78.6333313, 3068.0},
-	{3313, "Ecuador", "Alfredo Baquerizo Moreno", -1.9166667, -79.5166702, 45.0},
-	{3314, "Ecuador", "San Miguel", -1.7000000, -79.0333328, 2433.0},
-	{3315, "Ecuador", "Santa Lucia", -2.1833333, -80.0000000, 164.0},
-	{3316, "Ecuador", "Zaruma", -3.6833333, -79.6166687, 1140.0},
-	{3317, "Ecuador", "Balao", -2.9166667, -79.8166656, 14.0},
-	{3318, "Ecuador", "Valdez", 1.2491667, -78.9830551, -9999.0},
-	{3319, "Ecuador", "Catacocha", -4.0666667, -79.6333313, 1887.0},
-	{3320, "Ecuador", "San Miguel de Salcedo", -1.0333333, -78.5666656, 2779.0},
-	{3321, "Ecuador", "Rocafuerte", -0.9166667, -80.4333344, 145.0},
-	{3322, "Ecuador", "Yantzaza", -3.8277778, -78.7594452, 887.0},
-	{3323, "Ecuador", "Canar", -2.5500000, -78.9333344, 3097.0},
-	{3324, "Ecuador", "Catarama", -1.5833333, -79.4666672, 231.0},
-	{3325, "Ecuador", "Portovelo", -3.7166667, -79.6166687, 936.0},
-	{3326, "Ecuador", "Banos", -1.4000000, -78.4166641, 4124.0},
-	{3327, "Ecuador", "Colimes", -1.5500000, -80.0166702, 44.0},
-	{3328, "Ecuador", "Palestina", -1.9333333, -79.7333298, 20.0},
-	{3329, "Ecuador", "Pajan", -1.5666667, -80.4166641, 150.0},
-	{3330, "Ecuador", "Junin", -0.9333333, -80.2166672, 172.0},
-	{3331, "Ecuador", "Palenque", -1.4500000, -79.7333298, 40.0},
-	{3332, "Ecuador", "Puerto Ayora", -0.7500000, -90.3166656, 10.0},
-	{3333, "Ecuador", "Puerto Bolivar", -3.2666667, -79.9833298, 9.0},
-	{3334, "Ecuador", "Cotacachi", 0.3000000, -78.2666702, 2405.0},
-	{3335, "Ecuador", "Lomas de Sargentillo", -1.8833333, -80.0833359, 54.0},
-	{3336, "Ecuador", "Pillaro", -1.1666667, -78.5333328, 3003.0},
-	{3337, "Ecuador", "Sucua", -2.4666667, -78.1666641, 1088.0},
-	{3338, "Ecuador", "Pimampiro", 0.4000000, -77.9666672, 2139.0},
-	{3339, "Ecuador", "Archidona", -0.9166667, -77.8000031, 605.0},
-	{3340, "Ecuador", "Coronel Marcelino Mariduena", -2.2000000, -79.4166641, 193.0},
-	{3341, "Ecuador", "Palora", -1.7000000, -77.9333344, 1358.0},
-	{3342, "Ecuador", "San Cristobal", -0.9166667, -89.5666656, 148.0},
-	{3343, "Ecuador", "Pedernales", 0.0833333, -80.0500031, 33.0},
-	{3344, "Equatorial Guinea", "Bata", 1.8500000, 9.7500000, 531.0},
-	{3345, "Equatorial Guinea", "Malabo", 3.7500000, 8.7833328, 111.0},
-	{3346, "Equatorial Guinea", "Ebebiyin", 2.1500000, 11.3333330, 393.0},
-	{3347, "Equatorial Guinea", "Aconibe", 1.3000000, 10.9333334, 565.0},
-	{3348, "Equatorial Guinea", "Anisoc", 1.8500000, 10.7666664, 434.0},
-	{3349, "Equatorial Guinea", "Luba", 3.4500000, 8.5500002, 182.0},
-	{3350, "Equatorial Guinea", "Evinayong", 1.4500000, 10.5666666, 632.0},
-	{3351, "Equatorial Guinea", "Mongomo", 1.6333333, 11.3166666, 544.0},
-	{3352, "Equatorial Guinea", "Mikomeseng", 2.1333333, 10.6166668, 324.0},
-	{3353, "Equatorial Guinea", "Rebola", 3.7166667, 8.8333330, 268.0},
-	{3354, "Equatorial Guinea", "Pale", -1.4013889, 5.6325002, -9999.0},
-	{3355, "Equatorial Guinea", "Mbini", 1.5833333, 9.6166668, 344.0},
-	{3356, "Equatorial Guinea", "Nsok", 1.1333333, 11.2666664, 546.0},
-	{3357, "Equatorial Guinea", "Ayene", 1.8666667, 10.6999998, 413.0},
-	{3358, "Equatorial Guinea", "Machinda", 1.8833333, 9.9666672, 631.0},
-	{3359, "Equatorial Guinea", "Acurenam", 1.0333333, 10.6666670, 646.0},
-	{3360, "Equatorial Guinea", "Santiago de Baney", 3.7000000, 8.9166670, 107.0},
-	{3361, "Equatorial Guinea", "Bicurga", 1.5833333, 10.4666672, 561.0},
-	{3362, "Equatorial Guinea", "Nsang", 2.0333333, 10.9333334, 495.0},
-	{3363, "Equatorial Guinea", "Ncue", 2.0166667, 10.4666672, 327.0},
-	{3364, "Equatorial Guinea", "Bitica", 1.4166667, 9.6166668, 610.0},
-	{3365, "Equatorial Guinea", "Rio Campo", 2.3333333, 9.8166666, 57.0},
-	{3366, "Equatorial Guinea", "Ciudad de Riaba", 3.3833333, 8.7666664, -9999.0},
-	{3367, "Eritrea", "Asmara", 15.3333333, 38.9333344, 2360.0},
-	{3368, "Eritrea", "Mendefera", 14.8872222, 38.8152771, 1956.0},
-	{3369, "Eritrea", "Barentu", 15.1138889, 37.5927773, 1033.0},
-	{3370, "Eritrea", "Adi Keyh", 14.8444444, 39.3772240, 2309.0},
-	{3371, "Eritrea", "Idi", 13.9297222, 41.6925011, 20.0},
-	{3372, "Eritrea", "Dekemhare", 15.0700000, 39.0475006, 2042.0},
-	{3373, "Eritrea", "Akurdet", 15.5486111, 37.8866653, 638.0},
-	{3374, "Eritrea", "Teseney", 15.1100000, 36.6575012, 586.0},
-	{3375, "Estonia", "Tallinn", 59.4369583, 24.7535267, 22.0},
-	{3376, "Estonia", "Tartu", 58.3806248, 26.7250900, 37.0},
-	{3377, "Estonia", "Narva", 59.3772222, 28.1902771, 39.0},
-	{3378, "Estonia", "Kohtla-Jarve", 59.3986111, 27.2730560, 70.0},
-	{3379, "Estonia", "Parnu", 58.3747222, 24.5136108, 10.0},
-	{3380, "Estonia", "Viljandi", 58.3638889, 25.5900002, 84.0},
-	{3381, "Estonia", "Rakvere", 59.3463889, 26.3558331, 83.0},
-	{3382, "Estonia", "Sillamae", 59.3997222, 27.7547226, 18.0},
-	{3383, "Estonia", "Maardu", 59.4766667, 25.0249996, 41.0},
-	{3384, "Estonia", "Kuressaare", 58.2480556, 22.5038891, 5.0},
-	{3385, "Estonia", "Voru", 57.8338889, 27.0194435, 71.0},
-	{3386, "Estonia", "Valga", 57.7778128, 26.0472965, 70.0},
-	{3387, "Estonia", "Haapsalu", 58.9430556, 23.5413895, 8.0},
-	{3388, "Estonia", "Johvi", 59.3591667, 27.4211102, 53.0},
-	{3389, "Estonia", "Paide", 58.8855556, 25.5572224, 67.0},
-	{3390, "Estonia", "Keila", 59.3036111, 24.4130554, 39.0},
-	{3391, "Estonia", "Kivioli", 59.3530556, 26.9711113, 52.0},
-	{3392, "Estonia", "Tapa", 59.2605556, 25.9586105, 104.0},
-	{3393, "Estonia", "Polva", 58.0602778, 27.0694447, 62.0},
-	{3394, "Estonia", "Jogeva", 58.7466667, 26.3938885, 70.0},
-	{3395, "Estonia", "Turi", 58.8086111, 25.4325008, 66.0},
-	{3396, "Estonia", "Elva", 58.2225000, 26.4211102, 53.0},
-	{3397, "Estonia", "Rapla", 59.0072222, 24.7927780, 68.0},
-	{3398, "Estonia", "Saue", 59.3202778, 24.5524998, 41.0},
-	{3399, "Estonia", "Poltsamaa", 58.6525000, 25.9705563, 74.0},
-	{3400, "Estonia", "Saku", 59.3022222, 24.6577778, 42.0},
-	{3401, "Estonia", "Paldiski", 59.3566667, 24.0530548, 28.0},
-	{3402, "Estonia", "Laagri", 59.3520304, 24.6092892, 42.0},
-	{3403, "Estonia", "Sindi", 58.4005556, 24.6674995, 11.0},
-	{3404, "Estonia", "Kunda", 59.4841667, 26.5611115, 59.0},
-	{3405, "Estonia", "Kardla", 58.9977778, 22.7491665, 4.0},
-	{3406, "Estonia", "Rummu", 59.5352778, 24.7997227, 16.0},
-	{3407, "Estonia", "Loksa", 59.5763889, 25.7213898, 3.0},
-	{3408, "Estonia", "Kohila", 59.1680556, 24.7574997, 63.0},
-	{3409, "Estonia", "Kehra", 59.3361111, 25.3211117, 57.0},
-	{3410, "Estonia", "Torva", 58.0027778, 25.9349995, 76.0},
-	{3411, "Estonia", "Marjamaa", 58.9038889, 24.4305553, 41.0},
-	{3412, "Estonia", "Rapina", 58.0980556, 27.4636116, 41.0},
-	{3413, "Estonia", "Narva-Joesuu", 59.4588889, 28.0408325, -9999.0},
-	{3414, "Estonia", "Tamsalu", 59.1586111, 26.1152782, 138.0},
-	{3415, "Estonia", "Juri", 59.3541667, 24.8941669, 61.0},
-	{3416, "Estonia", "Vandra", 58.6480556, 25.0361118, 41.0},
-	{3417, "Estonia", "Kadrina", 59.3347222, 26.1450005, 94.0},
-	{3418, "Estonia", "Toila", 59.4236111, 27.5025005, 35.0},
-	{3419, "Estonia", "Kose", 59.4580556, 24.8783340, 47.0},
-	{3420, "Estonia", "Loo", 59.4363889, 24.9483337, 43.0},
-	{3421, "Estonia", "Kilingi-Nomme", 58.1502778, 24.9641666, 67.0},
-	{3422, "Estonia", "Tabasalu", 59.4302778, 24.5330563, 40.0},
-	{3423, "Estonia", "Vaike-Maarja", 59.1263889, 26.2500000, 115.0},
-	{3424, "Estonia", "Otepaa", 58.0583333, 26.4966660, 151.0},
-	{3425, "Estonia", "Haabneeme", 59.5113889, 24.8211117, 19.0},
-	{3426, "Estonia", "Paikuse", 58.3791667, 24.6344452, 12.0},
-	{3427, "Estonia", "Karksi-Nuia", 58.1033333, 25.5627785, 115.0},
-	{3428, "Estonia", "Pussi", 59.3600000, 27.0497227, 45.0},
-	{3429, "Estonia", "Aseri", 59.4505556, 26.8675003, 14.0},
-	{3430, "Estonia", "Arukula", 59.3644444, 25.0772228, 51.0},
-	{3431, "Estonia", "Viimsi", 59.4966667, 24.8422222, 44.0},
-	{3432, "Estonia", "Mustvee", 58.8486111, 26.9397221, 26.0},
-	{3433, "Estonia", "Kehtna", 58.9302778, 24.8780556, 65.0},
-	{3434, "Estonia", "Vohma", 58.6283333, 25.5483341, 68.0},
-	{3435, "Estonia", "Noo", 58.2755556, 26.5375004, 73.0},
-	{3436, "Estonia", "Jarvakandi", 58.7788889, 24.8258324, 61.0},
-	{3437, "Estonia", "Vana-Antsla", 57.8661111, 26.5322227, 77.0},
-	{3438, "Estonia", "Lihula", 58.6813889, 23.8452778, 11.0},
-	{3439, "Estonia", "Audru", 58.4086111, 24.3738880, 7.0},
-	{3440, "Estonia", "Abja-Paluoja", 58.1252778, 25.3497219, 76.0},
-	{3441, "Estonia", "Someru", 59.3611111, 26.4375000, 66.0},
-	{3442, "Estonia", "Parnu-Jaagupi", 58.6058333, 24.5152779, 20.0},
-	{3443, "Estonia", "Viiratsi", 58.3600000, 25.6372223, 81.0},
-	{3444, "Estonia", "Raasiku", 59.3663889, 25.1830559, 51.0},
-	{3445, "Estonia", "Koeru", 58.9630556, 26.0308342, 96.0},
-	{3446, "Estonia", "Suure-Jaani", 58.5361111, 25.4705563, 86.0},
-	{3447, "Estonia", "Kuusalu", 59.4438889, 25.4413891, 48.0},
-	{3448, "Estonia", "Haljala", 59.4336111, 26.2613888, 71.0},
-	{3449, "Estonia", "Kallaste", 58.6550000, 27.1591663, 17.0},
-	{3450, "Estonia", "Turba", 59.0830556, 24.2250004, 64.0},
-	{3451, "Estonia", "Moisakula", 58.0922222, 25.1863880, 81.0},
-	{3452, "Estonia", "Kohtla-Nomme", 59.3516667, 27.1749992, 55.0},
-	{3453, "Estonia", "Puhja", 58.3419444, 26.3147221, 73.0},
-	{3454, "Estonia", "Uuemoisa", 58.9394444, 23.5894451, 7.0},
-	{3455, "Estonia", "Orissaare", 58.5591651, 23.0826187, 1.0},
-	{3456, "Estonia", "Sauga", 58.4238889, 24.5022221, 10.0},
-	{3457, "Estonia", "Tabivere", 58.5541667, 26.5955563, 45.0},
-	{3458, "Estonia", "Riisipere", 59.1141667, 24.3105564, 63.0},
-	{3459, "Estonia", "Jarva-Jaani", 59.0386111, 25.8863888, 102.0},
-	{3460, "Estonia", "Ulenurme", 58.3161953, 26.7251663, 36.0},
-	{3461, "Estonia", "Voka", 59.3997222, 27.5816669, 37.0},
-	{3462, "Estonia", "Rakke", 58.9816667, 26.2544441, 100.0},
-	{3463, "Estonia", "Sarevere", 58.7900000, 25.4280548, 68.0},
-	{3464, "Estonia", "Vinni", 59.2958333, 26.4322224, 95.0},
-	{3465, "Estonia", "Vaida", 59.2905556, 24.9658337, 47.0},
-	{3466, "Estonia", "Tootsi", 58.5780556, 24.7924995, 31.0},
-	{3467, "Estonia", "Taebla", 58.9538889, 23.7522221, 10.0},
-	{3468, "Estonia", "Karla", 58.3358333, 22.2597218, 19.0},
-	{3469, "Estonia", "Klooga", 59.3197222, 24.2113895, 21.0},
-	{3470, "Estonia", "Kose-Uuemoisa", 59.2000000, 25.1052780, 65.0},
-	{3471, "Estonia", "Palivere", 58.9702778, 23.9033337, 54.0},
-	{3472, "Estonia", "Aravete", 59.1416667, 25.7613888, 101.0},
-	{3473, "Estonia", "Kiili", 59.3069444, 24.8377781, 46.0},
-	{3474, "Estonia", "Lagedi", 59.4055556, 24.9422226, 49.0},
-	{3475, "Ethiopia", "Addis Ababa", 9.0333333, 38.7000008, 2363.0},
-	{3476, "Ethiopia", "Dire Dawa", 9.5930556, 41.8661118, 1191.0},
-	{3477, "Ethiopia", "Nazret", 8.5500000, 39.2666664, 1726.0},
-	{3478, "Ethiopia", "Bahir Dar", 11.6000000, 37.3833351, 1784.0},
-	{3479, "Ethiopia", "Gonder", 12.6000000, 37.4666672, 2133.0},
-	{3480, "Ethiopia", "Dese", 11.1333333, 39.6333351, 2471.0},
-	{3481, "Ethiopia", "Awasa", 7.0500000, 38.4666672, 1680.0},
-	{3482, "Ethiopia", "Jima", 7.6666667, 36.8333321, 1767.0},
-	{3483, "Ethiopia", "Debre Zeyit", 8.7500000, 38.9833336, 2006.0},
-	{3484, "Ethiopia", "Kombolcha", 11.0833333, 39.7333336, 1839.0},
-	{3485, "Ethiopia", "Harer", 9.3094444, 42.1258316, 1861.0},
-	{3486, "Ethiopia", "Shashemene", 7.2000000, 38.5999985, 2009.0},
-	{3487, "Ethiopia", "Debre Birhan", 9.6833333, 39.5333328, 2816.0},
-	{3488, "Ethiopia", "Jijiga", 9.3500000, 42.7999992, 1609.0},
-	{3489, "Ethiopia", "Inda Silase", 14.1050000, 38.2844429, 1910.0},
-	{3490, "Ethiopia", "Ziway", 7.9333333, 38.7166672, 1643.0},
-	{3491, "Ethiopia", "Dila", 6.4166667, 38.3166656, 1667.0},
-	{3492, "Ethiopia", "Hagere Hiywet", 8.9833333, 37.8499985, 2126.0},
-	{3493, "Ethiopia", "Gambela", 8.2500000, 34.5833321, 512.0},
-	{3494, "Ethiopia", "Aksum", 14.1297222, 38.7158318, 2188.0},
-	{3495, "Ethiopia", "Giyon", 8.5333333, 37.9833336, 2049.0},
-	{3496, "Ethiopia", "Yirga `Alem", 6.7500000, 38.4166679, 1777.0},
-	{3497, "Ethiopia", "Mojo", 8.6000000, 39.1166649, 1803.0},
-	{3498, "Ethiopia", "Goba", 7.0166667, 39.9833336, 2688.0},
-	{3499, "Ethiopia", "Shakiso", 5.7500000, 38.9166679, 1758.0},
-	{3500, "Ethiopia", "Felege Neway", 6.3000000, 36.8833351, 1440.0},
-	{3501, "Ethiopia", "Areka", 7.0666667, 37.7000008, 1751.0},
-	{3502, "Ethiopia", "Boditi", 6.9666667, 37.8666649, 1967.0},
-	{3503, "Ethiopia", "Debre Tabor", 11.8500000, 38.0166664, 2706.0},
-	{3504, "Ethiopia", "Jinka", 5.6500000, 36.6500015, 1332.0},
-	{3505, "Ethiopia", "Gimbi", 9.1666667, 35.8333321, 1845.0},
-	{3506, "Ethiopia", "Asbe Teferi", 9.0833333, 40.8666649, 1826.0},
-	{3507, "Ethiopia", "Korem", 12.5058333, 39.5227776, 2539.0},
-	{3508, "Ethiopia", "Asosa", 10.0666667, 34.5333328, 1417.0},
-	{3509, "Ethiopia", "Butajira", 8.1166667, 38.3666649, 2123.0},
-	{3510, "Ethiopia", "Metu", 8.3000000, 35.5833321, 1605.0},
-	{3511, "Ethiopia", "Agaro", 7.8500000, 36.6500015, 1614.0},
-	{3512, "Ethiopia", "Kibre Mengist", 5.8833333, 38.9833336, 1752.0},
-	{3513, "Ethiopia", "Werota", 11.9166667, 37.7000008, 1828.0},
-	{3514, "Ethiopia", "Dembi Dolo", 8.5333333, 34.7999992, 1701.0},
-	{3515, "Ethiopia", "Dubti", 11.7361111, 41.0852776, 355.0},
-	{3516, "Ethiopia", "Fiche", 9.8000000, 38.7333336, 2853.0},
-	{3517, "Ethiopia", "Mendi", 9.8000000, 35.0999985, 1538.0},
-	{3518, "Ethiopia", "Tepi", 7.2000000, 35.4500008, 1097.0},
-	{3519, "Ethiopia", "Kemise", 10.7166667, 39.8666649, 1437.0},
-	{3520, "Ethiopia", "Asasa", 7.1000000, 39.2000008, 2367.0},
-	{3521, "Ethiopia", "Genet", 9.0666667, 38.5000000, 2381.0},
-	{3522, "Ethiopia", "Finote Selam", 10.7000000, 37.2666664, 1917.0},
-	{3523, "Ethiopia", "Metahara", 8.9000000, 39.9166679, 1007.0},
-	{3524, "Ethiopia", "Dodola", 6.9833333, 39.1833344, 2488.0},
-	{3525, "Ethiopia", "Adet", 11.2666667, 37.4833336, 2238.0},
-	{3526, "Ethiopia", "Adis Zemen", 12.1166667, 37.7833328, 1996.0},
-	{3527, "Ethiopia", "Agere Maryam", 5.6333333, 38.2333336, 1826.0},
-	{3528, "Ethiopia", "Bure", 10.7000000, 37.0666656, 2091.0},
-	{3529, "Ethiopia", "Robit", 12.0166667, 39.6333351, 1596.0},
-	{3530, "Ethiopia", "Asaita", 11.5636111, 41.4394455, 340.0},
-	{3531, "Ethiopia", "Bedele", 8.4500000, 36.3499985, 2024.0},
-	{3532, "Ethiopia", "Nejo", 9.5000000, 35.5000000, 1821.0},
-	{3533, "Ethiopia", "Sebeta", 8.9166667, 38.6166649, 2405.0},
-	{3534, "Ethiopia", "Bati", 11.1833333, 40.0166664, 1502.0},
-	{3535, "Ethiopia", "Bonga", 7.2833333, 36.2333336, 1663.0},
-	{3536, "Ethiopia", "Bako", 5.7833333, 36.5666656, 1417.0},
-	{3537, "Ethiopia", "Yabelo", 4.8833333, 38.0833321, 1878.0},
-	{3538, "Ethiopia", "Bedesa", 8.9000000, 40.7833328, 1758.0},
-	{3539, "Ethiopia", "Wenji", 8.4500000, 39.2833328, 1584.0},
-	{3540, "Ethiopia", "Ginir", 7.1333333, 40.7000008, 1877.0},
-	{3541, "Ethiopia", "Gebre Guracha", 9.8000000, 38.4000015, 2547.0},
-	{3542, "Ethiopia", "Bichena", 10.4500000, 38.2000008, 2541.0},
-	{3543, "Ethiopia", "Gelemso", 8.8166667, 40.5166664, 1926.0},
-	{3544, "Ethiopia", "Shambu", 9.5666667, 37.0999985, 2506.0},
-	{3545, "Ethiopia", "Abomsa", 9.9833333, 39.9833336, 1387.0},
-	{3546, "Ethiopia", "Mizan Teferi", 6.9833333, 35.5833321, 1430.0},
-	{3547, "Ethiopia", "Wendo", 6.6000000, 38.4166679, 2037.0},
-	{3548, "Ethiopia", "Guder", 8.9666667, 37.7666664, 1947.0},
-	{3549, "Ethiopia", "Huruta", 8.1500000, 39.3499985, 1929.0},
-	{3550, "Ethiopia", "Hirna", 9.2166667, 41.0999985, 1910.0},
-	{3551, "Ethiopia", "Dejen", 10.1666667, 38.1333351, 2460.0},
-	{3552, "Ethiopia", "Dabat", 12.9841667, 37.7649994, 2599.0},
-	{3553, "Ethiopia", "Awash", 8.9833333, 40.1666679, 876.0},
-	{3554, "Ethiopia", "Leku", 6.8666667, 38.4500008, 1939.0},
-	{3555, "Ethiopia", "Gewane", 10.1663889, 40.6452789, 618.0},
-	{3556, "Ethiopia", "Lalibela", 12.0333333, 39.0333328, 2236.0},
-	{3557, "Ethiopia", "Gidole", 5.6500000, 37.3666649, 2045.0},
-	{3558, "Ethiopia", "Tulu Bolo", 8.6666667, 38.2166672, 2193.0},
-	{3559, "Ethiopia", "Sire", 8.3166667, 39.4833336, 1736.0},
-	{3560, "Ethiopia", "Adis `Alem", 9.0333333, 38.4000015, 2178.0},
-	{3561, "Ethiopia", "Kofele", 7.0666667, 38.7833328, 2515.0},
-	{3562, "Ethiopia", "Debre Sina", 9.8500000, 39.7666664, 2660.0},
-	{3563, "Ethiopia", "Gore", 8.1500000, 35.5333328, 1838.0},
-	{3564, "Ethiopia", "Deder", 9.3166667, 41.4500008, 2092.0},
-	{3565, "Ethiopia", "Were Ilu", 10.6000000, 39.4333344, 2635.0},
-	{3566, "Ethiopia", "Gedo", 9.0166667, 37.4500008, 2432.0},
-	{3567, "Ethiopia", "Sendafa", 9.1500000, 39.0333328, 2538.0},
-	{3568, "Ethiopia", "Mega", 4.0500000, 38.2999992, 1740.0},
-	{3569, "Fiji", "Suva", -18.1333333, 178.4166718, -9999.0},
-	{3570, "Fiji", "Nandi", -17.8000000, 177.4166718, 2.0},
-	{3571, "Fiji", "Lambasa", -16.4166667, 179.3833313, -9999.0},
-	{3572, "Finland", "Helsinki", 60.1755556, 24.9341660, 23.0},
-	{3573, "Finland", "Espoo", 60.2166667, 24.6666660, 28.0},
-	{3574, "Finland", "Tampere", 61.4991132, 23.7871170, 114.0},
-	{3575, "Finland", "Vantaa", 60.2966805, 25.0138092, 16.0},
-	{3576, "Finland", "Turku", 60.4500000, 22.2833328, 22.0},
-	{3577, "Finland", "Oulu", 65.0166667, 25.4666672, -9999.0},
-	{3578, "Finland", "Lahti", 60.9832673, 25.6561661, 102.0},
-	{3579, "Finland", "Kuopio", 62.9000000, 27.6833324, 90.0},
-	{3580, "Finland", "Jyvaskyla", 62.2333333, 25.7333336, 70.0},
-	{3581, "Finland", "Pori", 61.4833333, 21.7833328, 19.0},
-	{3582, "Finland", "Lappeenranta", 61.0666667, 28.1833324, 68.0},
-	{3583, "Finland", "Vasa", 63.0960013, 21.6157722, 7.0},
-	{3584, "Finland", "Kotka", 60.4666667, 26.9166660, 1.0},
-	{3585, "Finland", "Joensuu", 62.6000000, 29.7666664, 92.0},
-	{3586, "Finland", "Hameenlinna", 61.0000000, 24.4500008, 96.0},
-	{3587, "Finland", "Borga", 60.4000000, 25.6666660, 37.0},
-	{3588, "Finland", "Mikkeli", 61.6833333, 27.2500000, 102.0},
-	{3589, "Finland", "Hyvinkaa", 60.6333333, 24.8666668, 113.0},
-	{3590, "Finland", "Jarvenpaa", 60.4666667, 25.1000004, 56.0},
-	{3591, "Finland", "Nurmijarvi", 60.4666667, 24.7999992, 86.0},
-	{3592, "Finland", "Rauma", 61.1333333, 21.5000000, 14.0},
-	{3593, "Finland", "Lojo", 60.2500000, 24.0833340, 63.0},
-	{3594, "Finland", "Karleby", 63.8333333, 23.1166668, 14.0},
-	{3595, "Finland", "Kajaani", 64.2281796, 27.7265739, 145.0},
-	{3596, "Finland", "Jyvaskylan Maalaiskunta", 62.2833333, 25.7166672, 93.0},
-	{3597, "Finland", "Rovaniemi", 66.5000000, 25.7166672, 92.0},
-	{3598, "Finland", "Tuusula", 60.4166667, 25.0333328, 33.0},
-	{3599, "Finland", "Kirkkonummi", 60.1166667, 24.4333324, 16.0},
-	{3600, "Finland", "Seinajoki", 62.8000000, 22.8333340, 50.0},
-	{3601, "Finland", "Kerava", 60.4000000, 25.1166668, 57.0},
-	{3602, "Finland", "Kouvola", 60.8666667, 26.7000008, 81.0},
-	{3603, "Finland", "Imatra", 61.1666667, 28.7666664, 72.0},
-	{3604, "Finland", "Nokia", 61.4666667, 23.5000000, 89.0},
-	{3605, "Finland", "Savonlinna", 61.8666667, 28.8833332, 75.0},
-	{3606, "Finland", "Riihimaki", 60.7500000, 24.7666664, 109.0},
-	{3607, "Finland", "Vihti", 60.4166667, 24.3333340, 51.0},
-	{3608, "Finland", "Salo", 60.3833333, 23.1333332, 20.0},
-	{3609, "Finland", "Kangasala", 61.4666667, 24.0833340, 88.0},
-	{3610, "Finland", "Raisio", 60.4833333, 22.1833324, 14.0},
-	{3611, "Finland", "Iisalmi", 63.5666667, 27.1833324, 100.0},
-	{3612, "Finland", "Varkaus", 62.3166667, 27.9166660, 74.0},
-	{3613, "Finland", "Raahe", 64.6833333, 24.4833336, 5.0},
-	{3614, "Finland", "Ylojarvi", 61.5500000, 23.6000004, 107.0},
-	{3615, "Finland", "Hamina", 60.5666667, 27.2000008, 1.0},
-	{3616, "Finland", "Kaarina", 60.4166667, 22.4166660, 22.0},
-	{3617, "Finland", "Tornio", 65.8500000, 24.1833324, 12.0},
-	{3618, "Finland", "Heinola", 61.2166667, 26.0333328, 88.0},
-	{3619, "Finland", "Hollola", 61.0500000, 25.4333324, 104.0},
-	{3620, "Finland", "Valkeakoski", 61.2666667, 24.0333328, 91.0},
-	{3621, "Finland", "Siilinjarvi", 63.0833333, 27.6666660, 83.0},
-	{3622, "Finland", "Kuusankoski", 60.9000000, 26.6333332, 85.0},
-	{3623, "Finland", "Sibbo", 60.3666667, 25.2666664, 32.0},
-	{3624, "Finland", "Pietarsaari", 63.6666667, 22.7000008, 15.0},
-	{3625, "Finland", "Lempaala", 61.3166667, 23.7500000, 85.0},
-	{3626, "Finland", "Mantsala", 60.6333333, 25.3166676, 59.0},
-	{3627, "Finland", "Forssa", 60.8166667, 23.6333332, 136.0},
-	{3628, "Finland", "Kuusamo", 65.9666667, 29.1833324, 262.0},
-	{3629, "Finland", "Haukipudas", 65.1833333, 25.3500004, 12.0},
-	{3630, "Finland", "Mustasaari", 63.1166667, 21.7166672, 17.0},
-	{3631, "Finland", "Laukaa", 62.4166667, 25.9500008, 104.0},
-	{3632, "Finland", "Anjala", 60.6833333, 26.8333340, 18.0},
-	{3633, "Finland", "Uusikaupunki", 60.8000000, 21.4166660, 10.0},
-	{3634, "Finland", "Janakkala", 60.9000000, 24.6000004, 118.0},
-	{3635, "Finland", "Pirkkala", 61.4666667, 23.5666676, 69.0},
-	{3636, "Finland", "Jamsa", 61.8666667, 25.2000008, 106.0},
-	{3637, "Finland", "Lieto", 60.5000000, 22.4500008, 14.0},
-	{3638, "Finland", "Vammala", 61.3333333, 22.8999996, 68.0},
-	{3639, "Finland", "Nastola", 60.9500000, 25.9333324, 112.0},
-	{3640, "Finland", "Orimattila", 60.8000000, 25.7500000, 86.0},
-	{3641, "Finland", "Kauhajoki", 62.4333333, 22.1833324, 101.0},
-	{3642, "Finland", "Tammisaari", 59.9666667, 23.4333324, -9999.0},
-	{3643, "Finland", "Kempele", 64.9166667, 25.5000000, 9.0},
-	{3644, "Finland", "Lapua", 62.9500000, 23.0000000, 28.0},
-	{3645, "Finland", "Lieksa", 63.3166667, 30.0166664, 95.0},
-	{3646, "Finland", "Naantali", 60.4500000, 22.0333328, 1.0},
-	{3647, "Finland", "Aanekoski", 62.6000000, 25.7333336, 102.0},
-	{3648, "Finland", "Ylivieska", 64.0833333, 24.5499992, 57.0},
-	{3649, "Finland", "Kontiolahti", 62.7666667, 29.8500004, 107.0},
-	{3650, "Finland", "Kankaanpaa", 61.8000000, 22.4166660, 105.0},
-	{3651, "Finland", "Ulvila", 61.4333333, 21.8833332, 5.0},
-	{3652, "Finland", "Pieksamaki", 62.3000000, 27.1333332, 119.0},
-	{3653, "Finland", "Kiiminki", 65.1333333, 25.7333336, 51.0},
-	{3654, "Finland", "Pargas", 60.3000000, 22.2999992, -9999.0},
-	{3655, "Finland", "Nurmo", 62.8333333, 22.8999996, 50.0},
-	{3656, "Finland", "Ilmajoki", 62.7333333, 22.5666676, 41.0},
-	{3657, "Finland", "Liperi", 62.5333333, 29.3666668, 80.0},
-	{3658, "Finland", "Valkeala", 60.9500000, 26.7999992, 75.0},
-	{3659, "Finland", "Keuruu", 62.2666667, 24.7000008, 118.0},
-	{3660, "Finland", "Leppavirta", 62.4833333, 27.7833328, 97.0},
-	{3661, "Finland", "Mariehamn", 60.1000000, 19.9500008, 1.0},
-	{3662, "Finland", "Kurikka", 62.6166667, 22.4166660, 62.0},
-	{3663, "Finland", "Nivala", 63.9166667, 24.9666672, 91.0},
-	{3664, "Finland", "Joutseno", 61.1000000, 28.5000000, 73.0},
-	{3665, "Finland", "Pedersoren Kunta", 63.6500000, 22.6833324, 18.0},
-	{3666, "Finland", "Sotkamo", 64.1333333, 28.4166660, 134.0},
-	{3667, "Finland", "Kuhmo", 64.1333333, 29.5166664, 166.0},
-	{3668, "Finland", "Paimio", 60.4567099, 22.6869392, 43.0},
-	{3669, "Finland", "Saarijarvi", 62.7166667, 25.2666664, 158.0},
-	{3670, "Finland", "Hameenkyro", 61.6333333, 23.2000008, 71.0},
-	{3671, "Finland", "Suomussalmi", 64.8868483, 28.9077759, 193.0},
-	{3672, "France", "Paris", 48.8534100, 2.3487999, 30.0},
-	{3673, "France", "Marseille", 43.3000000, 5.4000001, 54.0},
-	{3674, "France", "Lyon", 45.7500000, 4.8499999, 175.0},
-	{3675, "France", "Toulouse", 43.6042619, 1.4436722, 146.0},
-	{3676, "France", "Nice", 43.7031261, 7.2660828, -9999.0},
-	{3677, "France", "Nantes", 47.2172508, -1.5533638, 16.0},
-	{3678, "France", "Strasbourg", 48.5833333, 7.7500000, 140.0},
-	{3679, "France", "Montpellier", 43.6000000, 3.8833332, 25.0},
-	{3680, "France", "Bordeaux", 44.8333333, -0.5666667, 9.0},
-	{3681, "France", "Lille", 50.6333333, 3.0666666, 25.0},
-	{3682, "France", "Rennes", 48.0833333, -1.6833333, 30.0},
-	{3683, "France", "Reims", 49.2500000, 4.0333333, 83.0},
-	{3684, "France", "Le Havre", 49.4938035, 0.1076746, 5.0},
-	{3685, "France", "Saint-Etienne", 45.4333333, 4.4000001, 598.0},
-	{3686, "France", "Toulon", 43.1166667, 5.9333334, 7.0},
-	{3687, "France", "Angers", 47.4666667, -0.5500000, 39.0},
-	{3688, "France", "Grenoble", 45.1666667, 5.7166667, 215.0},
-	{3689, "France", "Dijon", 47.3166667, 5.0166669, 240.0},
-	{3690, "France", "Nimes", 43.8333333, 4.3499999, 37.0},
-	{3691, "France", "Aix-en-Provence", 43.5282956, 5.4497337, 200.0},
-	{3692, "France", "Brest", 48.4000000, -4.4833331, 76.0},
-	{3693, "France", "Le Mans", 48.0000000, 0.2000000, 50.0},
-	{3694, "France", "Amiens", 49.9000000, 2.3000000, 25.0},
-	{3695, "France", "Tours", 47.3833333, 0.6833333, 49.0},
-	{3696, "France", "Limoges", 45.8500000, 1.2500000, 298.0},
-	{3697, "France", "Clermont-Ferrand", 45.7833333, 3.0833333, 391.0},
-	{3698, "France", "Villeurbanne", 45.7666667, 4.8833332, 173.0},
-	{3699, "France", "Besancon", 47.2500000, 6.0333333, 262.0},
-	{3700, "France", "Orleans", 47.9166667, 1.9000000, 120.0},
-	{3701, "France", "Metz", 49.1191076, 6.1726856, 172.0},
-	{3702, "France", "Rouen", 49.4431288, 1.0993195, 32.0},
-	{3703, "France", "Mulhouse", 47.7500000, 7.3333335, 242.0},
-	{3704, "France", "Perpignan", 42.6976397, 2.8954124, 45.0},
-	{3705, "France", "Caen", 49.1810862, -0.3712177, 5.0},
-	{3706, "France", "Boulogne-Billancourt", 48.8333333, 2.2500000, 30.0},
-	{3707, "France", "Nancy", 48.6833333, 6.1999998, 200.0},
-	{3708, "France", "Argenteuil", 48.9500000, 2.2500000, 34.0},
-	{3709, "France", "Saint-Denis", 48.9333333, 2.3666668, 35.0},
-	{3710, "France", "Roubaix", 50.7000000, 3.1666667, 32.0},
-	{3711, "France", "Tourcoing", 50.7166667, 3.1500001, 37.0},
-	{3712, "France", "Montreuil", 48.8666667, 2.4333334, 108.0},
-	{3713, "France", "Avignon", 43.9500000, 4.8166666, 21.0},
-	{3714, "France", "Asnieres-sur-Seine", 48.9166667, 2.2833333, 30.0},
-	{3715, "France", "Nanterre", 48.9000000, 2.2000000, 40.0},
-	{3716, "France", "Poitiers", 46.5833333, 0.3333333, 114.0},
-	{3717, "France", "Versailles", 48.8000000, 2.1333332, 135.0},
-	{3718, "France", "Creteil", 48.7833333, 2.4666667, 43.0},
-	{3719, "France", "Pau", 43.3000000, -0.3666667, 203.0},
-	{3720, "France", "Colombes", 48.9166667, 2.2500000, 35.0},
-	{3721, "France", "Vitry-sur-Seine", 48.7833333, 2.4000001, 35.0},
-	{3722, "France", "Aulnay-sous-Bois", 48.9500000, 2.5166667, 57.0},
-	{3723, "France", "La Rochelle", 46.1666667, -1.1500000, 15.0},
-	{3724, "France", "Champigny-sur-Marne", 48.8166667, 2.5166667, 74.0},
-	{3725, "France", "Rueil-Malmaison", 48.8833333, 2.2000000, 90.0},
-	{3726, "France", "Antibes", 43.5833333, 7.1166668, 1.0},
-	{3727, "France", "Saint-Maur-des-Fosses", 48.8000000, 2.5000000, 38.0},
-	{3728, "France", "Calais", 50.9581023, 1.8520546, 4.0},
-	{3729, "France", "Beziers", 43.3500000, 3.2500000, 53.0},
-	{3730, "France", "Dunkerque", 51.0500000, 2.3666668, 2.0},
-	{3731, "France", "Aubervilliers", 48.9166667, 2.3833332, 35.0},
-	{3732, "France", "Cannes", 43.5500000, 7.0166669, -9999.0},
-	{3733, "France", "Merignac", 44.8333333, -0.6333333, 25.0},
-	{3734, "France", "Bourges", 47.0833333, 2.4000001, 140.0},
-	{3735, "France", "Saint-Nazaire", 47.2833333, -2.2000000, 1.0},
-	{3736, "France", "Colmar", 48.0833333, 7.3666668, 187.0},
-	{3737, "France", "Valence", 44.9333333, 4.9000001, 151.0},
-	{3738, "France", "Quimper", 48.0000000, -4.0999999, 10.0},
-	{3739, "France", "Drancy", 48.9333333, 2.4500000, 44.0},
-	{3740, "France", "Noisy-le-Grand", 48.8500000, 2.5666666, 89.0},
-	{3741, "France", "La Seyne-sur-Mer", 43.1000000, 5.8833332, 20.0},
-	{3742, "France", "Levallois-Perret", 48.9000000, 2.2833333, 30.0},
-	{3743, "France", "Chambery", 45.5666667, 5.9333334, 281.0},
-	{3744, "France", "Issy-les-Moulineaux", 48.8166667, 2.2666667, 60.0},
-	{3745, "France", "Neuilly-sur-Seine", 48.8833333, 2.2666667, 38.0},
-	{3746, "France", "Troyes", 48.3000000, 4.0833335, 106.0},
-	{3747, "France", "Antony", 48.7500000, 2.3000000, 59.0},
-	{3748, "France", "La Roche-sur-Yon", 46.6666667, -1.4333333, 56.0},
-	{3749, "France", "Lorient", 47.7500000, -3.3666668, 6.0},
-	{3750, "France", "Sarcelles", 49.0000000, 2.3833332, 75.0},
-	{3751, "France", "Pessac", 44.8000000, -0.6166667, 34.0},
-	{3752, "France", "Ivry-sur-Seine", 48.8166667, 2.3833332, 33.0},
-	{3753, "France", "Evreux", 49.0166667, 1.1500000, 117.0},
-	{3754, "France", "Venissieux", 45.6833333, 4.8833332, 209.0},
-	{3755, "France", "Clichy", 48.9000000, 2.3000000, 33.0},
-	{3756, "France", "Cergy-Pontoise", 49.0411735, 2.0810723, 45.0},
-	{3757, "France", "Saint-Quentin", 49.8488865, 3.2875729, 82.0},
-	{3758, "France", "Niort", 46.3166667, -0.4666667, 25.0},
-	{3759, "France", "Belfort", 47.6333333, 6.8666668, 412.0},
-	{3760, "France", "Ajaccio", 41.9166667, 8.7333336, -9999.0},
-	{3761, "France", "Vannes", 47.6666667, -2.7500000, 20.0},
-	{3762, "France", "Sartrouville", 48.9500000, 2.1833334, 55.0},
-	{3763, "France", "Maisons-Alfort", 48.8000000, 2.4333334, 34.0},
-	{3764, "France", "Meaux", 48.9500000, 2.8666668, 66.0},
-	{3765, "France", "Blois", 47.5833333, 1.3333333, 70.0},
-	{3766, "France", "Brive-la-Gaillarde", 45.1500000, 1.5333333, 186.0},
-	{3767, "France", "Arles", 43.6768113, 4.6303082, 5.0},
-	{3768, "France", "Beauvais", 49.4333333, 2.0833333, 65.0},
-	{3769, "France", "Chateauroux", 46.8166667, 1.7000000, 146.0},
-	{3770, "France", "Cholet", 47.0666667, -0.8833333, 124.0},
-	{3771, "Gabon", "Libreville", 0.3833333, 9.4499998, -9999.0},
-	{3772, "Gabon", "Port-Gentil", -0.7166667, 8.7833328, 1.0},
-	{3773, "Gabon", "Franceville", -1.6333333, 13.5833330, 332.0},
-	{3774, "Gabon", "Oyem", 1.6166667, 11.5833330, 552.0},
-	{3775, "Gabon", "Moanda", -1.5666667, 13.1999998, 331.0},
-	{3776, "Gabon", "Mouila", -1.8666667, 11.0166664, 157.0},
-	{3777, "Gabon", "Lambarene", -0.7000000, 10.2166672, 46.0},
-	{3778, "Gabon", "Tchibanga", -2.8500000, 11.0333328, 163.0},
-	{3779, "Gabon", "Koulamoutou", -1.1333333, 12.4833336, 350.0},
-	{3780, "Gabon", "Makokou", 0.5666667, 12.8666668, 356.0},
-	{3781, "Gabon", "Bitam", 2.0833333, 11.4833336, 448.0},
-	{3782, "Gabon", "Gamba", -2.6500000, 10.0000000, 8.0},
-	{3783, "Gabon", "Mounana", -1.4333333, 13.1666670, 304.0},
-	{3784, "Gabon", "Ntoum", 0.3833333, 9.7833328, 171.0},
-	{3785, "Gabon", "Lastoursville", -0.8166667, 12.6999998, 207.0},
-	{3786, "Gabon", "Okondja", -0.6833333, 13.7833328, 336.0},
-	{3787, "Gabon", "Ndende", -2.3833333, 11.3833332, 540.0},
-	{3788, "Gabon", "Booue", -0.1000000, 11.9333334, 179.0},
-	{3789, "Gabon", "Fougamou", -1.2166667, 10.6000004, 108.0},
-	{3790, "Gabon", "Ndjole", -0.1833333, 10.7500000, 112.0},
-	{3791, "Gabon", "Mbigou", -1.8833333, 11.9333334, 833.0},
-	{3792, "Gabon", "Mayumba", -3.4166667, 10.6499996, 1.0},
-	{3793, "Gabon", "Mitzic", 0.7833333, 11.5666666, 550.0},
-	{3794, "Gabon", "Lekoni", -1.5833333, 14.2333336, 562.0},
-	{3795, "Gabon", "Mimongo", -1.6333333, 11.6499996, 627.0},
-	{3796, "Gabon", "Omboue", -1.5666667, 9.2500000, 1.0},
-	{3797, "Gabon", "Cocobeach", 0.9833333, 9.6000004, 177.0},
-	{3798, "Gambia, The", "Brikama", 13.2675000, -16.6461105, 33.0},
-	{3799, "Gambia, The", "Bakau", 13.4780556, -16.6819439, 33.0},
-	{3800, "Gambia, The", "Banjul", 13.4564084, -16.5812874, 5.0},
-	{3801, "Gambia, The", "Farafenni", 13.5666667, -15.6000004, 26.0},
-	{3802, "Gambia, The", "Lamin", 13.3522222, -16.4338894, 27.0},
-	{3803, "Gambia, The", "Sukuta", 13.4113889, -16.7088890, 22.0},
-	{3804, "Gambia, The", "Basse Santa Su", 13.3166667, -14.2166672, 23.0},
-	{3805, "Gambia, The", "Gunjur", 13.2019444, -16.7338886, 34.0},
-	{3806, "Gambia, The", "Soma", 13.4333333, -15.5333328, 33.0},
-	{3807, "Gambia, The", "Sabi", 13.2333333, -14.1999998, 30.0},
-	{3808, "Gambia, The", "Bansang", 13.4333333, -14.6499996, 5.0},
-	{3809, "Gambia, The", "Gambissara", 13.2333333, -14.3166666, 49.0},
-	{3810, "Gambia, The", "Abuko", 13.4041667, -16.6558342, 27.0},
-	{3811, "Gambia, The", "Essau", 13.4838889, -16.5347214, 2.0},
-	{3812, "Gambia, The", "Barra", 13.4827778, -16.5455551, 1.0},
-	{3813, "Gambia, The", "Demba Kunda", 13.2500000, -14.2666664, 20.0},
-	{3814, "Gambia, The", "Koina", 13.4833333, -13.8666668, 13.0},
-	{3815, "Gambia, The", "Diabugu", 13.3833333, -14.3999996, 12.0},
-	{3816, "Gambia, The", "Georgetown", 13.5333333, -14.7666664, 6.0},
-	{3817, "Gambia, The", "Sami", 13.5833333, -15.1999998, 22.0},
-	{3818, "Gambia, The", "Kerewan", 13.4894444, -16.0911102, 7.0},
-	{3819, "Gambia, The", "Jarreng", 13.6166667, -15.1833334, 10.0},
-	{3820, "Gambia, The", "Sutukoba", 13.5000000, -14.0166664, 21.0},
-	{3821, "Gambia, The", "Somita", 13.2058333, -16.3055553, 41.0},
-	{3822, "Gambia, The", "Kuntaur", 13.6666667, -14.8833332, 1.0},
-	{3823, "Gambia, The", "Nyamanari", 13.3333333, -13.8666668, 37.0},
-	{3824, "Gambia, The", "Baro Kunda", 13.4833333, -15.2666664, 31.0},
-	{3825, "Gambia, The", "Bakadagy", 13.3000000, -14.3833332, 15.0},
-	{3826, "Gambia, The", "Saba", 13.5163889, -16.0491676, 1.0},
-	{3827, "Gambia, The", "Saruja", 13.5500000, -14.9166670, 25.0},
-	{3828, "Gambia, The", "No Kunda", 13.5666667, -15.8333330, 33.0},
-	{3829, "Gambia, The", "Galleh Manda", 13.4333333, -14.7833328, 49.0},
-	{3830, "Gambia, The", "Sankwia", 13.4666667, -15.5166664, 2.0},
-	{3831, "Gambia, The", "Kaiaf", 13.4000000, -15.6166668, 52.0},
-	{3832, "Gambia, The", "Jakhaly", 13.5500000, -14.9666672, 20.0},
-	{3833, "Gambia, The", "Keneba", 13.3288889, -16.0149994, 32.0},
-	{3834, "Gambia, The", "Kumbija", 13.2666667, -14.1833334, 26.0},
-	{3835, "Gambia, The", "Toniataba", 13.4333333, -15.5833330, 22.0},
-	{3836, "Gambia, The", "Karantaba", 13.4333333, -15.5166664, 24.0},
-	{3837, "Gambia, The", "Nioro", 13.3500000, -15.7500000, 33.0},
-	{3838, "Gambia, The", "Katchang", 13.5000000, -15.7500000, 26.0},
-	{3839, "Gambia, The", "Denton", 13.5000000, -14.9333334, 20.0},
-	{3840, "Gambia, The", "Dankunku", 13.5666667, -15.3166666, 25.0},
-	{3841, "Gambia, The", "Gunjur Kuta", 13.5333333, -14.1166668, 30.0},
-	{3842, "Gambia, The", "Sutukung", 13.4666667, -15.2666664, 18.0},
-	{3843, "Gambia, The", "Sudowol", 13.3666667, -13.9666672, 38.0},
-	{3844, "Gambia, The", "Wellingara Ba", 13.4166667, -15.3999996, 31.0},
-	{3845, "Gambia, The", "Bureng", 13.4166667, -15.2833328, 22.0},
-	{3846, "Gambia, The", "Wassu", 13.6833333, -14.8833332, 1.0},
-	{3847, "Gambia, The", "Sukuta", 13.6166667, -14.9166670, 13.0},
-	{3848, "Gambia, The", "Si Kunda", 13.4333333, -15.5666666, 38.0},
-	{3849, "Gambia, The", "Gunjur", 13.5227778, -16.0277786, 30.0},
-	{3850, "Gambia, The", "Sara Kunda", 13.5333333, -15.4166670, 23.0},
-	{3851, "Gambia, The", "Sun Kunda", 13.3833333, -13.8500004, 60.0},
-	{3852, "Gambia, The", "Daba Kunda", 13.3166667, -14.3000002, 8.0},
-	{3853, "Gambia, The", "Brifu", 13.5000000, -13.9333334, 54.0},
-	{3854, "Gambia, The", "Bambali", 13.4833333, -15.3333330, 23.0},
-	{3855, "Gambia, The", "Jenoi", 13.4833333, -15.5666666, 2.0},
-	{3856, "Gambia, The", "Chilla", 13.5500000, -16.2833328, 40.0},
-	{3857, "Gambia, The", "Kunting", 13.5333333, -14.6666670, 6.0},
-	{3858, "Gambia, The", "Bantanto", 13.4166667, -14.6499996, 23.0},
-	{3859, "Gambia, The", "Diabugu Basilla", 13.3333333, -13.9499998, 19.0},
-	{3860, "Gambia, The", "Pateh Sam", 13.6166667, -15.0666666, 15.0},
-	{3861, "Gambia, The", "Kass Wollof", 13.7833333, -14.9333334, 36.0},
-	{3862, "Gambia, The", "Brikama Nding", 13.5333333, -14.9333334, 28.0},
-	{3863, "Gambia, The", "Daru Rilwan", 13.5500000, -15.9833336, 47.0},
-	{3864, "Gambia, The", "Jifarong", 13.3000000, -15.8666668, 8.0},
-	{3865, "Gambia, The", "Perai", 13.3833333, -14.0333328, 16.0},
-	{3866, "Gambia, The", "Jali", 13.3500000, -15.9666672, 38.0},
-	{3867, "Gambia, The", "Banto Nding", 13.4666667, -14.0833330, 22.0},
-	{3868, "Gambia, The", "Sintet", 13.2333333, -15.8166666, 6.0},
-	{3869, "Gambia, The", "Sanunding", 13.2833333, -14.1166668, 8.0},
-	{3870, "Gambia, The", "Massembe", 13.4166667, -15.6333332, 2.0},
-	{3871, "Gambia, The", "Tankular", 13.4177778, -16.0369453, -9999.0},
-	{3872, "Gambia, The", "Bajana", 13.3166667, -15.8833332, 46.0},
-	{3873, "Gambia, The", "Sambang", 13.5333333, -15.3333330, 2.0},
-	{3874, "Gambia, The", "Jakaba", 13.6500000, -14.8833332, 17.0},
-	{3875, "Gambia, The", "Fass", 13.8000000, -15.0666666, 29.0},
-	{3876, "Gambia, The", "Dumbutu", 13.3500000, -15.8333330, 39.0},
-	{3877, "Gambia, The", "Bati Ndar", 13.7000000, -15.1999998, 8.0},
-	{3878, "Gambia, The", "Nema Kunku", 13.2500000, -15.8833332, 16.0},
-	{3879, "Gambia, The", "Madina Tunjang", 13.4333333, -14.7500000, 36.0},
-	{3880, "Gambia, The", "Janneh Kunda", 13.3802778, -16.1383324, 12.0},
-	{3881, "Gambia, The", "Bantang Killing", 13.5833333, -15.6999998, 39.0},
-	{3882, "Gambia, The", "Jarrol", 13.2441229, -15.8454609, 36.0},
-	{3883, "Gambia, The", "Sulolor", 13.4500000, -14.6666670, 53.0},
-	{3884, "Gambia, The", "Tambana", 13.5052778, -16.1744442, 34.0},
-	{3885, "Gambia, The", "Buniadu", 13.5500000, -15.3333330, 20.0},
-	{3886, "Gambia, The", "Madina", 13.7000000, -14.8833332, 12.0},
-	{3887, "Gambia, The", "Medina Kanuma", 13.4927778, -16.5233326, 21.0},
-	{3888, "Gambia, The", "Jassong", 13.4000000, -15.3166666, 44.0},
-	{3889, "Gambia, The", "Jiffin", 13.4166667, -15.5833330, 34.0},
-	{3890, "Gambia, The", "Dobbo", 13.4666667, -14.6333332, 15.0},
-	{3891, "Gambia, The", "Mara Magai", 13.5166667, -14.9166670, 11.0},
-	{3892, "Gambia, The", "Diganteh", 13.3666667, -15.4333334, 41.0},
-	{3893, "Gambia, The", "Cha Kunda", 13.3833333, -14.5333328, 9.0},
-	{3894, "Gambia, The", "Tuba-Wuli", 13.4333333, -14.2500000, 8.0},
-	{3895, "Gambia, The", "Sibito", 13.3833333, -15.7333336, 42.0},
-	{3896, "Gambia, The", "Tuba Koto", 13.6333333, -14.8999996, 27.0},
-	{3897, "Georgia", "Tbilisi", 41.7250000, 44.7908325, 420.0},
-	{3898, "Georgia", "Sokhumi", 43.0033333, 41.0152779, 19.0},
-	{3899, "Georgia", "Zugdidi", 42.5122222, 41.8691673, 128.0},
-	{3900, "Georgia", "Gori", 41.9736111, 44.1111107, 576.0},
-	{3901, "Georgia", "Samtredia", 42.1537005, 42.3351707, 33.0},
-	{3902, "Georgia", "Khashuri", 41.9911111, 43.5900002, 695.0},
-	{3903, "Georgia", "Senaki", 42.2688889, 42.0791664, 36.0},
-	{3904, "Georgia", "Stantsiya Novyy Afon", 43.0805556, 40.8383331, 22.0},
-	{3905, "Georgia", "Marneuli", 41.4636111, 44.8108330, 400.0},
-	{3906, "Georgia", "Kobuleti", 41.8200000, 41.7752762, 3.0},
-	{3907, "Georgia", "Tsqaltubo", 42.3397222, 42.5991669, 154.0},
-	{3908, "Georgia", "Tskhinvali", 42.2300000, 43.9700012, 873.0},
-	{3909, "Georgia", "Kaspi", 41.9161111, 44.4241676, 523.0},
-	{3910, "Georgia", "Borjomi", 41.8375000, 43.3791656, 864.0},
-	{3911, "Georgia", "Tqibuli", 42.3441667, 42.9900017, 556.0},
-	{3912, "Georgia", "Sagarejo", 41.7363889, 45.3302765, 764.0},
-	{3913, "Georgia", "Gali", 42.6261111, 41.7324982, 63.0},
-	{3914, "Georgia", "Gardabani", 41.4547222, 45.0947227, 312.0},
-	{3915, "Georgia", "Khoni", 42.3241667, 42.4300003, 121.0},
-	{3916, "Georgia", "Lentekhi", 42.7855556, 42.7261124, 844.0},
-	{3917, "Georgia", "Surami", 42.0225000, 43.5555573, 731.0},
-	{3918, "Georgia", "Gurjaani", 41.7430556, 45.8027763, 375.0},
-	{3919, "Georgia", "Tsalenjikha", 42.6044444, 42.0772209, 254.0},
-	{3920, "Georgia", "Qvareli", 41.9513889, 45.8016663, 453.0},
-	{3921, "Georgia", "Akhmeta", 42.0311111, 45.2075005, 572.0},
-	{3922, "Georgia", "Gagra", 43.3283333, 40.2238884, 177.0},
-	{3923, "Georgia", "Dioknisi", 41.6311111, 42.4152794, 1262.0},
-	{3924, "Georgia", "Tsnori", 41.6169444, 45.9719429, 290.0},
-	{3925, "Georgia", "Lagodekhi", 41.8227778, 46.2755547, 438.0},
-	{3926, "Georgia", "Abasha", 42.1941667, 42.2241669, 30.0},
-	{3927, "Georgia", "Ninotsminda", 41.2669444, 43.5905571, 1923.0},
-	{3928, "Georgia", "Agara", 42.0350000, 43.8352776, 633.0},
-	{3929, "Georgia", "Martvili", 42.4152778, 42.3738899, 196.0},
-	{3930, "Georgia", "Khobi", 42.3180556, 41.8980560, 14.0},
-	{3931, "Georgia", "Naghvarevi", 41.3494444, 44.7563896, 564.0},
-	{3932, "Georgia", "Patara-Kharagauli", 42.0166667, 43.2166672, 598.0},
-	{3933, "Georgia", "Manglisi", 41.6930556, 44.3780556, 1188.0},
-	{3934, "Georgia", "Jvari", 42.7138889, 42.0536118, 389.0},
-	{3935, "Georgia", "Vale", 41.6186111, 42.8627777, 1134.0},
-	{3936, "Georgia", "Bagdadi", 41.8166667, 46.0333328, 254.0},
-	{3937, "Georgia", "Vani", 42.0813889, 42.5244446, 91.0},
-	{3938, "Georgia", "Aspindza", 41.5738889, 43.2561111, 1183.0},
-	{3939, "Georgia", "Akhaldaba", 41.6530556, 42.1580544, 867.0},
-	{3940, "Georgia", "Zhinvali", 42.1158333, 44.7675018, 703.0},
-	{3941, "Georgia", "Didi Lilo", 41.7363889, 44.9658318, 782.0},
-	{3942, "Georgia", "Mestia", 43.0455556, 42.7297211, 1417.0},
-	{3943, "Georgia", "Naruja", 41.9069444, 41.9541664, 115.0},
-	{3944, "Georgia", "Dmanisi", 41.5227778, 44.3533325, 1165.0},
-	{3945, "Georgia", "Oni", 42.5794444, 43.4425011, 952.0},
-	{3946, "Georgia", "Tsaghveri", 41.8047222, 43.4819450, 1107.0},
-	{3947, "Georgia", "Makhinjauri", 41.6752778, 41.6947212, 47.0},
-	{3948, "Georgia", "Kulashi", 42.2041667, 42.3566666, 46.0},
-	{3949, "Georgia", "Ambrolauri", 42.5211111, 43.1622238, 654.0},
-	{3950, "Georgia", "Bakuriani", 41.7497222, 43.5325012, 1692.0},
-	{3951, "Georgia", "Zahesi", 41.8244444, 44.7577782, 494.0},
-	{3952, "Georgia", "Sighnaghi", 41.6188889, 45.9216652, 654.0},
-	{3953, "Georgia", "Shorapani", 42.0958333, 43.1155548, 228.0},
-	{3954, "Georgia", "Ureki", 41.9955556, 41.7786102, 39.0},
-	{3955, "Georgia", "Tsalka", 41.5913889, 44.0869446, 1570.0},
-	{3956, "Georgia", "Akhalgori", 42.1241667, 44.4858322, 1041.0},
-	{3957, "Georgia", "Adigeni", 41.6858333, 42.7102776, 1347.0},
-	{3958, "Georgia", "Abastumani", 41.7000000, 42.8499985, 1248.0},
-	{3959, "Georgia", "Bakurianis Andeziti", 41.7338889, 43.4702797, 1600.0},
-	{3960, "Georgia", "Sioni", 42.6072222, 44.5852776, 1944.0},
-	{3961, "Georgia", "Khulo", 41.6463889, 42.3111115, 776.0},
-	{3962, "Georgia", "Kojori", 41.6608333, 44.6916656, 1331.0},
-	{3963, "Georgia", "Java", 42.3997222, 43.9366684, 1280.0},
-	{3964, "Georgia", "Shuakhevi", 41.6300000, 42.1908340, 520.0},
-	{3965, "Georgia", "Kvaisi", 42.5230556, 43.6486130, 1473.0},
-	{3966, "Georgia", "Bediani", 41.5441667, 44.2444458, 969.0},
-	{3967, "Georgia", "Qornisi", 42.2708333, 43.8175011, 1028.0},
-	{3968, "Georgia", "Lajanurhesi", 42.6180556, 42.8355560, 808.0},
-	{3969, "Georgia", "Shatili", 42.6583333, 45.1599998, 1952.0},
-	{3970, "Germany", "Berlin", 52.5166667, 13.3999996, 35.0},
-	{3971, "Germany", "Hamburg", 53.5500000, 10.0000000, 3.0},
-	{3972, "Germany", "Muenchen", 48.1376831, 11.5743542, 515.0},
-	{3973, "Germany", "Koeln", 50.9333333, 6.9499998, 46.0},
-	{3974, "Germany", "Frankfurt am Main", 50.1166667, 8.6833334, 119.0},
-	{3975, "Germany", "Essen", 51.4500000, 7.0166669, 107.0},
-	{3976, "Germany", "Stuttgart", 48.7823243, 9.1770172, 255.0},
-	{3977, "Germany", "Dortmund", 51.5166667, 7.4499998, 95.0},
-	{3978, "Germany", "Dusseldorf", 51.2217226, 6.7761612, 42.0},
-	{3979, "Germany", "Bremen", 53.0751557, 8.8077736, 3.0},
-	{3980, "Germany", "Hannover", 52.3705163, 9.7332211, 54.0},
-	{3981, "Germany", "Leipzig", 51.3396200, 12.3712921, 110.0},
-	{3982, "Germany", "Duisburg", 51.4333333, 6.7500000, 33.0},
-	{3983, "Germany", "Nuernberg", 49.4477778, 11.0683336, 306.0},
-	{3984, "Germany", "Dresden", 51.0508911, 13.7383175, 110.0},
-	{3985, "Germany", "Bochum", 51.4833333, 7.2166667, 104.0},
-	{3986, "Germany", "Wuppertal", 51.2666667, 7.1833334, 175.0},
-	{3987, "Germany", "Bielefeld", 52.0333333, 8.5333328, 113.0},
-	{3988, "Germany", "Bonn", 50.7333333, 7.0999999, 54.0},
-	{3989, "Germany", "Mannheim", 49.4883333, 8.4647226, 95.0},
-	{3990, "Germany", "Karlsruhe", 49.0047222, 8.3858337, 116.0},
-	{3991, "Germany", "Wiesbaden", 50.0833333, 8.2500000, 182.0},
-	{3992, "Germany", "Munster", 51.9623559, 7.6257133, 56.0},
-	{3993, "Germany", "Gelsenkirchen-Alt", 51.5166667, 7.1166668, 51.0},
-	{3994, "Germany", "Aachen", 50.7766356, 6.0834217, 177.0},
-	{3995, "Germany", "Monchengladbach", 51.2000000, 6.4333334, 60.0},
-	{3996, "Germany", "Augsburg", 48.3666667, 10.8833332, 485.0},
-	{3997, "Germany", "Chemnitz", 50.8333333, 12.9166670, 297.0},
-	{3998, "Germany", "Braunschweig", 52.2666667, 10.5333328, 71.0},
-	{3999, "Germany", "Halle-Neustadt", 51.4833333, 11.9333334, 74.0},
-	{4000, "Germany", "Krefeld", 51.3333333, 6.5666666, 37.0},
-	{4001, "Germany", "Halle", 51.5000000, 12.0000000, 105.0},
-	{4002, "Germany", "Kiel", 54.3213293, 10.1348877, -9999.0},
-	{4003, "Germany", "Magdeburg", 52.1666667, 11.6666670, 42.0},
-	{4004, "Germany", "Neustadt", 52.1500000, 11.6333332, 49.0},
-	{4005, "Germany", "Oberhausen", 51.4666667, 6.8499999, 41.0},
-	{4006, "Germany", "Freiburg", 47.9958954, 7.8522205, 347.0},
-	{4007, "Germany", "Lubeck", 53.8689270, 10.6872940, 4.0},
-	{4008, "Germany", "Erfurt", 50.9833333, 11.0333328, 194.0},
-	{4009, "Germany", "Hagen", 51.3500000, 7.4666667, 196.0},
-	{4010, "Germany", "Rostock", 54.0886975, 12.1404934, 1.0},
-	{4011, "Germany", "Kassel", 51.3166667, 9.5000000, 152.0},
-	{4012, "Germany", "Hamm", 51.6803258, 7.8208923, 61.0},
-	{4013, "Germany", "Mainz", 50.0000000, 8.2711115, 98.0},
-	{4014, "Germany", "Saarbrucken", 49.2333333, 7.0000000, 200.0},
-	{4015, "Germany", "Herne", 51.5500000, 7.2166667, 61.0},
-	{4016, "Germany", "Mulheim an der Ruhr", 51.4333333, 6.8833332, 63.0},
-	{4017, "Germany", "Osnabruck", 52.2666667, 8.0500002, 65.0},
-	{4018, "Germany", "Solingen", 51.1833333, 7.0833335, 219.0},
-	{4019, "Germany", "Ludwigshafen am Rhein", 49.4811111, 8.4352779, 94.0},
-	{4020, "Germany", "Leverkusen", 51.0333333, 7.0000000, 48.0},
-	{4021, "Germany", "Oldenburg", 53.1666667, 8.1999998, 10.0},
-	{4022, "Germany", "Neuss", 51.2000000, 6.6833334, 40.0},
-	{4023, "Germany", "Potsdam", 52.3988578, 13.0656624, 30.0},
-	{4024, "Germany", "Heidelberg", 49.4076783, 8.6907864, 150.0},
-	{4025, "Germany", "Paderborn", 51.7190528, 8.7543869, 119.0},
-	{4026, "Germany", "Darmstadt", 49.8705556, 8.6494446, 153.0},
-	{4027, "Germany", "Wurzburg", 49.7877778, 9.9361115, 200.0},
-	{4028, "Germany", "Regensburg", 49.0150000, 12.0955553, 338.0},
-	{4029, "Germany", "Wolfsburg", 52.4333333, 10.8000002, 57.0},
-	{4030, "Germany", "Recklinghausen", 51.6166667, 7.1999998, 97.0},
-	{4031, "Germany", "Gottingen", 51.5333333, 9.9333334, 154.0},
-	{4032, "Germany", "Heilbronn", 49.1402778, 9.2200003, 176.0},
-	{4033, "Germany", "Ingolstadt", 48.7666667, 11.4333334, 368.0},
-	{4034, "Germany", "Ulm", 48.3984084, 9.9915504, 471.0},
-	{4035, "Germany", "Bottrop", 51.5166667, 6.9166665, 60.0},
-	{4036, "Germany", "Pforzheim", 48.8833333, 8.6999998, 349.0},
-	{4037, "Germany", "Offenbach", 50.1000000, 8.7666664, 109.0},
-	{4038, "Germany", "Bremerhaven", 53.5500000, 8.5833330, 3.0},
-	{4039, "Germany", "Remscheid", 51.1833333, 7.1999998, 325.0},
-	{4040, "Germany", "Reutlingen", 48.4914400, 9.2042685, 396.0},
-	{4041, "Germany", "Furth", 49.4759325, 10.9885597, 288.0},
-	{4042, "Germany", "Moers", 51.4500000, 6.6500001, 27.0},
-	{4043, "Germany", "Koblenz", 50.3500000, 7.5999999, 160.0},
-	{4044, "Germany", "Siegen", 50.8666667, 8.0333328, 305.0},
-	{4045, "Germany", "Bergisch Gladbach", 50.9833333, 7.1333332, 93.0},
-	{4046, "Germany", "Jena", 50.9333333, 11.5833330, 149.0},
-	{4047, "Germany", "Gera", 50.8666667, 12.0833330, 218.0},
-	{4048, "Germany", "Hildesheim", 52.1500000, 9.9666672, 129.0},
-	{4049, "Germany", "Erlangen", 49.5897222, 11.0038891, 282.0},
-	{4050, "Germany", "Witten", 51.4333333, 7.3333335, 89.0},
-	{4051, "Germany", "Trier", 49.7556526, 6.6393471, 149.0},
-	{4052, "Germany", "Zwickau", 50.7333333, 12.5000000, 302.0},
-	{4053, "Germany", "Kaiserslautern", 49.4500000, 7.7500000, 246.0},
-	{4054, "Germany", "Iserlohn", 51.3666667, 7.6999998, 273.0},
-	{4055, "Germany", "Schwerin", 53.6333333, 11.3833332, 53.0},
-	{4056, "Germany", "Gutersloh", 51.9000000, 8.3833332, 75.0},
-	{4057, "Germany", "Duren", 50.8000000, 6.4833331, 130.0},
-	{4058, "Germany", "Esslingen", 48.7396066, 9.3047333, 247.0},
-	{4059, "Germany", "Ratingen", 51.2972421, 6.8492889, 59.0},
-	{4060, "Germany", "Marl", 51.6500000, 7.0833335, 63.0},
-	{4061, "Germany", "Lunen", 51.6166667, 7.5166669, 54.0},
-	{4062, "Germany", "Hanau am Main", 50.1333333, 8.9166670, 103.0},
-	{4063, "Germany", "Velbert", 51.3333333, 7.0500002, 247.0},
-	{4064, "Germany", "Ludwigsburg", 48.8973114, 9.1916084, 289.0},
-	{4065, "Germany", "Flensburg", 54.7833333, 9.4333334, 26.0},
-	{4066, "Germany", "Cottbus", 51.7666667, 14.3333330, 70.0},
-	{4067, "Germany", "Wilhelmshaven", 53.5166667, 8.1333332, -9999.0},
-	{4068, "Germany", "Tubingen", 48.5226590, 9.0522194, 338.0},
-	{4069, "Germany", "Minden", 52.2833333, 8.9166670, 41.0},
-	{4070, "Ghana", "Accra", 5.5500000, -0.2166667, 104.0},
-	{4071, "Ghana", "Kumasi", 6.6833333, -1.6166667, 247.0},
-	{4072, "Ghana", "Tamale", 9.4000000, -0.8333333, 152.0},
-	{4073, "Ghana", "Takoradi", 4.8833333, -1.7500000, -9999.0},
-	{4074, "Ghana", "Achiaman", 5.7000000, -0.3333333, 72.0},
-	{4075, "Ghana", "Tema", 5.6166667, -0.0166667, 79.0},
-	{4076, "Ghana", "Teshi", 5.5833333, -0.1000000, 69.0},
-	{4077, "Ghana", "Cape Coast", 5.1000000, -1.2500000, -9999.0},
-	{4078, "Ghana", "Sekondi", 4.9333333, -1.7000000, -9999.0},
-	{4079, "Ghana", "Obuasi", 6.2000000, -1.6666667, 241.0},
-	{4080, "Ghana", "Madina", 5.6833333, -0.1666667, 76.0},
-	{4081, "Ghana", "Koforidua", 6.0833333, -0.2500000, 239.0},
-	{4082, "Ghana", "Wa", 10.0500000, -2.4833333, 306.0},
-	{4083, "Ghana", "Nungua", 5.6000000, -0.0666667, 68.0},
-	{4084, "Ghana", "Sunyani", 7.3333333, -2.3333333, 304.0},
-	{4085, "Ghana", "Ho", 6.6000000, 0.4666667, 154.0},
-	{4086, "Ghana", "Techiman", 7.5833333, -1.9333333, 385.0},
-	{4087, "Ghana", "Bawku", 11.0580556, -0.2416667, 229.0},
-	{4088, "Ghana", "Bolgatanga", 10.7855556, -0.8513889, 180.0},
-	{4089, "Ghana", "Tafo", 6.7333333, -1.6166667, 277.0},
-	{4090, "Ghana", "Swedru", 5.5333333, -0.7000000, 75.0},
-	{4091, "Ghana", "Dom", 5.6500000, -0.2333333, 73.0},
-	{4092, "Ghana", "Nkawkaw", 6.5500000, -0.7666667, 275.0},
-	{4093, "Ghana", "Berekum", 7.4500000, -2.5833333, 305.0},
-	{4094, "Ghana", "Gbawe", 5.5833333, -0.3000000, 122.0},
-	{4095, "Ghana", "Winneba", 5.3333333, -0.6166667, -9999.0},
-	{4096, "Ghana", "Oduponkpehe", 5.5333333, -0.4166667, 75.0},
-	{4097, "Ghana", "Ejura", 7.3833333, -1.3666667, 227.0},
-	{4098, "Ghana", "Oda", 5.9166667, -0.9833333, 194.0},
-	{4099, "Ghana", "Yendi", 9.4333333, -0.0166667, 157.0},
-	{4100, "Ghana", "Hohoe", 7.1500000, 0.4666667, 170.0},
-	{4101, "Ghana", "Asamankese", 5.8666667, -0.6666667, 159.0},
-	{4102, "Ghana", "Mampong", 7.0666667, -1.4000000, 370.0},
-	{4103, "Ghana", "Suhum", 6.0333333, -0.4500000, 186.0},
-	{4104, "Ghana", "Tarkwa", 5.3000000, -1.9833333, 67.0},
-	{4105, "Ghana", "Nsawam", 5.8000000, -0.3500000, 73.0},
-	{4106, "Ghana", "Konongo", 6.6166667, -1.2166667, 229.0},
-	{4107, "Ghana", "Prestea", 5.4333333, -2.1500001, 77.0},
-	{4108, "Ghana", "Agogo", 6.8000000, -1.0833333, 397.0},
-	{4109, "Ghana", "Wenchi", 7.7333333, -2.0999999, 304.0},
-	{4110, "Ghana", "Anloga", 5.8000000, 0.9000000, 43.0},
-	{4111, "Ghana", "Savelugu", 9.6247222, -0.8277778, 152.0},
-	{4112, "Ghana", "Elmina", 5.0833333, -1.3500000, -9999.0},
-	{4113, "Ghana", "Navrongo", 10.8950000, -1.0938889, 197.0},
-	{4114, "Ghana", "Dunkwa", 5.9666667, -1.7833333, 107.0},
-	{4115, "Ghana", "Axim", 4.8683333, -2.2413888, 71.0},
-	{4116, "Ghana", "Begoro", 6.3833333, -0.3833333, 448.0},
-	{4117, "Ghana", "Kintampo", 8.0500000, -1.7166667, 286.0},
-	{4118, "Ghana", "Kpandu", 7.0000000, 0.3000000, 152.0},
-	{4119, "Ghana", "Shama Junction", 5.0000000, -1.6500000, 69.0},
-	{4120, "Ghana", "Akwatia", 6.0500000, -0.8000000, 148.0},
-	{4121, "Ghana", "Apam", 5.2833333, -0.7333333, -9999.0},
-	{4122, "Ghana", "Salaga", 8.5500000, -0.5166667, 152.0},
-	{4123, "Ghana", "Bibiani", 6.4666667, -2.3333333, 250.0},
-	{4124, "Ghana", "Saltpond", 5.2000000, -1.0666667, 80.0},
-	{4125, "Ghana", "Keta", 5.9166667, 0.9833333, 43.0},
-	{4126, "Ghana", "Foso", 5.7000000, -1.2833333, 158.0},
-	{4127, "Ghana", "Aburi", 5.8500000, -0.1833333, 301.0},
-	{4128, "Ghana", "Bechem", 7.0833333, -2.0333333, 294.0},
-	{4129, "Ghana", "Duayaw Nkwanta", 7.1666667, -2.0999999, 304.0},
-	{4130, "Ghana", "Mumford", 5.2666667, -0.7500000, -9999.0},
-	{4131, "Ghana", "Akropong", 5.9666667, -0.0833333, 355.0},
-	{4132, "Ghana", "Kibi", 6.1666667, -0.5500000, 302.0},
-	{4133, "Ghana", "Kete Krachi", 7.8000000, -0.0166667, 87.0},
-	{4134, "Ghana", "Mpraeso", 6.5833333, -0.7333333, 368.0},
-	{4135, "Ghana", "Aboso", 5.3666667, -1.9333333, 72.0},
-	{4136, "Ghana", "Kpandae", 8.4666667, -0.0166667, 156.0},
-	{4137, "Ghana", "Akim Swedru", 5.9000000, -1.0166667, 154.0},
-	{4138, "Ghana", "Bekwai", 6.4500000, -1.5833333, 220.0},
-	{4139, "Greece", "Athens", 37.9833333, 23.7333336, 110.0},
-	{4140, "Greece", "Thessaloniki", 40.6402778, 22.9438896, -9999.0},
-	{4141, "Greece", "Piraeus", 37.9474464, 23.6370850, -9999.0},
-	{4142, "Greece", "Patrai", 38.2444444, 21.7344437, 52.0},
-	{4143, "Greece", "Peristerion", 38.0166667, 23.7000008, 67.0},
-	{4144, "Greece", "Irakleion", 35.3250000, 25.1305561, 70.0},
-	{4145, "Greece", "Larisa", 39.6372222, 22.4202785, 77.0},
-	{4146, "Greece", "Kallithea", 37.9500000, 23.7000008, 74.0},
-	{4147, "Greece", "Nikaia", 37.9666667, 23.6499996, 71.0},
-	{4148, "Greece", "Kalamaria", 40.5825000, 22.9502773, -9999.0},
-	{4149, "Greece", "Volos", 39.3666667, 22.9458332, 52.0},
-	{4150, "Greece", "Akharnai", 38.0833333, 23.7333336, 170.0},
-	{4151, "Greece", "Nea Liosia", 38.0333333, 23.7000008, 67.0},
-	{4152, "Greece", "Keratsinion", 37.9625000, 23.6197224, 31.0},
-	{4153, "Greece", "Nea Smirni", 37.9500000, 23.7166672, 108.0},
-	{4154, "Greece", "Khalandrion", 38.0305829, 23.7979317, 187.0},
-	{4155, "Greece", "Vrilissia", 38.0338110, 23.8296242, 237.0},
-	{4156, "Greece", "Aigaleo", 37.9833333, 23.6833324, 47.0},
-	{4157, "Greece", "Amarousion", 38.0500000, 23.7999992, 199.0},
-	{4158, "Greece", "Nea Ionia", 38.0333333, 23.7500000, 155.0},
-	{4159, "Greece", "Palaion Faliron", 37.9333333, 23.7000008, 50.0},
-	{4160, "Greece", "Ioannina", 39.6675000, 20.8508339, 468.0},
-	{4161, "Greece", "Viron", 37.9666667, 23.7500000, 155.0},
-	{4162, "Greece", "Ayia Paraskevi", 38.0166667, 23.8333340, 196.0},
-	{4163, "Greece", "Kavala", 40.9397222, 24.4019451, -9999.0},
-	{4164, "Greece", "Galatsion", 38.0166667, 23.7500000, 158.0},
-	{4165, "Greece", "Rodos", 36.4408333, 28.2224998, 22.0},
-	{4166, "Greece", "Serres", 41.0855556, 23.5497227, 61.0},
-	{4167, "Greece", "Chania", 35.5122222, 24.0155563, 5.0},
-	{4168, "Greece", "", 38.4636111, 23.5994453, 13.0},
-	{4169, "Greece", "Katerini", 40.2719444, 22.5025005, 17.0},
-	{4170, "Greece", "Alexandroupolis", 40.8475000, 25.8744450, -9999.0},
-	{4171, "Greece", "Petroupolis", 38.0500000, 23.6833324, 158.0},
-	{4172, "Greece", "Kalamata", 37.0388889, 22.1141663, 32.0},
-	{4173, "Greece", "Trikala", 39.5552778, 21.7674999, 123.0},
-	{4174, "Greece", "Xanthi", 41.1413889, 24.8836117, 36.0},
-	{4175, "Greece", "Lamia", 38.9000000, 22.4333324, 75.0},
-	{4176, "Greece", "Iraklion", 38.0666667, 23.7666664, 197.0},
-	{4177, "Greece", "Kifisia", 38.0666667, 23.8166676, 320.0},
-	{4178, "Greece", "Komotini", 41.1227778, 25.3963890, 19.0},
-	{4179, "Greece", "Sykeai", 40.6494444, 22.9508324, 170.0},
-	{4180, "Greece", "Veroia", 40.5233333, 22.2036114, 79.0},
-	{4181, "Greece", "Drama", 41.1544444, 24.1394444, 91.0},
-	{4182, "Greece", "Agrinion", 38.6213889, 21.4077778, 80.0},
-	{4183, "Greece", "Kalamakion", 37.9166667, 23.7166672, 79.0},
-	{4184, "Greece", "Polichni", 40.6667073, 22.9488087, 69.0},
-	{4185, "Greece", "Alimos", 37.9166670, 23.7166672, 79.0},
-	{4186, "Greece", "Kozani", 40.3011111, 21.7863884, 717.0},
-	{4187, "Greece", "Karditsa", 39.3655556, 21.9216671, 127.0},
-	{4188, "Greece", "Aspropirgos", 38.0666667, 23.5833340, 37.0},
-	{4189, "Greece", "Kholargos", 38.0000000, 23.7999992, 266.0},
-	{4190, "Greece", "Ayia Varvara", 37.9833333, 23.6666660, 13.0},
-	{4191, "Greece", "Corinth", 37.9377778, 22.9322224, 29.0},
-	{4192, "Greece", "Ptolemais", 40.5147222, 21.6786118, 594.0},
-	{4193, "Greece", "Rethymnon", 35.3647222, 24.4713898, 40.0},
-	{4194, "Greece", "Voula", 37.8500000, 23.7666664, 89.0},
-	{4195, "Greece", "Ano Liosia", 38.0833333, 23.7000008, 156.0},
-	{4196, "Greece", "Mytilini", 39.1100000, 26.5547218, 1.0},
-	{4197, "Greece", "Giannitsa", 40.7919444, 22.4074993, 61.0},
-	{4198, "Greece", "Elefsis", 38.0333333, 23.5333328, -9999.0},
-	{4199, "Greece", "Kerkyra", 39.6200000, 19.9197216, 68.0},
-	{4200, "Greece", "Salamis", 37.9666667, 23.4833336, 81.0},
-	{4201, "Greece", "Tripolis", 37.5088889, 22.3794441, 681.0},
-	{4202, "Greece", "Perama", 37.9680556, 23.5650005, 208.0},
-	{4203, "Greece", "Kaisariani", 37.9666667, 23.7999992, 578.0},
-	{4204, "Greece", "Argos", 37.6333333, 22.7333336, 20.0},
-	{4205, "Greece", "Megara", 38.0000000, 23.3455563, 89.0},
-	{4206, "Greece", "Chios", 38.3677778, 26.1358337, 1.0},
-	{4207, "Greece", "Nea Filadhelfia", 38.0333333, 23.7333336, 89.0},
-	{4208, "Greece", "Moskhaton", 37.9500000, 23.6666660, -9999.0},
-	{4209, "Greece", "Pylaia", 40.6005556, 22.9874992, 81.0},
-	{4210, "Greece", "Pyrgos", 37.6751253, 21.4410210, 18.0},
-	{4211, "Greece", "Melissia", 38.0500000, 23.8333340, 242.0},
-	{4212, "Greece", "Thivai", 38.3250000, 23.3188896, 202.0},
-	{4213, "Greece", "Aigion", 38.2486111, 22.0819435, 42.0},
-	{4214, "Greece", "Naousa", 40.6294444, 22.0680561, 240.0},
-	{4215, "Greece", "Kilkis", 40.9919444, 22.8708324, 257.0},
-	{4216, "Greece", "Amalias", 37.8000000, 21.3500004, 76.0},
-	{4217, "Greece", "Arta", 39.1605556, 20.9852772, 38.0},
-	{4218, "Greece", "Kos", 36.8933333, 27.2888889, 1.0},
-	{4219, "Greece", "Edessa", 40.8005556, 22.0472221, 302.0},
-	{4220, "Greece", "", 38.3701939, 21.4295197, 19.0},
-	{4221, "Greece", "Ellinikon", 37.8833333, 23.7333336, -9999.0},
-	{4222, "Greece", "Preveza", 38.9500000, 20.7500000, -9999.0},
-	{4223, "Greece", "Koropion", 37.9000000, 23.8833332, 111.0},
-	{4224, "Greece", "Panorama", 40.5833333, 23.0333328, 263.0},
-	{4225, "Greece", "Nea Erithraia", 38.1000000, 23.8166676, 309.0},
-	{4226, "Greece", "Orestias", 41.5030556, 26.5297222, 29.0},
-	{4227, "Greece", "Peraia", 40.5002778, 22.9249992, 11.0},
-	{4228, "Greece", "Menemeni", 40.6569444, 22.8999996, 10.0},
-	{4229, "Greece", "Sparta", 37.0733333, 22.4297218, 210.0},
-	{4230, "Greece", "Oraiokastron", 40.7308333, 22.9172230, 241.0},
-	{4231, "Greece", "Pallini", 38.0000000, 23.8833332, 188.0},
-	{4232, "Greece", "Nea Makri", 38.0833333, 23.9833336, 14.0},
-	{4233, "Greece", "Kalivia Thorikou", 37.8333333, 23.9166660, 150.0},
-	{4234, "Greece", "Kastoria", 40.5166667, 21.2666664, 705.0},
-	{4235, "Greece", "Nafplio", 37.5636111, 22.8075008, 208.0},
-	{4236, "Greece", "Thermi", 40.5500000, 23.0166664, 56.0},
-	{4237, "Greece", "Paiania", 37.9500000, 23.8500004, 271.0},
-	{4238, "Greece", "Alexandreia", 40.6266667, 22.4441662, 3.0},
-	{4239, "Grenada", "Gouyave", 12.1666667, -61.7333336, 18.0},
-	{4240, "Grenada", "Grenville", 12.1166667, -61.6166649, 1.0},
-	{4241, "Grenada", "Victoria", 12.1902072, -61.7067719, 94.0},
-	{4242, "Grenada", "Sauteurs", 12.2166667, -61.6333351, 145.0},
-	{4243, "Grenada", "Hillsborough", 12.4833333, -61.4666672, 1.0},
-	{4244, "Guatemala", "Guatemala City", 14.6211100, -90.5269394, 1533.0},
-	{4245, "Guatemala", "Mixco", 14.6333333, -90.6063919, 1692.0},
-	{4246, "Guatemala", "Villa Nueva", 14.5269444, -90.5875015, 1363.0},
-	{4247, "Guatemala", "Petapa", 14.5027778, -90.5516663, 1264.0},
-	{4248, "Guatemala", "San Juan Sacatepequez", 14.7188889, -90.6441650, 1802.0},
-	{4249, "Guatemala", "Quetzaltenango", 14.8333333, -91.5166702, 2381.0},
-	{4250, "Guatemala", "Villa Canales", 14.4813889, -90.5316696, 1277.0},
-	{4251, "Guatemala", "Escuintla", 14.3050000, -90.7850037, 349.0},
-	{4252, "Guatemala", "Chinautla", 14.7083333, -90.4994431, 1233.0},
-	{4253, "Guatemala", "Chimaltenango", 14.6686111, -90.8166656, 1798.0},
-	{4254, "Guatemala", "Chichicastenango", 14.9333333, -91.1166687, 2233.0},
-	{4255, "Guatemala", "Huehuetenango", 15.3197222, -91.4708328, 1907.0},
-	{4256, "Guatemala", "Amatitlan", 14.4875000, -90.6152802, 1211.0},
-	{4257, "Guatemala", "Totonicapan", 14.9166667, -91.3666687, 2480.0},
-	{4258, "Guatemala", "Santa Catarina Pinula", 14.5688889, -90.4952774, 1520.0},
-	{4259, "Guatemala", "Santa Lucia Cotzumalguapa", 14.3333333, -91.0166702, 356.0},
-	{4260, "Guatemala", "Puerto Barrios", 15.7166667, -88.5999985, 5.0},
-	{4261, "Guatemala", "San Francisco El Alto", 14.9500000, -91.4499969, 2582.0},
-	{4262, "Guatemala", "Coban", 15.4833333, -90.3666687, 1348.0},
-	{4263, "Guatemala", "San Jose Pinula", 14.5461111, -90.4113922, 1778.0},
-	{4264, "Guatemala", "San Pedro Ayampuc", 14.7866667, -90.4511108, 1208.0},
-	{4265, "Guatemala", "Jalapa", 14.6333333, -89.9833298, 1362.0},
-	{4266, "Guatemala", "Coatepeque", 14.7000000, -91.8666687, 439.0},
-	{4267, "Guatemala", "Solola", 14.7666667, -91.1833344, 2136.0},
-	{4268, "Guatemala", "Mazatenango", 14.5333333, -91.5000000, 343.0},
-	{4269, "Guatemala", "Chiquimula", 14.8000000, -89.5500031, 420.0},
-	{4270, "Guatemala", "San Pedro Sacatepequez", 14.9666667, -91.7666702, 2320.0},
-	{4271, "Guatemala", "Antigua Guatemala", 14.5611111, -90.7344437, 1578.0},
-	{4272, "Guatemala", "Retalhuleu", 14.5333333, -91.6833344, 242.0},
-	{4273, "Guatemala", "Zacapa", 14.9666667, -89.5333328, 232.0},
-	{4274, "Guatemala", "Jutiapa", 14.2833333, -89.9000015, 877.0},
-	{4275, "Guatemala", "Jacaltenango", 15.6666667, -91.7333298, 1342.0},
-	{4276, "Guatemala", "Santiago Atitlan", 14.6382337, -91.2290115, 1596.0},
-	{4277, "Guatemala", "Momostenango", 15.0436111, -91.4086075, 2233.0},
-	{4278, "Guatemala", "Palin", 14.4055556, -90.6983337, 1138.0},
-	{4279, "Guatemala", "San Benito", 16.9166667, -89.9000015, 115.0},
-	{4280, "Guatemala", "Barberena", 14.3094444, -90.3611145, 1233.0},
-	{4281, "Guatemala", "Ciudad Vieja", 14.5263889, -90.7597198, 1503.0},
-	{4282, "Guatemala", "Ostuncalco", 14.8666667, -91.6166687, 2498.0},
-	{4283, "Guatemala", "Fraijanes", 14.4652778, -90.4408340, 1586.0},
-	{4284, "Guatemala", "Nahuala", 14.8500000, -91.3166656, 2482.0},
-	{4285, "Guatemala", "Cantel", 14.8166667, -91.4499969, 2374.0},
-	{4286, "Guatemala", "Panzos", 15.4000000, -89.6666641, 156.0},
-	{4287, "Guatemala", "San Marcos", 14.9666667, -91.8000031, 2411.0},
-	{4288, "Guatemala", "Santiago Sacatepequez", 14.6352778, -90.6763916, 2013.0},
-	{4289, "Guatemala", "La Gomera", 14.0833333, -91.0500031, 36.0},
-	{4290, "Guatemala", "Santa Cruz del Quiche", 15.0305556, -91.1488876, 1979.0},
-	{4291, "Guatemala", "Nebaj", 15.4058333, -91.1461105, 2001.0},
-	{4292, "Guatemala", "Tecpan Guatemala", 14.7669444, -90.9941635, 2354.0},
-	{4293, "Guatemala", "Sumpango", 14.6463889, -90.7338867, 1880.0},
-	{4294, "Guatemala", "Comalapa", 14.7411111, -90.8874969, 2107.0},
-	{4295, "Guatemala", "Esquipulas", 14.5666667, -89.3499985, 943.0},
-	{4296, "Guatemala", "Flores", 16.9333333, -89.8833313, 114.0},
-	{4297, "Guatemala", "Chicacao", 14.5333333, -91.3166656, 475.0},
-	{4298, "Guatemala", "San Pablo Jocopilas", 14.5833333, -91.4499969, 598.0},
-	{4299, "Guatemala", "Comitancillo", 15.0833333, -91.7166672, 2322.0},
-	{4300, "Guatemala", "San Cristobal Verapaz", 15.3833333, -90.4000015, 1460.0},
-	{4301, "Guatemala", "Gualan", 15.1333333, -89.3666687, 136.0},
-	{4302, "Guatemala", "Nuevo San Carlos", 14.6000000, -91.6999969, 376.0},
-	{4303, "Guatemala", "Colomba", 14.7166667, -91.7333298, 1058.0},
-	{4304, "Guatemala", "Morales", 15.4833333, -88.8166656, 29.0},
-	{4305, "Guatemala", "Patzun", 14.6833333, -91.0166702, 2200.0},
-	{4306, "Guatemala", "Puerto San Jose", 13.9255556, -90.8244476, 6.0},
-	{4307, "Guatemala", "San Andres Itzapa", 14.6200000, -90.8441696, 1858.0},
-	{4308, "Guatemala", "Palencia", 14.6647222, -90.3586121, 1337.0},
-	{4309, "Guatemala", "Pueblo Nuevo Tiquisate", 14.2833333, -91.3666687, 64.0},
-	{4310, "Guatemala", "San Lucas Sacatepequez", 14.6097222, -90.6569443, 2087.0},
-	{4311, "Guatemala", "Jocotenango", 14.5819444, -90.7436142, 1570.0},
-	{4312, "Guatemala", "Alotenango", 14.4802778, -90.8075027, 1377.0},
-	{4313, "Guatemala", "Poptun", 16.3311111, -89.4169464, 530.0},
-	{4314, "Guatemala", "Chisec", 15.8166667, -90.2833328, 238.0},
-	{4315, "Guatemala", "Patzicia", 14.6316667, -90.9269409, 2121.0},
-	{4316, "Guatemala", "Cuilapa", 14.2763889, -90.3002777, 970.0},
-	{4317, "Guatemala", "La Esperanza", 14.8666667, -91.5666656, 2443.0},
-	{4318, "Guatemala", "Sanarate", 14.7950000, -90.1922226, 860.0},
-	{4319, "Guatemala", "El Estor", 15.5333333, -89.3499985, 46.0},
-	{4320, "Guatemala", "El Tejar", 14.6477778, -90.7927780, 1771.0},
-	{4321, "Guatemala", "Asuncion Mita", 14.3308333, -89.7108307, 485.0},
-	{4322, "Guatemala", "Santa Maria de Jesus", 14.5183333, -90.7266693, 1656.0},
-	{4323, "Guatemala", "El Palmar", 14.6500000, -91.5833359, 702.0},
-	{4324, "Guatemala", "Malacatan", 14.9000000, -92.0500031, 385.0},
-	{4325, "Guatemala", "San Sebastian", 14.5666667, -91.6500015, 322.0},
-	{4326, "Guatemala", "Santa Catarina Ixtahuacan", 14.8000000, -91.3666687, 2231.0},
-	{4327, "Guatemala", "San Lucas Toliman", 14.6333333, -91.1333313, 1962.0},
-	{4328, "Guatemala", "Livingston", 15.8333333, -88.7500000, -9999.0},
-	{4329, "Guatemala", "San Pedro Sacatepequez", 14.6841667, -90.6441650, 2096.0},
-	{4330, "Guatemala", "Patulul", 14.4166667, -91.1666641, 298.0},
-	{4331, "Guatemala", "Barillas", 15.8036111, -91.3158340, 1554.0},
-	{4332, "Guatemala", "San Francisco Zapotitlan", 14.5833333, -91.5166702, 563.0},
-	{4333, "Guatemala", "San Miguel Chicaj", 15.1000000, -90.4000015, 945.0},
-	{4334, "Guatemala", "San Pablo", 14.9333333, -92.0000000, 586.0},
-	{4335, "Guatemala", "Guastatoya", 14.8538889, -90.0647202, 561.0},
-	{4336, "Guatemala", "San Pedro Carcha", 15.4833333, -90.2666702, 1278.0},
-	{4337, "Guatemala", "San Mateo Ixtatan", 15.8319444, -91.4780579, 2514.0},
-	{4338, "Guatemala", "Joyabaj", 14.9950000, -90.8061142, 1405.0},
-	{4339, "Guatemala", "Santa Lucia Milpas Altas", 14.5758333, -90.6786118, 1939.0},
-	{4340, "Guatemala", "Santa Barbara", 14.4333333, -91.2333298, 390.0},
-	{4341, "Guatemala", "Panajachel", 14.7333333, -91.1500015, 1595.0},
-	{4342, "Guatemala", "Chiquimulilla", 14.0858333, -90.3766632, 272.0},
-	{4343, "Guatemala", "San Andres Xecul", 14.9000000, -91.4833298, 2446.0},
-	{4344, "Guyana", "Georgetown", 6.8000000, -58.1666679, 1.0},
-	{4345, "Guyana", "Linden", 6.0000000, -58.2999992, 49.0},
-	{4346, "Guyana", "New Amsterdam", 6.2500000, -57.5166664, 1.0},
-	{4347, "Guyana", "Bartica", 6.4000000, -58.6166649, 1.0},
-	{4348, "Guyana", "Skeldon", 5.8833333, -57.1333351, -9999.0},
-	{4349, "Guyana", "Rosignol", 6.2833333, -57.5333328, 1.0},
-	{4350, "Guyana", "Mahaica Village", 6.6833333, -57.9166679, 1.0},
-	{4351, "Guyana", "Vreed en Hoop", 6.8075000, -58.1869431, 1.0},
-	{4352, "Guyana", "Fort Wellington", 6.4000000, -57.5999985, 1.0},
-	{4353, "Guyana", "Mahaicony Village", 6.5500000, -57.7999992, 1.0},
-	{4354, "Guyana", "Mabaruma", 8.2000000, -59.7833328, 14.0},
-	{4355, "Guyana", "Lethem", 3.3833333, -59.7999992, 94.0},
-	{4356, "Haiti", "Port-au-Prince", 18.5391667, -72.3349991, 65.0},
-	{4357, "Haiti", "Carrefour", 18.5411401, -72.3992157, 19.0},
-	{4358, "Haiti", "Delmas 73", 18.5447222, -72.3027802, 127.0},
-	{4359, "Haiti", "Cap-Haitien", 19.7577778, -72.2041702, 1.0},
-	{4360, "Haiti", "Petionville", 18.5125000, -72.2852783, 392.0},
-	{4361, "Haiti", "Gonaives", 19.4500000, -72.6833344, 7.0},
-	{4362, "Haiti", "Saint-Marc", 19.1166667, -72.6999969, 105.0},
-	{4363, "Haiti", "Les Cayes", 18.2000000, -73.7500000, 1.0},
-	{4364, "Haiti", "Verrettes", 19.0500000, -72.4666672, 76.0},
-	{4365, "Haiti", "Ti Port-de-Paix", 19.9333333, -72.8333359, 83.0},
-	{4366, "Haiti", "Jacmel", 18.2341667, -72.5347214, 29.0},
-	{4367, "Haiti", "Limbe", 19.7000000, -72.4000015, 67.0},
-	{4368, "Haiti", "Jeremie", 18.6500000, -74.1166687, 17.0},
-	{4369, "Haiti", "Hinche", 19.1500000, -72.0166702, 230.0},
-	{4370, "Haiti", "Fond Parisien", 18.5058333, -71.9766693, 39.0},
-	{4371, "Haiti", "Desarmes", 18.9916667, -72.3888855, 56.0},
-	{4372, "Haiti", "Petit Goave", 18.4313889, -72.8669434, 32.0},
-	{4373, "Haiti", "Dessalines", 19.2833333, -72.5000000, 159.0},
-	{4374, "Haiti", "Saint-Louis du Nord", 19.9333333, -72.7166672, 13.0},
-	{4375, "Haiti", "Leogane", 18.5108333, -72.6338882, 13.0},
-	{4376, "Haiti", "Fort Liberte", 19.6677778, -71.8397217, 1.0},
-	{4377, "Haiti", "Trou du Nord", 19.6333333, -72.0166702, 25.0},
-	{4378, "Haiti", "Ouanaminthe", 19.5500000, -71.7333298, 38.0},
-	{4379, "Haiti", "Mirebalais", 18.8333333, -72.1052780, 164.0},
-	{4380, "Haiti", "Grande Riviere du Nord", 19.5833333, -72.1833344, 92.0},
-	{4381, "Haiti", "Croix des Bouquets", 18.5750000, -72.2249985, 68.0},
-	{4382, "Haiti", "Les Anglais", 18.3000000, -74.2166672, -9999.0},
-	{4383, "Haiti", "Lascahobas", 18.8294444, -71.9363861, 231.0},
-	{4384, "Haiti", "Cornillon", 18.6747222, -71.9533310, 1039.0},
-	{4385, "Haiti", "Gros Morne", 19.6666667, -72.6833344, 232.0},
-	{4386, "Haiti", "Anse a Galets", 18.8316667, -72.8641663, 17.0},
-	{4387, "Haiti", "Pignon", 19.3333333, -72.1166687, 338.0},
-	{4388, "Haiti", "Miragoane", 18.4500000, -73.0999985, -9999.0},
-	{4389, "Haiti", "Dame-Marie", 18.5666667, -74.4166641, -9999.0},
-	{4390, "Haiti", "Saint-Raphael", 19.4333333, -72.1999969, 367.0},
-	{4391, "Haiti", "Milot", 19.6166667, -72.2166672, 96.0},
-	{4392, "Haiti", "Jean-Rabel", 19.8533333, -73.1916656, 82.0},
-	{4393, "Haiti", "Aquin", 18.2833333, -73.4000015, 3.0},
-	{4394, "Haiti", "Maissade", 19.1666667, -72.1333313, 261.0},
-	{4395, "Haiti", "Kenscoff", 18.4505556, -72.2869415, 1325.0},
-	{4396, "Haiti", "Dondon", 19.5333333, -72.2333298, 565.0},
-	{4397, "Haiti", "Thomassique", 19.0833333, -71.8333359, 300.0},
-	{4398, "Haiti", "Grand Goave", 18.4288889, -72.7705536, 7.0},
-	{4399, "Haiti", "Anse Rouge", 19.6333333, -73.0500031, 51.0},
-	{4400, "Haiti", "Le Borgne", 19.8500000, -72.5333328, 13.0},
-	{4401, "Haiti", "Pilate", 19.6666667, -72.5500031, 336.0},
-	{4402, "Haiti", "Plaisance", 19.6000000, -72.4666672, 426.0},
-	{4403, "Haiti", "Arcahaie", 19.8166667, -72.9166641, 157.0},
-	{4404, "Haiti", "Cabaret", 18.7358333, -72.4175034, 65.0},
-	{4405, "Haiti", "Chardonniere", 18.2666667, -74.1666641, -9999.0},
-	{4406, "Haiti", "Port-a-Piment", 18.2500000, -74.0999985, -9999.0},
-	{4407, "Haiti", "Thomazeau", 18.6519444, -72.0947189, 36.0},
-	{4408, "Haiti", "Limonade", 19.6697222, -72.1252747, 17.0},
-	{4409, "Haiti", "Ferrier", 19.6166667, -71.7833328, 9.0},
-	{4410, "Haiti", "Thomonde", 19.0166667, -71.9666672, 285.0},
-	{4411, "Haiti", "Gressier", 18.5500000, -72.5166702, 33.0},
-	{4412, "Haiti", "Acul du Nord", 19.6833333, -72.3166656, 34.0},
-	{4413, "Haiti", "Mole Saint-Nicolas", 19.8000000, -73.3833313, 32.0},
-	{4414, "Haiti", "Port Margot", 19.7500000, -72.4333344, 109.0},
-	{4415, "Haiti", "Tiburon", 18.3333333, -74.4000015, 66.0},
-	{4416, "Haiti", "Cerca la Source", 19.1666667, -71.7833328, 402.0},
-	{4417, "Haiti", "Fond Verrettes", 18.3913889, -71.8569412, 951.0},
-	{4418, "Haiti", "Corail", 18.5672222, -73.8922195, -9999.0},
-	{4419, "Haiti", "Fond des Blancs", 18.2833333, -73.1333313, 300.0},
-	{4420, "Haiti", "Belle-Anse", 18.2372222, -72.0655594, 14.0},
-	{4421, "Haiti", "Plaine du Nord", 19.6833333, -72.2666702, 10.0},
-	{4422, "Haiti", "Thiote", 18.2500000, -71.8499985, 957.0},
-	{4423, "Haiti", "Cotes-de-Fer", 18.1833333, -73.0000000, 21.0},
-	{4424, "Haiti", "Phaeton", 19.6741667, -71.8972244, 1.0},
-	{4425, "Haiti", "Les Irois", 18.4000000, -74.4499969, 111.0},
-	{4426, "Haiti", "Marmelade", 19.5166667, -72.3499985, 640.0},
-	{4427, "Haiti", "Petit Trou de Nippes", 18.5333333, -73.5166702, -9999.0},
-	{4428, "Haiti", "Carice", 19.3833333, -71.8333359, 647.0},
-	{4429, "Haiti", "Mont Organise", 19.4000000, -71.7833328, 651.0},
-	{4430, "Haiti", "Coteaux", 18.2000000, -74.0333328, 17.0},
-	{4431, "Haiti", "Bombardopolis", 19.7000000, -73.3333359, 417.0},
-	{4432, "Haiti", "Torbeck", 18.1666667, -73.8119431, 3.0},
-	{4433, "Haiti", "Perches", 19.5166667, -71.9166641, 100.0},
-	{4434, "Haiti", "Derac", 19.6500000, -71.8166656, 7.0},
-	{4435, "Haiti", "Chantal", 18.2000000, -73.8833313, 41.0},
-	{4436, "Haiti", "Moron", 18.5666667, -74.2500000, 60.0},
-	{4437, "Haiti", "Petite Riviere de Nippes", 18.4833333, -73.2500000, -9999.0},
-	{4438, "Haiti", "Grande Saline", 19.2500000, -72.7833328, -9999.0},
-	{4439, "Haiti", "Anse-a-Pitres", 18.0500000, -71.7500000, 21.0},
-	{4440, "Haiti", "Anse-a-Veau", 18.5166667, -73.3499985, -9999.0},
-	{4441, "Haiti", "Marigot", 18.2338889, -72.3166656, 93.0},
-	{4442, "Haiti", "Baraderes", 18.4825000, -73.6386108, 92.0},
-	{4443, "Haiti", "Roche-a-Bateau", 18.1833333, -74.0000000, 98.0},
-	{4444, "Haiti", "Baie de Henne", 19.6627778, -73.2094421, 20.0},
-	{4445, "Haiti", "Bahon", 19.4666667, -72.1166687, 163.0},
-	{4446, "Haiti", "Cayes Jacmel", 18.2302778, -72.3961105, 1.0},
-	{4447, "Haiti", "Caracol", 19.6908333, -72.0161133, 4.0},
-	{4448, "Haiti", "Petite Anse", 19.6297222, -73.1544418, 37.0},
-	{4449, "Haiti", "Saint-Louis du Sud", 18.2666667, -73.5500031, 106.0},
-	{4450, "Haiti", "Ennery", 19.4833333, -72.4833298, 366.0},
-	{4451, "Haiti", "Chambellan", 18.5666667, -74.3166656, 485.0},
-	{4452, "Haiti", "Ranquitte", 19.4166667, -72.0833359, 541.0},
-	{4453, "Haiti", "Quartier Morin", 19.6966667, -72.1569443, 9.0},
-	{4454, "Haiti", "Abricots", 18.6333333, -74.3000031, 221.0},
-	{4455, "Haiti", "Fond Bassin Bleu", 19.8000000, -72.8000031, 273.0},
-	{4456, "Honduras", "Tegucigalpa", 14.1000000, -87.2166672, 997.0},
-	{4457, "Honduras", "San Pedro Sula", 15.5000000, -88.0333328, 84.0},
-	{4458, "Honduras", "Choloma", 15.6144401, -87.9530258, 40.0},
-	{4459, "Honduras", "La Ceiba", 15.7833333, -86.8000031, 8.0},
-	{4460, "Honduras", "El Progreso", 15.4000000, -87.8000031, 45.0},
-	{4461, "Honduras", "Ciudad Choluteca", 13.3002778, -87.1908340, 44.0},
-	{4462, "Honduras", "Comayagua", 14.4500000, -87.6333313, 594.0},
-	{4463, "Honduras", "Puerto Cortez", 15.8333333, -87.9499969, -9999.0},
-	{4464, "Honduras", "La Lima", 15.4333333, -87.9166641, 35.0},
-	{4465, "Honduras", "Danli", 14.0333333, -86.5833359, 815.0},
-	{4466, "Honduras", "Siguatepeque", 14.6000000, -87.8333359, 1067.0},
-	{4467, "Honduras", "Juticalpa", 14.6500000, -86.1999969, 411.0},
-	{4468, "Honduras", "Villanueva", 15.3166667, -88.0000000, 101.0},
-	{4469, "Honduras", "Tocoa", 15.6833333, -86.0000000, 54.0},
-	{4470, "Honduras", "Tela", 15.7833333, -87.4499969, 3.0},
-	{4471, "Honduras", "Santa Rosa de Copan", 14.7666667, -88.7833328, 1123.0},
-	{4472, "Honduras", "Olanchito", 15.5000000, -86.5666656, 148.0},
-	{4473, "Honduras", "San Lorenzo", 13.4241667, -87.4472198, 5.0},
-	{4474, "Honduras", "Cofradia", 15.4000000, -88.1500015, 155.0},
-	{4475, "Honduras", "El Paraiso", 13.8666667, -86.5500031, 820.0},
-	{4476, "Honduras", "La Paz", 14.3166667, -87.6833344, 680.0},
-	{4477, "Honduras", "Yoro", 15.1333333, -87.1333313, 661.0},
-	{4478, "Honduras", "Potrerillos", 15.2333333, -87.9666672, 96.0},
-	{4479, "Honduras", "Santa Barbara", 14.9166667, -88.2333298, 264.0},
-	{4480, "Honduras", "La Entrada", 15.0500000, -88.7333298, 496.0},
-	{4481, "Honduras", "Nacaome", 13.5361111, -87.4875031, 37.0},
-	{4482, "Honduras", "Intibuca", 14.3166667, -88.1666641, 1705.0},
-	{4483, "Honduras", "Talanga", 14.4000000, -87.0833359, 838.0},
-	{4484, "Honduras", "Guaimaca", 14.5333333, -86.8166656, 829.0},
-	{4485, "Honduras", "Santa Rita", 15.1666667, -87.2833328, 776.0},
-	{4486, "Honduras", "Morazan", 15.3166667, -87.5999985, 207.0},
-	{4487, "Honduras", "Santa Cruz de Yojoa", 14.9833333, -87.9000015, 487.0},
-	{4488, "Honduras", "Marcala", 14.1500000, -88.0333328, 1288.0},
-	{4489, "Honduras", "Saba", 15.4666667, -86.2500000, 329.0},
-	{4490, "Honduras", "Trujillo", 15.9166667, -86.0000000, -9999.0},
-	{4491, "Honduras", "El Negrito", 15.3166667, -87.6999969, 241.0},
-	{4492, "Honduras", "Baracoa", 15.7666667, -87.8499985, 13.0},
-	{4493, "Honduras", "San Marcos de Colon", 13.4333333, -86.8000031, 1020.0},
-	{4494, "Honduras", "Nueva Ocotepeque", 14.4333333, -89.1833344, 820.0},
-	{4495, "Honduras", "Pimienta Vieja", 15.2333333, -87.9666672, 96.0},
-	{4496, "Honduras", "Gracias", 14.5833333, -88.5833359, 803.0},
-	{4497, "Honduras", "Agua Blanca Sur", 15.2500000, -87.8833313, 64.0},
-	{4498, "Honduras", "Roatan", 16.3000000, -86.5500031, -9999.0},
-	{4499, "Honduras", "Las Vegas", 14.8666667, -88.0666656, 832.0},
-	{4500, "Honduras", "El Triunfo", 13.1166667, -87.0000000, 100.0},
-	{4501, "Honduras", "Jesus de Otoro", 14.4833333, -87.9833298, 638.0},
-	{4502, "Honduras", "Monjaras", 13.2005556, -87.3741684, 6.0},
-	{4503, "Honduras", "Campamento", 14.5500000, -86.6500015, 718.0},
-	{4504, "Honduras", "San Manuel", 15.3333333, -87.9166641, 43.0},
-	{4505, "Honduras", "Copan", 14.8333333, -89.1500015, 638.0},
-	{4506, "Honduras", "Mezapa", 15.5833333, -87.6500015, 164.0},
-	{4507, "Honduras", "Las Trojes", 14.0666667, -85.9833298, 763.0},
-	{4508, "Honduras", "Azacualpa", 15.3433333, -88.5513916, 267.0},
-	{4509, "Honduras", "Villa de San Francisco", 14.1666667, -86.9666672, 834.0},
-	{4510, "Honduras", "San Juan Pueblo", 15.5833333, -87.2333298, 149.0},
-	{4511, "Honduras", "San Luis", 15.0833333, -88.3833313, 1037.0},
-	{4512, "Honduras", "San Francisco de la Paz", 14.9000000, -86.1999969, 685.0},
-	{4513, "Honduras", "Villa de San Antonio", 14.3166667, -87.6166687, 597.0},
-	{4514, "Honduras", "Ajuterique", 14.3833333, -87.6999969, 655.0},
-	{4515, "Honduras", "San Marcos", 14.4000000, -88.9499969, 990.0},
-	{4516, "Honduras", "Florida", 15.0333333, -88.8333359, 476.0},
-	{4517, "Honduras", "La Esperanza", 14.3000000, -88.1833344, 1721.0},
-	{4518, "Honduras", "Cuyamel", 15.6666667, -88.1999969, 6.0},
-	{4519, "Honduras", "San Antonio de Cortes", 15.1166667, -88.0333328, 542.0},
-	{4520, "Honduras", "Puerto Lempira", 15.2666667, -83.7666702, 1.0},
-	{4521, "Honduras", "Omoa", 15.7666667, -88.0333328, 26.0},
-	{4522, "Honduras", "Corquin", 14.5666667, -88.8666687, 858.0},
-	{4523, "Honduras", "La Libertad", 14.7500000, -87.6166687, 484.0},
-	{4524, "Honduras", "Taulabe", 14.7000000, -87.9666672, 570.0},
-	{4525, "Honduras", "Valle de Angeles", 14.1500000, -87.0333328, 1290.0},
-	{4526, "Honduras", "Santa Maria del Real", 14.7666667, -85.9499969, 327.0},
-	{4527, "Honduras", "Arizona", 15.6333333, -87.3166656, 99.0},
-	{4528, "Honduras", "Trinidad", 15.1333333, -88.2333298, 292.0},
-	{4529, "Honduras", "Teupasenti", 14.2166667, -86.6999969, 602.0},
-	{4530, "Honduras", "La Jutosa", 15.6333333, -88.0000000, 192.0},
-	{4531, "Honduras", "Tras Cerros", 15.3000000, -88.6666641, 628.0},
-	{4532, "Honduras", "Brus Laguna", 15.7500000, -84.4833298, 15.0},
-	{4533, "Honduras", "La Masica", 15.6166667, -87.1166687, 77.0},
-	{4534, "Honduras", "San Jose de Colinas", 15.0333333, -88.3000031, 287.0},
-	{4535, "Honduras", "Naco", 15.3833333, -88.1833344, 163.0},
-	{4536, "Honduras", "San Marcos", 15.3000000, -88.4166641, 231.0},
-	{4537, "Honduras", "Zambrano", 14.2666667, -87.4000015, 1373.0},
-	{4538, "Honduras", "Lejamani", 14.3666667, -87.6999969, 668.0},
-	{4539, "Honduras", "Naranjito", 14.9500000, -88.6833344, 931.0},
-	{4540, "Honduras", "Pinalejo", 15.3833333, -88.4000015, 260.0},
-	{4541, "Honduras", "Bonito Oriental", 15.7333333, -85.7333298, 116.0},
-	{4542, "Honduras", "Langue", 13.6208333, -87.6524963, 138.0},
-	{4543, "Honduras", "San Juan de Flores", 14.2666667, -87.0333328, 717.0},
-	{4544, "Honduras", "Gualaco", 15.0500000, -86.0500031, 667.0},
-	{4545, "Honduras", "Dulce Nombre", 14.8500000, -88.8333359, 1090.0},
-	{4546, "Honduras", "Jutiquile", 14.7166667, -86.0833359, 425.0},
-	{4547, "Honduras", "Santa Ana", 15.6500000, -87.0666656, 70.0},
-	{4548, "Honduras", "Pueblo Nuevo", 15.2833333, -88.0166702, 146.0},
-	{4549, "Honduras", "Minas de Oro", 14.8000000, -87.3499985, 971.0},
-	{4550, "Honduras", "Pena Blanca", 15.5333333, -88.0500031, 137.0},
-	{4551, "Honduras", "San Nicolas", 14.9333333, -88.3166656, 486.0},
-	{4552, "Honduras", "Quimistan", 15.3500000, -88.4000015, 183.0},
-	{4553, "Honduras", "Rio Lindo", 15.0333333, -87.9833298, 153.0},
-	{4554, "Honduras", "San Esteban", 15.2000000, -85.7500000, 455.0},
-	{4555, "Honduras", "Agua Fria", 13.4688889, -87.5511093, 7.0},
-	{4556, "Hungary", "Budapest", 47.5000000, 19.0833340, 100.0},
-	{4557, "Hungary", "Debrecen", 47.5333333, 21.6333332, 122.0},
-	{4558, "Hungary", "Miskolc", 48.1000000, 20.7833328, 141.0},
-	{4559, "Hungary", "Szeged", 46.2529981, 20.1482391, 79.0},
-	{4560, "Hungary", "Pecs", 46.0833333, 18.2333336, 155.0},
-	{4561, "Hungary", "Gyor", 47.6833322, 17.6351166, 109.0},
-	{4562, "Hungary", "Nyiregyhaza", 47.9553868, 21.7167091, 112.0},
-	{4563, "Hungary", "Kecskemet", 46.9061838, 19.6912766, 115.0},
-	{4564, "Hungary", "Szekesfehervar", 47.1899458, 18.4103394, 125.0},
-	{4565, "Hungary", "Szombathely", 47.2308762, 16.6215534, 210.0},
-	{4566, "Hungary", "Paradsasvar", 47.9126028, 19.9770927, 346.0},
-	{4567, "Hungary", "Szolnok", 47.1833333, 20.2000008, 69.0},
-	{4568, "Hungary", "Tatabanya", 47.5666667, 18.4166660, 157.0},
-	{4569, "Hungary", "Kaposvar", 46.3666667, 17.7999992, 151.0},
-	{4570, "Hungary", "Bekescsaba", 46.6833333, 21.1000004, 90.0},
-	{4571, "Hungary", "Erd", 47.3666667, 18.9333324, 110.0},
-	{4572, "Hungary", "Veszprem", 47.1000000, 17.9166660, 267.0},
-	{4573, "Hungary", "Zalaegerszeg", 46.8400000, 16.8438892, 167.0},
-	{4574, "Hungary", "Sopron", 47.6833333, 16.6000004, 198.0},
-	{4575, "Hungary", "Eger", 47.9000000, 20.3833332, 157.0},
-	{4576, "Hungary", "Nagykanizsa", 46.4534701, 16.9910431, 158.0},
-	{4577, "Hungary", "Dunaujvaros", 46.9833333, 18.9333324, 132.0},
-	{4578, "Hungary", "Hodmezovasarhely", 46.4166667, 20.3333340, 81.0},
-	{4579, "Hungary", "Salgotarjan", 48.0987190, 19.8030281, 298.0},
-	{4580, "Hungary", "Cegled", 47.1666667, 19.7999992, 86.0},
-	{4581, "Hungary", "Ozd", 48.2166667, 20.2999992, 224.0},
-	{4582, "Hungary", "Baja", 46.1833333, 18.9666672, 96.0},
-	{4583, "Hungary", "Vac", 47.7833333, 19.1333332, 106.0},
-	{4584, "Hungary", "Szekszard", 46.3500000, 18.7166672, 84.0},
-	{4585, "Hungary", "Papa", 47.3333333, 17.4666672, 145.0},
-	{4586, "Hungary", "Gyongyos", 47.7833333, 19.9333324, 167.0},
-	{4587, "Hungary", "Kazincbarcika", 48.2500000, 20.6333332, 203.0},
-	{4588, "Hungary", "Godollo", 47.6000000, 19.3666668, 202.0},
-	{4589, "Hungary", "Gyula", 46.6500000, 21.2833328, 87.0},
-	{4590, "Hungary", "Hajduboszormeny", 47.6666667, 21.5166664, 143.0},
-	{4591, "Hungary", "Kiskunfelegyhaza", 46.7136111, 19.8522224, 99.0},
-	{4592, "Hungary", "Ajka", 47.1000000, 17.5666676, 311.0},
-	{4593, "Hungary", "Oroshaza", 46.5666667, 20.6666660, 94.0},
-	{4594, "Hungary", "Szentes", 46.6500000, 20.2666664, 84.0},
-	{4595, "Hungary", "Mosonmagyarovar", 47.8666667, 17.2833328, 124.0},
-	{4596, "Hungary", "Dunakeszi", 47.6333333, 19.1333332, 112.0},
-	{4597, "Hungary", "Kiskunhalas", 46.4313889, 19.4874992, 118.0},
-	{4598, "Hungary", "Esztergom", 47.8000000, 18.7500000, 261.0},
-	{4599, "Hungary", "Jaszbereny", 47.5000000, 19.9166660, 88.0},
-	{4600, "Hungary", "Komlo", 46.2000000, 18.2666664, 271.0},
-	{4601, "Hungary", "Nagykoros", 47.0333333, 19.7833328, 99.0},
-	{4602, "Hungary", "Mako", 46.2166667, 20.4833336, 83.0},
-	{4603, "Hungary", "Budaors", 47.4618127, 18.9584541, 176.0},
-	{4604, "Hungary", "Szigetszentmiklos", 47.3500000, 19.0499992, 92.0},
-	{4605, "Hungary", "Tata", 47.6500000, 18.3166676, 122.0},
-	{4606, "Hungary", "Szentendre", 47.6694334, 19.0756130, 91.0},
-	{4607, "Hungary", "Hajduszoboszlo", 47.4500000, 21.3999996, 101.0},
-	{4608, "Hungary", "Siofok", 46.9000000, 18.0499992, 125.0},
-	{4609, "Hungary", "Torokszentmiklos", 47.1833333, 20.4166660, 84.0},
-	{4610, "Hungary", "Hatvan", 47.6666667, 19.6833324, 111.0},
-	{4611, "Hungary", "Karcag", 47.3166667, 20.9333324, 82.0},
-	{4612, "Hungary", "Gyal", 47.3833333, 19.2333336, 98.0},
-	{4613, "Hungary", "Monor", 47.3500000, 19.4500008, 127.0},
-	{4614, "Hungary", "Keszthely", 46.7666667, 17.2500000, 126.0},
-	{4615, "Hungary", "Varpalota", 47.2000000, 18.1333332, 158.0},
-	{4616, "Hungary", "Bekes", 46.7666667, 21.1333332, 85.0},
-	{4617, "Hungary", "Dombovar", 46.3833333, 18.1166668, 131.0},
-	{4618, "Hungary", "Paks", 46.6333333, 18.8666668, 71.0},
-	{4619, "Hungary", "Oroszlany", 47.4833333, 18.3166676, 203.0},
-	{4620, "Hungary", "Komarom", 47.7333333, 18.1166668, 115.0},
-	{4621, "Hungary", "Vecses", 47.4000000, 19.2833328, 103.0},
-	{4622, "Hungary", "Mezotur", 47.0000000, 20.6333332, 83.0},
-	{4623, "Hungary", "Mateszalka", 47.9500000, 22.3333340, 107.0},
-	{4624, "Hungary", "Mohacs", 45.9930556, 18.6830559, 81.0},
-	{4625, "Hungary", "Csongrad", 46.7000000, 20.1499996, 82.0},
-	{4626, "Hungary", "Kalocsa", 46.5263889, 18.9858341, 80.0},
-	{4627, "Hungary", "Kisvarda", 48.2166667, 22.0833340, 106.0},
-	{4628, "Hungary", "Szarvas", 46.8666667, 20.5499992, 88.0},
-	{4629, "Hungary", "Satoraljaujhely", 48.4000000, 21.6666660, 99.0},
-	{4630, "Hungary", "Hajdunanas", 47.8500000, 21.4333324, 100.0},
-	{4631, "Hungary", "Balmazujvaros", 47.6166667, 21.3500004, 97.0},
-	{4632, "Hungary", "Mezokovesd", 47.8166667, 20.5833340, 116.0},
-	{4633, "Hungary", "Tapolca", 46.8833333, 17.4333324, 129.0},
-	{4634, "Hungary", "Szazhalombatta", 47.3333333, 18.9333324, 129.0},
-	{4635, "Hungary", "Balassagyarmat", 48.0833333, 19.2999992, 136.0},
-	{4636, "Hungary", "Tiszaujvaros", 47.9333333, 21.0833340, 94.0},
-	{4637, "Hungary", "Dunaharaszti", 47.3500000, 19.0833340, 96.0},
-	{4638, "Hungary", "Fot", 47.6166667, 19.2000008, 199.0},
-	{4639, "Hungary", "Dabas", 47.1833333, 19.3166676, 100.0},
-	{4640, "Hungary", "Abony", 47.1833333, 20.0000000, 69.0},
-	{4641, "Hungary", "Berettyoujfalu", 47.2166667, 21.5499992, 96.0},
-	{4642, "Hungary", "Puspokladany", 47.3166667, 21.1166668, 93.0},
-	{4643, "Hungary", "God", 47.7000000, 19.1333332, 109.0},
-	{4644, "Hungary", "Sarvar", 47.2539512, 16.9352531, 156.0},
-	{4645, "Hungary", "Gyomaendrod", 46.9333333, 20.8333340, 91.0},
-	{4646, "Hungary", "Kiskoros", 46.6205556, 19.2886105, 101.0},
-	{4647, "Hungary", "Pomaz", 47.6500000, 19.0333328, 102.0},
-	{4648, "Hungary", "Mor", 47.3833333, 18.2000008, 228.0},
-	{4649, "Hungary", "Sarospatak", 48.3166667, 21.5833340, 93.0},
-	{4650, "Hungary", "Batonyterenye", 47.9666667, 19.8333340, 240.0},
-	{4651, "Hungary", "Bonyhad", 46.3000000, 18.5333328, 95.0},
-	{4652, "Hungary", "Gyomro", 47.4166667, 19.3999996, 166.0},
-	{4653, "Hungary", "Tiszavasvari", 47.9666667, 21.3500004, 100.0},
-	{4654, "Hungary", "Ujfeherto", 47.8000000, 21.6833324, 124.0},
-	{4655, "Hungary", "Nyirbator", 47.8333333, 22.1333332, 128.0},
-	{4656, "Iceland", "Reykjavik", 64.1500000, -21.9500008, 16.0},
-	{4657, "Iceland", "Kopavogur", 64.1000000, -21.9166660, 19.0},
-	{4658, "Iceland", "Hafnarfjordur", 64.0666667, -21.9500008, 22.0},
-	{4659, "Iceland", "Akureyri", 65.6666667, -18.1000004, 2.0},
-	{4660, "Iceland", "Gardabar", 64.0833333, -21.9833336, 11.0},
-	{4661, "Iceland", "Keflavik", 64.0166667, -22.5666676, 20.0},
-	{4662, "Iceland", "Akranes", 64.3166667, -22.1000004, -9999.0},
-	{4663, "Iceland", "Selfoss", 63.9333333, -21.0000000, 16.0},
-	{4664, "Iceland", "Njardvik", 63.9666667, -22.5166664, 20.0},
-	{4665, "Iceland", "Saudarkrokur", 65.7500000, -19.6499996, 4.0},
-	{4666, "Iceland", "Grindavik", 63.8333333, -22.4333324, -9999.0},
-	{4667, "Iceland", "Isafjordur", 66.0833333, -23.1499996, 117.0},
-	{4668, "Iceland", "Husavik", 66.0500000, -17.3500004, 17.0},
-	{4669, "Iceland", "Egilsstadir", 65.2653270, -14.3948364, 51.0},
-	{4670, "Iceland", "Hveragerdi", 64.0000000, -21.2000008, 30.0},
-	{4671, "Iceland", "Borgarnes", 64.5333333, -21.9166660, -9999.0},
-	{4672, "Iceland", "Hofn", 64.2500000, -15.2166672, -9999.0},
-	{4673, "Iceland", "Neskaupstadur", 65.1500000, -13.6999998, 1.0},
-	{4674, "Iceland", "Dalvik", 65.9701754, -18.5286140, -9999.0},
-	{4675, "Iceland", "Siglufjordur", 66.1500000, -18.9166660, 2.0},
-	{4676, "Iceland", "Sandgerdi", 64.0500000, -22.7000008, 19.0},
-	{4677, "Iceland", "Torlakshofn", 63.8500000, -21.3666668, -9999.0},
-	{4678, "Iceland", "Stykkisholmur", 65.0666667, -22.7333336, 14.0},
-	{4679, "Iceland", "Olafsvik", 64.8833333, -23.7166672, 211.0},
-	{4680, "Iceland", "Eskifjordur", 65.0666667, -14.0166664, -9999.0},
-	{4681, "Iceland", "Vogar", 63.9666667, -22.3666668, 20.0},
-	{4682, "Iceland", "Blonduos", 65.6666667, -20.2999992, 38.0},
-	{4683, "Iceland", "Bolungarvik", 66.1500000, -23.2500000, 2.0},
-	{4684, "Iceland", "Olafsfjordur", 66.0666667, -18.6499996, 105.0},
-	{4685, "Iceland", "Reydarfjordur", 65.0333333, -14.2166672, -9999.0},
-	{4686, "Iceland", "Hvolsvollur", 63.7500000, -20.2333336, 33.0},
-	{4687, "Iceland", "Seydisfjordur", 65.2666667, -14.0000000, -9999.0},
-	{4688, "Iceland", "Hella", 63.8333333, -20.3999996, 27.0},
-	{4689, "Iceland", "Patreksfjordur", 65.5833333, -24.0000000, -9999.0},
-	{4690, "Iceland", "Faskrudsfjordur", 64.9333333, -14.0166664, -9999.0},
-	{4691, "Iceland", "Eyrarbakki", 63.8666667, -21.1499996, 5.0},
-	{4692, "Iceland", "Hvammstangi", 65.4000000, -20.9500008, 33.0},
-	{4693, "Iceland", "Skagastrond", 65.8333333, -20.3166676, 4.0},
-	{4694, "Iceland", "Vopnafjordur", 65.7500000, -14.8333330, -9999.0},
-	{4695, "Iceland", "Stokkseyri", 63.8333333, -21.0666676, -9999.0},
-	{4696, "Iceland", "Holmavik", 65.7166667, -21.6833324, 11.0},
-	{4697, "Iceland", "Hnifsdalur", 66.1166667, -23.1333332, 1.0},
-	{4698, "Iceland", "Hellissandur", 64.9166667, -23.8999996, -9999.0},
-	{4699, "Iceland", "Djupivogur", 64.6500000, -14.2833328, 6.0},
-	{4700, "Iceland", "Sudureyri", 66.1166667, -23.5333328, 314.0},
-	{4701, "Iceland", "Torshofn", 66.2000000, -15.3333330, 18.0},
-	{4702, "Iceland", "Grenivik", 65.9500000, -18.1833324, 27.0},
-	{4703, "Iceland", "Flateyri", 66.0500000, -23.5166664, -9999.0},
-	{4704, "Iceland", "Tingeyri", 65.8666667, -23.5000000, 125.0},
-	{4705, "Iceland", "Stodvarfjordur", 64.8350000, -13.8766670, 63.0},
-	{4706, "Iceland", "Budardalur", 65.1166667, -21.7666664, -9999.0},
-	{4707, "Iceland", "Hvanneyri", 64.5666667, -21.7666664, 9.0},
-	{4708, "Iceland", "Raufarhofn", 66.4500000, -15.9499998, 1.0},
-	{4709, "Iceland", "Laugarvatn", 64.2166667, -20.7333336, 68.0},
-	{4710, "Iceland", "Bildudalur", 65.6833333, -23.6000004, -9999.0},
-	{4711, "Iceland", "Reykjahlid", 65.6500000, -16.9166660, 294.0},
-	{4712, "Iceland", "Sudavik", 66.0333333, -23.0000000, 3.0},
-	{4713, "Iceland", "Hofsos", 65.9000000, -19.4333324, 1.0},
-	{4714, "Iceland", "Breiddalsvik", 64.8000000, -14.0000000, 1.0},
-	{4715, "Iceland", "Kopasker", 66.3000000, -16.4500008, -9999.0},
-	{4716, "Iceland", "Kirkjubajarklaustur", 63.7833333, -18.0666676, 37.0},
-	{4717, "Iceland", "Litli-Arskogssandur", 65.9500000, -18.3666668, 3.0},
-	{4718, "Iceland", "Hafnir", 63.9333333, -22.6833324, 10.0},
-	{4719, "Iceland", "Reykholar", 65.4500000, -22.2000008, 5.0},
-	{4720, "Iceland", "Holar", 65.7333333, -19.1333332, 137.0},
-	{4721, "Iceland", "Bakkafjordur", 66.0333333, -14.8000002, 94.0},
-	{4722, "Iceland", "Drangsnes", 65.6833333, -21.4500008, -9999.0},
-	{4723, "Iceland", "Hallormsstadur", 65.1000000, -14.7500000, 84.0},
-	{4724, "Iceland", "Eidar", 65.3666667, -14.3500004, 55.0},
-	{4725, "Iceland", "Hjalteyri", 65.8500000, -18.2000008, -9999.0},
-	{4726, "Iceland", "Reykholt", 64.6666667, -21.2999992, 84.0},
-	{4727, "Iceland", "Bordeyri", 65.2000000, -21.1000004, -9999.0},
-	{4728, "Iceland", "Solheimar", 64.0805297, -20.6448555, 80.0},
-	{4729, "Kiribati", "Bairiki", 1.3291406, 172.9780121, -9999.0},
-	{4730, "Kiribati", "Tarawa", -0.8692170, 169.5406342, 1.0},
-	{4731, "Kiribati", "Taburao", 1.8166667, 173.0166626, 1.0},
-	{4732, "Kiribati", "Temaraia", -0.6666667, 174.4499969, -9999.0},
-	{4733, "Kiribati", "Butaritari", 3.0833333, 172.8166656, -9999.0},
-	{4734, "Kiribati", "Utiroa", -1.2166667, 174.7500000, 1.0},
-	{4735, "Kiribati", "Tabukiniberu", -1.3419259, 175.9894714, 1.0},
-	{4736, "Kiribati", "Rawannawi", 2.0500000, 173.2666626, 1.0},
-	{4737, "Kiribati", "Tabiauea", 0.9000000, 173.0333405, -9999.0},
-	{4738, "Kiribati", "Rungata", -1.3500000, 176.4333344, -9999.0},
-	{4739, "Kiribati", "Ijaki", -1.9166667, 175.5833282, -9999.0},
-	{4740, "Kiribati", "Buariki", -1.4833333, 175.0666656, -9999.0},
-	{4741, "Kiribati", "Binoinano", 0.4166667, 173.9166718, -9999.0},
-	{4742, "Kiribati", "Ooma", -0.9000000, 169.5500031, -9999.0},
-	{4743, "India", "Mumbai", 19.0144100, 72.8479385, 12.0},
-	{4744, "India", "Delhi", 28.6666667, 77.2166672, 213.0},
-	{4745, "India", "Bengaluru", 12.9762267, 77.6032944, 914.0},
-	{4746, "India", "Calcutta", 22.5697222, 88.3697205, 16.0},
-	{4747, "India", "Chennai", 13.0878385, 80.2784729, 20.0},
-	{4748, "India", "Ahmadabad", 23.0333333, 72.6166687, 66.0},
-	{4749, "India", "Hyderabad", 17.3752778, 78.4744415, 494.0},
-	{4750, "India", "Pune", 18.5195742, 73.8553543, 562.0},
-	{4751, "India", "Surat", 21.1666667, 72.8333359, 13.0},
-	{4752, "India", "Kanpur", 26.4666667, 80.3499985, 128.0},
-	{4753, "India", "Jaipur", 26.9166667, 75.8166656, 426.0},
-	{4754, "India", "Lucknow", 26.8500000, 80.9166641, 131.0},
-	{4755, "India", "Nagpur", 21.1500000, 79.0999985, 304.0},
-	{4756, "India", "Indore", 22.7179235, 75.8332977, 544.0},
-	{4757, "India", "Patna", 25.6000000, 85.1166687, 50.0},
-	{4758, "India", "Bhopal", 23.2666667, 77.4000015, 487.0},
-	{4759, "India", "Ludhiana", 30.9000000, 75.8499985, 243.0},
-	{4760, "India", "Agra", 27.1833333, 78.0166702, 171.0},
-	{4761, "India", "Vadodara", 22.3000000, 73.1999969, 194.0},
-	{4762, "India", "Gorakhpur", 29.4500000, 75.6833344, 209.0},
-	{4763, "India", "Nasik", 19.9833333, 73.8000031, 570.0},
-	{4764, "India", "Pimpri", 18.6166667, 73.8000031, 593.0},
-	{4765, "India", "Kalyan", 19.2500000, 73.1500015, 14.0},
-	{4766, "India", "Thane", 19.2000000, 72.9666672, 9.0},
-	{4767, "India", "Meerut", 28.9833333, 77.6999969, 219.0},
-	{4768, "India", "Faridabad", 28.4333333, 77.3166656, 198.0},
-	{4769, "India", "Ghaziabad", 28.6666667, 77.4333344, 212.0},
-	{4770, "India", "Rajkot", 22.3000000, 70.7833328, 134.0},
-	{4771, "India", "Benares", 25.3333333, 83.0000000, 77.0},
-	{4772, "India", "Amritsar", 31.6330556, 74.8655548, 218.0},
-	{4773, "India", "Allahabad", 25.4500000, 81.8499985, 72.0},
-	{4774, "India", "Vishakhapatnam", 17.7000000, 83.3000031, -9999.0},
-	{4775, "India", "Teni", 10.0000000, 77.4833298, 303.0},
-	{4776, "India", "Jabalpur", 23.1669749, 79.9500656, 403.0},
-	{4777, "India", "Haora", 22.5891667, 88.3102798, 12.0},
-	{4778, "India", "Aurangabad", 19.8833333, 75.3333359, 572.0},
-	{4779, "India", "Solapur", 17.6833333, 75.9166641, 458.0},
-	{4780, "India", "Srinagar", 34.0833333, 74.8166656, 1555.0},
-	{4781, "India", "Coimbatore", 11.0000000, 76.9666672, 380.0},
-	{4782, "India", "Jodhpur", 26.2866667, 73.0299988, 235.0},
-	{4783, "India", "Chandigarh", 30.7372222, 76.7872238, 326.0},
-	{4784, "India", "Madurai", 9.9333333, 78.1166687, 136.0},
-	{4785, "India", "Guwahati", 26.1861736, 91.7509460, 53.0},
-	{4786, "India", "Gwalior", 26.2236111, 78.1791687, 202.0},
-	{4787, "India", "Vijayawada", 16.5166667, 80.6166687, 12.0},
-	{4788, "India", "Mysore", 12.3072222, 76.6497192, 754.0},
-	{4789, "India", "Ranchi", 23.3500000, 85.3333359, 623.0},
-	{4790, "India", "Hubli", 15.3500000, 75.1666641, 640.0},
-	{4791, "India", "Jalandhar", 31.3255556, 75.5791702, 233.0},
-	{4792, "India", "Thiruvananthapuram", 8.4833333, 76.9166641, 1.0},
-	{4793, "India", "Salem", 11.6500000, 78.1666641, 278.0},
-	{4794, "India", "Tiruchchirappalli", 10.8166667, 78.6833344, 76.0},
-	{4795, "India", "Kota", 25.1833333, 75.8333359, 249.0},
-	{4796, "India", "Bhubaneshwar", 20.2333333, 85.8333359, 27.0},
-	{4797, "India", "Aligarh", 27.8833333, 78.0833359, 180.0},
-	{4798, "India", "Bareilly", 28.3500000, 79.4166641, 166.0},
-	{4799, "India", "Moradabad", 28.8333333, 78.7833328, 186.0},
-	{4800, "India", "Bhiwandi", 19.3000000, 73.0666656, 24.0},
-	{4801, "India", "Raipur", 21.2333333, 81.6333313, 285.0},
-	{4802, "India", "Gorakhpur", 26.7550000, 83.3738861, 70.0},
-	{4803, "India", "Bhilai", 21.2166667, 81.4333344, 288.0},
-	{4804, "India", "Jamshedpur", 22.8000000, 86.1833344, 133.0},
-	{4805, "India", "Cochin", 9.9666667, 76.2333298, -9999.0},
-	{4806, "India", "Amravati", 20.9333333, 77.7500000, 343.0},
-	{4807, "India", "Cuttack", 20.4649727, 85.8792648, 33.0},
-	{4808, "India", "Bikaner", 28.0166667, 73.3000031, 226.0},
-	{4809, "India", "Warangal", 18.0000000, 79.5833359, 302.0},
-	{4810, "India", "Bhavnagar", 21.7666667, 72.1500015, 25.0},
-	{4811, "India", "Guntur", 16.3000000, 80.4499969, 33.0},
-	{4812, "India", "Dehra Dun", 30.3166667, 78.0333328, 652.0},
-	{4813, "India", "Durgapur", 23.4833333, 87.3166656, 65.0},
-	{4814, "India", "Ajmer", 26.4500000, 74.6333313, 517.0},
-	{4815, "India", "Ulhasnagar", 19.2166667, 73.1500015, 21.0},
-	{4816, "India", "Kolhapur", 16.7000000, 74.2166672, 545.0},
-	{4817, "India", "Shiliguri", 26.7000000, 88.4333344, 122.0},
-	{4818, "India", "Asansol", 23.6833333, 86.9833298, 96.0},
-	{4819, "India", "Jamnagar", 22.4666667, 70.0666656, 16.0},
-	{4820, "India", "Saharanpur", 29.9666667, 77.5500031, 268.0},
-	{4821, "India", "Gulbarga", 17.3333333, 76.8333359, 458.0},
-	{4822, "India", "Bhatpara", 22.8713889, 88.4088898, 12.0},
-	{4823, "India", "Jammu", 32.7333333, 74.8666687, 327.0},
-	{4824, "India", "Ujjain", 23.1833333, 75.7666702, 491.0},
-	{4825, "India", "Nangi", 22.5083333, 88.2152786, 8.0},
-	{4826, "India", "Calicut", 11.2500000, 75.7666702, 1.0},
-	{4827, "India", "Tirunelveli", 8.7333333, 77.6999969, 47.0},
-	{4828, "India", "Malegaon", 20.5500000, 74.5333328, 423.0},
-	{4829, "India", "Jalgaon", 21.0166667, 75.5666656, 200.0},
-	{4830, "India", "Akola", 20.7333333, 77.0000000, 283.0},
-	{4831, "India", "Belgaum", 15.8666667, 74.5000000, 768.0},
-	{4832, "India", "Gaya", 24.7833333, 85.0000000, 111.0},
-	{4833, "India", "Udaipur", 24.5833333, 73.6833344, 590.0},
-	{4834, "India", "Korba", 22.3500000, 82.6833344, 265.0},
-	{4835, "India", "Bokaro", 23.7833333, 85.9666672, 234.0},
-	{4836, "India", "Mangalore", 12.8666667, 74.8833313, 56.0},
-	{4837, "India", "Jhansi", 25.4333333, 78.5833359, 285.0},
-	{4838, "India", "Nellore", 14.4333333, 79.9666672, 19.0},
-	{4839, "India", "Tiruppur", 11.1000000, 77.3499985, 295.0},
-	{4840, "India", "Quilon", 8.8833333, 76.5999985, 1.0},
-	{4841, "India", "Panihati", 22.6941667, 88.3744431, 17.0},
-	{4842, "India", "Ahmadnagar", 19.0833333, 74.7333298, 646.0},
-	{4843, "Indonesia", "Jakarta", -6.1744444, 106.8294449, 2.0},
-	{4844, "Indonesia", "Surabaya", -7.2491667, 112.7508316, 1.0},
-	{4845, "Indonesia", "Medan", 3.5833333, 98.6666641, 50.0},
-	{4846, "Indonesia", "Bandung", -6.9127778, 107.6205521, 815.0},
-	{4847, "Indonesia", "Bekasi", -6.2366667, 106.9919434, 36.0},
-	{4848, "Indonesia", "Palembang", -2.9167253, 104.7457962, 3.0},
-	{4849, "Indonesia", "Tangerang", -6.1780556, 106.6299973, 24.0},
-	{4850, "Indonesia", "Makassar", -5.1463889, 119.4386139, 13.0},
-	{4851, "Indonesia", "Semarang", -6.9666667, 110.4166641, 22.0},
-	{4852, "Indonesia", "Depok", -6.4000000, 106.8186111, 84.0},
-	{4853, "Indonesia", "Mamuju", -2.6786111, 118.8933334, 42.0},
-	{4854, "Indonesia", "Padang", -0.9492440, 100.3542709, 61.0},
-	{4855, "Indonesia", "Tanjungkarang-Telukbetung", -5.4500000, 105.2666702, -9999.0},
-	{4856, "Indonesia", "Bogor", -6.5897222, 106.7913895, 205.0},
-	{4857, "Indonesia", "Malang", -7.9777778, 112.6280594, 476.0},
-	{4858, "Indonesia", "Yogyakarta", -7.7827778, 110.3608322, 162.0},
-	{4859, "Indonesia", "Situbondo", -7.7062286, 114.0097580, 35.0},
-	{4860, "Indonesia", "Banjarmasin", -3.3333333, 114.5833359, 1.0},
-	{4861, "Indonesia", "Surakarta", -7.5561111, 110.8316650, 109.0},
-	{4862, "Indonesia", "Cimahi", -6.8722222, 107.5425034, 842.0},
-	{4863, "Indonesia", "Pontianak", -0.0333333, 109.3333359, 1.0},
-	{4864, "Indonesia", "Manado", 1.5016667, 124.8441696, 14.0},
-	{4865, "Indonesia", "Balikpapan", -1.2675300, 116.8288727, 1.0},
-	{4866, "Indonesia", "Jambi", -1.6000000, 103.6166687, 23.0},
-	{4867, "Indonesia", "Denpasar", -8.6500000, 115.2166672, 41.0},
-	{4868, "Indonesia", "Ambon", -3.7166667, 128.1999969, 297.0},
-	{4869, "Indonesia", "Samarinda", -0.5000000, 117.1500015, 1.0},
-	{4870, "Indonesia", "Mataram", -8.5833333, 116.1166687, 32.0},
-	{4871, "Indonesia", "Percut", 3.6833333, 98.7500000, 3.0},
-	{4872, "Indonesia", "Bengkulu", -3.8004430, 102.2655411, 6.0},
-	{4873, "Indonesia", "Jember", -8.1660391, 113.7031784, 133.0},
-	{4874, "Indonesia", "Palu", -0.9016667, 119.8597260, 16.0},
-	{4875, "Indonesia", "Kupang", -10.1666667, 123.5833359, 62.0},
-	{4876, "Indonesia", "Sukabumi", -6.9166667, 106.9272232, 610.0},
-	{4877, "Indonesia", "Tasikmalaya", -7.3333333, 108.1999969, 336.0},
-	{4878, "Indonesia", "Pekalongan", -6.8833333, 109.6666641, 7.0},
-	{4879, "Indonesia", "Cirebon", -6.7333333, 108.5666656, 1.0},
-	{4880, "Indonesia", "Banda Aceh", 5.5616667, 95.3258362, 8.0},
-	{4881, "Indonesia", "Tegal", -6.8666667, 109.1333313, 7.0},
-	{4882, "Indonesia", "Kediri", -7.8166667, 112.0166702, 94.0},
-	{4883, "Indonesia", "Binjai", 3.6000000, 98.5000000, 56.0},
-	{4884, "Indonesia", "Purwokerto", -7.4213889, 109.2344437, 113.0},
-	{4885, "Indonesia", "Purwakarta", -6.5569444, 107.4388885, 130.0},
-	{4886, "Indonesia", "Loa Janan", -0.5829520, 117.0950317, 1.0},
-	{4887, "Indonesia", "Pematangsiantar", 2.9500000, 99.0500031, 438.0},
-	{4888, "Indonesia", "Ciputat", -6.2375000, 106.6955566, 28.0},
-	{4889, "Indonesia", "Ciampea", -6.5400000, 106.7102814, 112.0},
-	{4890, "Indonesia", "Sumedang Utara", -6.8500000, 107.9166641, 565.0},
-	{4891, "Indonesia", "Cileungsi", -6.3947222, 106.9591675, 102.0},
-	{4892, "Indonesia", "Rengasdengklok", -6.1483333, 107.2830582, 23.0},
-	{4893, "Indonesia", "Parung", -6.4213889, 106.7330551, 84.0},
-	{4894, "Indonesia", "Curug", -6.2658333, 106.5563889, 50.0},
-	{4895, "Indonesia", "Labuhanbajo", -8.4833333, 119.9000015, 118.0},
-	{4896, "Indonesia", "Cibinong", -6.4816667, 106.8541641, 107.0},
-	{4897, "Indonesia", "Madiun", -7.6166667, 111.5166702, 94.0},
-	{4898, "Indonesia", "Pemalang", -6.9000000, 109.3666687, 21.0},
-	{4899, "Indonesia", "Lembang", -6.8116667, 107.6175003, 1250.0},
-	{4900, "Indonesia", "Probolinggo", -7.7500000, 113.2166672, 2.0},
-	{4901, "Indonesia", "Pamulang", -6.3427778, 106.7383347, 61.0},
-	{4902, "Indonesia", "Cikupa", -6.2363889, 106.5083313, 54.0},
-	{4903, "Indonesia", "Salatiga", -7.3319444, 110.4927750, 579.0},
-	{4904, "Indonesia", "Plumbon", -6.7050000, 108.4727783, 66.0},
-	{4905, "Indonesia", "Banjaran", -7.0452778, 107.5877762, 792.0},
-	{4906, "Indonesia", "Serang", -6.1127778, 106.1477814, 47.0},
-	{4907, "Indonesia", "Lawang", -7.8263889, 112.7019424, 454.0},
-	{4908, "Indonesia", "Pasuruan", -7.6333333, 112.9000015, 1.0},
-	{4909, "Indonesia", "Sunggal", 3.5500000, 98.6166687, 58.0},
-	{4910, "Indonesia", "Perbaungan", 3.5666667, 98.9499969, 14.0},
-	{4911, "Indonesia", "Pasarkemis", -6.1702778, 106.5302811, 40.0},
-	{4912, "Indonesia", "Soreang", -7.0330556, 107.5183334, 823.0},
-	{4913, "Indonesia", "Purwodadi", -7.0833333, 110.9000015, 41.0},
-	{4914, "Indonesia", "Metro", -5.1130556, 105.3066635, 72.0},
-	{4915, "Indonesia", "Lubuklinggau", -3.3000000, 102.8666687, 132.0},
-	{4916, "Indonesia", "Astanajapura", -6.8000000, 108.5999985, 55.0},
-	{4917, "Indonesia", "Cikampek", -6.4136111, 107.4594421, 67.0},
-	{4918, "Indonesia", "Gorontalo", 0.5344444, 123.0616684, 22.0},
-	{4919, "Indonesia", "Dumai", 1.6833333, 101.4499969, 1.0},
-	{4920, "Indonesia", "Tanjungbalai", 2.9666667, 99.8000031, 5.0},
-	{4921, "Indonesia", "Sidoarjo", -7.4538889, 112.7202759, 1.0},
-	{4922, "Indonesia", "Weru", -6.7000000, 108.5000000, 37.0},
-	{4923, "Indonesia", "Bitung", 1.4411111, 125.1572189, 54.0},
-	{4924, "Indonesia", "Jayapura", -2.5333333, 140.6999969, 287.0},
-	{4925, "Indonesia", "Baturaja", -4.1333333, 104.1666641, 115.0},
-	{4926, "Indonesia", "Adiwerna", -6.9333333, 109.1166687, 39.0},
-	{4927, "Indonesia", "Singaraja", -8.1120006, 115.0881805, 20.0},
-	{4928, "Indonesia", "Blitar", -8.1000000, 112.1500015, 162.0},
-	{4929, "Indonesia", "Martapura", -3.4166667, 114.8499985, 26.0},
-	{4930, "Indonesia", "Kisaran", 2.9833333, 99.6166687, 28.0},
-	{4931, "Indonesia", "Teluknaga", -6.0988889, 106.6380539, 5.0},
-	{4932, "Indonesia", "Ungaran", -7.1397222, 110.4049988, 319.0},
-	{4933, "Indonesia", "Rangkasbitung", -6.3547222, 106.2472229, 91.0},
-	{4934, "Indonesia", "Klaten", -7.7058333, 110.6063919, 185.0},
-	{4935, "Indonesia", "Jombang", -7.5459539, 112.2330704, 57.0},
-	{4936, "Indonesia", "Paseh", -7.1000000, 107.7500000, 920.0},
-	{4937, "Indonesia", "Pangkalpinang", -2.1333333, 106.1333313, 46.0},
-	{4938, "Indonesia", "Sorong", -0.8833333, 131.2500000, 32.0},
-	{4939, "Indonesia", "Tanjungpinang", 0.9166667, 104.4499969, 1.0},
-	{4940, "Indonesia", "Padalarang", -6.8377778, 107.4727783, 876.0},
-	{4941, "Indonesia", "Ciamis", -7.3333333, 108.3499985, 152.0},
-	{4942, "Indonesia", "Lumajang", -8.1333333, 113.2166672, 98.0},
-	{4943, "Iran", "Tehran", 35.6719444, 51.4244461, 1149.0},
-	{4944, "Iran", "Mashhad", 36.2958333, 59.6119461, 1015.0},
-	{4945, "Iran", "Esfahan", 32.6597222, 51.6713905, 1566.0},
-	{4946, "Iran", "Karaj", 35.8287611, 50.9982033, 1360.0},
-	{4947, "Iran", "Tabriz", 38.0800000, 46.2919426, 1395.0},
-	{4948, "Iran", "Shiraz", 29.6150000, 52.5383339, 1531.0},
-	{4949, "Iran", "Qom", 34.6452778, 50.8808327, 925.0},
-	{4950, "Iran", "Ahvaz", 31.3291667, 48.6911125, 43.0},
-	{4951, "Iran", "Kahriz", 34.3877778, 47.0580559, 1298.0},
-	{4952, "Iran", "Kermanshah", 34.3141667, 47.0649986, 1389.0},
-	{4953, "Iran", "Rasht", 37.2794444, 49.5858345, 5.0},
-	{4954, "Iran", "Kerman", 30.2938889, 57.0841675, 1764.0},
-	{4955, "Iran", "Zahedan", 29.4977778, 60.8727760, 1350.0},
-	{4956, "Iran", "Hamadan", 34.7961111, 48.5158348, 1824.0},
-	{4957, "Iran", "Azadshahr", 34.8000000, 48.5499992, 1873.0},
-	{4958, "Iran", "Arak", 34.0855556, 49.6838875, 1724.0},
-	{4959, "Iran", "Yazd", 31.8972222, 54.3675003, 1229.0},
-	{4960, "Iran", "Ardabil", 38.2494444, 48.3013878, 1333.0},
-	{4961, "Iran", "Abadan", 30.3525000, 48.2958336, 12.0},
-	{4962, "Iran", "Zanjan", 36.6736000, 48.4786987, 1639.0},
-	{4963, "Iran", "Sanandaj", 35.3172222, 46.9988899, 1487.0},
-	{4964, "Iran", "Qazvin", 36.2622222, 50.0169449, 1290.0},
-	{4965, "Iran", "Khorramshahr", 30.4391667, 48.1791649, 18.0},
-	{4966, "Iran", "Khorramabad", 33.4877778, 48.3558350, 1246.0},
-	{4967, "Iran", "Eslamshahr", 35.5644444, 51.2558327, 1046.0},
-	{4968, "Iran", "Homayunshahr", 32.6861111, 51.5319443, 1589.0},
-	{4969, "Iran", "Sari", 36.5677778, 53.0586128, 52.0},
-	{4970, "Iran", "Borujerd", 33.8973000, 48.7515984, 1564.0},
-	{4971, "Iran", "Qarchak", 35.4394444, 51.5688896, 966.0},
-	{4972, "Iran", "Gorgan", 36.8394444, 54.4361115, 175.0},
-	{4973, "Iran", "Sabzevar", 36.2144444, 57.6797218, 999.0},
-	{4974, "Iran", "Najafabad", 32.6330556, 51.3658333, 1655.0},
-	{4975, "Iran", "Neyshabur", 36.2097222, 58.7988892, 1220.0},
-	{4976, "Iran", "Nazarabad", 35.9521000, 50.6074982, 1180.0},
-	{4977, "Iran", "Bukan", 36.5219444, 46.2150002, 1372.0},
-	{4978, "Iran", "Sirjan", 29.4513889, 55.6716652, 1749.0},
-	{4979, "Iran", "Babol", 36.5441667, 52.6788902, 1.0},
-	{4980, "Iran", "Amol", 36.4708333, 52.3633347, 90.0},
-	{4981, "Iran", "Birjand", 32.8738889, 59.2144432, 1445.0},
-	{4982, "Iran", "Bojnurd", 37.4775000, 57.3244438, 1070.0},
-	{4983, "Iran", "Varamin", 35.3252778, 51.6455574, 916.0},
-	{4984, "Iran", "Malayer", 34.2941667, 48.8199997, 1729.0},
-	{4985, "Iran", "Saveh", 35.0213000, 50.3566017, 1009.0},
-	{4986, "Iran", "Khvoy", 38.5525000, 44.9477768, 1138.0},
-	{4987, "Iran", "Bandar-e Bushehr", 28.9684000, 50.8385010, 9.0},
-	{4988, "Iran", "Mahabad", 36.7631000, 45.7221985, 1304.0},
-	{4989, "Iran", "Saqqez", 36.2499190, 46.2734985, 1480.0},
-	{4990, "Iran", "Rafsanjan", 30.4066667, 55.9938889, 1517.0},
-	{4991, "Iran", "Ilam", 33.6374000, 46.4226990, 1381.0},
-	{4992, "Iran", "Miandoab", 36.9611111, 46.1091652, 1305.0},
-	{4993, "Iran", "Shahrud", 36.4300000, 54.9477768, 1615.0},
-	{4994, "Iran", "Gonbad-e Kavus", 37.2550000, 55.1713905, 57.0},
-	{4995, "Iran", "Iranshahr", 27.2038889, 60.6919441, 560.0},
-	{4996, "Iran", "Shahr-e Kord", 32.3255556, 50.8644447, 2061.0},
-	{4997, "Iran", "Torbat-e Heydariyeh", 35.2736111, 59.2200012, 1330.0},
-	{4998, "Iran", "Semnan", 35.5752778, 53.3941650, 1137.0},
-	{4999, "Iran", "Marand", 38.4329000, 45.7748985, 1344.0},
-	{5000, "Iran", "Zabol", 31.0297222, 61.4977760, 478.0},
-	{5001, "Iran", "Quchan", 37.1069444, 58.5097237, 1318.0},
-	{5002, "Iran", "Masjed-e Soleyman", 31.9363889, 49.3038902, 288.0},
-	{5003, "Iran", "Bandar-e Anzali", 37.4711111, 49.4622231, -16.0},
-	{5004, "Iran", "Baneh", 35.9975000, 45.8852997, 1503.0},
-	{5005, "Iran", "Parsabad", 39.6482000, 47.9174004, 33.0},
-	{5006, "Iran", "Shahreza", 32.0338889, 51.8794441, 1785.0},
-	{5007, "Iran", "Kuhdasht", 33.5350000, 47.6060982, 1193.0},
-	{5008, "Iran", "Bam", 29.1077778, 58.3619461, 1057.0},
-	{5009, "Iran", "Akbarabad", 29.2450000, 52.7755547, 1505.0},
-	{5010, "Iran", "Fasa", 28.9394444, 53.6500015, 1366.0},
-	{5011, "Iran", "Kashmar", 35.2363889, 58.4819450, 1072.0},
-	{5012, "Iran", "Qeshm", 26.9581000, 56.2719002, 39.0},
-	{5013, "Iran", "Do Gonbadan", 30.3586000, 50.7980995, 739.0},
-	{5014, "Iran", "Kazerun", 29.6183333, 51.6583328, 851.0},
-	{5015, "Iran", "Ahar", 38.4774000, 47.0699005, 1342.0},
-	{5016, "Iran", "Behshahr", 36.6944444, 53.5424995, 1.0},
-	{5017, "Iran", "Aligudarz", 33.3836111, 49.6963882, 2032.0},
-	{5018, "Iran", "Qorveh", 35.1664000, 47.8045006, 1907.0},
-	{5019, "Iran", "Borazjan", 29.2622222, 51.2052765, 69.0},
-	{5020, "Iran", "Salmas", 38.1975000, 44.7680550, 1397.0},
-	{5021, "Iran", "Bonab", 37.3404000, 46.0560989, 1282.0},
-	{5022, "Iran", "Behbahan", 30.5983333, 50.2449989, 326.0},
-	{5023, "Iran", "Shushtar", 32.0436111, 48.8569450, 60.0},
-	{5024, "Iran", "Khomeyn", 33.6436111, 50.0780563, 1798.0},
-	{5025, "Iran", "Nahavand", 34.1919444, 48.3658333, 1626.0},
-	{5026, "Iran", "Alvand", 36.3187000, 49.1678009, 1871.0},
-	{5027, "Iran", "Naqadeh", 36.9563889, 45.3880539, 1324.0},
-	{5028, "Iran", "Nurabad", 34.0734000, 47.9724998, 1832.0},
-	{5029, "Iran", "Takestan", 36.0696000, 49.6959000, 1275.0},
-	{5030, "Iran", "Minab", 27.1467000, 57.0801010, 48.0},
-	{5031, "Iran", "Abhar", 36.1468000, 49.2179985, 1543.0},
-	{5032, "Iran", "Deh Dasht", 30.7936111, 50.5649986, 806.0},
-	{5033, "Iran", "Chalus", 36.6547222, 51.4219437, 9.0},
-	{5034, "Iran", "Khash", 28.2197222, 61.2130547, 1409.0},
-	{5035, "Iran", "Langerud", 37.1952778, 50.1491661, -1.0},
-	{5036, "Iran", "Damghan", 36.1666667, 54.3438873, 1192.0},
-	{5037, "Iran", "Firuzabad", 28.8461111, 52.5713882, 1316.0},
-	{5038, "Iran", "Nurabad", 30.1252778, 51.5383339, 958.0},
-	{5039, "Iran", "Darab", 28.7519444, 54.5444450, 1146.0},
-	{5040, "Iran", "Robat Karim", 35.4846000, 51.0829010, 1032.0},
-	{5041, "Iran", "Kamyaran", 34.7956000, 46.9355011, 1433.0},
-	{5042, "Iran", "Esfarayen", 37.0733333, 57.5072212, 1246.0},
-	{5043, "Iraq", "Baghdad", 33.3386111, 44.3938904, 41.0},
-	{5044, "Iraq", "Al Basrah", 30.4941667, 47.8191681, 6.0},
-	{5045, "Iraq", "Al Mawsil al Jadidah", 36.3291667, 43.0861130, 283.0},
-	{5046, "Iraq", "Al Basrah al Qadimah", 30.4955556, 47.8175011, 6.0},
-	{5047, "Iraq", "Mosul", 36.3350000, 43.1188889, 260.0},
-	{5048, "Iraq", "Arbil", 36.1900000, 44.0088882, 429.0},
-	{5049, "Iraq", "Abu Ghurayb", 33.3083333, 44.1850014, 32.0},
-	{5050, "Iraq", "As Sulaymaniyah", 35.5616667, 45.4408340, 830.0},
-	{5051, "Iraq", "Kirkuk", 35.4680556, 44.3922234, 354.0},
-	{5052, "Iraq", "An Najaf", 31.9961111, 44.3147240, 51.0},
-	{5053, "Iraq", "An Nasiriyah", 31.0541667, 46.2594452, 4.0},
-	{5054, "Iraq", "Al `Amarah", 31.8452778, 47.1752777, 5.0},
-	{5055, "Iraq", "Ad Diwaniyah", 31.9877778, 44.9255562, 17.0},
-	{5056, "Iraq", "Al Kut", 32.4975000, 45.8291664, 23.0},
-	{5057, "Iraq", "Al Hillah", 32.4794444, 44.4327774, 26.0},
-	{5058, "Iraq", "Ar Ramadi", 33.4235370, 43.3092957, 51.0},
-	{5059, "Iraq", "Al Fallujah", 33.3561111, 43.7827797, 39.0},
-	{5060, "Iraq", "As Samawah", 31.3094444, 45.2802773, 8.0},
-	{5061, "Iraq", "Ba`qubah", 33.7500000, 44.6411095, 41.0},
-	{5062, "Iraq", "Sina", 36.8086111, 43.0386124, 669.0},
-	{5063, "Iraq", "Az Zubayr", 30.3891667, 47.7080574, 23.0},
-	{5064, "Iraq", "Al Faw", 29.9680556, 48.4672203, 7.0},
-	{5065, "Iraq", "Zakho", 37.1441667, 42.6872215, 428.0},
-	{5066, "Iraq", "Al Harithah", 30.7186111, 47.7208328, 19.0},
-	{5067, "Iraq", "Ash Shatrah", 31.4175000, 46.1772232, 2.0},
-	{5068, "Iraq", "Al Hayy", 32.1769444, 46.0447235, 8.0},
-	{5069, "Iraq", "Chamchamal", 35.5366667, 44.8319435, 706.0},
-	{5070, "Iraq", "Al Khalis", 33.8183333, 44.5244446, 42.0},
-	{5071, "Iraq", "Tozkhurmato", 34.8830556, 44.6219444, 198.0},
-	{5072, "Iraq", "Ash Shamiyah", 31.9630556, 44.5977783, 5.0},
-	{5073, "Iraq", "Al Hindiyah", 32.5433333, 44.2224998, 16.0},
-	{5074, "Iraq", "Halabjah", 35.1777778, 45.9861107, 721.0},
-	{5075, "Iraq", "Al Miqdadiyah", 33.9786111, 44.9369431, 67.0},
-	{5076, "Iraq", "Ar Rumaythah", 31.5261111, 45.2063904, 9.0},
-	{5077, "Iraq", "Koi Sanjaq", 36.0838889, 44.6277771, 582.0},
-	{5078, "Iraq", "Al `Aziziyah", 32.9083333, 45.0666656, 32.0},
-	{5079, "Iraq", "Al Musayyib", 32.7786111, 44.2900009, 28.0},
-	{5080, "Iraq", "Tikrit", 34.5966667, 43.6769447, 116.0},
-	{5081, "Iraq", "As Suwayrah", 32.9255556, 44.7758331, 25.0},
-	{5082, "Iraq", "Balad", 34.0163889, 44.1463890, 53.0},
-	{5083, "Iraq", "Sinjar", 36.3166667, 41.8499985, 506.0},
-	{5084, "Iraq", "Al Qasim", 32.3077778, 44.6788902, 20.0},
-	{5085, "Iraq", "Bayji", 34.9250000, 43.4874992, 124.0},
-	{5086, "Iraq", "Al Majarr al Kabir", 31.5750000, 47.1580544, 3.0},
-	{5087, "Iraq", "Hit", 33.6366667, 42.8224983, 89.0},
-	{5088, "Iraq", "Hadithah", 34.1397222, 42.3780556, 149.0},
-	{5089, "Iraq", "Ghammas", 31.7352778, 44.6074982, 23.0},
-	{5090, "Iraq", "Saddat al Hindiyah", 32.7147222, 44.2802773, 23.0},
-	{5091, "Iraq", "Kifri", 34.6913889, 44.9608345, 219.0},
-	{5092, "Iraq", "Mandali", 33.7436111, 45.5463905, 138.0},
-	{5093, "Iraq", "Qarah Qush", 36.2694444, 43.3772240, 274.0},
-	{5094, "Iraq", "Penjwin", 35.6202778, 45.9472237, 1256.0},
-	{5095, "Iraq", "Ad Dujayl", 33.8466667, 44.2344437, 51.0},
-	{5096, "Iraq", "Tall Kayf", 36.4894444, 43.1191673, 302.0},
-	{5097, "Iraq", "Al Mishkhab", 31.8016667, 44.4902763, 13.0},
-	{5098, "Iraq", "Rawandoz", 36.6113889, 44.5224991, 827.0},
-	{5099, "Iraq", "Ash Shinafiyah", 31.5822222, 44.6455574, 8.0},
-	{5100, "Iraq", "Ar Rutbah", 33.0380556, 40.2844429, 618.0},
-	{5101, "Iraq", "`Afak", 32.0625000, 45.2427788, 23.0},
-	{5102, "Iraq", "Nahiyat al Fuhud", 30.9697222, 46.7227783, 7.0},
-	{5103, "Iraq", "Rawah", 34.4686111, 41.9169426, 119.0},
-	{5104, "Iraq", "`Anah", 34.4666667, 41.9308319, 119.0},
-	{5105, "Iraq", "`Ali al Gharbi", 32.4641667, 46.6786118, 12.0},
-	{5106, "Ireland", "Dublin", 53.3330556, -6.2488890, 9.0},
-	{5107, "Ireland", "Cork", 51.8986111, -8.4958334, 57.0},
-	{5108, "Ireland", "Dun Laoghaire", 53.2939516, -6.1358643, 1.0},
-	{5109, "Ireland", "Limerick", 52.6647222, -8.6230555, 24.0},
-	{5110, "Ireland", "Galway", 53.2719444, -9.0488892, -9999.0},
-	{5111, "Ireland", "Tallaght", 53.2858965, -6.3734436, 87.0},
-	{5112, "Ireland", "Port Lairge", 52.2583333, -7.1119442, 8.0},
-	{5113, "Ireland", "Drogheda", 53.7188889, -6.3477778, 1.0},
-	{5114, "Ireland", "Dun Dealgan", 54.0000000, -6.4166665, 3.0},
-	{5115, "Ireland", "Bray", 53.2027778, -6.0983334, -9999.0},
-	{5116, "Ireland", "Sord", 53.4597222, -6.2180557, 6.0},
-	{5117, "Ireland", "An Uaimh", 53.6527778, -6.6813889, 42.0},
-	{5118, "Ireland", "Inis", 52.8436111, -8.9863892, 18.0},
-	{5119, "Ireland", "Tra Li", 52.2666667, -9.7166672, 37.0},
-	{5120, "Ireland", "Cill Chainnigh", 52.6541667, -7.2522221, 61.0},
-	{5121, "Ireland", "An Nas", 53.2158333, -6.6669445, 108.0},
-	{5122, "Ireland", "Sligeach", 54.2696915, -8.4694290, 5.0},
-	{5123, "Ireland", "Ceatharlach", 52.8408333, -6.9261112, 47.0},
-	{5124, "Ireland", "Droichead Nua", 53.1819444, -6.7966666, 108.0},
-	{5125, "Ireland", "Cill Droichid", 53.3386111, -6.5436110, 54.0},
-	{5126, "Ireland", "Loch Garman", 52.3341667, -6.4575000, -9999.0},
-	{5127, "Ireland", "Clonmel", 52.3550000, -7.7038889, 36.0},
-	{5128, "Ireland", "An Muileann gCearr", 53.5333333, -7.3499999, 112.0},
-	{5129, "Ireland", "Letterkenny", 54.9500000, -7.7333331, 53.0},
-	{5130, "Ireland", "Baile Atha Luain", 53.4333333, -7.9499998, 57.0},
-	{5131, "Ireland", "Leixlip", 53.3658333, -6.4955554, 48.0},
-	{5132, "Ireland", "Malahide", 53.4508333, -6.1544442, -9999.0},
-	{5133, "Ireland", "Port Laoise", 53.0344136, -7.2997928, 139.0},
-	{5134, "Ireland", "Carraig Ui Leighin", 51.8116667, -8.3986111, 17.0},
-	{5135, "Ireland", "Castlebar", 53.8500000, -9.3000002, 49.0},
-	{5136, "Ireland", "Greystones", 53.1408333, -6.0630555, 1.0},
-	{5137, "Ireland", "Tullamore", 53.2738889, -7.4888887, 73.0},
-	{5138, "Ireland", "Maynooth", 53.3850000, -6.5936112, 71.0},
-	{5139, "Ireland", "Balbriggan", 53.6127778, -6.1819444, -9999.0},
-	{5140, "Ireland", "Arklow", 52.7930556, -6.1413889, -9999.0},
-	{5141, "Ireland", "Cobh", 51.8572222, -8.2991667, 47.0},
-	{5142, "Ireland", "Cill Mhantain", 52.9750000, -6.0494442, 69.0},
-	{5143, "Ireland", "Beal An Atha", 54.1166667, -9.1666670, 9.0},
-	{5144, "Ireland", "Na Sceiri", 53.5827778, -6.1083331, -9999.0},
-	{5145, "Ireland", "Inis Corthaidh", 52.5008333, -6.5577779, 57.0},
-	{5146, "Ireland", "Mallow", 52.1333333, -8.6333332, 74.0},
-	{5147, "Ireland", "Tra Mhor", 52.1623500, -7.1524429, 2.0},
-	{5148, "Ireland", "Mainister na Corann", 51.9094444, -8.1736107, 44.0},
-	{5149, "Ireland", "Sionainn", 52.7038889, -8.8641663, 1.0},
-	{5150, "Ireland", "Portmarnock", 53.4230556, -6.1374998, 1.0},
-	{5151, "Ireland", "An Longfort", 53.7333333, -7.8000002, 72.0},
-	{5152, "Ireland", "Durlas", 52.6819444, -7.8022223, 98.0},
-	{5153, "Ireland", "Dungarvan", 52.0880556, -7.6252780, 1.0},
-	{5154, "Ireland", "Cill Airne", 52.0500000, -9.5166664, 60.0},
-	{5155, "Ireland", "An Ros", 53.5222986, -6.0930777, 1.0},
-	{5156, "Ireland", "Cill Dheaglain", 53.5108333, -6.4272223, 72.0},
-	{5157, "Ireland", "Eochaill", 51.9500000, -7.8505554, 1.0},
-	{5158, "Ireland", "Newtown Trim", 53.5561111, -6.7700000, 60.0},
-	{5159, "Ireland", "Dunboyne", 53.4000000, -6.4666667, 76.0},
-	{5160, "Ireland", "Ros Mhic Thriuin", 52.3966667, -6.9366665, 64.0},
-	{5161, "Ireland", "Nenagh Bridge", 52.8816667, -8.1958332, 69.0},
-	{5162, "Ireland", "An Cabhan", 53.9908333, -7.3605556, 101.0},
-	{5163, "Ireland", "Beal Atha na Sluaighe", 53.3275000, -8.2194443, 65.0},
-	{5164, "Ireland", "Baile Atha I", 52.9913889, -6.9802780, 63.0},
-	{5165, "Ireland", "Cherryville", 53.1569444, -6.9666667, 105.0},
-	{5166, "Ireland", "An Inse", 53.6819444, -6.2391667, -9999.0},
-	{5167, "Ireland", "Cathair na Mart", 53.8000000, -9.5166664, 108.0},
-	{5168, "Ireland", "Tuaim", 53.5166667, -8.8500004, 48.0},
-	{5169, "Ireland", "Gorey", 52.6747222, -6.2925000, 52.0},
-	{5170, "Ireland", "Muineachan", 54.2500000, -6.9666667, 72.0},
-	{5171, "Ireland", "Carrick-on-Suir", 52.3491667, -7.4130554, 15.0},
-	{5172, "Ireland", "Bun Cranncha", 55.1333333, -7.4499998, 63.0},
-	{5173, "Ireland", "An tAonach", 52.8619444, -8.1966667, 71.0},
-	{5174, "Ireland", "Droichead na Bandon", 51.7469444, -8.7425003, 70.0},
-	{5175, "Ireland", "Claonadh", 53.2913889, -6.6891665, 83.0},
-	{5176, "Ireland", "Tiobraid Arann", 52.4733333, -8.1558332, 166.0},
-	{5177, "Ireland", "Mainistir Fhear Mai", 52.1358333, -8.2758331, 35.0},
-	{5178, "Ireland", "An Pasaiste", 51.8738889, -8.3444443, 19.0},
-	{5179, "Ireland", "Edenderry", 53.3452778, -7.0497222, 99.0},
-	{5180, "Ireland", "Ros Comain", 53.6333333, -8.1833334, 73.0},
-	{5181, "Ireland", "Ceanannas", 53.7263889, -6.8791666, 76.0},
-	{5182, "Ireland", "Roscrea", 52.9511111, -7.8016667, 102.0},
-	{5183, "Ireland", "Birr", 53.0913889, -7.9133334, 69.0},
-	{5184, "Ireland", "Baile Locha Riach", 53.1969444, -8.5669441, 97.0},
-	{5185, "Ireland", "Portarlington", 53.1622222, -7.1911111, 83.0},
-	{5186, "Ireland", "Gaoth Dobhair", 55.0502778, -8.2319441, 126.0},
-	{5187, "Ireland", "An Caislean Nua", 52.4491667, -9.0611115, 67.0},
-	{5188, "Ireland", "Lios Tuathail", 52.4463889, -9.4849997, 69.0},
-	{5189, "Ireland", "Rath To", 53.5080556, -6.4625001, 78.0},
-	{5190, "Ireland", "Clonakilty", 51.6230556, -8.8705559, 10.0},
-	{5191, "Ireland", "Baile Atha Fhirdhia", 53.8597222, -6.5405555, 31.0},
-	{5192, "Ireland", "Carraig Mhachaire Rois", 53.9727778, -6.7188888, 79.0},
-	{5193, "Ireland", "Donabate", 53.4872222, -6.1519446, 1.0},
-	{5194, "Ireland", "Bealach Feich", 54.8000000, -7.7833333, 54.0},
-	{5195, "Ireland", "Cionn tSaile", 51.7075000, -8.5305557, 1.0},
-	{5196, "Ireland", "Dunshaughlin", 53.5125000, -6.5400000, 86.0},
-	{5197, "Ireland", "Mountmellick", 53.1136111, -7.3200002, 109.0},
-	{5198, "Ireland", "Dunlewy", 55.0166667, -8.1000004, 235.0},
-	{5199, "Ireland", "Baile Mhisteala", 52.2658333, -8.2680559, 135.0},
-	{5200, "Ireland", "Cill Choca", 53.4022222, -6.6708331, 78.0},
-	{5201, "Ireland", "Beanntrai", 51.6833333, -9.4499998, 112.0},
-	{5202, "Ireland", "Macroom", 51.9000000, -8.9499998, 88.0},
-	{5203, "Ireland", "Na Sollain", 53.2488889, -6.6661110, 97.0},
-	{5204, "Ireland", "Tower", 51.9166667, -8.6000004, 70.0},
-	{5205, "Ireland", "Caher", 52.3769444, -7.9216666, 62.0},
-	{5206, "Israel", "Jerusalem", 31.7666667, 35.2333336, 669.0},
-	{5207, "Israel", "Tel Aviv-Yafo", 32.0677778, 34.7647209, -9999.0},
-	{5208, "Israel", "Haifa", 32.8155556, 34.9891663, 290.0},
-	{5209, "Israel", "Ashdod", 31.8166667, 34.6500015, 4.0},
-	{5210, "Israel", "Rishon LeZiyyon", 31.9641667, 34.8044434, 78.0},
-	{5211, "Israel", "Beersheba", 31.2333333, 34.7833328, 259.0},
-	{5212, "Israel", "Netanya", 32.3336111, 34.8577766, 60.0},
-	{5213, "Israel", "Holon", 32.0113889, 34.7722206, 23.0},
-	{5214, "Israel", "Bene Beraq", 32.0902778, 34.8397217, 24.0},
-	{5215, "Israel", "Bat Yam", 32.0230556, 34.7502785, 52.0},
-	{5216, "Israel", "Ramat Gan", 32.0805556, 34.8141670, 59.0},
-	{5217, "Israel", "Ashqelon", 31.6666667, 34.5833321, 26.0},
-	{5218, "Israel", "Herzliyya", 32.1658333, 34.8366661, 66.0},
-	{5219, "Israel", "Kefar Sava", 32.1750000, 34.9069443, 73.0},
-	{5220, "Israel", "Hadera", 32.4352778, 34.9174995, 55.0},
-	{5221, "Israel", "Bet Shemesh", 31.7500000, 35.0000000, 219.0},
-	{5222, "Israel", "Lod", 31.9513889, 34.8952789, 63.0},
-	{5223, "Israel", "Nazareth", 32.7036111, 35.2955551, 504.0},
-	{5224, "Israel", "Ramla", 31.9252778, 34.8669434, 76.0},
-	{5225, "Israel", "Nahariyya", 33.0058333, 35.0947227, -9999.0},
-	{5226, "Israel", "Qiryat Ata", 32.8005556, 35.1063881, 20.0},
-	{5227, "Israel", "Giv`atayim", 32.0697222, 34.8116684, 73.0},
-	{5228, "Israel", "Qiryat Gat", 31.6000000, 34.7666664, 126.0},
-	{5229, "Israel", "`Akko", 32.9236111, 35.0727768, -9999.0},
-	{5230, "Israel", "Elat", 29.5611111, 34.9516678, 72.0},
-	{5231, "Israel", "Hod HaSharon", 32.1508333, 34.8883324, 60.0},
-	{5232, "Israel", "Umm el Fahm", 32.5158333, 35.1525002, 505.0},
-	{5233, "Israel", "Tiberias", 32.7955556, 35.5286102, -39.0},
-	{5234, "Israel", "Qiryat Motzkin", 32.8363889, 35.0752792, 18.0},
-	{5235, "Israel", "Qiryat Yam", 32.8508333, 35.0702782, -9999.0},
-	{5236, "Israel", "Qiryat Bialik", 32.8275000, 35.0858345, 23.0},
-	{5237, "Israel", "Ramat HaSharon", 32.1472222, 34.8416672, 89.0},
-	{5238, "Israel", "Dimona", 31.0666667, 35.0333328, 535.0},
-	{5239, "Israel", "Et Taiyiba", 32.2647222, 35.0080566, 93.0},
-	{5240, "Israel", "Yavne", 31.8763889, 34.7400017, 47.0},
-	{5241, "Israel", "Or Yehuda", 32.0311111, 34.8458328, 25.0},
-	{5242, "Israel", "Zefat", 32.9666667, 35.4944458, 642.0},
-	{5243, "Israel", "Mevo Betar", 31.7166667, 35.0999985, 775.0},
-	{5244, "Israel", "Tamra", 32.8486111, 35.2047234, 83.0},
-	{5245, "Israel", "Daliyat el Karmil", 32.6936111, 35.0408325, 331.0},
-	{5246, "Israel", "Sakhnin", 32.8622222, 35.2933350, 215.0},
-	{5247, "Israel", "Netivot", 31.4166667, 34.5833321, 145.0},
-	{5248, "Israel", "Ofaqim", 31.2833333, 34.6166649, 176.0},
-	{5249, "Israel", "Qiryat Shemona", 33.2080556, 35.5686111, 91.0},
-	{5250, "Israel", "Nesher", 32.7650000, 35.0499992, 36.0},
-	{5251, "Israel", "Et Tira", 32.2327778, 34.9502792, 89.0},
-	{5252, "Israel", "Sederot", 31.5166667, 34.5833321, 134.0},
-	{5253, "Israel", "Tirat Karmel", 32.7616667, 34.9719429, 34.0},
-	{5254, "Israel", "Maghar", 32.8869444, 35.4069443, 346.0},
-	{5255, "Israel", "Kafr Kanna", 32.7500000, 35.3416672, 343.0},
-	{5256, "Israel", "Judeida", 32.9255556, 35.1549988, 95.0},
-	{5257, "Israel", "Kafr Qasim", 32.1141667, 34.9738884, 79.0},
-	{5258, "Israel", "Qalansuwa", 32.2847222, 34.9797211, 47.0},
-	{5259, "Israel", "Er Reina", 32.7236111, 35.3125000, 312.0},
-	{5260, "Israel", "Kafr Manda", 32.8072222, 35.2602768, 152.0},
-	{5261, "Israel", "Gan Yavne", 31.7833333, 34.7166672, 55.0},
-	{5262, "Israel", "Gedera", 31.8166667, 34.7666664, 67.0},
-	{5263, "Israel", "Kefar Yona", 32.3166667, 34.9333344, 84.0},
-	{5264, "Israel", "Ganne Tiqwa", 32.0602778, 34.8747215, 90.0},
-	{5265, "Israel", "Iksal", 32.6786111, 35.3222237, 150.0},
-	{5266, "Israel", "Azor", 32.0191667, 34.8105545, 22.0},
-	{5267, "Israel", "Nahf", 32.9333333, 35.3166656, 278.0},
-	{5268, "Israel", "Beit Jann", 32.9652778, 35.3794441, 935.0},
-	{5269, "Israel", "El Fureidis", 32.6000000, 34.9500008, 143.0},
-	{5270, "Israel", "Kabul", 32.8666667, 35.2130547, 48.0},
-	{5271, "Israel", "Even Yehuda", 32.2666667, 34.8833351, 69.0},
-	{5272, "Israel", "Tel Mond", 32.2500000, 34.9333344, 85.0},
-	{5273, "Israel", "Yeroham", 30.9872222, 34.9313889, 489.0},
-	{5274, "Israel", "Rekhasim", 32.7500000, 35.0999985, 105.0},
-	{5275, "Israel", "Deir Hanna", 32.8625000, 35.3666649, 287.0},
-	{5276, "Israel", "Kafr Yasif", 32.9536111, 35.1652794, 25.0},
-	{5277, "Israel", "Dabburiya", 32.6958333, 35.3722229, 235.0},
-	{5278, "Israel", "Mazkeret Batya", 31.8538889, 34.8386116, 61.0},
-	{5279, "Israel", "Bene `Ayish", 31.7833333, 34.7500000, 74.0},
-	{5280, "Israel", "Jaljulya", 32.1558333, 34.9527779, 38.0},
-	{5281, "Israel", "Bir el Maksur", 32.7500000, 35.2333336, 135.0},
-	{5282, "Israel", "Pardesiyya", 32.3000000, 34.9166679, 53.0},
-	{5283, "Israel", "Lehavim", 31.3728388, 34.8161888, 283.0},
-	{5284, "Israel", "Abu Ghaush", 31.8000000, 35.0999985, 721.0},
-	{5285, "Israel", "Shelomi", 33.0666667, 35.0999985, -9999.0},
-	{5286, "Israel", "Kefar Weradim", 32.9930556, 35.2750015, 642.0},
-	{5287, "Israel", "Bet Dagan", 32.0013889, 34.8302765, 35.0},
-	{5288, "Israel", "Ramat Yishay", 32.7005556, 35.1797218, 124.0},
-	{5289, "Israel", "Hurfeish", 33.0150000, 35.3494453, 605.0},
-	{5290, "Israel", "Kefar Habad", 31.9866667, 34.8511124, 57.0},
-	{5291, "Israel", "Mizpe Ramon", 30.6094444, 34.8011093, 811.0},
-	{5292, "Israel", "Sajur", 32.9458333, 35.3430557, 330.0},
-	{5293, "Israel", "Savyon", 32.0530556, 34.8777771, 82.0},
-	{5294, "Israel", "Kafr Kama", 32.7166667, 35.4416656, 243.0},
-	{5295, "Israel", "Fassuta", 33.0497222, 35.3088875, 654.0},
-	{5296, "Israel", "Elyakhin", 32.4000000, 34.9166679, 21.0},
-	{5297, "Israel", "Jish", 33.0247222, 35.4444427, 803.0},
-	{5298, "Israel", "`Uzeir", 32.7944444, 35.3319435, 160.0},
-	{5299, "Israel", "Rosh Pinna", 32.9666667, 35.5333328, 493.0},
-	{5300, "Israel", "Sulam", 32.6083333, 35.3333321, 97.0},
-	{5301, "Israel", "Kefar Tavor", 32.6888889, 35.4208336, 138.0},
-	{5302, "Israel", "Esh Sheikh Dannun", 32.9900000, 35.1450005, 71.0},
-	{5303, "Israel", "Nordiyya", 32.3166667, 34.9000015, 61.0},
-	{5304, "Israel", "Nehalim", 32.0619444, 34.9138870, 58.0},
-	{5305, "Italy", "Rome", 41.9000000, 12.4833336, 14.0},
-	{5306, "Italy", "Milano", 45.4642694, 9.1895056, 108.0},
-	{5307, "Italy", "Napoli", 40.8333333, 14.2500000, -9999.0},
-	{5308, "Italy", "Torino", 45.0704898, 7.6868248, 205.0},
-	{5309, "Italy", "Palermo", 38.1158198, 13.3597612, 20.0},
-	{5310, "Italy", "Genova", 44.4063163, 8.9338589, 32.0},
-	{5311, "Italy", "Florence", 43.7666667, 11.2500000, 87.0},
-	{5312, "Italy", "Bologna", 44.4938115, 11.3387489, 54.0},
-	{5313, "Italy", "Bari", 41.1177340, 16.8511848, 8.0},
-	{5314, "Italy", "Catania", 37.5021340, 15.0871897, 19.0},
-	{5315, "Italy", "Venice", 45.4386111, 12.3266668, 1.0},
-	{5316, "Italy", "Verona", 45.4341860, 10.9977884, 71.0},
-	{5317, "Italy", "Messina", 38.1932680, 15.5496902, 59.0},
-	{5318, "Italy", "Trieste", 45.6486111, 13.7799997, 53.0},
-	{5319, "Italy", "Padova", 45.4151940, 11.8818083, 11.0},
-	{5320, "Italy", "Taranto", 40.4761111, 17.2297230, -9999.0},
-	{5321, "Italy", "Brescia", 45.5247820, 10.2272692, 131.0},
-	{5322, "Italy", "Reggio di Calabria", 38.1104670, 15.6612940, 145.0},
-	{5323, "Italy", "Mestre", 45.4902778, 12.2425003, 4.0},
-	{5324, "Italy", "Modena", 44.6478340, 10.9253883, 25.0},
-	{5325, "Italy", "Prato", 43.8842500, 11.0909166, 58.0},
-	{5326, "Italy", "Cagliari", 39.2073839, 9.1346169, 17.0},
-	{5327, "Italy", "Parma", 44.8026650, 10.3289843, 48.0},
-	{5328, "Italy", "Livorno", 43.5426360, 10.3160048, 5.0},
-	{5329, "Italy", "Foggia", 41.4609320, 15.5492506, 60.0},
-	{5330, "Italy", "Perugia", 43.0967430, 12.3828583, 336.0},
-	{5331, "Italy", "Salerno", 40.6632100, 14.8037453, 66.0},
-	{5332, "Italy", "Ravenna", 44.4175000, 12.2011108, 3.0},
-	{5333, "Italy", "Ferrara", 44.8267770, 11.6207132, 9.0},
-	{5334, "Italy", "Rimini", 44.0633333, 12.5808334, 5.0},
-	{5335, "Italy", "Siracusa", 37.0851470, 15.2729959, 53.0},
-	{5336, "Italy", "Sassari", 40.7272222, 8.5602779, 248.0},
-	{5337, "Italy", "Monza", 45.5824750, 9.2748470, 156.0},
-	{5338, "Italy", "Pescara", 42.4602440, 14.2102070, 9.0},
-	{5339, "Italy", "Bergamo", 45.6979810, 9.6689529, 264.0},
-	{5340, "Italy", "Forli", 44.2236111, 12.0527782, 25.0},
-	{5341, "Italy", "Latina", 41.4661420, 12.9043007, 20.0},
-	{5342, "Italy", "Vicenza", 45.5572940, 11.5408983, 27.0},
-	{5343, "Italy", "Terni", 42.5671330, 12.6498709, 173.0},
-	{5344, "Italy", "Trento", 46.0678714, 11.1210823, 218.0},
-	{5345, "Italy", "Pinocchio di Ancona", 43.5833333, 13.5000000, 80.0},
-	{5346, "Italy", "Novara", 45.4405650, 8.6168346, 150.0},
-	{5347, "Italy", "Ancona", 43.5981640, 13.5100746, 87.0},
-	{5348, "Italy", "Giugliano in Campania", 40.9274110, 14.1910276, 100.0},
-	{5349, "Italy", "Andria", 41.2306320, 16.2908707, 131.0},
-	{5350, "Italy", "Piacenza", 45.0467650, 9.6993656, 44.0},
-	{5351, "Italy", "Catanzaro", 38.8907880, 16.5987053, 136.0},
-	{5352, "Italy", "Udine", 46.0619444, 13.2422218, 98.0},
-	{5353, "Italy", "Bolzano", 46.4927160, 11.3335762, 260.0},
-	{5354, "Italy", "Barletta", 41.3118340, 16.2907696, 7.0},
-	{5355, "Italy", "Arezzo", 43.4613922, 11.8769073, 256.0},
-	{5356, "Italy", "La Spezia", 44.1105450, 9.8433857, -9999.0},
-	{5357, "Italy", "Pesaro", 43.9035660, 12.8902559, 12.0},
-	{5358, "Italy", "Cesena", 44.1391022, 12.2431469, 38.0},
-	{5359, "Italy", "Torre del Greco", 40.7839210, 14.3708038, 72.0},
-	{5360, "Italy", "Lido di Roma", 41.7321235, 12.2765350, -9999.0},
-	{5361, "Italy", "Pisa", 43.7175199, 10.3964996, 1.0},
-	{5362, "Italy", "Brindisi", 40.6277310, 17.9368153, 16.0},
-	{5363, "Italy", "Alessandria", 44.9124520, 8.6189451, 78.0},
-	{5364, "Italy", "Pistoia", 43.9212500, 10.9236116, 46.0},
-	{5365, "Italy", "Lecce", 40.3570270, 18.1720238, 53.0},
-	{5366, "Italy", "Casoria", 40.9066368, 14.2958736, 58.0},
-	{5367, "Italy", "Lucca", 43.8435654, 10.5058479, 7.0},
-	{5368, "Italy", "Varese", 45.8290760, 8.8219318, 390.0},
-	{5369, "Italy", "Treviso", 45.6666667, 12.2449999, 7.0},
-	{5370, "Italy", "Sesto San Giovanni", 45.5344913, 9.2340088, 130.0},
-	{5371, "Italy", "Pozzuoli", 40.8319630, 14.1100073, -9999.0},
-	{5372, "Italy", "Como", 45.8099780, 9.0874376, 283.0},
-	{5373, "Italy", "Marsala", 37.8212400, 12.4896307, 19.0},
-	{5374, "Italy", "Busto Arsizio", 45.6112756, 8.8491440, 211.0},
-	{5375, "Italy", "Caserta", 41.0832150, 14.3349295, 81.0},
-	{5376, "Italy", "Guidonia", 42.0166667, 12.7500000, 149.0},
-	{5377, "Italy", "Cosenza", 39.3099930, 16.2501926, 204.0},
-	{5378, "Italy", "Gela", 37.0803400, 14.2306747, 49.0},
-	{5379, "Italy", "Cinisello Balsamo", 45.5570730, 9.2218456, 146.0},
-	{5380, "Italy", "Asti", 44.8979480, 8.2068357, 122.0},
-	{5381, "Italy", "Grosseto", 42.7714250, 11.1079350, 10.0},
-	{5382, "Italy", "Pavia", 45.1844640, 9.1614523, 60.0},
-	{5383, "Italy", "Cremona", 45.1361710, 10.0279722, 31.0},
-	{5384, "Italy", "Lamezia Terme", 38.9658870, 16.3091984, 158.0},
-	{5385, "Italy", "Nicastro", 38.9833333, 16.3166676, 289.0},
-	{5386, "Italy", "Potenza", 40.6443170, 15.8085670, 698.0},
-	{5387, "Italy", "Ragusa", 36.9282400, 14.7171869, 591.0},
-	{5388, "Italy", "Trapani", 38.0158430, 12.5107670, 1.0},
-	{5389, "Italy", "Guidonia Montecelio", 41.9936220, 12.7223806, 94.0},
-	{5390, "Italy", "Castellammare di Stabia", 40.7155090, 14.4891367, 10.0},
-	{5391, "Italy", "Massa", 44.0220450, 10.1140928, 12.0},
-	{5392, "Italy", "Carrara", 44.0629450, 10.0606909, 23.0},
-	{5393, "Italy", "Imola", 44.3522670, 11.7158232, 30.0},
-	{5394, "Italy", "Altamura", 40.8292260, 16.5536804, 411.0},
-	{5395, "Italy", "Molfetta", 41.2002340, 16.5974789, -9999.0},
-	{5396, "Italy", "Afragola", 40.9260120, 14.3108311, 40.0},
-	{5397, "Italy", "Savona", 44.3090480, 8.4771538, 15.0},
-	{5398, "Italy", "Benevento", 41.1295190, 14.7861376, 165.0},
-	{5399, "Italy", "Carpi", 44.7823700, 10.8776970, 16.0},
-	{5400, "Italy", "Caltanissetta", 37.4877450, 14.0449667, 597.0},
-	{5401, "Italy", "Viareggio", 43.8735430, 10.2557993, 3.0},
-	{5402, "Jamaica", "Kingston", 17.9970194, -76.7935791, 54.0},
-	{5403, "Jamaica", "New Kingston", 18.0074679, -76.7831879, 114.0},
-	{5404, "Jamaica", "Spanish Town", 17.9833333, -76.9499969, 24.0},
-	{5405, "Jamaica", "Portmore", 17.9702424, -76.8672180, 1.0},
-	{5406, "Jamaica", "Montego Bay", 18.4666667, -77.9166641, 53.0},
-	{5407, "Jamaica", "Mandeville", 18.0333333, -77.5000000, 619.0},
-	{5408, "Jamaica", "May Pen", 17.9666667, -77.2333298, 69.0},
-	{5409, "Jamaica", "Old Harbour", 17.9333333, -77.1166687, 20.0},
-	{5410, "Jamaica", "Linstead", 18.1368287, -77.0317154, 131.0},
-	{5411, "Jamaica", "Half Way Tree", 18.0000000, -76.8000031, 54.0},
-	{5412, "Jamaica", "Bog Walk", 18.1020475, -77.0054016, 78.0},
-	{5413, "Jamaica", "Constant Spring", 18.0500000, -76.8000031, 155.0},
-	{5414, "Jamaica", "Ewarton", 18.1808384, -77.0766449, 197.0},
-	{5415, "Jamaica", "Hayes", 17.8666667, -77.2333298, 74.0},
-	{5416, "Jamaica", "Ocho Rios", 18.4025012, -77.1047974, 42.0},
-	{5417, "Jamaica", "Morant Bay", 17.8833333, -76.4166641, 3.0},
-	{5418, "Jamaica", "Stony Hill", 18.0753680, -76.7910843, 429.0},
-	{5419, "Jamaica", "Santa Cruz", 18.0500000, -77.6999969, 33.0},
-	{5420, "Jamaica", "Old Harbour Bay", 17.9000000, -77.0833359, -9999.0},
-	{5421, "Jamaica", "Port Maria", 18.3666667, -76.9000015, 13.0},
-	{5422, "Jamaica", "Falmouth", 18.5000000, -77.6500015, -9999.0},
-	{5423, "Jamaica", "Yallahs", 17.8666667, -76.5666656, -9999.0},
-	{5424, "Jamaica", "Bull Savanna", 17.8833333, -77.5999985, 218.0},
-	{5425, "Jamaica", "Runaway Bay", 18.4666667, -77.3333359, -9999.0},
-	{5426, "Jamaica", "Lucea", 18.4500000, -78.1666641, 1.0},
-	{5427, "Jamaica", "Porus", 18.0333333, -77.4166641, 151.0},
-	{5428, "Jamaica", "Lionel Town", 17.8000000, -77.2333298, 8.0},
-	{5429, "Jamaica", "Point Hill", 18.0833333, -77.0999985, 491.0},
-	{5430, "Jamaica", "Seaforth", 17.9500000, -76.4666672, 127.0},
-	{5431, "Jamaica", "Sandy Bay", 18.4500000, -78.0833359, 64.0},
-	{5432, "Jamaica", "Chapelton", 18.0833333, -77.2666702, 255.0},
-	{5433, "Jamaica", "Bamboo", 18.3833333, -77.2666702, 642.0},
-	{5434, "Jamaica", "Black River", 18.0166667, -77.8499985, -9999.0},
-	{5435, "Jamaica", "Anchovy", 18.4000000, -77.9333344, 275.0},
-	{5436, "Jamaica", "Riversdale", 18.1666667, -76.9666672, 184.0},
-	{5437, "Jamaica", "Cambridge", 18.3166667, -77.9000015, 323.0},
-	{5438, "Jamaica", "Coleyville", 18.2000000, -77.5166702, 873.0},
-	{5439, "Jamaica", "Albert Town", 18.2833333, -77.5500031, 611.0},
-	{5440, "Jamaica", "Port Royal", 17.9333333, -76.8499985, -9999.0},
-	{5441, "Jamaica", "Moneague", 18.2666667, -77.1166687, 327.0},
-	{5442, "Jamaica", "Gayle", 18.3166667, -77.0166702, 331.0},
-	{5443, "Jamaica", "Malvern", 17.9666667, -77.6999969, 576.0},
-	{5444, "Jamaica", "Williamsfield", 18.0666667, -77.4666672, 401.0},
-	{5445, "Jamaica", "Lacovia", 18.0666667, -77.7500000, 52.0},
-	{5446, "Jamaica", "Lluidas Vale", 18.1333333, -77.1500015, 383.0},
-	{5447, "Jamaica", "Rocky Point", 17.7666667, -77.2500000, 10.0},
-	{5448, "Jamaica", "Maroon Town", 18.3500000, -77.8000031, 510.0},
-	{5449, "Jamaica", "Golden Grove", 17.9166667, -76.2666702, 31.0},
-	{5450, "Jamaica", "Bethel Town", 18.3000000, -77.9499969, 305.0},
-	{5451, "Jamaica", "Dalvey", 17.9166667, -76.2333298, 12.0},
-	{5452, "Jamaica", "Race Course", 17.8333333, -77.2833328, 21.0},
-	{5453, "Jamaica", "Islington", 18.3000000, -76.8499985, 133.0},
-	{5454, "Jamaica", "Trinity Ville", 17.9500000, -76.5333328, 359.0},
-	{5455, "Jamaica", "Mona Heights", 18.0137530, -76.7518616, 179.0},
-	{5456, "Jamaica", "Balaclava", 18.1666667, -77.6500015, 305.0},
-	{5457, "Jamaica", "Bluefields", 18.1666667, -78.0333328, 77.0},
-	{5458, "Jamaica", "Wakefield", 18.4166667, -77.7166672, 125.0},
-	{5459, "Jamaica", "Buff Bay", 18.2333333, -76.6666641, 41.0},
-	{5460, "Jamaica", "Siloah", 18.1666667, -77.7166672, 131.0},
-	{5461, "Jamaica", "Southfield", 17.8833333, -77.6666641, 553.0},
-	{5462, "Jamaica", "Easington", 17.9166667, -76.5999985, 92.0},
-	{5463, "Jamaica", "Kellits", 18.1500000, -77.2333298, 406.0},
-	{5464, "Jamaica", "Discovery Bay", 18.4666667, -77.4000015, 1.0},
-	{5465, "Jamaica", "Nain", 17.9666667, -77.5999985, 248.0},
-	{5466, "Jamaica", "Bath", 17.9500000, -76.3499985, 56.0},
-	{5467, "Jamaica", "Manchioneal", 18.0333333, -76.2833328, 1.0},
-	{5468, "Jamaica", "Petersfield", 18.2666667, -78.0666656, 65.0},
-	{5469, "Jamaica", "Richmond", 18.2333333, -76.9000015, 176.0},
-	{5470, "Jamaica", "Alexandria", 18.3000000, -77.3499985, 648.0},
-	{5471, "Jamaica", "Duncans", 18.4666667, -77.5333328, 116.0},
-	{5472, "Jamaica", "Mavis Bank", 18.0333333, -76.6666641, 887.0},
-	{5473, "Jamaica", "Alligator Pond", 17.8666667, -77.5666656, 38.0},
-	{5474, "Jamaica", "Hope Bay", 18.2000000, -76.5666656, 81.0},
-	{5475, "Jamaica", "Darliston", 18.2333333, -77.9666672, 383.0},
-	{5476, "Jamaica", "Ulster Spring", 18.3166667, -77.5166702, 516.0},
-	{5477, "Jamaica", "Maggotty", 18.1500000, -77.7666702, 314.0},
-	{5478, "Jamaica", "Cascade", 18.4000000, -78.0999985, 258.0},
-	{5479, "Jamaica", "Moore Town", 18.0666667, -76.4333344, 211.0},
-	{5480, "Jamaica", "Gordon Town", 18.0333333, -76.7166672, 586.0},
-	{5481, "Jamaica", "Frome", 18.3000000, -78.1500015, 26.0},
-	{5482, "Jamaica", "Lucky Hill", 18.3000000, -77.0166702, 434.0},
-	{5483, "Japan", "Tokyo", 35.6895266, 139.6916809, 40.0},
-	{5484, "Japan", "Yokohama", 35.4500000, 139.6499939, 1.0},
-	{5485, "Japan", "Osaka", 34.6937398, 135.5021820, 4.0},
-	{5486, "Japan", "Nagoya", 35.1814728, 136.9064026, 14.0},
-	{5487, "Japan", "Sapporo", 43.0547222, 141.3538818, 26.0},
-	{5488, "Japan", "Kobe", 34.6833333, 135.1666718, 29.0},
-	{5489, "Japan", "Kyoto", 35.0210700, 135.7538452, 86.0},
-	{5490, "Japan", "Fukuoka", 33.5833333, 130.3999939, 10.0},
-	{5491, "Japan", "Kawasaki", 35.5205556, 139.7172241, 5.0},
-	{5492, "Japan", "Hiroshima", 34.4000000, 132.4499969, 26.0},
-	{5493, "Japan", "Yono", 35.8833333, 139.6333313, 18.0},
-	{5494, "Japan", "Sendai", 38.2547222, 140.8847198, 55.0},
-	{5495, "Japan", "Kitakyushu", 33.8333333, 130.8333282, 218.0},
-	{5496, "Japan", "Chiba", 35.6000000, 140.1166687, 2.0},
-	{5497, "Japan", "Sakai", 34.5833333, 135.4666595, 4.0},
-	{5498, "Japan", "Shizuoka", 34.9666667, 138.3833313, 22.0},
-	{5499, "Japan", "Nerima", 35.7333333, 139.6499939, 39.0},
-	{5500, "Japan", "Kumamoto", 32.8000000, 130.7166595, 23.0},
-	{5501, "Japan", "Sagamihara", 35.5530556, 139.3544464, 86.0},
-	{5502, "Japan", "Okayama", 34.6500000, 133.9166718, 30.0},
-	{5503, "Japan", "Hamamatsu", 34.7000000, 137.7333374, 12.0},
-	{5504, "Japan", "Hachioji", 35.6558333, 139.3238831, 130.0},
-	{5505, "Japan", "Funabashi", 35.6930556, 139.9833374, 7.0},
-	{5506, "Japan", "Kagoshima", 31.6000000, 130.5500031, 58.0},
-	{5507, "Japan", "Niigata", 37.9166667, 139.0500031, 1.0},
-	{5508, "Japan", "Himeji", 34.8166667, 134.6999969, 22.0},
-	{5509, "Japan", "Matsudo", 35.7833333, 139.8999939, 20.0},
-	{5510, "Japan", "Nishinomiya", 34.7166667, 135.3333282, 1.0},
-	{5511, "Japan", "Kawaguchi", 35.8050000, 139.7205505, 5.0},
-	{5512, "Japan", "Kanazawa", 36.5666667, 136.6499939, 36.0},
-	{5513, "Japan", "Ichikawa", 35.7197222, 139.9247284, 14.0},
-	{5514, "Japan", "Utsunomiya", 36.5500000, 139.8666687, 116.0},
-	{5515, "Japan", "Oita", 33.2372222, 131.6044464, 41.0},
-	{5516, "Japan", "Amagasaki", 34.7166667, 135.4166718, 4.0},
-	{5517, "Japan", "Kurashiki", 34.5833333, 133.7666626, 82.0},
-	{5518, "Japan", "Yokosuka", 35.2836111, 139.6672211, 14.0},
-	{5519, "Japan", "Nagasaki", 32.7550000, 129.8683319, 18.0},
-	{5520, "Japan", "Hirakata", 34.8135214, 135.6491394, 8.0},
-	{5521, "Japan", "Machida", 35.5402778, 139.4508362, 82.0},
-	{5522, "Japan", "Gifu", 35.4229095, 136.7603912, 58.0},
-	{5523, "Japan", "Fujisawa", 35.3419444, 139.4700012, 18.0},
-	{5524, "Japan", "Toyonaka", 34.7824391, 135.4693146, 14.0},
-	{5525, "Japan", "Fukuyama", 34.4833333, 133.3666687, 49.0},
-	{5526, "Japan", "Toyohashi", 34.7666667, 137.3833313, 19.0},
-	{5527, "Japan", "Shimminatocho", 34.1833333, 135.1999969, 65.0},
-	{5528, "Japan", "Nara", 34.6850548, 135.8048553, 81.0},
-	{5529, "Japan", "Toyota", 35.0833333, 137.1499939, 48.0},
-	{5530, "Japan", "Nagano", 36.6500000, 138.1833344, 373.0},
-	{5531, "Japan", "Iwaki", 37.0500000, 140.8833313, 45.0},
-	{5532, "Japan", "Asahikawa", 43.7677778, 142.3702850, 112.0},
-	{5533, "Japan", "Takatsuki", 34.8483254, 135.6167755, 22.0},
-	{5534, "Japan", "Okazaki", 34.9500000, 137.1666718, 28.0},
-	{5535, "Japan", "Suita", 34.7614290, 135.5156708, 10.0},
-	{5536, "Japan", "Wakayama", 34.0902778, 135.0899963, -9999.0},
-	{5537, "Japan", "Koriyama", 37.4000000, 140.3833313, 242.0},
-	{5538, "Japan", "Kashiwa", 35.8544444, 139.9688873, 20.0},
-	{5539, "Japan", "Tokorozawa", 35.7991586, 139.4690399, 68.0},
-	{5540, "Japan", "Kawagoe", 35.9086111, 139.4852753, 19.0},
-	{5541, "Japan", "Kochi", 33.5500000, 133.5500031, 10.0},
-	{5542, "Japan", "Takamatsu", 34.3333333, 134.0500031, 20.0},
-	{5543, "Japan", "Toyama", 36.6833333, 137.2166595, 29.0},
-	{5544, "Japan", "Akita", 39.7166667, 140.1166687, 23.0},
-	{5545, "Japan", "Koshigaya", 35.8833333, 139.7833405, 8.0},
-	{5546, "Japan", "Miyazaki", 31.9000000, 131.4333344, 7.0},
-	{5547, "Japan", "Naha", 26.2072222, 127.6733322, -9999.0},
-	{5548, "Japan", "Kasugai", 35.2476212, 136.9722900, 44.0},
-	{5549, "Japan", "Aomori", 40.8211111, 140.7511139, 3.0},
-	{5550, "Japan", "Otsu", 35.0000000, 135.8666687, 163.0},
-	{5551, "Japan", "Akashi", 34.6333333, 134.9833374, -9999.0},
-	{5552, "Japan", "Yokkaichi", 34.9666667, 136.6166687, 12.0},
-	{5553, "Japan", "Morioka", 39.7000000, 141.1499939, 136.0},
-	{5554, "Japan", "Fukushima", 37.7500000, 140.4666595, 89.0},
-	{5555, "Japan", "Ichihara", 35.5166667, 140.0833282, 4.0},
-	{5556, "Japan", "Maebashi", 36.3833333, 139.0666656, 100.0},
-	{5557, "Japan", "Ichinomiya", 35.3000000, 136.8000031, 14.0},
-	{5558, "Japan", "Hakodate", 41.7758333, 140.7366638, 9.0},
-	{5559, "Japan", "Yao", 34.6166667, 135.6000061, 10.0},
-	{5560, "Japan", "Kakogawa", 34.7666667, 134.8500061, 23.0},
-	{5561, "Japan", "Tokushima", 34.0666667, 134.5666656, 10.0},
-	{5562, "Japan", "Ibaraki", 34.8164106, 135.5682831, 17.0},
-	{5563, "Japan", "Hiratsuka", 35.3230556, 139.3422241, 3.0},
-	{5564, "Japan", "Yamagata", 38.2527778, 140.3374939, 177.0},
-	{5565, "Japan", "Fukui", 36.0644338, 136.2225647, 17.0},
-	{5566, "Japan", "Mito", 36.3666667, 140.4666595, 19.0},
-	{5567, "Japan", "Shimonoseki", 33.9500000, 130.9499969, -9999.0},
-	{5568, "Japan", "Takasaki", 36.3333333, 139.0166626, 91.0},
-	{5569, "Japan", "Fuchu", 35.6666667, 139.4833374, 45.0},
-	{5570, "Japan", "Fuji", 35.1666667, 138.6833344, 67.0},
-	{5571, "Japan", "Hachinohe", 40.5000000, 141.5000000, 20.0},
-	{5572, "Japan", "Kurume", 33.3166667, 130.5166626, 7.0},
-	{5573, "Japan", "Sasebo", 33.1591667, 129.7227783, 1.0},
-	{5574, "Japan", "Chigasaki", 35.3261111, 139.4038849, 8.0},
-	{5575, "Japan", "Soka", 35.8202778, 139.8044434, 6.0},
-	{5576, "Japan", "Atsugi", 35.4388889, 139.3597260, 34.0},
-	{5577, "Japan", "Minami-rinkan", 35.4833333, 139.4499969, 61.0},
-	{5578, "Japan", "Takarazuka", 34.7993561, 135.3569641, 49.0},
-	{5579, "Japan", "Ageo", 35.9697222, 139.5886078, 15.0},
-	{5580, "Japan", "Chofu", 35.6555556, 139.5522156, 33.0},
-	{5581, "Japan", "Matsumoto", 36.2333333, 137.9666595, 590.0},
-	{5582, "Japan", "Kasukabe", 35.9763889, 139.7536163, 8.0},
-	{5583, "Jordan", "Amman", 31.9500000, 35.9333344, 765.0},
-	{5584, "Jordan", "Irbid", 32.5555556, 35.8499985, 584.0},
-	{5585, "Jordan", "Wadi as Sir", 31.9500000, 35.8166656, 929.0},
-	{5586, "Jordan", "`Ajlun", 32.3338889, 35.7508316, 786.0},
-	{5587, "Jordan", "Al `Aqabah", 29.5266667, 35.0077782, 38.0},
-	{5588, "Jordan", "Madaba", 31.7166667, 35.7999992, 745.0},
-	{5589, "Jordan", "As Salt", 32.0391667, 35.7272224, 759.0},
-	{5590, "Jordan", "Ar Ramtha", 32.5591667, 36.0069427, 491.0},
-	{5591, "Jordan", "Al Mafraq", 32.3500000, 36.2000008, 696.0},
-	{5592, "Jordan", "Al Jubayhah", 32.0166667, 35.8666649, 1000.0},
-	{5593, "Jordan", "Sahab", 31.8786111, 36.0044441, 858.0},
-	{5594, "Jordan", "Al Quwaysimah", 31.9166667, 35.9500008, 951.0},
-	{5595, "Jordan", "Jarash", 32.2805556, 35.8952789, 528.0},
-	{5596, "Jordan", "At Tafilah", 30.8333333, 35.5999985, 986.0},
-	{5597, "Jordan", "`Izra", 31.1588889, 35.6927795, 1123.0},
-	{5598, "Jordan", "Qir Moav", 31.1847222, 35.7047234, 1016.0},
-	{5599, "Jordan", "Aydun", 32.5072222, 35.8574982, 646.0},
-	{5600, "Jordan", "Umm as Summaq", 31.8833333, 35.8499985, 968.0},
-	{5601, "Jordan", "Kurayyimah", 32.2791667, 35.5977783, -209.0},
-	{5602, "Jordan", "`Anjarah", 32.3069444, 35.7536125, 845.0},
-	{5603, "Jordan", "At Turrah", 32.6413889, 35.9919434, 483.0},
-	{5604, "Jordan", "Wadi Musa", 30.3166667, 35.4833336, 1064.0},
-	{5605, "Jordan", "Suf", 32.3175000, 35.8355560, 898.0},
-	{5606, "Jordan", "At Tayyibah", 32.5416667, 35.7169456, 306.0},
-	{5607, "Jordan", "Judayta", 32.4000000, 35.7000008, 329.0},
-	{5608, "Jordan", "Ash Shajarah", 32.6500000, 35.9333344, 241.0},
-	{5609, "Jordan", "Jawa", 31.8500000, 35.9333344, 852.0},
-	{5610, "Jordan", "Sakhrah", 32.3697222, 35.8450012, 1105.0},
-	{5611, "Jordan", "Sakib", 32.2852778, 35.8097229, 862.0},
-	{5612, "Jordan", "`Ayn Janna", 32.3333333, 35.7666664, 1064.0},
-	{5613, "Jordan", "Al Karamah", 31.9522222, 35.5791664, -279.0},
-	{5614, "Jordan", "Al Mazar", 31.0655556, 35.6961098, 1264.0},
-	{5615, "Jordan", "Samma", 32.5722222, 35.6908340, 325.0},
-	{5616, "Jordan", "Kafr Asad", 32.6000000, 35.7166672, 302.0},
-	{5617, "Jordan", "Bayt Yafa", 32.5166667, 35.7833328, 511.0},
-	{5618, "Jordan", "Al Quwayrah", 29.8000000, 35.3166656, 825.0},
-	{5619, "Jordan", "`Ayy", 31.1333333, 35.6408348, 834.0},
-	{5620, "Jordan", "Busayra", 30.7333333, 35.5999985, 1043.0},
-	{5621, "Jordan", "Kafr Sawm", 32.6833333, 35.7999992, 468.0},
-	{5622, "Jordan", "Hakama", 32.5950000, 35.8861122, 512.0},
-	{5623, "Jordan", "Sal", 32.5694444, 35.9119453, 574.0},
-	{5624, "Jordan", "Malka", 32.6744444, 35.7522240, 450.0},
-	{5625, "Jordan", "Kafr Abil", 32.4183333, 35.6699982, 444.0},
-	{5626, "Jordan", "Dayr Yusuf", 32.4919444, 35.7955551, 573.0},
-	{5627, "Jordan", "Saham", 32.6983333, 35.7761116, 423.0},
-	{5628, "Jordan", "Raymun", 32.2833333, 35.8333321, 773.0},
-	{5629, "Jordan", "Waqqas", 32.5500000, 35.5999985, -134.0},
-	{5630, "Jordan", "Al Kittah", 32.2783333, 35.8424988, 736.0},
-	{5631, "Jordan", "Al Bunayyat ash Shamaliyah", 31.9000000, 35.8833351, 906.0},
-	{5632, "Jordan", "Hatim", 32.6452778, 35.7783318, 437.0},
-	{5633, "Jordan", "Kharja", 32.6666667, 35.8833351, 405.0},
-	{5634, "Jordan", "Halawah", 32.3863889, 35.6608315, 342.0},
-	{5635, "Jordan", "Sabha", 32.3333333, 36.5000000, 837.0},
-	{5636, "Jordan", "Kitim", 32.4394444, 35.8969460, 846.0},
-	{5637, "Jordan", "At Tayyibah", 31.0558333, 35.6013870, 940.0},
-	{5638, "Jordan", "Tibnah", 32.4833333, 35.7333336, 625.0},
-	{5639, "Jordan", "Balila", 32.3847222, 35.9324989, 867.0},
-	{5640, "Jordan", "Qumaym", 32.5702778, 35.7316666, 298.0},
-	{5641, "Jordan", "Yarqa", 31.9786111, 35.6933327, 671.0},
-	{5642, "Jordan", "Burma", 32.2200000, 35.7811127, 666.0},
-	{5643, "Jordan", "Bayt Idis", 32.4369444, 35.6997223, 583.0},
-	{5644, "Jordan", "Adir", 31.2000000, 35.7666664, 975.0},
-	{5645, "Jordan", "Qafqafa", 32.3505556, 35.9375000, 974.0},
-	{5646, "Jordan", "Al Jizah", 31.7000000, 35.9500008, 731.0},
-	{5647, "Jordan", "Umm Qays", 32.6550000, 35.6836128, 373.0},
-	{5648, "Jordan", "At Tayyibah", 30.2500000, 35.4666672, 1426.0},
-	{5649, "Jordan", "Ar Rabbah", 31.2702778, 35.7386093, 926.0},
-	{5650, "Jordan", "Umm al Qittayn", 32.3166667, 36.6333351, 968.0},
-	{5651, "Jordan", "Zahar", 32.5666667, 35.7775002, 413.0},
-	{5652, "Jordan", "Al Qasr", 31.3144444, 35.7449989, 936.0},
-	{5653, "Jordan", "Al Jafr", 30.2933333, 36.2138901, 854.0},
-	{5654, "Kazakhstan", "Almaty", 43.2500000, 76.9499969, 862.0},
-	{5655, "Kazakhstan", "Qaraghandy", 49.7988889, 73.0994415, 526.0},
-	{5656, "Kazakhstan", "Karagandy", 50.0666667, 54.8666649, 127.0},
-	{5657, "Kazakhstan", "Shymkent", 42.3000000, 69.5999985, 533.0},
-	{5658, "Kazakhstan", "Taraz", 42.9000000, 71.3666687, 611.0},
-	{5659, "Kazakhstan", "Astana", 51.1811111, 71.4277802, 339.0},
-	{5660, "Kazakhstan", "Pavlodar", 52.3000000, 76.9499969, 132.0},
-	{5661, "Kazakhstan", "Kyzylorda", 44.8527778, 65.5091629, 138.0},
-	{5662, "Kazakhstan", "Semipalatinsk", 50.4111111, 80.2275009, 186.0},
-	{5663, "Kazakhstan", "Aqtobe", 50.2796868, 57.2071838, 224.0},
-	{5664, "Kazakhstan", "Qostanay", 53.1666667, 63.5833321, 161.0},
-	{5665, "Kazakhstan", "Petropavlovsk", 54.8752778, 69.1627808, 139.0},
-	{5666, "Kazakhstan", "Taldyqorghan", 45.0000000, 77.9166641, 452.0},
-	{5667, "Kazakhstan", "Oral", 51.2333333, 51.3666649, 33.0},
-	{5668, "Kazakhstan", "Temirtau", 50.0544444, 72.9483337, 511.0},
-	{5669, "Kazakhstan", "Atyrau", 47.1166667, 51.8833351, -25.0},
-	{5670, "Kazakhstan", "Kokshetau", 53.2775000, 69.3883362, 232.0},
-	{5671, "Kazakhstan", "Rudnyy", 52.9594444, 63.1205559, 160.0},
-	{5672, "Kazakhstan", "Ekibastuz", 51.6666667, 75.3666687, 199.0},
-	{5673, "Kazakhstan", "Zhezqazghan", 47.7833333, 67.7666702, 339.0},
-	{5674, "Kazakhstan", "Turkestan", 43.3333333, 68.2500000, 224.0},
-	{5675, "Kazakhstan", "Balkhash", 46.8480556, 74.9950027, 361.0},
-	{5676, "Kazakhstan", "Sarkand", 45.4100000, 79.9186096, 780.0},
-	{5677, "Kazakhstan", "Baykonyr", 45.6166670, 63.3166656, 77.0},
-	{5678, "Kazakhstan", "Kentau", 43.5219444, 68.5108337, 446.0},
-	{5679, "Kazakhstan", "Ridder", 50.3550000, 83.5133362, 720.0},
-	{5680, "Kazakhstan", "Shchuchinsk", 52.9333333, 70.1999969, 390.0},
-	{5681, "Kazakhstan", "Stepnogorsk", 52.3544444, 71.8869476, 300.0},
-	{5682, "Kazakhstan", "Zyryanovsk", 49.7386111, 84.2719421, 456.0},
-	{5683, "Kazakhstan", "Aqsu", 52.0333333, 76.9166641, 123.0},
-	{5684, "Kazakhstan", "Dzhetygara", 52.1908333, 61.2005539, 254.0},
-	{5685, "Kazakhstan", "Talghar", 43.3044444, 77.2327805, 988.0},
-	{5686, "Kazakhstan", "Kapchagai", 43.8833333, 77.0833359, 492.0},
-	{5687, "Kazakhstan", "Arqalyq", 50.2486111, 66.9113922, 334.0},
-	{5688, "Kazakhstan", "Lisakovsk", 52.5369444, 62.4936104, 208.0},
-	{5689, "Kazakhstan", "Shu", 43.5983333, 73.7613907, 461.0},
-	{5690, "Kazakhstan", "Qaratau", 43.1666667, 70.4666672, 528.0},
-	{5691, "Kazakhstan", "Ayteke Bi", 45.8536111, 62.1561127, 59.0},
-	{5692, "Kazakhstan", "Abay", 49.6311111, 72.8538895, 505.0},
-	{5693, "Kazakhstan", "Aksay", 51.1677778, 52.9949989, 62.0},
-	{5694, "Kazakhstan", "Atbasar", 51.8000000, 68.3333359, 282.0},
-	{5695, "Kazakhstan", "Zharkent", 44.1627778, 80.0000000, 628.0},
-	{5696, "Kazakhstan", "Zhangatas", 43.5666667, 69.7500000, 536.0},
-	{5697, "Kazakhstan", "Ayakoz", 47.9713889, 80.4391632, 654.0},
-	{5698, "Kazakhstan", "Aral", 46.8000000, 61.6666679, 54.0},
-	{5699, "Kazakhstan", "Shieli", 44.1666667, 66.7500000, 144.0},
-	{5700, "Kazakhstan", "Yesik", 43.3550000, 77.4633331, 1055.0},
-	{5701, "Kazakhstan", "Aksu", 42.4166667, 69.8333359, 639.0},
-	{5702, "Kazakhstan", "Shardara", 41.2547222, 67.9691696, 291.0},
-	{5703, "Kazakhstan", "Saryagash", 41.4500000, 69.1666641, 419.0},
-	{5704, "Kazakhstan", "Khromtau", 50.2502778, 58.4347229, 416.0},
-	{5705, "Kazakhstan", "Tekeli", 44.8300000, 78.8238907, 1286.0},
-	{5706, "Kazakhstan", "Georgievka", 49.3313889, 81.5761108, 413.0},
-	{5707, "Kazakhstan", "Zhangaqorghan", 43.9150000, 67.2480545, 167.0},
-	{5708, "Kazakhstan", "Lenger", 42.2000000, 69.9000015, 826.0},
-	{5709, "Kazakhstan", "Burunday", 43.3602778, 76.8577805, 682.0},
-	{5710, "Kazakhstan", "Ushtobe", 45.2422222, 77.9822235, 420.0},
-	{5711, "Kazakhstan", "Shemonaikha", 50.6327778, 81.9055557, 297.0},
-	{5712, "Kazakhstan", "Dzhusaly", 45.4877778, 64.0780563, 102.0},
-	{5713, "Kazakhstan", "Balyqshy", 47.0666667, 51.8666649, -17.0},
-	{5714, "Kazakhstan", "Emba", 48.8263889, 58.1441650, 249.0},
-	{5715, "Kazakhstan", "Turar Ryskulov", 42.5333333, 70.3333359, 764.0},
-	{5716, "Kazakhstan", "Makinsk", 52.6291667, 70.4169464, 381.0},
-	{5717, "Kazakhstan", "Zaysan", 47.4666667, 84.8666687, 638.0},
-	{5718, "Kazakhstan", "Tasboget", 44.7727778, 65.5524979, 135.0},
-	{5719, "Kazakhstan", "Merke", 42.8727778, 73.1786118, 683.0},
-	{5720, "Kazakhstan", "Sarykemer", 43.0000000, 71.5000000, 547.0},
-	{5721, "Kazakhstan", "Urzhar", 47.0883333, 81.6308365, 471.0},
-	{5722, "Kazakhstan", "Kazygurt", 41.7588889, 69.3863907, 566.0},
-	{5723, "Kazakhstan", "Beyneu", 45.3166667, 55.2000008, -5.0},
-	{5724, "Kazakhstan", "Zhalaghash", 45.0833333, 64.6666641, 117.0},
-	{5725, "Kazakhstan", "Yereymentau", 51.6213889, 73.1069412, 381.0},
-	{5726, "Kazakhstan", "Maqat", 47.6500000, 53.3166656, -22.0},
-	{5727, "Kazakhstan", "Saryozek", 44.3583333, 77.9752808, 943.0},
-	{5728, "Kazakhstan", "Derzhavinsk", 51.1000000, 66.3166656, 227.0},
-	{5729, "Kazakhstan", "Qarazhal", 48.0077778, 70.7902756, 449.0},
-	{5730, "Kazakhstan", "Esil", 51.9555556, 66.4041672, 210.0},
-	{5731, "Kazakhstan", "Shakhan", 49.8233333, 72.6341629, 457.0},
-	{5732, "Kazakhstan", "Inderbor", 48.5500000, 51.7833328, 27.0},
-	{5733, "Kazakhstan", "Atasu", 48.6813889, 71.6433334, 483.0},
-	{5734, "Kazakhstan", "Taiynsha", 53.8477778, 69.7638855, 171.0},
-	{5735, "Kazakhstan", "Qulan", 42.9152778, 72.7080536, 730.0},
-	{5736, "Kazakhstan", "Temirlanovka", 42.5994444, 69.2633362, 301.0},
-	{5737, "Kazakhstan", "Shetpe", 44.1666667, 52.1166649, 239.0},
-	{5738, "Kazakhstan", "Quryq", 43.2000000, 51.6500015, -22.0},
-	{5739, "Kazakhstan", "Balpyk Bi", 44.8947222, 78.2083359, 573.0},
-	{5740, "Kazakhstan", "Shubarkuduk", 49.1447222, 56.4872208, 174.0},
-	{5741, "Kazakhstan", "Zhetibay", 43.5941667, 52.0788879, 146.0},
-	{5742, "Kazakhstan", "Asykata", 40.8994444, 68.3611145, 254.0},
-	{5743, "Kazakhstan", "Glubokoe", 50.1455556, 82.3113861, 307.0},
-	{5744, "Kazakhstan", "Moyynqum", 44.2913889, 72.9469452, 337.0},
-	{5745, "Kazakhstan", "Sholaqqkorghan", 43.7650000, 69.1813889, 466.0},
-	{5746, "Kazakhstan", "Lugovoy", 42.9472222, 72.7644424, 698.0},
-	{5747, "Kazakhstan", "Kachar", 53.3905556, 62.9297218, 191.0},
-	{5748, "Kazakhstan", "Khantaghy", 43.5263889, 68.5774994, 469.0},
-	{5749, "Kenya", "Nairobi", -1.2833333, 36.8166656, 1724.0},
-	{5750, "Kenya", "Mombasa", -4.0500000, 39.6666679, 1.0},
-	{5751, "Kenya", "Nakuru", -0.2833333, 36.0666656, 2017.0},
-	{5752, "Kenya", "Eldoret", 0.5166667, 35.2833328, 2116.0},
-	{5753, "Kenya", "Kisumu", -0.1000000, 34.7500000, 1132.0},
-	{5754, "Kenya", "Thika", -1.0332596, 37.0693283, 1495.0},
-	{5755, "Kenya", "Kitale", 1.0166667, 35.0000000, 1901.0},
-	{5756, "Kenya", "Malindi", -3.2166667, 40.1166649, 1.0},
-	{5757, "Kenya", "Garissa", -0.4569444, 39.6583328, 152.0},
-	{5758, "Kenya", "Kakamega", 0.2833333, 34.7500000, 1524.0},
-	{5759, "Kenya", "Bungoma", 0.5666667, 34.5666656, 1384.0},
-	{5760, "Kenya", "Nyeri", -0.4166667, 36.9500008, 1769.0},
-	{5761, "Kenya", "Meru", 0.0500000, 37.6500015, 1582.0},
-	{5762, "Kenya", "Kilifi", -3.6333333, 39.8499985, -9999.0},
-	{5763, "Kenya", "Wajir", 1.7472222, 40.0688896, 251.0},
-	{5764, "Kenya", "Mumias", 0.3363889, 34.4891663, 1258.0},
-	{5765, "Kenya", "Lugulu", 0.3936111, 34.3052788, 1220.0},
-	{5766, "Kenya", "Homa Bay", -0.5166667, 34.4500008, 1166.0},
-	{5767, "Kenya", "Naivasha", -0.7166667, 36.4333344, 2086.0},
-	{5768, "Kenya", "Nanyuki", 0.0166667, 37.0666656, 1975.0},
-	{5769, "Kenya", "Mandera", 3.9261111, 41.8461113, 219.0},
-	{5770, "Kenya", "Narok", -1.0833333, 35.8666649, 1848.0},
-	{5771, "Kenya", "Kericho", -0.3666667, 35.2833328, 2096.0},
-	{5772, "Kenya", "Migori", -1.0666667, 34.4666672, 1323.0},
-	{5773, "Kenya", "Embu", -0.5333333, 37.4500008, 1324.0},
-	{5774, "Kenya", "Isiolo", 0.3500000, 37.5833321, 1148.0},
-	{5775, "Kenya", "Nyahururu", 0.0333333, 36.3666649, 2304.0},
-	{5776, "Kenya", "Machakos", -1.5166667, 37.2666664, 1715.0},
-	{5777, "Kenya", "Rongai", -0.1666667, 35.8499985, 1912.0},
-	{5778, "Kenya", "Kisii", -0.6833333, 34.7666664, 1805.0},
-	{5779, "Kenya", "Molo", -0.2500000, 35.7333336, 2534.0},
-	{5780, "Kenya", "Athi River", -1.4500000, 36.9833336, 1532.0},
-	{5781, "Kenya", "Lamu", -2.2783333, 40.9033318, 1.0},
-	{5782, "Kenya", "Webuye", 0.6166667, 34.7666664, 1552.0},
-	{5783, "Kenya", "Karuri", -0.7000000, 37.1833344, 1209.0},
-	{5784, "Kenya", "Kiambu", -1.1666667, 36.8333321, 1734.0},
-	{5785, "Kenya", "Maralal", 1.1000000, 36.7000008, 1966.0},
-	{5786, "Kenya", "Makueni", -1.8000000, 37.6166649, 1219.0},
-	{5787, "Kenya", "Lodwar", 3.1166667, 35.5999985, 507.0},
-	{5788, "Kenya", "Voi", -3.3833333, 38.5666656, 608.0},
-	{5789, "Kenya", "Moyale", 3.5163889, 39.0691681, 936.0},
-	{5790, "Kenya", "Kitui", -1.3666667, 38.0166664, 1152.0},
-	{5791, "Kenya", "Marsabit", 2.3333333, 37.9833336, 1344.0},
-	{5792, "Kenya", "Siaya", 0.0600000, 34.2861099, 1224.0},
-	{5793, "Kenya", "Keruguya", -0.5000000, 37.2833328, 1548.0},
-	{5794, "Kenya", "Muhoroni", -0.1500000, 35.2000008, 1476.0},
-	{5795, "Kenya", "Magadi", -1.9000000, 36.2833328, 596.0},
-	{5796, "Kenya", "Taveta", -3.4000000, 37.6833344, 909.0},
-	{5797, "Kenya", "Namanga", -2.5500000, 36.7833328, 1355.0},
-	{5798, "Kenya", "Kinancha", -1.1833333, 34.6166649, 1467.0},
-	{5799, "Kenya", "Sawasawa", -4.4666667, 39.4833336, 8.0},
-	{5800, "Kenya", "Mariakani", -3.8666667, 39.4666672, 184.0},
-	{5801, "Kenya", "Eldama Ravine", 0.0500000, 35.7166672, 2122.0},
-	{5802, "Kenya", "Wundanyi", -3.4000000, 38.3666649, 1324.0},
-	{5803, "Kenya", "Mwingi", -0.9333333, 38.0666656, 933.0},
-	{5804, "Kenya", "Butere", 0.2094444, 34.4927788, 1333.0},
-	{5805, "Kenya", "Kajiado", -1.8500000, 36.7833328, 1710.0},
-	{5806, "Kenya", "Maua", 0.2333333, 37.9333344, 1693.0},
-	{5807, "Kenya", "Takaungu", -3.6833333, 39.8499985, 3.0},
-	{5808, "Kenya", "Kabarnet", 0.5000000, 35.7500000, 1816.0},
-	{5809, "Kenya", "Oyugis", -0.5000000, 34.7166672, 1420.0},
-	{5810, "Kenya", "Kangundo", -1.3000000, 37.3499985, 1545.0},
-	{5811, "Kenya", "Luanda", 0.3077778, 34.0586128, 1153.0},
-	{5812, "Kenya", "Ngong", -1.3666667, 36.6500015, 2062.0},
-	{5813, "Kenya", "Chuka", -0.3333333, 37.6500015, 1402.0},
-	{5814, "Kenya", "Naro Moru", -0.1666667, 37.0166664, 2036.0},
-	{5815, "Kenya", "Ahero", -0.1833333, 34.9166679, 1153.0},
-	{5816, "Kenya", "Bondo", 0.2386111, 34.2694435, 1227.0},
-	{5817, "Kenya", "Malaba", 0.6327778, 34.2694435, 1189.0},
-	{5818, "Kenya", "Kinango", -4.1333333, 39.3166656, 198.0},
-	{5819, "Kenya", "Baringo", 0.4666667, 35.9666672, 1070.0},
-	{5820, "Kenya", "Port Bunyala", 0.1000000, 33.9666672, 1260.0},
-	{5821, "Kenya", "Limuru", -1.1000000, 36.6500015, 2241.0},
-	{5822, "Kenya", "Wote", -1.7833333, 37.6333351, 1157.0},
-	{5823, "Kenya", "Witu", -2.3905556, 40.4530563, 21.0},
-	{5824, "Kenya", "Bomet", -0.7833333, 35.3499985, 1981.0},
-	{5825, "Kenya", "Gazi", -4.4166667, 39.5000000, 4.0},
-	{5826, "Kenya", "Kwale", -4.1833333, 39.4500008, 323.0},
-	{5827, "Kenya", "Mtito Andei", -2.6833333, 38.1666679, 764.0},
-	{5828, "Kenya", "Londiani", -0.1666667, 35.5999985, 2326.0},
-	{5829, "Kenya", "Kijabe", -0.9333333, 36.5666656, 2124.0},
-	{5830, "Kenya", "Maragua", -0.7833333, 37.1333351, 1331.0},
-	{5831, "Kenya", "Rumuruti", 0.2666667, 36.5333328, 1828.0},
-	{5832, "Kenya", "Othaya", -0.5666667, 36.9500008, 1826.0},
-	{5833, "Kenya", "Malikisi", 0.6769444, 34.4216652, 1344.0},
-	{5834, "Kenya", "Kangema", -0.6833333, 36.9666672, 1751.0},
-	{5835, "Kenya", "Nandi Hills", 0.1166667, 35.1833344, 1986.0},
-	{5836, "Kenya", "Keroka", -0.7833333, 34.9500008, 2133.0},
-	{5837, "Kenya", "Kipini", -2.5227778, 40.5388870, 1.0},
-	{5838, "Kenya", "Kikuyu", -1.2500000, 36.6666679, 1932.0},
-	{5839, "Kenya", "Kapsabet", 0.2000000, 35.0999985, 1981.0},
-	{5840, "Kenya", "Sagana", -0.6666667, 37.2000008, 1208.0},
-	{5841, "Kenya", "Litein", -0.5833333, 35.1833344, 1901.0},
-	{5842, "Kenya", "Lumbwa", -0.2000000, 35.4666672, 2059.0},
-	{5843, "Kenya", "Yala", 0.1000000, 34.5333328, 1373.0},
-	{5844, "Kenya", "Sotik", -0.6833333, 35.1166649, 1827.0},
-	{5845, "Kenya", "Shimoni", -4.6500000, 39.3833351, -9999.0},
-	{5846, "Kenya", "Konza", -1.7500000, 37.1166649, 1584.0},
-	{5847, "Kenya", "Ogembo", -0.8000000, 34.7166672, 1644.0},
-	{5848, "Kuwait", "As Salimiyah", 29.3338889, 48.0761108, 7.0},
-	{5849, "Kuwait", "Sabah as Salim", 29.2572222, 48.0572205, 45.0},
-	{5850, "Kuwait", "Al Farwaniyah", 29.2775000, 47.9586105, 47.0},
-	{5851, "Kuwait", "Al Fuhayhil", 29.0825000, 48.1302795, 9.0},
-	{5852, "Kuwait", "Kuwait", 29.3697222, 47.9783325, 12.0},
-	{5853, "Kuwait", "Ar Riqqah", 29.1458333, 48.0947227, 39.0},
-	{5854, "Kuwait", "Salwa", 29.2958333, 48.0786095, 12.0},
-	{5855, "Kuwait", "Al Manqaf", 29.0961111, 48.1327782, 25.0},
-	{5856, "Kuwait", "Ar Rabiyah", 29.2950000, 47.9330559, 25.0},
-	{5857, "Kuwait", "Bayan", 29.3180556, 48.0391655, 28.0},
-	{5858, "Kuwait", "Al Fintas", 29.1738889, 48.1211128, 17.0},
-	{5859, "Kuwait", "Janub as Surrah", 29.2691667, 47.9780540, 51.0},
-	{5860, "Kuwait", "Al Mahbulah", 29.1450000, 48.1302795, 5.0},
-	{5861, "Kuwait", "Ad Dasmah", 29.3650000, 48.0013885, 8.0},
-	{5862, "Kuwait", "Ash Shamiyah", 29.3472222, 47.9616661, 9.0},
-	{5863, "Kuwait", "Al Wafrah", 28.6391667, 47.9305573, 174.0},
-	{5864, "Kuwait", "Az Zawr", 29.4425000, 48.2747231, -9999.0},
-	{5865, "Kuwait", "Al Funaytis", 29.2252778, 48.1016655, 1.0},
-	{5866, "Latvia", "Riga", 56.9500000, 24.1000004, 9.0},
-	{5867, "Latvia", "Daugavpils", 55.8833333, 26.5333328, 102.0},
-	{5868, "Latvia", "Vec-Liepaja", 56.5333333, 21.0166664, 14.0},
-	{5869, "Latvia", "Liepaja", 56.5166667, 21.0166664, 12.0},
-	{5870, "Latvia", "Jelgava", 56.6500000, 23.7127781, 17.0},
-	{5871, "Latvia", "Jurmala", 56.9608333, 23.7500000, 6.0},
-	{5872, "Latvia", "Ventspils", 57.3894444, 21.5605564, 11.0},
-	{5873, "Latvia", "Rezekne", 56.5000000, 27.3166676, 152.0},
-	{5874, "Latvia", "Jekabpils", 56.4833333, 25.8500004, 78.0},
-	{5875, "Latvia", "Valmiera", 57.5359155, 25.4244232, 33.0},
-	{5876, "Latvia", "Ogre", 56.8166667, 24.6000004, 40.0},
-	{5877, "Latvia", "Tukums", 56.9669444, 23.1552773, 69.0},
-	{5878, "Latvia", "Cesis", 57.3000000, 25.2500000, 120.0},
-	{5879, "Latvia", "Salaspils", 56.8611111, 24.3494453, 17.0},
-	{5880, "Latvia", "Kuldiga", 56.9666667, 21.9833336, 31.0},
-	{5881, "Latvia", "Olaine", 56.7852778, 23.9380550, 5.0},
-	{5882, "Latvia", "Saldus", 56.6666667, 22.5000000, 120.0},
-	{5883, "Latvia", "Talsi", 57.2500000, 22.6000004, 97.0},
-	{5884, "Latvia", "Dobele", 56.6250000, 23.2786102, 55.0},
-	{5885, "Latvia", "Kraslava", 55.9000000, 27.1666660, 129.0},
-	{5886, "Latvia", "Bauska", 56.4075000, 24.1905556, 27.0},
-	{5887, "Latvia", "Ludza", 56.5500000, 27.7166672, 146.0},
-	{5888, "Latvia", "Sigulda", 57.1519444, 24.8647213, 101.0},
-	{5889, "Latvia", "Livani", 56.3666667, 26.1833324, 88.0},
-	{5890, "Latvia", "Gulbene", 57.1833333, 26.7500000, 134.0},
-	{5891, "Latvia", "Madona", 56.8500000, 26.2166672, 126.0},
-	{5892, "Latvia", "Limbazi", 57.5102778, 24.7141666, 80.0},
-	{5893, "Latvia", "Aizkraukle", 56.6000000, 25.1833324, 43.0},
-	{5894, "Latvia", "Preili", 56.3000000, 26.7166672, 136.0},
-	{5895, "Latvia", "Balvi", 57.1333333, 27.2500000, 119.0},
-	{5896, "Latvia", "Valka", 57.7752041, 26.0101318, 62.0},
-	{5897, "Latvia", "Smiltene", 57.4333333, 25.8999996, 112.0},
-	{5898, "Latvia", "Aizpute", 56.7166667, 21.6000004, 74.0},
-	{5899, "Latvia", "Lielvarde", 56.7225000, 24.8050003, 48.0},
-	{5900, "Latvia", "Kekava", 56.8294444, 24.2372227, 4.0},
-	{5901, "Latvia", "Vecumnieki", 56.6063889, 24.5222225, 35.0},
-	{5902, "Latvia", "Grobina", 56.5500000, 21.1666660, 38.0},
-	{5903, "Latvia", "Vangazi", 57.0936111, 24.5486107, 16.0},
-	{5904, "Latvia", "Iecava", 56.5986111, 24.2022228, 23.0},
-	{5905, "Latvia", "Vilani", 56.5500000, 26.9500008, 120.0},
-	{5906, "Latvia", "Balozi", 56.8761111, 24.1186104, 11.0},
-	{5907, "Latvia", "Plavinas", 56.6166667, 25.7166672, 93.0},
-	{5908, "Latvia", "Rujiena", 57.9000000, 25.3166676, 54.0},
-	{5909, "Latvia", "Kandava", 57.0333333, 22.7666664, 49.0},
-	{5910, "Latvia", "Broceni", 56.7000000, 22.5333328, 124.0},
-	{5911, "Latvia", "Salacgriva", 57.7536111, 24.3580551, -9999.0},
-	{5912, "Latvia", "Ozolnieki", 56.6863889, 23.7877769, 8.0},
-	{5913, "Latvia", "Ikskile", 56.8333333, 24.5000000, 38.0},
-	{5914, "Latvia", "Saulkrasti", 57.2636111, 24.4180565, 13.0},
-	{5915, "Latvia", "Auce", 56.4666667, 22.8833332, 107.0},
-	{5916, "Latvia", "Ilukste", 55.9666667, 26.2999992, 98.0},
-	{5917, "Latvia", "Ulbroka", 56.9411111, 24.2897224, 18.0},
-	{5918, "Latvia", "Dagda", 56.1000000, 27.5333328, 191.0},
-	{5919, "Latvia", "Skrunda", 56.6833333, 22.0166664, 42.0},
-	{5920, "Latvia", "Karsava", 56.7833333, 27.6666660, 98.0},
-	{5921, "Latvia", "Priekule", 56.4333333, 21.5833340, 67.0},
-	{5922, "Latvia", "Kegums", 56.7441667, 24.7158337, 46.0},
-	{5923, "Latvia", "Aluksne", 57.4166667, 27.0499992, 198.0},
-	{5924, "Latvia", "Jumprava", 56.6775000, 24.9738884, 63.0},
-	{5925, "Latvia", "Viesite", 56.3500000, 25.5499992, 117.0},
-	{5926, "Latvia", "Tireli", 56.8416667, 23.5874996, 7.0},
-	{5927, "Latvia", "Baldone", 56.7427778, 24.3944435, 42.0},
-	{5928, "Latvia", "Stende", 57.1500000, 22.5333328, 87.0},
-	{5929, "Latvia", "Jaunjelgava", 56.6166667, 25.0833340, 42.0},
-	{5930, "Latvia", "Lubana", 56.9000000, 26.7166672, 93.0},
-	{5931, "Latvia", "Zilupe", 56.3833333, 28.1166668, 109.0},
-	{5932, "Latvia", "Mersrags", 57.3400000, 23.1213894, 3.0},
-	{5933, "Latvia", "Cesvaine", 56.9666667, 26.3166676, 123.0},
-	{5934, "Latvia", "Roja", 57.5000000, 22.8166676, 11.0},
-	{5935, "Latvia", "Seda", 57.6500000, 25.7500000, 52.0},
-	{5936, "Latvia", "Mazsalaca", 57.8666667, 25.0499992, 60.0},
-	{5937, "Latvia", "Engure", 57.1630556, 23.2241669, 4.0},
-	{5938, "Latvia", "Strenci", 57.6257360, 25.6853485, 47.0},
-	{5939, "Latvia", "Sabile", 57.0500000, 22.5833340, 86.0},
-	{5940, "Latvia", "Ape", 57.5333333, 26.6666660, 93.0},
-	{5941, "Latvia", "Aloja", 57.7669444, 24.8824997, 81.0},
-	{5942, "Latvia", "Valdemarpils", 57.3666667, 22.5833340, 53.0},
-	{5943, "Latvia", "Ligatne", 57.2333333, 25.0499992, 104.0},
-	{5944, "Latvia", "Akniste", 56.1666667, 25.7500000, 121.0},
-	{5945, "Latvia", "Ainazi", 57.8636111, 24.3577785, 13.0},
-	{5946, "Latvia", "Staicele", 57.8363889, 24.7500000, 51.0},
-	{5947, "Latvia", "Pavilosta", 56.8833333, 21.1833324, 18.0},
-	{5948, "Latvia", "Piltene", 57.2166667, 21.6666660, 8.0},
-	{5949, "Latvia", "Subate", 56.0166667, 25.8999996, 147.0},
-	{5950, "Latvia", "Rundale", 56.4266667, 23.9852772, 22.0},
-	{5951, "Latvia", "Ragaciems", 57.0244444, 23.4944439, 1.0},
-	{5952, "Latvia", "Durbe", 56.5833333, 21.3500004, 36.0},
-	{5953, "Latvia", "Kolka", 57.7500000, 22.5833340, 11.0},
-	{5954, "Latvia", "Rauda", 57.0086111, 23.1813889, 77.0},
-	{5955, "Latvia", "Seme", 57.0422222, 23.1277771, 50.0},
-	{5956, "Latvia", "Kulciems", 57.2655556, 23.0319443, 5.0},
-	{5957, "Latvia", "Berzciems", 57.2463889, 23.1736107, 1.0},
-	{5958, "Latvia", "Plienciems", 57.0827778, 23.2641659, 7.0},
-	{5959, "Latvia", "Kesterciems", 57.1147222, 23.2311115, 1.0},
-	{5960, "Latvia", "Apsuciems", 57.0616667, 23.3055553, 1.0},
-	{5961, "Latvia", "Upesgriva", 57.3863889, 23.0138893, 1.0},
-	{5962, "Latvia", "Klapkalnciems", 57.0408333, 23.3636112, 7.0},
-	{5963, "Latvia", "Kaive", 57.0605556, 23.0272217, 56.0},
-	{5964, "Latvia", "Dzedri", 57.2486111, 23.0266666, 5.0},
-	{5965, "Latvia", "Abragciems", 57.2027778, 23.2036114, 1.0},
-	{5966, "Lebanon", "Beirut", 33.8719444, 35.5097237, 56.0},
-	{5967, "Lebanon", "Tripoli", 34.4366667, 35.8497238, 31.0},
-	{5968, "Lebanon", "Sidon", 33.5630556, 35.3688889, -9999.0},
-	{5969, "Lebanon", "Tyre", 33.2733333, 35.1938896, -9999.0},
-	{5970, "Lebanon", "Habbouch", 33.4075000, 35.4827766, 404.0},
-	{5971, "Lebanon", "Jounie", 33.9808333, 35.6177788, 74.0},
-	{5972, "Lebanon", "Zahle", 33.8497222, 35.9041672, 952.0},
-	{5973, "Lebanon", "Baalbek", 34.0058333, 36.2180557, 1220.0},
-	{5974, "Lebanon", "Jbail", 34.1211111, 35.6480560, -9999.0},
-	{5975, "Lebanon", "Batroun", 34.2552778, 35.6580544, -9999.0},
-	{5976, "Lebanon", "Ain Ebel", 33.1102269, 35.4025078, 829.0},
-	{5977, "Lebanon", "Cheikhlar", 34.6211111, 36.2730560, 367.0},
-	{5978, "Liberia", "Monrovia", 6.3105556, -10.8047218, 30.0},
-	{5979, "Liberia", "Gbarnga", 6.9955556, -9.4722223, 275.0},
-	{5980, "Liberia", "Bensonville", 6.4461111, -10.6125002, 97.0},
-	{5981, "Liberia", "Harper", 4.3750000, -7.7169442, -9999.0},
-	{5982, "Liberia", "Buchanan", 5.8808333, -10.0466671, 78.0},
-	{5983, "Liberia", "Zwedru", 6.0666667, -8.1280556, 275.0},
-	{5984, "Liberia", "New Yekepa", 7.5794444, -8.5377779, 585.0},
-	{5985, "Liberia", "Ganta", 7.3022222, -8.5308332, 498.0},
-	{5986, "Liberia", "Robertsport", 6.7533333, -11.3686113, 149.0},
-	{5987, "Liberia", "Tubmanburg", 6.8708333, -10.8172226, 152.0},
-	{5988, "Libya", "Tripoli", 32.8925000, 13.1800003, 1.0},
-	{5989, "Libya", "Banghazi", 32.1166667, 20.0666676, 15.0},
-	{5990, "Libya", "Misratah", 32.3783333, 15.0905552, 29.0},
-	{5991, "Libya", "Tarhunah", 32.4333333, 13.6333332, 398.0},
-	{5992, "Libya", "Al Khums", 32.6500000, 14.2666664, 23.0},
-	{5993, "Libya", "Harat az Zawiyah", 32.7630556, 12.7150002, 18.0},
-	{5994, "Libya", "Zuwarah", 32.9344444, 12.0791664, 1.0},
-	{5995, "Libya", "Ajdabiya", 30.7591667, 20.2230549, 10.0},
-	{5996, "Libya", "Surt", 31.2061111, 16.5947227, 37.0},
-	{5997, "Libya", "Al Jadid", 27.0500000, 14.3999996, 423.0},
-	{5998, "Libya", "Tobruk", 32.0836111, 23.9763889, 11.0},
-	{5999, "Libya", "Sabratah", 32.7919444, 12.4847221, 7.0},
-	{6000, "Libya", "Al Marj", 32.5000000, 20.8333340, 334.0},
-	{6001, "Libya", "Gasr Garian", 32.1722222, 13.0202780, 694.0},
-	{6002, "Libya", "Darnah", 32.7650000, 22.6391659, 76.0},
-	{6003, "Libya", "Yafran", 32.0630556, 12.5269442, 700.0},
-	{6004, "Libya", "Nalut", 31.8683333, 10.9827776, 631.0},
-	{6005, "Libya", "Al Qubbah", 32.7666667, 22.2500000, 549.0},
-	{6006, "Libya", "Bani Walid", 31.7666667, 13.9833336, 283.0},
-	{6007, "Libya", "At Taj", 24.2166667, 23.2999992, 428.0},
-	{6008, "Libya", "Murzuq", 25.9044444, 13.8972225, 506.0},
-	{6009, "Libya", "Awbari", 26.5833333, 12.7666664, 471.0},
-	{6010, "Libya", "Birak", 27.5333333, 14.2833328, 345.0},
-	{6011, "Libya", "Al Abyar", 32.1833333, 20.6000004, 277.0},
-	{6012, "Libya", "Waddan", 29.1666667, 16.1333332, 263.0},
-	{6013, "Libya", "Mizdah", 31.4333333, 12.9833336, 432.0},
-	{6014, "Libya", "Surman", 32.7563889, 12.5749998, 16.0},
-	{6015, "Libya", "Ghat", 24.9647222, 10.1727781, 658.0},
-	{6016, "Libya", "Masallatah", 32.6166667, 14.0000000, 298.0},
-	{6017, "Libya", "Al `Aquriyah", 32.5333333, 20.5666676, 31.0},
-	{6018, "Libya", "Az Zuwaytinah", 30.9538889, 20.1216660, 1.0},
-	{6019, "Libya", "Hun", 29.1166667, 15.9333334, 279.0},
-	{6020, "Libya", "Zaltan", 32.9500000, 11.8666668, 5.0},
-	{6021, "Libya", "Suluq", 31.6686111, 20.2500000, 50.0},
-	{6022, "Libya", "Al Burayqah", 30.4166667, 19.5786114, -9999.0},
-	{6023, "Libya", "Bardiyah", 31.7666667, 25.1000004, -9999.0},
-	{6024, "Libya", "Awjilah", 29.1080556, 21.2869453, 31.0},
-	{6025, "Libya", "Jadu", 31.9500000, 12.0166664, 644.0},
-	{6026, "Libya", "Jalu", 29.0333333, 21.5499992, 49.0},
-	{6027, "Liechtenstein", "Schaan", 47.1649755, 9.5086670, 560.0},
-	{6028, "Liechtenstein", "Vaduz", 47.1415115, 9.5215416, 711.0},
-	{6029, "Liechtenstein", "Triesen", 47.1000000, 9.5333328, 648.0},
-	{6030, "Liechtenstein", "Balzers", 47.0666667, 9.5000000, 494.0},
-	{6031, "Liechtenstein", "Eschen", 47.2107065, 9.5222282, 463.0},
-	{6032, "Liechtenstein", "Mauren", 47.2180524, 9.5442009, 436.0},
-	{6033, "Liechtenstein", "Triesenberg", 47.1181539, 9.5419693, 1172.0},
-	{6034, "Liechtenstein", "Ruggell", 47.2333333, 9.5166664, 539.0},
-	{6035, "Liechtenstein", "Gamprin", 47.2203842, 9.5093536, 549.0},
-	{6036, "Liechtenstein", "Schellenberg", 47.2312259, 9.5467758, 552.0},
-	{6037, "Liechtenstein", "Planken", 47.1851627, 9.5443726, 910.0},
-	{6038, "Liechtenstein", "Malbun", 47.1013885, 9.6098614, 1957.0},
-	{6039, "Liechtenstein", "Steg", 47.1126634, 9.5753574, 1483.0},
-	{6040, "Lithuania", "Vilnius", 54.6833333, 25.3166676, 125.0},
-	{6041, "Lithuania", "Kaunas", 54.9000000, 23.8999996, 48.0},
-	{6042, "Lithuania", "Klaipeda", 55.7172222, 21.1175003, 8.0},
-	{6043, "Lithuania", "Siauliai", 55.9333333, 23.3166676, 107.0},
-	{6044, "Lithuania", "Dainava (Kaunas}", 54.9152540, 23.9683056, 85.0},
-	{6045, "Lithuania", "Panevezys", 55.7333333, 24.3500004, 61.0},
-	{6046, "Lithuania", "Alytus", 54.4000000, 24.0499992, 93.0},
-	{6047, "Lithuania", "Marijampole", 54.5666667, 23.3500004, 86.0},
-	{6048, "Lithuania", "Mazeikiai", 56.3166667, 22.3333340, 81.0},
-	{6049, "Lithuania", "Jonava", 55.0833333, 24.2833328, 65.0},
-	{6050, "Lithuania", "Utena", 55.5000000, 25.6000004, 113.0},
-	{6051, "Lithuania", "Kedainiai", 55.2883333, 23.9747219, 39.0},
-	{6052, "Lithuania", "Telsiai", 55.9813889, 22.2472229, 123.0},
-	{6053, "Lithuania", "Ukmerge", 55.2500000, 24.7500000, 64.0},
-	{6054, "Lithuania", "Visaginas", 55.6000000, 26.4166660, 164.0},
-	{6055, "Lithuania", "Taurage", 55.2522222, 22.2897224, 44.0},
-	{6056, "Lithuania", "Plunge", 55.9113889, 21.8441658, 119.0},
-	{6057, "Lithuania", "Kretinga", 55.8758333, 21.2508335, 31.0},
-	{6058, "Lithuania", "Silute", 55.3488889, 21.4830551, 11.0},
-	{6059, "Lithuania", "Radviliskis", 55.8166667, 23.5333328, 117.0},
-	{6060, "Lithuania", "Palanga", 55.9175000, 21.0686111, 7.0},
-	{6061, "Lithuania", "Druskininkai", 54.0166667, 23.9666672, 110.0},
-	{6062, "Lithuania", "Rokiskis", 55.9666667, 25.5833340, 133.0},
-	{6063, "Lithuania", "Gargzdai", 55.7127778, 21.4033337, 10.0},
-	{6064, "Lithuania", "Birzai", 56.2000000, 24.7500000, 63.0},
-	{6065, "Lithuania", "Kursenai", 55.9850000, 22.9188881, 105.0},
-	{6066, "Lithuania", "Garliava", 54.8166667, 23.8666668, 97.0},
-	{6067, "Lithuania", "Elektrenai", 54.7666667, 24.6333332, 95.0},
-	{6068, "Lithuania", "Jurbarkas", 55.0777778, 22.7663898, 29.0},
-	{6069, "Lithuania", "Vilkaviskis", 54.6516667, 23.0322227, 75.0},
-	{6070, "Lithuania", "Raseiniai", 55.3736111, 23.1216660, 125.0},
-	{6071, "Lithuania", "Naujoji Akmene", 56.3166667, 22.8999996, 89.0},
-	{6072, "Lithuania", "Lentvaris", 54.6500000, 25.0499992, 158.0},
-	{6073, "Lithuania", "Anyksciai", 55.5333333, 25.1000004, 75.0},
-	{6074, "Lithuania", "Grigiskes", 54.6833333, 25.0833340, 90.0},
-	{6075, "Lithuania", "Prienai", 54.6333333, 23.9500008, 75.0},
-	{6076, "Lithuania", "Joniskis", 56.2402778, 23.6152782, 60.0},
-	{6077, "Lithuania", "Kelme", 55.6338889, 22.9341660, 131.0},
-	{6078, "Lithuania", "Varena", 54.2166667, 24.5666676, 134.0},
-	{6079, "Lithuania", "Kaisiadorys", 54.8666667, 24.4500008, 73.0},
-	{6080, "Lithuania", "Pasvalys", 56.0594444, 24.4036102, 55.0},
-	{6081, "Lithuania", "Kupiskis", 55.8333333, 24.9666672, 101.0},
-	{6082, "Lithuania", "Zarasai", 55.7333333, 26.2500000, 134.0},
-	{6083, "Lithuania", "Skuodas", 56.2666667, 21.5333328, 31.0},
-	{6084, "Lithuania", "Sirvintos", 55.0500000, 24.9500008, 126.0},
-	{6085, "Lithuania", "Kazlu Ruda", 54.7666667, 23.5000000, 95.0},
-	{6086, "Lithuania", "Moletai", 55.2333333, 25.4166660, 155.0},
-	{6087, "Lithuania", "Salcininkai", 54.3000000, 25.3833332, 181.0},
-	{6088, "Lithuania", "Svencioneliai", 55.1666667, 26.0000000, 176.0},
-	{6089, "Lithuania", "Sakiai", 54.9547222, 23.0358334, 71.0},
-	{6090, "Lithuania", "Ignalina", 55.3500000, 26.1666660, 165.0},
-	{6091, "Lithuania", "Pabrade", 55.0000000, 25.7833328, 124.0},
-	{6092, "Lithuania", "Kybartai", 54.6355556, 22.7383327, 81.0},
-	{6093, "Lithuania", "Nemencine", 54.8500000, 25.4833336, 122.0},
-	{6094, "Lithuania", "Silale", 55.4905556, 22.1813889, 107.0},
-	{6095, "Lithuania", "Pakruojis", 55.9666667, 23.8666668, 76.0},
-	{6096, "Lithuania", "Svencionys", 55.1500000, 26.1666660, 230.0},
-	{6097, "Lithuania", "Trakai", 54.6333333, 24.9333324, 155.0},
-	{6098, "Lithuania", "Vievis", 54.7666667, 24.7999992, 128.0},
-	{6099, "Lithuania", "Lazdijai", 54.2333333, 23.5166664, 126.0},
-	{6100, "Lithuania", "Kalvarija", 54.4000000, 23.2333336, 136.0},
-	{6101, "Lithuania", "Ziezmariai", 54.8000000, 24.4500008, 73.0},
-	{6102, "Lithuania", "Eisiskes", 54.1666667, 25.0000000, 159.0},
-	{6103, "Lithuania", "Rietavas", 55.7194444, 21.9311104, 106.0},
-	{6104, "Lithuania", "Ariogala", 55.2666667, 23.4666672, 92.0},
-	{6105, "Lithuania", "Seduva", 55.7666667, 23.7666664, 87.0},
-	{6106, "Lithuania", "Venta", 56.2000000, 22.7000008, 84.0},
-	{6107, "Lithuania", "Birstonas", 54.6166667, 24.0333328, 79.0},
-	{6108, "Lithuania", "Akmene", 56.2500000, 22.7500000, 78.0},
-	{6109, "Lithuania", "Tytuveneliai", 55.6166667, 23.1833324, 135.0},
-	{6110, "Lithuania", "Rudiskes", 54.5166667, 24.8333340, 147.0},
-	{6111, "Lithuania", "Rusne", 55.2963889, 21.3758335, 8.0},
-	{6112, "Lithuania", "Neringa", 55.3719444, 21.0647221, 1.0},
-	{6113, "Lithuania", "Pagegiai", 55.1380556, 21.9088898, 28.0},
-	{6114, "Lithuania", "Vilkija", 55.0500000, 23.5833340, 85.0},
-	{6115, "Lithuania", "Vieksniai", 56.2333333, 22.5166664, 80.0},
-	{6116, "Lithuania", "Zagare", 56.3591667, 23.2500000, 77.0},
-	{6117, "Lithuania", "Ezerelis", 54.8833333, 23.6166668, 95.0},
-	{6118, "Lithuania", "Juodupe", 56.0833333, 25.6166668, 123.0},
-	{6119, "Lithuania", "Skaudvile", 55.4166667, 22.6166668, 119.0},
-	{6120, "Lithuania", "Kudirkos Naumiestis", 54.7730556, 22.8630562, 52.0},
-	{6121, "Lithuania", "Simnas", 54.4000000, 23.6499996, 108.0},
-	{6122, "Lithuania", "Gelgaudiskis", 55.0791667, 22.9758339, 51.0},
-	{6123, "Lithuania", "Salantai", 56.0666667, 21.5666676, 66.0},
-	{6124, "Lithuania", "Linkuva", 56.0855556, 23.9724998, 98.0},
-	{6125, "Lithuania", "Priekule", 55.5536111, 21.3266659, 4.0},
-	{6126, "Lithuania", "Veisiejai", 54.1000000, 23.7000008, 119.0},
-	{6127, "Lithuania", "Nida", 55.3080556, 21.0066662, -9999.0},
-	{6128, "Lithuania", "Ramygala", 55.5166667, 24.2999992, 69.0},
-	{6129, "Lithuania", "Kulautuva", 54.9333333, 23.6000004, 78.0},
-	{6130, "Lithuania", "Jieznas", 54.6000000, 24.1666660, 109.0},
-	{6131, "Lithuania", "Zemaiciu Naumiestis", 55.3591667, 21.7022228, 31.0},
-	{6132, "Lithuania", "Virbalis", 54.6266667, 22.8169441, 79.0},
-	{6133, "Lithuania", "Daugai", 54.3666667, 24.3333340, 150.0},
-	{6134, "Lithuania", "Obeliai", 55.9666667, 25.1499996, 87.0},
-	{6135, "Lithuania", "Varniai", 55.7405556, 22.3644447, 158.0},
-	{6136, "Lithuania", "Seda", 56.1666667, 22.1000004, 129.0},
-	{6137, "Lithuania", "Vabalninkas", 55.9666667, 24.7500000, 62.0},
-	{6138, "Lithuania", "Baltoji Voke", 54.6000000, 25.2000008, 145.0},
-	{6139, "Lithuania", "Dukstas", 55.5333333, 26.3333340, 167.0},
-	{6140, "Luxembourg", "Luxembourg", 49.6116667, 6.1300001, 259.0},
-	{6141, "Luxembourg", "Esch-sur-Alzette", 49.4958333, 5.9805555, 300.0},
-	{6142, "Luxembourg", "Dudelange", 49.4805556, 6.0875001, 321.0},
-	{6143, "Luxembourg", "Schifflange", 49.5063889, 6.0127778, 307.0},
-	{6144, "Luxembourg", "Bettembourg", 49.5186111, 6.1027780, 275.0},
-	{6145, "Luxembourg", "Petange", 49.5583333, 5.8805556, 300.0},
-	{6146, "Luxembourg", "Ettelbruck", 49.8475000, 6.1041665, 253.0},
-	{6147, "Luxembourg", "Diekirch", 49.8677778, 6.1558332, 209.0},
-	{6148, "Luxembourg", "Strassen", 49.6205556, 6.0733333, 313.0},
-	{6149, "Luxembourg", "Bertrange", 49.6111111, 6.0500002, 290.0},
-	{6150, "Luxembourg", "Belvaux", 49.5127778, 5.9294443, 322.0},
-	{6151, "Luxembourg", "Differdange", 49.5241667, 5.8913889, 300.0},
-	{6152, "Luxembourg", "Mamer", 49.6275000, 6.0233331, 297.0},
-	{6153, "Luxembourg", "Soleuvre", 49.5255556, 5.9405556, 340.0},
-	{6154, "Luxembourg", "Wiltz", 49.9688889, 5.9319444, 320.0},
-	{6155, "Luxembourg", "Echternach", 49.8055556, 6.4222221, 195.0},
-	{6156, "Luxembourg", "Rodange", 49.5463889, 5.8408332, 290.0},
-	{6157, "Luxembourg", "Obercorn", 49.5136111, 5.8961110, 339.0},
-	{6158, "Luxembourg", "Bascharage", 49.5675000, 5.9102778, 302.0},
-	{6159, "Luxembourg", "Kayl", 49.4891667, 6.0397220, 285.0},
-	{6160, "Luxembourg", "Grevenmacher", 49.6747222, 6.4419446, 296.0},
-	{6161, "Luxembourg", "Bereldange", 49.6569444, 6.1275001, 257.0},
-	{6162, "Luxembourg", "Mersch", 49.7488889, 6.1061110, 222.0},
-	{6163, "Luxembourg", "Mondercange", 49.5330556, 5.9883332, 289.0},
-	{6164, "Luxembourg", "Remich", 49.5450000, 6.3669443, 148.0},
-	{6165, "Luxembourg", "Niedercorn", 49.5361111, 5.8930554, 288.0},
-	{6166, "Luxembourg", "Mondorf-les-Bains", 49.5050000, 6.2811112, 216.0},
-	{6167, "Luxembourg", "Tetange", 49.4758333, 6.0422220, 380.0},
-	{6168, "Luxembourg", "Bissen", 49.7875000, 6.0694442, 268.0},
-	{6169, "Luxembourg", "Sandweiler", 49.6133333, 6.2188888, 330.0},
-	{6170, "Luxembourg", "Sanem", 49.5480556, 5.9288888, 313.0},
-	{6171, "Luxembourg", "Lamadelaine", 49.5463889, 5.8563890, 308.0},
-	{6172, "Luxembourg", "Bridel", 49.6583333, 6.0822220, 320.0},
-	{6173, "Luxembourg", "Junglinster", 49.7072222, 6.2530556, 335.0},
-	{6174, "Luxembourg", "Wasserbillig", 49.7100000, 6.5002780, 155.0},
-	{6175, "Luxembourg", "Steinfort", 49.6613889, 5.9191666, 322.0},
-	{6176, "Luxembourg", "Helmsange", 49.6527778, 6.1413889, 349.0},
-	{6177, "Luxembourg", "Hesperange", 49.5680556, 6.1513891, 270.0},
-	{6178, "Luxembourg", "Leudelange", 49.5683333, 6.0652776, 315.0},
-	{6179, "Luxembourg", "Steinsel", 49.6769444, 6.1238890, 230.0},
-	{6180, "Luxembourg", "Itzig", 49.5841667, 6.1736112, 280.0},
-	{6181, "Luxembourg", "Clemency", 49.5966667, 5.8758335, 350.0},
-	{6182, "Luxembourg", "Colmar", 49.8102778, 6.0972223, 232.0},
-	{6183, "Luxembourg", "Lintgen", 49.7158333, 6.1336112, 308.0},
-	{6184, "Luxembourg", "Kehlen", 49.6683333, 6.0358334, 363.0},
-	{6185, "Luxembourg", "Vianden", 49.9350000, 6.2088890, 340.0},
-	{6186, "Luxembourg", "Heisdorf-sur-Alzette", 49.6686111, 6.1422224, 382.0},
-	{6187, "Luxembourg", "Eischen", 49.6855556, 5.8786111, 300.0},
-	{6188, "Luxembourg", "Bergem", 49.5250000, 6.0422220, 282.0},
-	{6189, "Luxembourg", "Niederanven", 49.6475000, 6.2600002, 251.0},
-	{6190, "Luxembourg", "Hautcharage", 49.5775000, 5.9108334, 310.0},
-	{6191, "Luxembourg", "Mertzig", 49.8338889, 6.0075002, 317.0},
-	{6192, "Luxembourg", "Gonderange", 49.6888889, 6.2483335, 328.0},
-	{6193, "Luxembourg", "Olm", 49.6572222, 6.0002780, 309.0},
-	{6194, "Luxembourg", "Troisvierges", 50.1211111, 6.0002780, 470.0},
-	{6195, "Luxembourg", "Rollingen", 49.7416667, 6.1144443, 246.0},
-	{6196, "Luxembourg", "Beaufort", 49.8358333, 6.2916665, 352.0},
-	{6197, "Luxembourg", "Larochette", 49.7769444, 6.2211113, 340.0},
-	{6198, "Luxembourg", "Schieren", 49.8305556, 6.0986109, 242.0},
-	{6199, "Luxembourg", "Alzingen", 49.5650000, 6.1636109, 272.0},
-	{6200, "Luxembourg", "Dalheim", 49.5408333, 6.2597222, 330.0},
-	{6201, "Luxembourg", "Frisange", 49.5150000, 6.1916666, 252.0},
-	{6202, "Luxembourg", "Capellen", 49.6450000, 5.9908333, 310.0},
-	{6203, "Luxembourg", "Fentange", 49.5627778, 6.1538887, 270.0},
-	{6204, "Luxembourg", "Crauthem", 49.5355556, 6.1469445, 290.0},
-	{6205, "Luxembourg", "Consdorf", 49.7730556, 6.3383331, 356.0},
-	{6206, "Luxembourg", "Warken", 49.8544444, 6.0949998, 256.0},
-	{6207, "Luxembourg", "Moutfort", 49.5852778, 6.2555556, 263.0},
-	{6208, "Luxembourg", "Redange-sur-Attert", 49.7655556, 5.8908334, 280.0},
-	{6209, "Luxembourg", "Mertert", 49.6961111, 6.4813890, 272.0},
-	{6210, "Luxembourg", "Niederfeulen", 49.8555556, 6.0472221, 307.0},
-	{6211, "Luxembourg", "Clervaux", 50.0547222, 6.0313888, 438.0},
-	{6212, "Luxembourg", "Schouweiler", 49.5825000, 5.9563890, 338.0},
-	{6213, "Luxembourg", "Contern", 49.5819444, 6.2266669, 266.0},
-	{6214, "Luxembourg", "Bettendorf", 49.8766667, 6.2180557, 200.0},
-	{6215, "Luxembourg", "Koerich", 49.6700000, 5.9499998, 315.0},
-	{6216, "Luxembourg", "Hobscheid", 49.6886111, 5.9147220, 280.0},
-	{6217, "Luxembourg", "Schrassig", 49.6058333, 6.2588887, 270.0},
-	{6218, "Luxembourg", "Mullendorf", 49.6802778, 6.1300001, 230.0},
-	{6219, "Luxembourg", "Aspelt", 49.5227778, 6.2247224, 244.0},
-	{6220, "Luxembourg", "Pontpierre", 49.5363889, 6.0294442, 315.0},
-	{6221, "Luxembourg", "Noertzange", 49.5091667, 6.0561113, 275.0},
-	{6222, "Luxembourg", "Bettange-sur-Mess", 49.5758333, 5.9838891, 330.0},
-	{6223, "Luxembourg", "Hagen", 49.6494444, 5.9316669, 308.0},
-	{6224, "Luxembourg", "Roodt-sur-Syre", 49.6625000, 6.3027778, 323.0},
-	{6225, "Luxembourg", "Beringen", 49.7583333, 6.1169443, 295.0},
-	{6226, "Luxembourg", "Canach", 49.6069444, 6.3258333, 257.0},
-	{6227, "Luxembourg", "Medernach", 49.8019444, 6.2183332, 379.0},
-	{6228, "Luxembourg", "Berdorf", 49.8136111, 6.3502779, 332.0},
-	{6229, "Luxembourg", "Gilsdorf", 49.8669444, 6.1824999, 196.0},
-	{6230, "Luxembourg", "Kleinbettingen", 49.6463889, 5.9163890, 321.0},
-	{6231, "Luxembourg", "Berchem", 49.5402778, 6.1291666, 270.0},
-	{6232, "Luxembourg", "Erpeldange-sur-Sure", 49.8647222, 6.1147223, 244.0},
-	{6233, "Luxembourg", "Schuttrange", 49.6205556, 6.2686110, 259.0},
-	{6234, "Luxembourg", "Ingeldorf", 49.8519444, 6.1347222, 263.0},
-	{6235, "Luxembourg", "Garnich", 49.6166667, 5.9524999, 332.0},
-	{6236, "Luxembourg", "Mensdorf", 49.6508333, 6.3036113, 277.0},
-	{6237, "Luxembourg", "Hosingen", 50.0177778, 6.0933332, 485.0},
-	{6238, "Luxembourg", "Dippach", 49.5883333, 5.9833331, 361.0},
-	{6239, "Luxembourg", "Bofferdange", 49.6830556, 6.1416669, 389.0},
-	{6240, "Macedonia", "Skopje", 42.0000000, 21.4333324, 246.0},
-	{6241, "Macedonia", "Kumanovo", 42.1322222, 21.7144451, 352.0},
-	{6242, "Macedonia", "Bitola", 41.0311111, 21.3402786, 583.0},
-	{6243, "Macedonia", "Prilep", 41.3463889, 21.5544453, 655.0},
-	{6244, "Macedonia", "Tetovo", 42.0105556, 20.9713898, 458.0},
-	{6245, "Macedonia", "Veles", 41.7155556, 21.7755547, 208.0},
-	{6246, "Macedonia", "Ohrid", 41.1172222, 20.8019447, 699.0},
-	{6247, "Macedonia", "Gostivar", 41.7972222, 20.9083328, 532.0},
-	{6248, "Macedonia", "Shtip", 41.7458333, 22.1958332, 340.0},
-	{6249, "Macedonia", "Strumica", 41.4375000, 22.6433334, 203.0},
-	{6250, "Macedonia", "Kavadarci", 41.4330556, 22.0119438, 286.0},
-	{6251, "Macedonia", "Struga", 41.1780556, 20.6761112, 681.0},
-	{6252, "Macedonia", "Kochani", 41.9163889, 22.4127769, 339.0},
-	{6253, "Macedonia", "Kicevo", 41.5144444, 20.9633331, 716.0},
-	{6254, "Macedonia", "Lipkovo", 42.1563889, 21.5852776, 449.0},
-	{6255, "Macedonia", "Zhelino", 41.9802778, 21.0641670, 584.0},
-	{6256, "Macedonia", "Saraj", 42.0000000, 21.3277779, 269.0},
-	{6257, "Macedonia", "Radovish", 41.6383333, 22.4647217, 405.0},
-	{6258, "Macedonia", "Tearce", 42.0744444, 21.0524998, 481.0},
-	{6259, "Macedonia", "Kriva Palanka", 42.2019444, 22.3319435, 661.0},
-	{6260, "Macedonia", "Gevgelija", 41.1391667, 22.5025005, 25.0},
-	{6261, "Macedonia", "Negotino", 41.4838889, 22.0891666, 145.0},
-	{6262, "Macedonia", "Studenichani", 41.9158333, 21.5305557, 360.0},
-	{6263, "Macedonia", "Vinica", 41.8827778, 22.5091667, 458.0},
-	{6264, "Macedonia", "Debar", 41.5250000, 20.5275002, 764.0},
-	{6265, "Macedonia", "Negotino", 41.8786111, 20.8838882, 554.0},
-	{6266, "Macedonia", "Delchevo", 41.9672222, 22.7694435, 603.0},
-	{6267, "Macedonia", "Resen", 41.0888889, 21.0122223, 866.0},
-	{6268, "Macedonia", "Ilinden", 41.9961111, 21.5822220, 231.0},
-	{6269, "Macedonia", "Brvenica", 41.9672222, 20.9808331, 462.0},
-	{6270, "Macedonia", "Kamenjane", 41.9444444, 20.9266663, 474.0},
-	{6271, "Macedonia", "Bogovinje", 41.9236111, 20.9136105, 520.0},
-	{6272, "Macedonia", "Berovo", 41.7030556, 22.8577785, 916.0},
-	{6273, "Macedonia", "Sveti Nikole", 41.8695608, 21.9527435, 341.0},
-	{6274, "Macedonia", "Arachinovo", 42.0263889, 21.5619450, 313.0},
-	{6275, "Macedonia", "Probishtip", 42.0030556, 22.1786118, 612.0},
-	{6276, "Macedonia", "Chegrane", 41.8388889, 20.9758339, 556.0},
-	{6277, "Macedonia", "Bosilovo", 41.4405556, 22.7277775, 202.0},
-	{6278, "Macedonia", "Vasilevo", 41.4761111, 22.6416664, 211.0},
-	{6279, "Macedonia", "Zajas", 41.6072222, 20.9383335, 713.0},
-	{6280, "Macedonia", "Novo Selo", 41.4138889, 22.8811111, 217.0},
-	{6281, "Macedonia", "Kondovo", 42.0116667, 21.3136120, 323.0},
-	{6282, "Macedonia", "Dolneni", 41.4252778, 21.4547215, 602.0},
-	{6283, "Macedonia", "Kratovo", 42.0791667, 22.1813889, 672.0},
-	{6284, "Macedonia", "Dolna Banjica", 41.7861111, 20.9061108, 522.0},
-	{6285, "Macedonia", "Sopishte", 41.9547222, 21.4274998, 421.0},
-	{6286, "Macedonia", "Rostusha", 41.6100000, 20.6000004, 874.0},
-	{6287, "Macedonia", "Labunishta", 41.2686111, 20.5961113, 861.0},
-	{6288, "Macedonia", "Vrapciste", 41.8338889, 20.8852787, 536.0},
-	{6289, "Macedonia", "Velesta", 41.2408333, 20.6438885, 692.0},
-	{6290, "Macedonia", "Chucher - Sandevo", 42.1036111, 21.3822231, 511.0},
-	{6291, "Macedonia", "Bogdanci", 41.2030556, 22.5755558, 95.0},
-	{6292, "Macedonia", "Delogozda", 41.2572222, 20.7236118, 728.0},
-	{6293, "Macedonia", "Petrovec", 41.9388889, 21.6149998, 221.0},
-	{6294, "Macedonia", "Sipkovica", 42.0350000, 20.9155560, 914.0},
-	{6295, "Macedonia", "Dzepciste", 42.0327778, 21.0000000, 444.0},
-	{6296, "Macedonia", "Makedonska Kamenica", 42.0200000, 22.5919437, 582.0},
-	{6297, "Macedonia", "Jegunovce", 42.0736111, 21.1236115, 388.0},
-	{6298, "Macedonia", "Krivogashtani", 41.3361111, 21.3330555, 577.0},
-	{6299, "Macedonia", "Makedonski Brod", 41.5136111, 21.2152786, 543.0},
-	{6300, "Macedonia", "Krushevo", 41.3688889, 21.2488880, 1177.0},
-	{6301, "Macedonia", "Obleshevo", 41.8833333, 22.3338890, 297.0},
-	{6302, "Macedonia", "Bistrica", 40.9833333, 21.3666668, 592.0},
-	{6303, "Macedonia", "Celopek", 41.9316667, 21.0133324, 522.0},
-	{6304, "Macedonia", "Plasnica", 41.4672222, 21.1230564, 744.0},
-	{6305, "Macedonia", "Demir Kapija", 41.4050000, 22.2488880, 100.0},
-	{6306, "Macedonia", "Mogila", 41.1083333, 21.3786106, 568.0},
-	{6307, "Macedonia", "Kuklish", 41.4052778, 22.6652775, 219.0},
-	{6308, "Macedonia", "Orizari", 41.9227778, 22.4469452, 417.0},
-	{6309, "Macedonia", "Dobri Dol", 41.8658333, 20.8875008, 544.0},
-	{6310, "Macedonia", "Staro Nagorichane", 42.1980556, 21.8286114, 404.0},
-	{6311, "Macedonia", "Rosoman", 41.5161111, 21.9497223, 114.0},
-	{6312, "Macedonia", "Rankovce", 42.1819444, 22.1227779, 471.0},
-	{6313, "Macedonia", "Zelenikovo", 41.8866667, 21.5869446, 213.0},
-	{6314, "Macedonia", "Karbinci", 41.8166667, 22.2374992, 278.0},
-	{6315, "Macedonia", "Pirok", 41.9108333, 20.9105549, 502.0},
-	{6316, "Macedonia", "Valandovo", 41.3169444, 22.5611115, 142.0},
-	{6317, "Macedonia", "Oraovica", 41.6258333, 22.5133324, 404.0},
-	{6318, "Macedonia", "Podares", 41.6138889, 22.5422230, 418.0},
-	{6319, "Macedonia", "Gradec", 41.8961111, 20.9041672, 524.0},
-	{6320, "Macedonia", "Gradsko", 41.5775000, 21.9427776, 109.0},
-	{6321, "Macedonia", "Vratnica", 42.1433333, 21.1169453, 717.0},
-	{6322, "Macedonia", "Forino", 41.8241667, 20.9624996, 634.0},
-	{6323, "Macedonia", "Srbinovo", 41.7058333, 20.9602776, 846.0},
-	{6324, "Macedonia", "Konce", 41.4958333, 22.3850002, 525.0},
-	{6325, "Macedonia", "Slatino", 42.0677778, 21.0375004, 515.0},
-	{6326, "Macedonia", "Star Dojran", 41.1875000, 22.7208328, 131.0},
-	{6327, "Macedonia", "Zletovo", 41.9886111, 22.2361107, 554.0},
-	{6328, "Macedonia", "Dobroste", 42.1033333, 21.0777779, 518.0},
-	{6329, "Macedonia", "Batinci", 41.9197222, 21.4819450, 290.0},
-	{6330, "Macedonia", "Zrnovci", 41.8541667, 22.4444447, 483.0},
-	{6331, "Macedonia", "Varos", 41.3572222, 21.5324993, 691.0},
-	{6332, "Macedonia", "Drugovo", 41.4841667, 20.9233341, 656.0},
-	{6333, "Macedonia", "Golema Recica", 41.9902778, 20.9458332, 457.0},
-	{6334, "Macedonia", "Nerashte", 42.1066667, 21.1088886, 533.0},
-	{6335, "Macedonia", "Caska", 41.6505556, 21.6622219, 299.0},
-	{6336, "Macedonia", "Lozovo", 41.7838889, 21.9055557, 310.0},
-	{6337, "Macedonia", "Radolishta", 41.1641667, 20.6233330, 699.0},
-	{6338, "Macedonia", "Belcista", 41.3027778, 20.8302784, 782.0},
-	{6339, "Macedonia", "Vatasa", 41.4169444, 22.0188885, 374.0},
-	{6340, "Madagascar", "Antananarivo", -18.9166667, 47.5166664, 1289.0},
-	{6341, "Madagascar", "Toamasina", -18.1666667, 49.3833351, 28.0},
-	{6342, "Madagascar", "Fianarantsoa", -21.4333333, 47.0833321, 1139.0},
-	{6343, "Madagascar", "Mahajanga", -15.7166667, 46.3166656, 125.0},
-	{6344, "Madagascar", "Toliara", -23.3500000, 43.6666679, 21.0},
-	{6345, "Madagascar", "Antsiranana", -12.2666667, 49.2833328, -9999.0},
-	{6346, "Madagascar", "Antanifotsy", -19.6500000, 47.3166656, 1638.0},
-	{6347, "Madagascar", "Ambovombe", -25.1666667, 46.0833321, 150.0},
-	{6348, "Madagascar", "Amparafaravola", -17.5833333, 48.2166672, 813.0},
-	{6349, "Madagascar", "Fort Dauphin", -25.0318335, 46.9998131, 12.0},
-	{6350, "Madagascar", "Ambatondrazaka", -17.8333333, 48.4166679, 835.0},
-	{6351, "Madagascar", "Mananara Avaratra", -16.1666667, 49.7666664, 127.0},
-	{6352, "Madagascar", "Soavinandriana", -19.1666667, 46.7333336, 1533.0},
-	{6353, "Madagascar", "Mahanoro", -19.9000000, 48.7999992, 7.0},
-	{6354, "Madagascar", "Soanierana Ivongo", -16.9166667, 49.5833321, 117.0},
-	{6355, "Madagascar", "Faratsiho", -19.4000000, 46.9500008, 1824.0},
-	{6356, "Madagascar", "Nosy Varika", -20.5833333, 48.5333328, 1.0},
-	{6357, "Madagascar", "Vavatenina", -17.4666667, 49.2000008, 131.0},
-	{6358, "Madagascar", "Morondava", -20.2833333, 44.2833328, -9999.0},
-	{6359, "Madagascar", "Amboasary", -25.0333333, 46.3833351, 4.0},
-	{6360, "Madagascar", "Manakara", -22.1333333, 48.0166664, 29.0},
-	{6361, "Madagascar", "Antalaha", -14.8833333, 50.2833328, -9999.0},
-	{6362, "Madagascar", "Ikongo", -21.8833333, 47.4333344, 377.0},
-	{6363, "Madagascar", "Manjakandriana", -18.9166667, 47.7999992, 1516.0},
-	{6364, "Madagascar", "Sambava", -14.2666667, 50.1666679, 97.0},
-	{6365, "Madagascar", "Fandriana", -20.2333333, 47.3833351, 1417.0},
-	{6366, "Madagascar", "Marovoay", -16.1000000, 46.6333351, 83.0},
-	{6367, "Madagascar", "Betioky", -23.7166667, 44.3833351, 271.0},
-	{6368, "Madagascar", "Ambanja", -13.6833333, 48.4500008, 149.0},
-	{6369, "Madagascar", "Ambositra", -20.5166667, 47.2500000, 1297.0},
-	{6370, "Madagascar", "Tsiombe", -25.3000000, 45.4833336, 70.0},
-	{6371, "Madagascar", "Betafo", -19.8333333, 46.8499985, 1433.0},
-	{6372, "Madagascar", "Moramanga", -18.9333333, 48.2000008, 909.0},
-	{6373, "Madagascar", "Ambatolampy", -19.3833333, 47.4166679, 1644.0},
-	{6374, "Madagascar", "Ambalavao", -21.8333333, 46.9333344, 983.0},
-	{6375, "Madagascar", "Mananjary", -21.2166667, 48.3333321, 20.0},
-	{6376, "Madagascar", "Tsiroanomandidy", -18.7666667, 46.0333328, 807.0},
-	{6377, "Madagascar", "Marolambo", -20.0500000, 48.1166649, 494.0},
-	{6378, "Madagascar", "Ambatofinandrahana", -20.5500000, 46.7999992, 1454.0},
-	{6379, "Madagascar", "Ankazoabo", -22.2833333, 44.5166664, 423.0},
-	{6380, "Madagascar", "Ampanihy", -24.7000000, 44.7500000, 233.0},
-	{6381, "Madagascar", "Vohibinany", -17.3500000, 49.0333328, 299.0},
-	{6382, "Madagascar", "Farafangana", -22.8166667, 47.8333321, 15.0},
-	{6383, "Madagascar", "Belo Tsiribihina", -19.7000000, 44.5499992, 27.0},
-	{6384, "Madagascar", "Maroantsetra", -15.4333333, 49.7333336, 166.0},
-	{6385, "Madagascar", "Vangaindrano", -23.3500000, 47.5999985, 39.0},
-	{6386, "Madagascar", "Beloha", -25.1666667, 45.0499992, 161.0},
-	{6387, "Madagascar", "Andoany", -13.4000000, 48.2666664, -9999.0},
-	{6388, "Madagascar", "Ambato Boeny", -16.4666667, 46.7166672, 88.0},
-	{6389, "Madagascar", "Arivonimamo", -19.0166667, 47.1833344, 1386.0},
-	{6390, "Madagascar", "Sakaraha", -22.9000000, 44.5333328, 499.0},
-	{6391, "Madagascar", "Antsohihy", -14.8666667, 47.9833336, 145.0},
-	{6392, "Madagascar", "Fenoarivo Atsinanana", -17.3666667, 49.4166679, -9999.0},
-	{6393, "Madagascar", "Andapa", -14.6500000, 49.6500015, 628.0},
-	{6394, "Madagascar", "Miandrivazo", -19.5166667, 45.4666672, 152.0},
-	{6395, "Madagascar", "Vondrozo", -22.8166667, 47.2833328, 726.0},
-	{6396, "Madagascar", "Andilamena", -17.0166667, 48.5833321, 970.0},
-	{6397, "Madagascar", "Fenoarivo Be", -18.4333333, 46.5666656, 1046.0},
-	{6398, "Madagascar", "Anjozorobe", -18.4000000, 47.8666649, 1253.0},
-	{6399, "Madagascar", "Beroroha", -21.6666667, 45.1666679, 242.0},
-	{6400, "Madagascar", "Ifanadiana", -21.3000000, 47.6333351, 553.0},
-	{6401, "Madagascar", "Ihosy", -22.4000000, 46.1166649, 760.0},
-	{6402, "Madagascar", "Bealanana", -14.5500000, 48.7333336, 1109.0},
-	{6403, "Madagascar", "Tsaratanana", -16.7833333, 47.6500015, 418.0},
-	{6404, "Madagascar", "Ankazobe", -18.3166667, 47.1166649, 1207.0},
-	{6405, "Madagascar", "Ikalamavony", -21.1500000, 46.5833321, 829.0},
-	{6406, "Madagascar", "Ambilobe", -13.2000000, 49.0499992, 98.0},
-	{6407, "Madagascar", "Ambodifotatra", -16.9833333, 49.8499985, -9999.0},
-	{6408, "Madagascar", "Andevoranto", -18.9500000, 49.0999985, 1.0},
-	{6409, "Malawi", "Lilongwe", -13.9833333, 33.7833328, 1025.0},
-	{6410, "Malawi", "Blantyre", -15.7833333, 35.0000000, 1069.0},
-	{6411, "Malawi", "Zomba", -15.3833333, 35.3333321, 910.0},
-	{6412, "Malawi", "Kasungu", -13.0333333, 33.4833336, 1327.0},
-	{6413, "Malawi", "Mangochi", -14.4666667, 35.2666664, 470.0},
-	{6414, "Malawi", "Karonga", -9.9333333, 33.9333344, 482.0},
-	{6415, "Malawi", "Salima", -13.7833333, 34.4333344, 540.0},
-	{6416, "Malawi", "Nkhotakota", -12.9166667, 34.2999992, 472.0},
-	{6417, "Malawi", "Liwonde", -15.0666667, 35.2166672, 508.0},
-	{6418, "Malawi", "Nsanje", -16.9166667, 35.2666664, 15.0},
-	{6419, "Malawi", "Rumphi", -11.0166667, 33.8666649, 1225.0},
-	{6420, "Malawi", "Mzimba", -11.9000000, 33.5999985, 1379.0},
-	{6421, "Malawi", "Balaka", -14.9833333, 34.9500008, 616.0},
-	{6422, "Malawi", "Mchinji", -13.8000000, 32.9000015, 1182.0},
-	{6423, "Malawi", "Mulanje", -16.0333333, 35.5000000, 640.0},
-	{6424, "Malawi", "Dedza", -14.3666667, 34.3333321, 1759.0},
-	{6425, "Malawi", "Luchenza", -16.0166667, 35.2999992, 668.0},
-	{6426, "Malawi", "Nkhata Bay", -11.6000000, 34.2999992, 472.0},
-	{6427, "Malawi", "Monkey Bay", -14.0833333, 34.9166679, 484.0},
-	{6428, "Malawi", "Mwanza", -15.6166667, 34.5166664, 618.0},
-	{6429, "Malawi", "Mponela", -13.5166667, 33.7166672, 1208.0},
-	{6430, "Malawi", "Ntcheu", -14.8166667, 34.6333351, 1202.0},
-	{6431, "Malawi", "Chitipa", -9.7166667, 33.2666664, 1243.0},
-	{6432, "Malawi", "Thyolo", -16.0666667, 35.1333351, 983.0},
-	{6433, "Malawi", "Dowa", -13.6500000, 33.9333344, 1527.0},
-	{6434, "Malawi", "Livingstonia", -10.6000000, 34.1166649, 1202.0},
-	{6435, "Malawi", "Chipoka", -13.9833333, 34.5166664, 472.0},
-	{6436, "Malawi", "Phalombe", -15.8000000, 35.6500015, 757.0},
-	{6437, "Malawi", "Chiradzulu", -15.7000000, 35.1833344, 1208.0},
-	{6438, "Malawi", "Machinga", -14.9666667, 35.5166664, 676.0},
-	{6439, "Malaysia", "Kuala Lumpur", 3.1666667, 101.6999969, 62.0},
-	{6440, "Malaysia", "Klang", 3.0333333, 101.4499969, 6.0},
-	{6441, "Malaysia", "Kampung Baru Subang", 3.1500000, 101.5333328, 80.0},
-	{6442, "Malaysia", "Johor Bahru", 1.4666667, 103.7500000, 5.0},
-	{6443, "Malaysia", "Ipoh", 4.5833333, 101.0833359, 47.0},
-	{6444, "Malaysia", "Kuching", 1.5500000, 110.3333359, 5.0},
-	{6445, "Malaysia", "Petaling Jaya", 3.1072633, 101.6067123, 58.0},
-	{6446, "Malaysia", "Shah Alam", 3.0850656, 101.5328140, 76.0},
-	{6447, "Malaysia", "Kota Kinabalu", 5.9833333, 116.0666656, 9.0},
-	{6448, "Malaysia", "Sandakan", 5.8333333, 118.1166687, -9999.0},
-	{6449, "Malaysia", "Seremban", 2.7166667, 101.9333344, 89.0},
-	{6450, "Malaysia", "Kuantan", 3.8000000, 103.3333359, 2.0},
-	{6451, "Malaysia", "Tawau", 4.2500000, 117.9000015, 1.0},
-	{6452, "Malaysia", "Kuala Terengganu", 5.3333333, 103.1333313, -9999.0},
-	{6453, "Malaysia", "Kota Baharu", 6.1333333, 102.2500000, 1.0},
-	{6454, "Malaysia", "Sungai Petani", 5.6500000, 100.5000000, 41.0},
-	{6455, "Malaysia", "Miri", 4.3833333, 113.9833298, 3.0},
-	{6456, "Malaysia", "Taiping", 4.8500000, 100.7333298, 1.0},
-	{6457, "Malaysia", "Alor Setar", 6.1166667, 100.3666687, 2.0},
-	{6458, "Malaysia", "Bukit Mertajam", 5.3666667, 100.4666672, 90.0},
-	{6459, "Malaysia", "Sibu", 2.3000000, 111.8166656, 1.0},
-	{6460, "Malaysia", "Melaka", 2.1969444, 102.2480545, -9999.0},
-	{6461, "Malaysia", "George Town", 5.4166667, 100.3333359, 1.0},
-	{6462, "Malaysia", "Kulim", 5.3666667, 100.5666656, 60.0},
-	{6463, "Malaysia", "Keluang", 2.0333333, 103.3166656, 29.0},
-	{6464, "Malaysia", "Sekudai", 1.5333333, 103.6666641, 9.0},
-	{6465, "Malaysia", "Batu Pahat", 1.8500000, 102.9333344, 2.0},
-	{6466, "Malaysia", "Bintulu", 3.1666667, 113.0333328, 1.0},
-	{6467, "Malaysia", "Kampung Pasir Gudang", 1.4500000, 103.8833313, -9999.0},
-	{6468, "Malaysia", "Kampong Sungai Ara", 5.3333333, 100.2666702, 60.0},
-	{6469, "Malaysia", "Tasek Gelugur", 5.4833333, 100.5000000, 35.0},
-	{6470, "Malaysia", "Muar", 2.0333333, 102.5666656, 4.0},
-	{6471, "Malaysia", "Rawang", 3.3166667, 101.5833359, 76.0},
-	{6472, "Malaysia", "Butterworth", 5.4166667, 100.4000015, 8.0},
-	{6473, "Malaysia", "Lahad Datu", 5.0333333, 118.3166656, 1.0},
-	{6474, "Malaysia", "Port Dickson", 2.5166667, 101.8000031, 1.0},
-	{6475, "Malaysia", "Cukai", 4.2500000, 103.4166641, 1.0},
-	{6476, "Malaysia", "Semenyih", 2.9500000, 101.8499985, 44.0},
-	{6477, "Malaysia", "Putatan", 5.8833333, 116.0500031, 17.0},
-	{6478, "Malaysia", "Keningau", 5.3333333, 116.1666641, 389.0},
-	{6479, "Malaysia", "Banting", 2.8166667, 101.5000000, 15.0},
-	{6480, "Malaysia", "Ulu Tiram", 1.6000000, 103.8166656, 45.0},
-	{6481, "Malaysia", "Victoria", 5.2766667, 115.2416687, 1.0},
-	{6482, "Malaysia", "Senai", 1.6000000, 103.6500015, 13.0},
-	{6483, "Malaysia", "Donggongon", 5.9166667, 116.0833359, 114.0},
-	{6484, "Malaysia", "Segamat", 2.5000000, 102.8166656, 14.0},
-	{6485, "Malaysia", "Kampong Baharu Balakong", 3.0333333, 101.7500000, 76.0},
-	{6486, "Malaysia", "Perai", 5.3833333, 100.3833313, 3.0},
-	{6487, "Malaysia", "Kangar", 6.4333333, 100.1999969, 18.0},
-	{6488, "Malaysia", "Kulai", 1.6666667, 103.5999985, 27.0},
-	{6489, "Malaysia", "Jitra", 6.2666667, 100.4166641, 26.0},
-	{6490, "Malaysia", "Teluk Intan", 4.0333333, 101.0166702, 7.0},
-	{6491, "Malaysia", "Semporna", 4.4811111, 118.6158371, 2.0},
-	{6492, "Malaysia", "Temerluh", 3.4500000, 102.4166641, 34.0},
-	{6493, "Malaysia", "Kampong Dungun", 3.2166667, 101.3166656, 46.0},
-	{6494, "Malaysia", "Simpang Empat", 4.9500000, 100.6333313, 206.0},
-	{6495, "Malaysia", "Kuala Selangor", 3.3500000, 101.2500000, 1.0},
-	{6496, "Malaysia", "Kampong Bukit Baru", 2.2166667, 102.2833328, 37.0},
-	{6497, "Malaysia", "Kota Tinggi", 1.7333333, 103.9000015, 6.0},
-	{6498, "Malaysia", "Pontian Kecil", 1.4833333, 103.3833313, -9999.0},
-	{6499, "Malaysia", "Putrajaya", 2.9352727, 101.6911163, 30.0},
-	{6500, "Malaysia", "Bentong Town", 3.5166667, 101.9000015, 146.0},
-	{6501, "Malaysia", "Bedung", 5.7333333, 100.5166702, 54.0},
-	{6502, "Malaysia", "Batu Gajah", 4.4666667, 101.0500031, 44.0},
-	{6503, "Malaysia", "Mentekab", 3.4833333, 102.3499985, 61.0},
-	{6504, "Malaysia", "Nibung Tebal", 5.1666667, 100.4833298, 8.0},
-	{6505, "Malaysia", "Raub", 3.8000000, 101.8666687, 222.0},
-	{6506, "Malaysia", "Kampong Pangkal Kalong", 5.9166667, 102.2166672, 29.0},
-	{6507, "Malaysia", "Lumut", 4.2333333, 100.6333313, 51.0},
-	{6508, "Malaysia", "Kuala Kangsar", 4.7666667, 100.9333344, 55.0},
-	{6509, "Malaysia", "Kelebang Besar", 2.2166667, 102.1999969, 2.0},
-	{6510, "Malaysia", "Air Keruh", 2.2666667, 102.2833328, 14.0},
-	{6511, "Malaysia", "Kampong Baharu Nilai", 2.8166667, 101.8000031, 59.0},
-	{6512, "Malaysia", "Tangkak", 2.2666667, 102.5500031, 30.0},
-	{6513, "Malaysia", "Jerantut", 3.9333333, 102.3666687, 109.0},
-	{6514, "Malaysia", "Kudat", 6.8833333, 116.8333359, 1.0},
-	{6515, "Malaysia", "Pekan", 3.5000000, 103.4166641, -9999.0},
-	{6516, "Malaysia", "Bahau", 2.8166667, 102.4166641, 62.0},
-	{6517, "Malaysia", "Bakri", 2.0500000, 102.6666641, 25.0},
-	{6518, "Malaysia", "Kuah", 6.3166667, 99.8499985, 80.0},
-	{6519, "Malaysia", "Bidur", 4.1166667, 101.2833328, 59.0},
-	{6520, "Malaysia", "Sarikei", 2.1166667, 111.5166702, 1.0},
-	{6521, "Malaysia", "Kampong Masjid Tanah", 2.3500000, 102.1166687, 21.0},
-	{6522, "Malaysia", "Tanah Merah", 5.8000000, 102.1500015, 25.0},
-	{6523, "Malaysia", "Serendah", 3.3666667, 101.6166687, 78.0},
-	{6524, "Malaysia", "Tampin", 2.4666667, 102.2333298, 64.0},
-	{6525, "Malaysia", "Tapah Road", 4.1666667, 101.1999969, 58.0},
-	{6526, "Malaysia", "Parit Buntar", 5.1166667, 100.5000000, 4.0},
-	{6527, "Malaysia", "Simanggang", 1.2472222, 111.4527740, 36.0},
-	{6528, "Malaysia", "Permatang Kuching", 5.4833333, 100.4000015, 13.0},
-	{6529, "Malaysia", "Yong Peng", 2.0166667, 103.0666656, 12.0},
-	{6530, "Malaysia", "Sungai Besar", 3.6666667, 100.9833298, 1.0},
-	{6531, "Malaysia", "Limbang", 4.7500000, 115.0000000, 9.0},
-	{6532, "Malaysia", "Sungai Udang", 2.2666667, 102.1500015, 12.0},
-	{6533, "Malaysia", "Batu Berendam", 2.2500000, 102.2500000, 5.0},
-	{6534, "Malaysia", "Jenjarum", 2.8833333, 101.5000000, 11.0},
-	{6535, "Malaysia", "Kertih", 4.5166667, 103.4499969, 37.0},
-	{6536, "Malaysia", "Tanjong Tokong", 5.4666667, 100.3000031, -9999.0},
-	{6537, "Malaysia", "Pengkalan Kundang", 3.2833333, 101.5166702, 66.0},
-	{6538, "Malaysia", "Pekan Nanas", 1.5166667, 103.5166702, 15.0},
-	{6539, "Maldives", "Male", 4.1771077, 73.5098648, -9999.0},
-	{6540, "Maldives", "Hithadhoo", -0.6000000, 73.0833359, 1.0},
-	{6541, "Maldives", "Kulhudhuffushi", 6.6220656, 73.0699844, -9999.0},
-	{6542, "Maldives", "Thinadhoo", 0.5333333, 72.9333344, -9999.0},
-	{6543, "Maldives", "Naifaru", 5.4333333, 73.3333359, -9999.0},
-	{6544, "Maldives", "Dhidhdhoo", 6.8833333, 73.0999985, -9999.0},
-	{6545, "Maldives", "Eydhafushi", 5.1166667, 73.0333328, -9999.0},
-	{6546, "Maldives", "Mahibadhoo", 3.7833333, 72.9666672, -9999.0},
-	{6547, "Maldives", "Midu", -0.5833333, 73.2333298, 1.0},
-	{6548, "Maldives", "Manadhoo", 5.7666667, 73.3833313, -9999.0},
-	{6549, "Maldives", "Kudahuvadhoo", 2.6666667, 72.9000015, -9999.0},
-	{6550, "Maldives", "Ungoofaaru", 5.6666667, 73.0000000, -9999.0},
-	{6551, "Maldives", "Funadhoo", 6.1500000, 73.2666702, -9999.0},
-	{6552, "Maldives", "Muli", 2.9166667, 73.5666656, -9999.0},
-	{6553, "Maldives", "Hithadhoo", 1.7833333, 73.3833313, -9999.0},
-	{6554, "Maldives", "Felidhoo", 3.4833333, 73.5333328, -9999.0},
-	{6555, "Maldives", "Magoodhoo", 3.1000000, 72.9666672, -9999.0},
-	{6556, "Maldives", "Nolhivaranfaru", 6.7017755, 73.1184769, -9999.0},
-	{6557, "Malta", "Bamako", 12.6500000, -8.0000000, 350.0},
-	{6558, "Malta", "Sikasso", 11.3166667, -5.6666665, 412.0},
-	{6559, "Malta", "Mopti", 14.4930237, -4.1942024, 276.0},
-	{6560, "Malta", "Koutiala", 12.3833333, -5.4666667, 325.0},
-	{6561, "Malta", "Kayes Ndi", 14.4666667, -11.4333334, 48.0},
-	{6562, "Malta", "Segou", 13.4500000, -6.2666669, 272.0},
-	{6563, "Malta", "Gao", -0.0447222, 16.2716675, 227.0},
-	{6564, "Malta", "Markala", 13.6833333, -6.0833335, 268.0},
-	{6565, "Malta", "Kolokani", 13.5833333, -8.0333328, 380.0},
-	{6566, "Malta", "Kati", 12.7466667, -8.0713892, 440.0},
-	{6567, "Malta", "Bougouni", 11.4166667, -7.4833331, 333.0},
-	{6568, "Malta", "Tombouctou", 16.7734800, -3.0074215, 259.0},
-	{6569, "Malta", "Banamba", 13.5500000, -7.4499998, 373.0},
-	{6570, "Malta", "Bafoulabe", 13.8000000, -10.8333330, 84.0},
-	{6571, "Malta", "San", 13.3000000, -4.9000001, 277.0},
-	{6572, "Malta", "Koulikoro", 12.8661111, -7.5605555, 345.0},
-	{6573, "Malta", "Djenne", 13.9060750, -4.5533180, 274.0},
-	{6574, "Malta", "Yorosso", 12.3666667, -4.7833333, 380.0},
-	{6575, "Malta", "Kangaba", 11.9333333, -8.4166670, 347.0},
-	{6576, "Malta", "Kidal", 18.4411111, 1.4077778, 457.0},
-	{6577, "Malta", "Dire", 12.2833333, -10.9666672, 204.0},
-	{6578, "Malta", "Kolondieba", 11.0833333, -6.9000001, 317.0},
-	{6579, "Malta", "Ke Massina", 13.9666667, -5.3666668, 255.0},
-	{6580, "Malta", "Menaka", 15.9166667, 2.4000001, 303.0},
-	{6581, "Malta", "Goundam", 16.4166667, -3.6666667, 336.0},
-	{6582, "Malta", "Douentza", 15.0000000, -2.9500000, 287.0},
-	{6583, "Malta", "Tenenkou", 14.4666667, -4.9166665, 275.0},
-	{6584, "Malta", "Niafounke", 15.9333333, -4.0000000, 267.0},
-	{6585, "Malta", "Bandiagara", 14.3500468, -3.6103821, 404.0},
-	{6586, "Malta", "Kemparana", 12.8333333, -4.9333334, 304.0},
-	{6587, "Malta", "Kita", 13.0500000, -9.4833336, 393.0},
-	{6588, "Malta", "Sokolo", 14.7333333, -6.1333332, 263.0},
-	{6589, "Malta", "Araouane", 18.9047610, -3.5264919, 288.0},
-	{6590, "Malta", "Taoudenni", 22.6783333, -3.9836111, 299.0},
-	{6591, "Malta", "Ansongo", 15.6594444, 0.5016667, 231.0},
-	{6592, "Marshall Islands", "Majuro", 7.1000000, 171.3833313, 1.0},
-	{6593, "Marshall Islands", "Jabor", 5.9166667, 169.6499939, -9999.0},
-	{6594, "Marshall Islands", "Ine", 6.9833333, 171.6999969, 1.0},
-	{6595, "Mauritania", "Nouakchott", 18.1194444, -16.0405560, -9999.0},
-	{6596, "Mauritania", "Nouadhibou", 20.9025000, -17.0422230, 28.0},
-	{6597, "Mauritania", "Barkewol", 16.6403889, -12.4984932, 75.0},
-	{6598, "Mauritius", "Port Louis", -20.1619444, 57.4988899, 133.0},
-	{6599, "Mauritius", "Vacoas", -20.2980556, 57.4783325, 369.0},
-	{6600, "Mauritius", "Curepipe", -20.3147222, 57.5202789, 403.0},
-	{6601, "Mauritius", "Quatre Bornes", -20.2644444, 57.4719429, 313.0},
-	{6602, "Mauritius", "Triolet", -20.0547222, 57.5452766, 134.0},
-	{6603, "Mauritius", "Goodlands", -20.0350000, 57.6430550, 40.0},
-	{6604, "Mauritius", "Centre de Flacq", -20.1897222, 57.7144432, 39.0},
-	{6605, "Mauritius", "Bel Air", -20.2541667, 57.7452774, 65.0},
-	{6606, "Mauritius", "Mahebourg", -20.4080556, 57.7000008, 152.0},
-	{6607, "Mauritius", "Saint Pierre", -20.2175000, 57.5208321, 243.0},
-	{6608, "Mauritius", "Le Hochet", -20.1350000, 57.5211105, 130.0},
-	{6609, "Mauritius", "Rose Belle", -20.4002778, 57.5966682, 243.0},
-	{6610, "Mauritius", "Chemin Grenier", -20.4872222, 57.4655571, 149.0},
-	{6611, "Mauritius", "Riviere du Rempart", -20.1030556, 57.6847229, 48.0},
-	{6612, "Mauritius", "Grand Baie", -20.0136111, 57.5738907, -9999.0},
-	{6613, "Mauritius", "Plaine Magnien", -20.4286111, 57.6530571, 150.0},
-	{6614, "Mauritius", "Pailles", -20.1855556, 57.4772224, 152.0},
-	{6615, "Mauritius", "Surinam", -20.5097222, 57.5052795, 149.0},
-	{6616, "Mauritius", "Lalmatie", -20.1897222, 57.6611099, 160.0},
-	{6617, "Mauritius", "New Grove", -20.4086111, 57.6136093, 181.0},
-	{6618, "Mauritius", "Riviere des Anguilles", -20.4852778, 57.5508347, 152.0},
-	{6619, "Mauritius", "Terre Rouge", -20.1261111, 57.5244446, 134.0},
-	{6620, "Mauritius", "Petit Raffray", -20.0133333, 57.6119461, 69.0},
-	{6621, "Mauritius", "Moka", -20.2188889, 57.4958344, 203.0},
-	{6622, "Mauritius", "Pamplemousses", -20.1038889, 57.5702782, 145.0},
-	{6623, "Mauritius", "Montagne Blanche", -20.2827778, 57.6544456, 309.0},
-	{6624, "Mauritius", "Grand Bois", -20.4188889, 57.5441666, 325.0},
-	{6625, "Mauritius", "Long Mountain", -20.1430556, 57.5622215, 153.0},
-	{6626, "Mauritius", "Plaine des Papayes", -20.0650000, 57.5724983, 147.0},
-	{6627, "Mauritius", "Grande Pointe aux Piments", -20.0627778, 57.5130539, -9999.0},
-	{6628, "Mauritius", "Brisee Verdiere", -20.1638889, 57.6466675, 158.0},
-	{6629, "Mauritius", "Nouvelle France", -20.3705556, 57.5611115, 430.0},
-	{6630, "Mauritius", "Grand Gaube", -20.0063889, 57.6608315, 29.0},
-	{6631, "Mauritius", "Poste de Flacq", -20.1630556, 57.7305565, 15.0},
-	{6632, "Mauritius", "Beau Vallon", -20.4188889, 57.6952782, 152.0},
-	{6633, "Mauritius", "La Dagotiere", -20.2444444, 57.5541649, 301.0},
-	{6634, "Mauritius", "Bon Accueil", -20.1708333, 57.6563873, 152.0},
-	{6635, "Mauritius", "Quartier Militaire", -20.2438889, 57.5891685, 338.0},
-	{6636, "Mauritius", "Laventure", -20.1458333, 57.6766663, 89.0},
-	{6637, "Mauritius", "Morcellemont Saint Andre", -20.0730556, 57.5580559, 141.0},
-	{6638, "Mauritius", "Ecroignard", -20.2261111, 57.7361107, 65.0},
-	{6639, "Mauritius", "Piton", -20.0902778, 57.6302795, 105.0},
-	{6640, "Mauritius", "Fond du Sac", -20.0430556, 57.5786095, 142.0},
-	{6641, "Mauritius", "Sebastopol", -20.2888889, 57.6875000, 204.0},
-	{6642, "Mauritius", "Roches Noire", -20.1111111, 57.7122231, 34.0},
-	{6643, "Mauritius", "Quatre Cocos", -20.2077778, 57.7625008, 21.0},
-	{6644, "Mauritius", "Petite Riviere", -20.1894444, 57.4452782, 136.0},
-	{6645, "Mauritius", "Camp Diable", -20.4627778, 57.5788879, 163.0},
-	{6646, "Mauritius", "Chamouny", -20.4822222, 57.4661102, 168.0},
-	{6647, "Mauritius", "Cap Malheureux", -19.9841667, 57.6141663, -9999.0},
-	{6648, "Mauritius", "Calebasses", -20.1116667, 57.5538902, 144.0},
-	{6649, "Mauritius", "Midlands", -20.3325000, 57.5583344, 386.0},
-	{6650, "Mauritius", "Camp Ithier", -20.2158333, 57.7455559, 36.0},
-	{6651, "Mauritius", "Souillac", -20.5166667, 57.5166664, 153.0},
-	{6652, "Mauritius", "Plaines des Roches", -20.1116667, 57.6908340, 53.0},
-	{6653, "Mauritius", "Saint Hubert", -20.3641667, 57.6383324, 153.0},
-	{6654, "Mauritius", "Olivia", -20.2975000, 57.7255554, 93.0},
-	{6655, "Mauritius", "Notre Dame", -20.1405556, 57.5530548, 145.0},
-	{6656, "Mauritius", "Tamarin", -20.3255556, 57.3705559, -9999.0},
-	{6657, "Mauritius", "The Vale", -20.0283333, 57.5877762, 115.0},
-	{6658, "Mauritius", "Cottage", -20.0597222, 57.6291656, 62.0},
-	{6659, "Mauritius", "Quatre Soeurs", -20.2991667, 57.7705574, 92.0},
-	{6660, "Mauritius", "Gros Cailloux", -20.2072222, 57.4300003, 141.0},
-	{6661, "Mauritius", "Albion", -20.2116667, 57.4008331, 147.0},
-	{6662, "Mauritius", "Roche Terre", -20.0186111, 57.6447220, 39.0},
-	{6663, "Mauritius", "Saint Julien", -20.2263889, 57.6363907, 293.0},
-	{6664, "Mauritius", "Providence", -20.2447222, 57.6122208, 331.0},
-	{6665, "Mauritius", "Creve Coeur", -20.1911111, 57.5572205, 302.0},
-	{6666, "Mauritius", "Queen Victoria", -20.2200000, 57.7075005, 110.0},
-	{6667, "Mauritius", "Amaury", -20.1308333, 57.6591682, 95.0},
-	{6668, "Mauritius", "Dubreuil", -20.2975000, 57.5950012, 305.0},
-	{6669, "Mauritius", "Camp de Masque", -20.2369444, 57.6633339, 181.0},
-	{6670, "Mauritius", "Cascavelle", -20.2869444, 57.4072227, 152.0},
-	{6671, "Mauritius", "Arsenal", -20.1055556, 57.5352783, 142.0},
-	{6672, "Mauritius", "Grande Riviere Sud Est", -20.2861111, 57.7758331, 56.0},
-	{6673, "Mauritius", "Grande Riviere Noire", -20.3602778, 57.3661118, -9999.0},
-	{6674, "Mauritius", "Esperance Trebuchet", -20.0697222, 57.6419449, 56.0},
-	{6675, "Mauritius", "Camp Thorel", -20.2147222, 57.6161118, 310.0},
-	{6676, "Mauritius", "Verdun", -20.2283333, 57.5438881, 303.0},
-	{6677, "Mauritius", "Grand Sable", -20.3141667, 57.7644463, 208.0},
-	{6678, "Mauritius", "Mare La Chaux", -20.1980556, 57.7494431, 18.0},
-	{6679, "Mauritius", "Flic en Flac", -20.2741667, 57.3630562, -9999.0},
-	{6680, "Mauritius", "Melrose", -20.2697222, 57.6319427, 313.0},
-	{6681, "Mauritius", "Bois des Amourettes", -20.3630556, 57.7311096, 142.0},
-	{6682, "Mauritius", "Congomah", -20.1488889, 57.5908318, 160.0},
-	{6683, "Mauritius", "Clemencia", -20.2638889, 57.7061119, 137.0},
-	{6684, "Mauritius", "Saint Aubin", -20.4916667, 57.5413895, 150.0},
-	{6685, "Mauritius", "Cluny", -20.3669444, 57.6038895, 296.0},
-	{6686, "Mauritius", "Bambous Virieux", -20.3427778, 57.7574997, 117.0},
-	{6687, "Mauritius", "Petite Case Noyale", -20.3930556, 57.3650017, -9999.0},
-	{6688, "Mauritius", "Mapou", -20.0758333, 57.6013870, 120.0},
-	{6689, "Mauritius", "Chamarel", -20.4225000, 57.3838882, 357.0},
-	{6690, "Mauritius", "Bananes", -20.3466667, 57.5886116, 316.0},
-	{6691, "Mexico", "Mexico City", 19.4341667, -99.1386108, 2216.0},
-	{6692, "Mexico", "Ecatepec", 19.6011111, -99.0524979, 2259.0},
-	{6693, "Mexico", "Guadalajara", 20.6666667, -103.3333359, 1545.0},
-	{6694, "Mexico", "Ciudad Juarez", 31.7333333, -106.4833298, 1144.0},
-	{6695, "Mexico", "Puebla de Zaragoza", 19.0500000, -98.1999969, 2175.0},
-	{6696, "Mexico", "Tijuana", 32.5333333, -117.0166702, 32.0},
-	{6697, "Mexico", "Ciudad Nezahualcoyotl", 19.4136111, -99.0330582, 2214.0},
-	{6698, "Mexico", "Monterrey", 25.6666667, -100.3166656, 563.0},
-	{6699, "Mexico", "Leon", 21.1166667, -101.6666641, 1801.0},
-	{6700, "Mexico", "Zapopan", 20.7166667, -103.4000015, 1571.0},
-	{6701, "Mexico", "Naucalpan de Juarez", 19.4785079, -99.2396317, 2290.0},
-	{6702, "Mexico", "Guadalupe", 25.6833333, -100.2500000, 488.0},
-	{6703, "Mexico", "Merida", 20.9666667, -89.6166687, 10.0},
-	{6704, "Mexico", "Tlalnepantla", 19.5269444, -99.2216644, 2277.0},
-	{6705, "Mexico", "Chihuahua", 28.6333333, -106.0833359, 1434.0},
-	{6706, "Mexico", "San Luis Potosi", 22.1500000, -100.9833298, 1893.0},
-	{6707, "Mexico", "Aguascalientes", 21.8833333, -102.3000031, 1879.0},
-	{6708, "Mexico", "Acapulco de Juarez", 16.8633624, -99.8900986, 37.0},
-	{6709, "Mexico", "Saltillo", 25.4166667, -101.0000000, 1596.0},
-	{6710, "Mexico", "Queretaro", 20.6000000, -100.3833313, 1868.0},
-	{6711, "Mexico", "Mexicali", 32.6519444, -115.4683304, 2.0},
-	{6712, "Mexico", "Hermosillo", 29.0666667, -110.9666672, 195.0},
-	{6713, "Mexico", "Morelia", 19.7007789, -101.1844254, 1906.0},
-	{6714, "Mexico", "Culiacan", 24.7994444, -107.3897247, 71.0},
-	{6715, "Mexico", "Veracruz", 19.2000000, -96.1333313, 1.0},
-	{6716, "Mexico", "Cancun", 21.1742876, -86.8465576, 5.0},
-	{6717, "Mexico", "Torreon", 25.5500000, -103.4333344, 1129.0},
-	{6718, "Mexico", "Ciudad Lopez Mateos", 19.5583333, -99.2613907, 2326.0},
-	{6719, "Mexico", "San Nicolas de los Garzas", 25.7500000, -100.3000031, 501.0},
-	{6720, "Mexico", "Toluca", 19.2883333, -99.6672211, 2686.0},
-	{6721, "Mexico", "Reynosa", 26.0833333, -98.2833328, 35.0},
-	{6722, "Mexico", "Tlaquepaque", 20.6500000, -103.3166656, 1572.0},
-	{6723, "Mexico", "Tuxtla Gutierrez", 16.7500000, -93.1166687, 571.0},
-	{6724, "Mexico", "Cuautitlan Izcalli", 19.6469444, -99.2466660, 2300.0},
-	{6725, "Mexico", "Durango", 24.0333333, -104.6666641, 1895.0},
-	{6726, "Mexico", "Matamoros", 25.8833333, -97.5000000, 6.0},
-	{6727, "Mexico", "Jalapa Enriquez", 19.5333333, -96.9166641, 1397.0},
-	{6728, "Mexico", "Tonala", 20.6166667, -103.2333298, 1595.0},
-	{6729, "Mexico", "Xico", 19.2666667, -98.9333344, 2239.0},
-	{6730, "Mexico", "Villahermosa", 17.9833333, -92.9166641, 9.0},
-	{6731, "Mexico", "Mazatlan", 23.2166667, -106.4166641, 1.0},
-	{6732, "Mexico", "Apodaca", 25.7666667, -100.1999969, 435.0},
-	{6733, "Mexico", "Ixtapaluca", 19.3166667, -98.8833313, 2252.0},
-	{6734, "Mexico", "Nuevo Laredo", 27.5000000, -99.5166702, 110.0},
-	{6735, "Mexico", "Cuernavaca", 18.9166667, -99.2500000, 1510.0},
-	{6736, "Mexico", "Irapuato", 20.6833333, -101.3499985, 1755.0},
-	{6737, "Mexico", "Pachuca de Soto", 20.1169732, -98.7332916, 2409.0},
-	{6738, "Mexico", "Coacalco", 19.6316667, -99.1102753, 2264.0},
-	{6739, "Mexico", "Tampico", 22.2166667, -97.8499985, 4.0},
-	{6740, "Mexico", "General Escobedo", 25.8166667, -100.3333359, 505.0},
-	{6741, "Mexico", "Celaya", 20.5166667, -100.8166656, 1765.0},
-	{6742, "Mexico", "Tepic", 21.5000000, -104.9000015, 966.0},
-	{6743, "Mexico", "Ciudad Victoria", 23.7333333, -99.1333313, 298.0},
-	{6744, "Mexico", "Oaxaca de Juarez", 17.0500000, -96.7166672, 1550.0},
-	{6745, "Mexico", "Ciudad Obregon", 27.4833333, -109.9333344, 38.0},
-	{6746, "Mexico", "Ensenada", 31.8666667, -116.6166687, 27.0},
-	{6747, "Mexico", "Santa Catarina", 25.6833333, -100.4666672, 699.0},
-	{6748, "Mexico", "Los Reyes", 19.3500000, -98.9666672, 2340.0},
-	{6749, "Mexico", "Nicolas Romero", 19.6219444, -99.3130569, 2371.0},
-	{6750, "Mexico", "Tehuacan", 18.4500000, -97.3833313, 1555.0},
-	{6751, "Mexico", "Uruapan del Progreso", 19.4166667, -102.0666656, 1664.0},
-	{6752, "Mexico", "Coatzacoalcos", 18.1500000, -94.4166641, 1.0},
-	{6753, "Mexico", "Gomez Palacio", 25.5666667, -103.5000000, 1139.0},
-	{6754, "Mexico", "Los Mochis", 25.7666667, -108.9666672, 15.0},
-	{6755, "Mexico", "Campeche", 19.8500000, -90.5333328, 1.0},
-	{6756, "Mexico", "Tapachula", 14.9000000, -92.2833328, 135.0},
-	{6757, "Mexico", "Monclova", 26.9000000, -101.4166641, 614.0},
-	{6758, "Mexico", "Soledad Diez Gutierrez", 22.2000000, -100.9499969, 1851.0},
-	{6759, "Mexico", "Ciudad Madero", 22.2666667, -97.8333359, 10.0},
-	{6760, "Mexico", "Puerto Vallarta", 20.6204136, -105.2306595, 32.0},
-	{6761, "Mexico", "Heroica Nogales", 31.3333333, -110.9333344, 1273.0},
-	{6762, "Mexico", "Poza Rica de Hidalgo", 20.5331483, -97.4594574, 72.0},
-	{6763, "Mexico", "San Pablo de las Salinas", 19.6658333, -99.0963898, 2237.0},
-	{6764, "Mexico", "Metepec", 19.2536111, -99.6077805, 2627.0},
-	{6765, "Mexico", "La Paz", 24.1666667, -110.3000031, 42.0},
-	{6766, "Mexico", "Jiutepec", 18.8666667, -99.1833344, 1430.0},
-	{6767, "Mexico", "Chilpancingo de los Bravos", 17.5500000, -99.5000000, 1262.0},
-	{6768, "Mexico", "Chalco de Diaz Covarrubias", 19.2666667, -98.9000015, 2243.0},
-	{6769, "Mexico", "Cholula", 19.0640649, -98.3035202, 2156.0},
-	{6770, "Mexico", "Minatitlan", 17.9833333, -94.5166702, 4.0},
-	{6771, "Mexico", "Cuautla Morelos", 18.8000000, -98.9499969, 1313.0},
-	{6772, "Mexico", "Ciudad Acuna", 29.3000000, -100.9166641, 294.0},
-	{6773, "Mexico", "Ciudad del Carmen", 18.6333333, -91.8333359, 1.0},
-	{6774, "Mexico", "Piedras Negras", 28.7000000, -100.5166702, 222.0},
-	{6775, "Mexico", "San Luis Rio Colorado", 32.4630556, -114.7766647, 34.0},
-	{6776, "Mexico", "Salamanca", 20.5666667, -101.1999969, 1715.0},
-	{6777, "Mexico", "Chetumal", 18.5000000, -88.3000031, 1.0},
-	{6778, "Mexico", "Cordoba", 18.8833333, -96.9333344, 824.0},
-	{6779, "Mexico", "Garza Garcia", 25.6666667, -100.4000015, 614.0},
-	{6780, "Mexico", "San Cristobal de Las Casas", 16.7500000, -92.6333313, 2190.0},
-	{6781, "Mexico", "Colima", 19.2333333, -103.7166672, 482.0},
-	{6782, "Mexico", "Zamora de Hidalgo", 19.9833333, -102.2666702, 1590.0},
-	{6783, "Mexico", "Huixquilucan", 19.3602778, -99.3513870, 2694.0},
-	{6784, "Mexico", "Orizaba", 18.8500000, -97.0999985, 1216.0},
-	{6785, "Mexico", "Zacatecas", 22.7755880, -102.5721817, 2483.0},
-	{6786, "Mexico", "San Juan del Rio", 20.3833333, -100.0000000, 1970.0},
-	{6787, "Mexico", "Texcoco de Mora", 19.5166667, -98.8833313, 2254.0},
-	{6788, "Mexico", "Iguala de la Independencia", 18.3500000, -99.5333328, 761.0},
-	{6789, "Mexico", "Manzanillo", 19.0501111, -104.3187866, 70.0},
-	{6790, "Mexico", "Ciudad Valles", 21.9833333, -99.0166702, 66.0},
-	{6791, "Micronesia", "Weno", 7.4458333, 151.8500061, 49.0},
-	{6792, "Micronesia", "Toynim", 5.3147222, 163.0036163, 290.0},
-	{6793, "Micronesia", "Palikir", 6.9166667, 158.1499939, 159.0},
-	{6794, "Micronesia", "Kolonia", 6.9638889, 158.2083282, 1.0},
-	{6795, "Micronesia", "Tonoas", 7.3666667, 151.8999939, -9999.0},
-	{6796, "Micronesia", "Nan Uh", 6.9502778, 158.2711182, 2.0},
-	{6797, "Micronesia", "Colonia", 9.5144444, 138.1291656, -9999.0},
-	{6798, "Micronesia", "Tafunsak", 5.3630556, 162.9822235, 181.0},
-	{6799, "Micronesia", "Paata", 7.3861111, 151.8733368, 1.0},
-	{6800, "Micronesia", "Tamil", 9.5166667, 138.1499939, 11.0},
-	{6801, "Micronesia", "Malem", 5.2886111, 163.0238953, 6.0},
-	{6802, "Moldova", "Chisinau", 47.0055556, 28.8575001, 52.0},
-	{6803, "Moldova", "Tiraspol", 46.8402778, 29.6433334, 8.0},
-	{6804, "Moldova", "Balti", 47.7616667, 27.9288883, 128.0},
-	{6805, "Moldova", "Tighina", 46.8305556, 29.4711113, 15.0},
-	{6806, "Moldova", "Stefan-Voda", 46.5152778, 29.6630554, 162.0},
-	{6807, "Moldova", "Ribnita", 47.7663889, 29.0011120, 61.0},
-	{6808, "Moldova", "Cahul", 45.9075000, 28.1944447, 27.0},
-	{6809, "Moldova", "Ungheni", 47.2041667, 27.7958336, 74.0},
-	{6810, "Moldova", "Soroca", 48.1558333, 28.2975006, 45.0},
-	{6811, "Moldova", "Orhei", 47.3830556, 28.8230553, 68.0},
-	{6812, "Moldova", "Dubasari", 47.2630556, 29.1608334, 38.0},
-	{6813, "Moldova", "Comrat", 46.3002778, 28.6572227, 33.0},
-	{6814, "Moldova", "Edinet", 48.1680556, 27.3050003, 209.0},
-	{6815, "Moldova", "Causeni", 46.6441667, 29.4138889, 7.0},
-	{6816, "Moldova", "Ceadir-Lunga", 46.0550000, 28.8302784, 45.0},
-	{6817, "Moldova", "Straseni", 47.1413889, 28.6102772, 108.0},
-	{6818, "Moldova", "Floresti", 47.8933333, 28.3013897, 111.0},
-	{6819, "Moldova", "Drochia", 48.0350000, 27.8161106, 226.0},
-	{6820, "Moldova", "Bilicenii Vechi", 47.6563889, 28.0472221, 129.0},
-	{6821, "Moldova", "Slobozia", 46.7280556, 29.7077770, 18.0},
-	{6822, "Moldova", "Ialoveni", 46.9430556, 28.7777786, 100.0},
-	{6823, "Moldova", "Falesti", 47.5736111, 27.7091675, 81.0},
-	{6824, "Moldova", "Vulcanesti", 45.6841667, 28.4027786, 31.0},
-	{6825, "Moldova", "Leova", 46.4786111, 28.2552776, 42.0},
-	{6826, "Moldova", "Briceni", 48.3572222, 27.7036114, 228.0},
-	{6827, "Moldova", "Calarasi", 47.2544444, 28.3080559, 126.0},
-	{6828, "Moldova", "Taraclia", 45.9000000, 28.6688881, 76.0},
-	{6829, "Moldova", "Riscani", 47.9572222, 27.5538883, 134.0},
-	{6830, "Moldova", "Cimislia", 46.5200000, 28.7841663, 67.0},
-	{6831, "Moldova", "Nisporeni", 47.0813889, 28.1783333, 94.0},
-	{6832, "Moldova", "Camenca", 48.0319444, 28.6977787, 88.0},
-	{6833, "Moldova", "Basarabeasca", 46.3311111, 28.9727783, 38.0},
-	{6834, "Moldova", "Dnestrovsc", 46.6222222, 29.9133339, 15.0},
-	{6835, "Moldova", "Glodeni", 47.7708333, 27.5144444, 113.0},
-	{6836, "Moldova", "Rezina", 47.7491667, 28.9622231, 55.0},
-	{6837, "Moldova", "Grigoriopol", 47.1536111, 29.2963886, 22.0},
-	{6838, "Moldova", "Donduseni", 48.2244444, 27.5852776, 218.0},
-	{6839, "Moldova", "Mindresti", 47.5072222, 28.2769451, 102.0},
-	{6840, "Moldova", "Anenii Noi", 46.8788889, 29.2247219, 39.0},
-	{6841, "Moldova", "Cricova", 47.1388889, 28.8619442, 75.0},
-	{6842, "Moldova", "Pervomaisc", 46.7338889, 29.9586105, 3.0},
-	{6843, "Moldova", "Singera", 46.9130556, 28.9775009, 78.0},
-	{6844, "Moldova", "Criuleni", 47.2144444, 29.1613884, 19.0},
-	{6845, "Moldova", "Telenesti", 47.4997222, 28.3655548, 96.0},
-	{6846, "Moldova", "Soldanesti", 47.8152778, 28.7966671, 178.0},
-	{6847, "Moldova", "Stauceni", 47.0875000, 28.8702774, 149.0},
-	{6848, "Moldova", "Iargara", 46.4275000, 28.4366665, 102.0},
-	{6849, "Moldova", "Vadul lui Voda", 47.0916667, 29.0755558, 20.0},
-	{6850, "Moldova", "Biruinta", 47.8161111, 28.0686111, 73.0},
-	{6851, "Moldova", "Crasnoe", 46.6513889, 29.8047218, 4.0},
-	{6852, "Moldova", "Cantemir", 46.2780556, 28.2008324, 51.0},
-	{6853, "Moldova", "Vatra", 47.0738889, 28.7350006, 59.0},
-	{6854, "Moldova", "Giurgiulesti", 45.4816667, 28.1972218, 40.0},
-	{6855, "Moldova", "Marculesti", 47.8686111, 28.2474995, 76.0},
-	{6856, "Moldova", "Tiraspolul Nou", 46.8319444, 29.5766659, 6.0},
-	{6857, "Moldova", "Leninskiy", 47.8638889, 28.3894444, 139.0},
-	{6858, "Moldova", "Bugeac", 46.3658333, 28.6633339, 43.0},
-	{6859, "Moldova", "Visniovca", 46.3333333, 28.4494438, 102.0},
-	{6860, "Moldova", "Bucovat", 47.1886111, 28.4569435, 76.0},
-	{6861, "Moldova", "Maiac", 47.2375000, 29.3858337, 99.0},
-	{6862, "Mongolia", "Ulaanbaatar", 47.9166667, 106.9166641, 1299.0},
-	{6863, "Mongolia", "Erdenet", 49.0333333, 104.0833359, 1290.0},
-	{6864, "Mongolia", "Darhan", 49.4866667, 105.9227753, 678.0},
-	{6865, "Mongolia", "Hovd", 48.0055556, 91.6419449, 1385.0},
-	{6866, "Mongolia", "OElgiy", 48.9683333, 89.9625015, 1719.0},
-	{6867, "Mongolia", "Ulaangom", 49.9811111, 92.0666656, 933.0},
-	{6868, "Mongolia", "Hovd", 44.6702399, 102.1749115, 1503.0},
-	{6869, "Mongolia", "Moron", 49.6341667, 100.1624985, 1273.0},
-	{6870, "Mongolia", "Suhbaatar", 50.2313889, 106.2077789, 602.0},
-	{6871, "Mongolia", "Bayanhongor", 46.7166667, 100.1166687, 2197.0},
-	{6872, "Mongolia", "Dzuunharaa", 48.8522914, 106.4578629, 871.0},
-	{6873, "Mongolia", "Dzuunmod", 47.7069444, 106.9527740, 1510.0},
-	{6874, "Mongolia", "Bulgan", 48.8125000, 103.5347214, 1182.0},
-	{6875, "Mongolia", "Baruun-Urt", 46.6805556, 113.2791672, 1002.0},
-	{6876, "Mongolia", "Mandalgovi", 45.7625000, 106.2708359, 1417.0},
-	{6877, "Mongolia", "Dalanzadgad", 43.5708333, 104.4250031, 1468.0},
-	{6878, "Mongolia", "Ondorhaan", 47.3194444, 110.6555557, 1050.0},
-	{6879, "Mongolia", "Choyr", 46.3611111, 108.3611145, 1288.0},
-	{6880, "Mongolia", "Tosontsengel", 48.7566667, 98.2838898, 1694.0},
-	{6881, "Mongolia", "Harhorin", 47.1975279, 102.8237915, 1462.0},
-	{6882, "Mongolia", "Tsetserleg", 47.4750000, 101.4541702, 1700.0},
-	{6883, "Mongolia", "Choybalsan", 48.0666667, 114.5000000, 770.0},
-	{6884, "Mongolia", "Ereencav", 49.8806989, 115.7252579, 607.0},
-	{6885, "Morocco", "Casablanca", 33.5900000, -7.6100001, 17.0},
-	{6886, "Morocco", "Rabat", 34.0200000, -6.8299999, 54.0},
-	{6887, "Morocco", "Fes", 34.0500000, -4.9800000, 448.0},
-	{6888, "Morocco", "Marrakech", 31.6300000, -8.0000000, 451.0},
-	{6889, "Morocco", "Agadir", 30.4000000, -9.6000004, 31.0},
-	{6890, "Morocco", "Tangier", 35.7800000, -5.8099999, 80.0},
-	{6891, "Morocco", "Meknes", 33.9000000, -5.5500002, 548.0},
-	{6892, "Morocco", "Oujda", 34.6800000, -1.9100000, 557.0},
-	{6893, "Morocco", "Kenitra", 34.2600000, -6.5700002, 31.0},
-	{6894, "Morocco", "Tetouan", 35.5700000, -5.3699999, 167.0},
-	{6895, "Morocco", "Safi", 32.3000000, -9.2299995, 87.0},
-	{6896, "Morocco", "Khouribga", 32.8800000, -6.9000001, 787.0},
-	{6897, "Morocco", "Beni Mellal", 32.3300000, -6.3499999, 621.0},
-	{6898, "Morocco", "El Jadida", 33.2500000, -8.5000000, 1.0},
-	{6899, "Morocco", "Taza", 34.2100000, -4.0100002, 599.0},
-	{6900, "Morocco", "Nador", 35.1700000, -2.9300001, 62.0},
-	{6901, "Morocco", "Settat", 33.0000000, -7.6100001, 403.0},
-	{6902, "Morocco", "Larache", 35.1900000, -6.1500001, 56.0},
-	{6903, "Morocco", "Ksar el Kebir", 35.0000000, -5.9000001, 25.0},
-	{6904, "Morocco", "Khemisset", 33.8100000, -6.0599999, 410.0},
-	{6905, "Morocco", "Guelmim", 28.9800000, -10.0600004, 286.0},
-	{6906, "Morocco", "Berrechid", 33.2600000, -7.5799999, 214.0},
-	{6907, "Morocco", "Oued Zem", 32.8600000, -6.5599999, 779.0},
-	{6908, "Morocco", "Fkih Ben Salah", 32.5000000, -6.6999998, 430.0},
-	{6909, "Morocco", "Taourirt", 34.4100000, -2.8900001, 423.0},
-	{6910, "Morocco", "Berkane", 34.9200000, -2.3199999, 185.0},
-	{6911, "Morocco", "Sidi Slimane", 34.2600000, -5.9299998, 42.0},
-	{6912, "Morocco", "Sidi Qacem", 34.2100000, -5.6999998, 195.0},
-	{6913, "Morocco", "Khenifra", 32.9300000, -5.6599998, 880.0},
-	{6914, "Morocco", "Taroudant", 30.4800000, -8.8599997, 239.0},
-	{6915, "Morocco", "Essaouira", 31.5100000, -9.7600002, 12.0},
-	{6916, "Morocco", "Tiflet", 33.9000000, -6.3299999, 351.0},
-	{6917, "Morocco", "Oulad Teima", 30.4000000, -9.2100000, 131.0},
-	{6918, "Morocco", "Sefrou", 33.8300000, -4.8299999, 801.0},
-	{6919, "Morocco", "Youssoufia", 32.2500000, -8.5299997, 299.0},
-	{6920, "Morocco", "Tan-Tan", 28.4300000, -11.1000004, 31.0},
-	{6921, "Morocco", "Ouazzane", 34.8000000, -5.5999999, 221.0},
-	{6922, "Morocco", "Guercif", 34.2300000, -3.3599999, 379.0},
-	{6923, "Morocco", "Ouarzazat", 30.9200000, -6.9099998, 1152.0},
-	{6924, "Morocco", "Tirhanimine", 35.2300000, -3.9600000, 362.0},
-	{6925, "Morocco", "Tiznit", 29.7100000, -9.7100000, 245.0},
-	{6926, "Morocco", "mohammed  boulaich el habate ksar sghir", 35.7000000, -5.6999998, 209.0},
-	{6927, "Morocco", "Azrou", 33.4300000, -5.2100000, 1509.0},
-	{6928, "Morocco", "Midelt", 32.6800000, -4.7399998, 1465.0},
-	{6929, "Morocco", "Skhirat", 33.8500000, -7.0300002, 62.0},
-	{6930, "Morocco", "Jerada", 34.3100000, -2.1600001, 1131.0},
-	{6931, "Morocco", "Kasba Tadla", 32.6000000, -6.2600002, 464.0},
-	{6932, "Morocco", "Sidi Bennour", 32.6500000, -8.4300003, 184.0},
-	{6933, "Morocco", "Martil", 35.6100000, -5.2700000, 1.0},
-	{6934, "Morocco", "Azemmour", 33.2800000, -8.3400002, 9.0},
-	{6935, "Morocco", "Tineghir", 31.5100000, -5.5300002, 1257.0},
-	{6936, "Morocco", "Chefchaouene", 35.1700000, -5.2600002, 565.0},
-	{6937, "Morocco", "El Aioun", 34.5800000, -2.5000000, 643.0},
-	{6938, "Morocco", "Zagora", 30.3100000, -5.8299999, 725.0},
-	{6939, "Morocco", "Taounate", 34.5500000, -4.6500001, 567.0},
-	{6940, "Morocco", "Sidi Yahia el Gharb", 34.3000000, -6.3099999, 13.0},
-	{6941, "Morocco", "Zaio", 34.9300000, -2.7300000, 156.0},
-	{6942, "Morocco", "Asilah", 35.4600000, -6.0400000, 32.0},
-	{6943, "Morocco", "El Hajeb", 33.7000000, -5.3600001, 933.0},
-	{6944, "Morocco", "Mechra Belqsiri", 34.5600000, -5.9499998, 16.0},
-	{6945, "Morocco", "Bouznika", 33.7800000, -7.1500001, 40.0},
-	{6946, "Morocco", "Imzourene", 35.1500000, -3.8599999, 130.0},
-	{6947, "Morocco", "Tahala", 34.0500000, -4.4099998, 573.0},
-	{6948, "Morocco", "Sidi Ifni", 29.3800000, -10.1599998, 156.0},
-	{6949, "Morocco", "Ahfir", 34.9500000, -2.0999999, 269.0},
-	{6950, "Morocco", "Ifrane", 31.7000000, -6.3499999, 1498.0},
-	{6951, "Morocco", "Figuig", 32.1100000, -1.2200000, 879.0},
-	{6952, "Morocco", "Tafraout", 29.7100000, -9.0000000, 993.0},
-	{6953, "Morocco", "Sidi Smail", 32.8300000, -8.5100002, 159.0},
-	{6954, "Morocco", "Jebel Tiskaouine", 31.0500000, -5.1500001, 1184.0},
-	{6955, "Mozambique", "Maputo", -25.9652778, 32.5891685, 47.0},
-	{6956, "Mozambique", "Matola", -25.9622222, 32.4588890, 4.0},
-	{6957, "Mozambique", "Beira", -19.8436111, 34.8388901, 14.0},
-	{6958, "Mozambique", "Nampula", -15.1197222, 39.2647209, 363.0},
-	{6959, "Mozambique", "Chimoio", -19.1163889, 33.4833336, 716.0},
-	{6960, "Mozambique", "Cidade de Nacala", -14.5427778, 40.6727791, -9999.0},
-	{6961, "Mozambique", "Quelimane", -17.8786111, 36.8883324, 1.0},
-	{6962, "Mozambique", "Tete", -16.1563889, 33.5866661, 103.0},
-	{6963, "Mozambique", "Xai-Xai", -25.0519444, 33.6441650, 10.0},
-	{6964, "Mozambique", "Maxixe", -23.8597222, 35.3472214, 31.0},
-	{6965, "Mozambique", "Lichinga", -13.3127778, 35.2405548, 1289.0},
-	{6966, "Mozambique", "Pemba", -12.9608333, 40.5077782, 2.0},
-	{6967, "Mozambique", "Dondo", -19.6094444, 34.7430573, 57.0},
-	{6968, "Mozambique", "Antonio Enes", -16.2325000, 39.9086113, 2.0},
-	{6969, "Mozambique", "Inhambane", -23.8650000, 35.3833351, -9999.0},
-	{6970, "Mozambique", "Cuamba", -14.8030556, 36.5372238, 576.0},
-	{6971, "Mozambique", "Montepuez", -13.1255556, 38.9997215, 555.0},
-	{6972, "Mozambique", "Mocuba", -16.8402778, 38.2569427, 56.0},
-	{6973, "Mozambique", "Chokwe", -24.5333333, 32.9833336, 30.0},
-	{6974, "Mozambique", "Chibuto", -24.6866667, 33.5305557, 107.0},
-	{6975, "Mozambique", "Ilha de Mocambique", -15.0341667, 40.7358322, 1.0},
-	{6976, "Mozambique", "Mutuali", -14.8705556, 37.0044441, 577.0},
-	{6977, "Mozambique", "Mocimboa", -11.3166667, 40.3499985, 1.0},
-	{6978, "Mozambique", "Manjacaze", -24.7116667, 33.8827782, 86.0},
-	{6979, "Mozambique", "Macia", -25.0269444, 33.0988884, 79.0},
-	{6980, "Nepa", "Kathmandu", 27.7166667, 85.3166656, 1304.0},
-	{6981, "Nepa", "Pokhara", 28.2333333, 83.9833298, 922.0},
-	{6982, "Nepa", "Patan", 27.6666667, 85.3333359, 1309.0},
-	{6983, "Nepa", "Biratnagar", 26.4833333, 87.2833328, 70.0},
-	{6984, "Nepa", "Birganj", 27.0000000, 84.8666687, 67.0},
-	{6985, "Nepa", "Dharan Bazar", 26.8166667, 87.2833328, 366.0},
-	{6986, "Nepa", "Bharatpur", 27.6833333, 84.4333344, 203.0},
-	{6987, "Nepa", "Janakpur", 26.7000000, 85.9166641, 66.0},
-	{6988, "Nepa", "Dhangarhi", 28.6833333, 80.5999985, 165.0},
-	{6989, "Nepa", "Butwal", 27.7000000, 83.4499969, 150.0},
-	{6990, "Nepa", "Mahendranagar", 28.9166667, 80.3333359, 199.0},
-	{6991, "Nepa", "Hetauda", 27.4166667, 85.0333328, 459.0},
-	{6992, "Nepa", "Bhaktapur", 27.6673377, 85.4167252, 1319.0},
-	{6993, "Nepa", "Bhairahawa", 27.5000000, 83.4499969, 88.0},
-	{6994, "Nepa", "Gulariya", 28.2333333, 81.3333359, 143.0},
-	{6995, "Nepa", "Ithari", 26.6666667, 87.2833328, 107.0},
-	{6996, "Nepa", "Tikapur", 28.5000000, 81.1333313, 152.0},
-	{6997, "Nepa", "Kirtipur", 27.6666667, 85.2833328, 1342.0},
-	{6998, "Nepa", "Tulsipur", 28.1333333, 82.3000031, 685.0},
-	{6999, "Nepa", "Rajbiraj", 26.5333333, 86.7500000, 76.0},
-	{7000, "Nepa", "Lahan", 26.7166667, 86.4833298, 111.0},
-	{7001, "Nepa", "Panaoti", 27.5833333, 85.5166702, 1437.0},
-	{7002, "Nepa", "Gaur", 26.7666667, 85.2666702, 67.0},
-	{7003, "Nepa", "Siraha", 26.6500000, 86.1999969, 65.0},
-	{7004, "Nepa", "Jaleswar", 26.6333333, 85.8000031, 54.0},
-	{7005, "Nepa", "Baglung", 28.2666667, 83.5999985, 948.0},
-	{7006, "Nepa", "Khandbari", 27.3666667, 87.2166672, 833.0},
-	{7007, "Nepa", "Dhankuta", 26.9833333, 87.3333359, 960.0},
-	{7008, "Nepa", "Waling", 27.9833333, 83.7666702, 804.0},
-	{7009, "Nepa", "Dailekh", 28.8333333, 81.7333298, 1090.0},
-	{7010, "Nepa", "Malangwa", 26.8666667, 85.5666656, 81.0},
-	{7011, "Nepa", "Bhadrapur", 26.5333333, 88.0833359, 101.0},
-	{7012, "Nepa", "Dadeldhura", 29.3000000, 80.5833359, 1699.0},
-	{7013, "Nepa", "Darchula", 29.8500000, 80.5500031, 977.0},
-	{7014, "Nepa", "Ilam", 26.9000000, 87.9333344, 670.0},
-	{7015, "Nepa", "Banepa", 27.6333333, 85.5166702, 1439.0},
-	{7016, "Nepa", "Jumla", 29.2833333, 82.1666641, 2663.0},
-	{7017, "Nepa", "Kodari", 27.9666667, 85.9333344, 3187.0},
-	{7018, "New Zealand", "Auckland", -36.8666667, 174.7666626, 26.0},
-	{7019, "New Zealand", "Wellington", -41.2785362, 174.7766418, 51.0},
-	{7020, "New Zealand", "Christchurch", -43.5333333, 172.6333313, 7.0},
-	{7021, "New Zealand", "Manakau", -40.7166667, 175.2166595, 48.0},
-	{7022, "New Zealand", "North Shore", -36.8000000, 174.7500000, 8.0},
-	{7023, "New Zealand", "Waitakere", -36.8500000, 174.5500031, 66.0},
-	{7024, "New Zealand", "Hamilton", -37.7833333, 175.2833405, 28.0},
-	{7025, "New Zealand", "Dunedin", -45.8741595, 170.5036163, 94.0},
-	{7026, "New Zealand", "Tauranga", -37.6861111, 176.1666718, 1.0},
-	{7027, "New Zealand", "Lower Hutt", -41.2166667, 174.9166718, 15.0},
-	{7028, "New Zealand", "Palmerston North", -40.3500000, 175.6166687, 30.0},
-	{7029, "New Zealand", "Rotorua", -38.1387422, 176.2451630, 269.0},
-	{7030, "New Zealand", "Hastings", -39.6381000, 176.8491821, 11.0},
-	{7031, "New Zealand", "Napier", -39.4833333, 176.9166718, -9999.0},
-	{7032, "New Zealand", "Porirua", -41.1333333, 174.8500061, 27.0},
-	{7033, "New Zealand", "Whangarei", -35.7316731, 174.3239136, 50.0},
-	{7034, "New Zealand", "New Plymouth", -39.0666667, 174.0833282, 27.0},
-	{7035, "New Zealand", "Invercargill", -46.4000000, 168.3500061, 9.0},
-	{7036, "New Zealand", "Wanganui", -39.9333333, 175.0500031, 31.0},
-	{7037, "New Zealand", "Upper Hutt", -41.1382712, 175.0502014, 57.0},
-	{7038, "New Zealand", "Gisborne", -38.6533333, 178.0041656, 19.0},
-	{7039, "New Zealand", "Timaru", -44.4000000, 171.2500000, 1.0},
-	{7040, "New Zealand", "Blenheim", -41.5160328, 173.9528046, 6.0},
-	{7041, "New Zealand", "Taupo", -38.6833333, 176.0833282, 384.0},
-	{7042, "New Zealand", "Pukekohe East", -37.2000000, 174.9499969, 141.0},
-	{7043, "New Zealand", "Masterton", -40.9597222, 175.6575012, 121.0},
-	{7044, "New Zealand", "Levin", -40.6333333, 175.2749939, 24.0},
-	{7045, "New Zealand", "Whakatane", -37.9833333, 177.0000000, 139.0},
-	{7046, "New Zealand", "Tokoroa", -38.2333333, 175.8666687, 326.0},
-	{7047, "New Zealand", "Oamaru", -45.0783623, 170.9800873, 49.0},
-	{7048, "New Zealand", "Gore", -46.1028235, 168.9435730, 78.0},
-	{7049, "New Zealand", "Hawera", -39.5916667, 174.2833405, 86.0},
-	{7050, "New Zealand", "Queenstown", -45.0302262, 168.6627045, 520.0},
-	{7051, "New Zealand", "Greymouth", -42.4666667, 171.1999969, 43.0},
-	{7052, "New Zealand", "Waiuku", -37.2500000, 174.7500000, 51.0},
-	{7053, "New Zealand", "Motueka", -41.1333333, 173.0166626, 3.0},
-	{7054, "New Zealand", "Thames", -37.1333333, 175.5333405, 157.0},
-	{7055, "New Zealand", "Kawerau", -38.1000000, 176.6999969, 38.0},
-	{7056, "New Zealand", "Waitara", -38.9250000, 174.2500000, -9999.0},
-	{7057, "New Zealand", "Otaki", -40.7583333, 175.1499939, 28.0},
-	{7058, "New Zealand", "Kerikeri", -35.2166667, 173.9666595, 18.0},
-	{7059, "New Zealand", "Foxton", -40.4666667, 175.3000031, 15.0},
-	{7060, "New Zealand", "Dargaville", -35.9333333, 173.8833313, 17.0},
-	{7061, "New Zealand", "Waihi", -37.3833333, 175.8333282, 326.0},
-	{7062, "New Zealand", "Balclutha", -46.2338889, 169.7500000, 55.0},
-	{7063, "New Zealand", "Wanaka", -44.7000000, 169.1499939, 345.0},
-	{7064, "New Zealand", "Wairoa", -39.0333333, 177.3666687, 28.0},
-	{7065, "New Zealand", "Whangamata", -37.2000000, 175.8666687, 23.0},
-	{7066, "New Zealand", "Opotiki", -38.0833333, 177.2833405, 10.0},
-	{7067, "New Zealand", "Paeroa", -37.3666667, 175.6666718, 22.0},
-	{7068, "New Zealand", "Westport", -41.7526171, 171.6036987, 5.0},
-	{7069, "New Zealand", "Warkworth", -36.4000000, 174.6666718, 58.0},
-	{7070, "New Zealand", "Hokitika", -42.7166667, 170.9666595, 4.0},
-	{7071, "New Zealand", "Turangi", -39.0000000, 175.9333344, 536.0},
-	{7072, "New Zealand", "Whitianga", -36.8333333, 175.6999969, -9999.0},
-	{7073, "New Zealand", "Katikati", -37.5500000, 175.9166718, 3.0},
-	{7074, "New Zealand", "Rolleston", -43.5833333, 172.3833313, 69.0},
-	{7075, "New Zealand", "Woodend", -43.3166667, 172.6666718, 10.0},
-	{7076, "New Zealand", "Picton", -41.2882693, 174.0101776, 13.0},
-	{7077, "New Zealand", "Raglan", -37.8000000, 174.8833313, 10.0},
-	{7078, "New Zealand", "Waimate North", -35.3166667, 173.8833313, 133.0},
-	{7079, "New Zealand", "Otorohanga", -38.1833333, 175.1999969, 45.0},
-	{7080, "New Zealand", "Muriwai Beach", -36.8166667, 174.4499969, 70.0},
-	{7081, "New Zealand", "Eltham", -39.4291667, 174.3000031, 233.0},
-	{7082, "New Zealand", "Lincoln", -43.6500000, 172.4833374, 14.0},
-	{7083, "New Zealand", "Winton", -46.1500000, 168.3333282, 54.0},
-	{7084, "New Zealand", "Te Anau", -45.4166667, 167.7166595, 213.0},
-	{7085, "New Zealand", "Waihi Beach", -37.4000000, 175.9333344, 175.0},
-	{7086, "New Zealand", "Milton", -46.1208333, 169.9694519, 21.0},
-	{7087, "New Zealand", "Wellsford", -36.2833333, 174.5166626, 35.0},
-	{7088, "New Zealand", "Paihia", -35.2833333, 174.0833282, 5.0},
-	{7089, "New Zealand", "Bluff", -46.6000000, 168.3333282, 1.0},
-	{7090, "New Zealand", "Waipawa", -41.4122222, 175.5152740, 124.0},
-	{7091, "New Zealand", "Murupara", -38.4666667, 176.6999969, 182.0},
-	{7092, "New Zealand", "Mapua", -41.2500000, 173.1000061, -9999.0},
-	{7093, "New Zealand", "Bulls", -40.1666667, 175.3833313, 67.0},
-	{7094, "New Zealand", "Oxford", -43.3000000, 172.1833344, 256.0},
-	{7095, "New Zealand", "Taipa", -34.9833333, 173.4666595, -9999.0},
-	{7096, "New Zealand", "Brightwater", -41.3833333, 173.1166687, 8.0},
-	{7097, "New Zealand", "Wakefield", -41.4000000, 173.0500031, 129.0},
-	{7098, "New Zealand", "Moerewa", -35.3833333, 174.0333405, 142.0},
-	{7099, "New Zealand", "Riverton", -46.3500000, 168.0166626, 16.0},
-	{7100, "New Zealand", "Edgecumbe", -37.9833333, 176.8333282, 3.0},
-	{7101, "New Zealand", "Darfield", -43.4833333, 172.1166687, 212.0},
-	{7102, "New Zealand", "Tairua", -37.0166667, 175.8500061, 10.0},
-	{7103, "New Zealand", "Coromandel", -36.7666667, 175.5000000, 50.0},
-	{7104, "New Zealand", "Opunake", -39.4555556, 173.8583374, 43.0},
-	{7105, "New Zealand", "Kawakawa", -35.3833333, 174.0666656, 101.0},
-	{7106, "New Zealand", "Parakai", -36.6500000, 174.4333344, 4.0},
-	{7107, "New Zealand", "Ngunguru", -35.6166667, 174.5000000, 77.0},
-	{7108, "New Zealand", "Waiouru", -39.4833333, 175.6666718, 821.0},
-	{7109, "New Zealand", "Patea", -39.7583333, 174.4833374, 39.0},
-	{7110, "New Zealand", "Leeston", -43.7666667, 172.3000031, 20.0},
-	{7111, "New Zealand", "Burnham", -43.6166667, 172.3166656, 66.0},
-	{7112, "New Zealand", "Methven", -43.6333333, 171.6499939, 324.0},
-	{7113, "New Zealand", "Takaka", -40.8500000, 172.8000031, 44.0},
-	{7114, "New Zealand", "Pleasant Point", -44.2666667, 171.1333313, 68.0},
-	{7115, "New Zealand", "Te Kauwhata", -37.4000000, 175.1499939, 24.0},
-	{7116, "New Zealand", "Ngatea", -37.2833333, 175.5000000, 3.0},
-	{7117, "New Zealand", "Amberley", -43.1666667, 172.7333374, 40.0},
-	{7118, "Nicaragua", "Managua", 12.1508333, -86.2683334, 59.0},
-	{7119, "Nicaragua", "Leon", 12.4355556, -86.8794479, 102.0},
-	{7120, "Nicaragua", "Masaya", 11.9666667, -86.0999985, 242.0},
-	{7121, "Nicaragua", "Tipitapa", 12.2005556, -86.0938873, 53.0},
-	{7122, "Nicaragua", "Chinandega", 12.6166667, -87.1500015, 50.0},
-	{7123, "Nicaragua", "Matagalpa", 12.9166667, -85.9166641, 682.0},
-	{7124, "Nicaragua", "Esteli", 13.0833333, -86.3499985, 857.0},
-	{7125, "Nicaragua", "Granada", 11.9333333, -85.9499969, 47.0},
-	{7126, "Nicaragua", "Ciudad Sandino", 12.1588889, -86.3441696, 101.0},
-	{7127, "Nicaragua", "Juigalpa", 12.0833333, -85.4000015, 84.0},
-	{7128, "Nicaragua", "El Viejo", 12.6666667, -87.1666641, 50.0},
-	{7129, "Nicaragua", "Nueva Guinea", 11.6833333, -84.4499969, 185.0},
-	{7130, "Nicaragua", "Jinotega", 13.1000000, -86.0000000, 1040.0},
-	{7131, "Nicaragua", "Bluefields", 12.0000000, -83.7500000, -9999.0},
-	{7132, "Nicaragua", "Diriamba", 11.8500000, -86.2333298, 533.0},
-	{7133, "Nicaragua", "Ocotal", 13.6333333, -86.4833298, 599.0},
-	{7134, "Nicaragua", "Puerto Cabezas", 14.0333333, -83.3833313, 8.0},
-	{7135, "Nicaragua", "Chichigalpa", 12.5666667, -87.0333328, 76.0},
-	{7136, "Nicaragua", "Rivas", 11.4333333, -85.8333359, 70.0},
-	{7137, "Nicaragua", "San Rafael del Sur", 11.8500000, -86.4499969, 109.0},
-	{7138, "Nicaragua", "Jinotepe", 11.8500000, -86.1999969, 551.0},
-	{7139, "Nicaragua", "Boaco", 12.4666667, -85.6666641, 402.0},
-	{7140, "Nicaragua", "Nagarote", 12.2675000, -86.5652771, 89.0},
-	{7141, "Nicaragua", "Jalapa", 13.9166667, -86.1333313, 694.0},
-	{7142, "Nicaragua", "La Paz Centro", 12.3400000, -86.6752777, 71.0},
-	{7143, "Nicaragua", "San Marcos", 11.9166667, -86.1999969, 510.0},
-	{7144, "Nicaragua", "Masatepe", 11.9166667, -86.1500015, 434.0},
-	{7145, "Nicaragua", "Nandaime", 11.7500000, -86.0500031, 119.0},
-	{7146, "Nicaragua", "Rama", 12.1500000, -84.2166672, 26.0},
-	{7147, "Nicaragua", "Somoto", 13.4833333, -86.5833359, 706.0},
-	{7148, "Nicaragua", "Corinto", 12.4833333, -87.1666641, 11.0},
-	{7149, "Nicaragua", "Rio Blanco", 12.9333333, -85.2166672, 262.0},
-	{7150, "Nicaragua", "Camoapa", 12.3833333, -85.5166702, 541.0},
-	{7151, "Nicaragua", "El Crucero", 11.9833333, -86.3166656, 763.0},
-	{7152, "Nicaragua", "Siuna", 13.7333333, -84.7666702, 196.0},
-	{7153, "Nicaragua", "Somotillo", 13.0333333, -86.9166641, 34.0},
-	{7154, "Nicaragua", "Santo Tomas", 12.0666667, -85.0833359, 367.0},
-	{7155, "Nicaragua", "Quilali", 13.5666667, -86.0333328, 419.0},
-	{7156, "Nicaragua", "San Carlos", 11.1166667, -84.7833328, 28.0},
-	{7157, "Nicaragua", "Ciudad Dario", 12.7305556, -86.1250000, 457.0},
-	{7158, "Nicaragua", "Ticuantepe", 12.0233333, -86.2005539, 307.0},
-	{7159, "Nicaragua", "El Sauce", 12.8869444, -86.5380554, 171.0},
-	{7160, "Nicaragua", "Condega", 13.3500000, -86.4000015, 563.0},
-	{7161, "Nicaragua", "Acoyapa", 11.9666667, -85.1666641, 83.0},
-	{7162, "Nicaragua", "Matiguas", 12.8333333, -85.4666672, 302.0},
-	{7163, "Nicaragua", "Diriomo", 11.8666667, -86.0500031, 325.0},
-	{7164, "Nicaragua", "Telica", 12.5205556, -86.8600006, 121.0},
-	{7165, "Nicaragua", "San Lorenzo", 12.3833333, -85.6666641, 356.0},
-	{7166, "Nicaragua", "Corn Island", 12.1666667, -83.0333328, -9999.0},
-	{7167, "Nicaragua", "Bocana de Paiwas", 12.8000000, -85.1333313, 259.0},
-	{7168, "Nicaragua", "Villa Sandino", 12.0500000, -84.9833298, 290.0},
-	{7169, "Nicaragua", "San Juan del Sur", 11.2500000, -85.8666687, 69.0},
-	{7170, "Nicaragua", "Niquinohomo", 11.9000000, -86.0999985, 448.0},
-	{7171, "Nicaragua", "Larreynaga", 12.6744444, -86.5708313, 99.0},
-	{7172, "Nicaragua", "Puerto Morazan", 12.8500000, -87.1833344, 5.0},
-	{7173, "Nicaragua", "San Jorge", 11.4500000, -85.8000031, 40.0},
-	{7174, "Nicaragua", "Nindiri", 12.0047222, -86.1194458, 222.0},
-	{7175, "Nicaragua", "Dolores", 11.8500000, -86.2166672, 550.0},
-	{7176, "Nicaragua", "Wiwili", 13.6166667, -85.8333359, 302.0},
-	{7177, "Nicaragua", "La Concepcion", 11.9333333, -86.1833344, 471.0},
-	{7178, "Nicaragua", "Nandasmo", 11.9333333, -86.1166687, 390.0},
-	{7179, "Nicaragua", "Laguna de Perlas", 12.3500000, -83.6666641, 1.0},
-	{7180, "Nicaragua", "Waslala", 13.2333333, -85.3833313, 330.0},
-	{7181, "Nicaragua", "Posoltega", 12.5430556, -86.9788895, 71.0},
-	{7182, "Nicaragua", "Waspam", 14.7333333, -83.9666672, 40.0},
-	{7183, "Nicaragua", "Bonanza", 14.0166667, -84.5833359, 187.0},
-	{7184, "Nicaragua", "El Realejo", 12.5333333, -87.1666641, 9.0},
-	{7185, "Nicaragua", "Santo Domingo", 12.2666667, -85.0833359, 579.0},
-	{7186, "Nicaragua", "Santa Teresa", 11.7333333, -86.2166672, 141.0},
-	{7187, "Nicaragua", "San Rafael del Norte", 13.2166667, -86.1166687, 1172.0},
-	{7188, "Nicaragua", "Belen", 11.5000000, -85.8833313, 70.0},
-	{7189, "Nicaragua", "El Ayote", 12.1833333, -85.2833328, 610.0},
-	{7190, "Nicaragua", "Potosi", 11.5000000, -85.8833313, 70.0},
-	{7191, "Nicaragua", "Carlos Fonseca Amador", 11.9833333, -86.5166702, 94.0},
-	{7192, "Nicaragua", "Tisma", 12.0838889, -86.0175018, 54.0},
-	{7193, "Nicaragua", "San Juan de Limay", 13.1666667, -86.6166687, 299.0},
-	{7194, "Nicaragua", "Muy Muy", 12.7666667, -85.6333313, 313.0},
-	{7195, "Nicaragua", "Pueblo Nuevo", 13.3833333, -86.4833298, 600.0},
-	{7196, "Nicaragua", "San Sebastian de Yali", 13.3000000, -86.1833344, 1019.0},
-	{7197, "Nicaragua", "Teustepe", 12.4166667, -85.8000031, 155.0},
-	{7198, "Nicaragua", "San Miguelito", 11.4000000, -84.9000015, 41.0},
-	{7199, "Nicaragua", "Moyogalpa", 11.5333333, -85.6999969, 66.0},
-	{7200, "Nicaragua", "San Dionisio", 12.7500000, -85.8499985, 467.0},
-	{7201, "Nicaragua", "Diria", 11.8833333, -86.0500031, 345.0},
-	{7202, "Nicaragua", "San Fernando", 13.6833333, -86.3166656, 742.0},
-	{7203, "Nicaragua", "San Juan de Rio Coco", 13.5500000, -86.1666641, 866.0},
-	{7204, "Nicaragua", "Palacaguina", 13.4500000, -86.4000015, 528.0},
-	{7205, "Nicaragua", "Muelle de los Bueyes", 12.0666667, -84.5333328, 85.0},
-	{7206, "Nicaragua", "Achuapa", 13.0500000, -86.5833359, 375.0},
-	{7207, "Nicaragua", "La Libertad", 12.2166667, -85.1666641, 447.0},
-	{7208, "Nicaragua", "El Rosario", 11.7833333, -86.3666687, 107.0},
-	{7209, "Nicaragua", "Santa Rosa del Penon", 12.8011111, -86.3705521, 199.0},
-	{7210, "Nicaragua", "San Ramon", 12.9166667, -85.8333359, 651.0},
-	{7211, "Nicaragua", "Altagracia", 11.5666667, -85.5833359, 64.0},
-	{7212, "Nicaragua", "El Almendro", 11.6833333, -84.6999969, 187.0},
-	{7213, "Nicaragua", "Tola", 11.4333333, -85.9333344, 39.0},
-	{7214, "Nicaragua", "La Paz de Oriente", 11.8166667, -86.1333313, 387.0},
-	{7215, "Nicaragua", "Cuapa", 12.2666667, -85.3833313, 309.0},
-	{7216, "Nicaragua", "San Jose de Bocay", 13.5333333, -85.5333328, 461.0},
-	{7217, "Nicaragua", "Mozonte", 13.6500000, -86.4499969, 647.0},
-	{7218, "Nigeria", "Lagos", 6.4530556, 3.3958333, 35.0},
-	{7219, "Nigeria", "Kano", 11.9963889, 8.5166664, 477.0},
-	{7220, "Nigeria", "Ibadan", 7.3877778, 3.8963890, 239.0},
-	{7221, "Nigeria", "Kaduna", 10.5230556, 7.4402776, 614.0},
-	{7222, "Nigeria", "Port Harcourt", 4.7891667, 6.9986110, 459.0},
-	{7223, "Nigeria", "Benin-City", 6.3333333, 5.6333332, 79.0},
-	{7224, "Nigeria", "Maiduguri", 11.8450000, 13.1599998, 300.0},
-	{7225, "Nigeria", "Zaria", 11.0666667, 7.6999998, 675.0},
-	{7226, "Nigeria", "Aba", 5.1166667, 7.3666668, 205.0},
-	{7227, "Nigeria", "Jos", 9.9166667, 8.8999996, 1208.0},
-	{7228, "Nigeria", "Ilorin", 8.5000000, 4.5500002, 290.0},
-	{7229, "Nigeria", "Oyo", 7.8500000, 3.9333334, 287.0},
-	{7230, "Nigeria", "Enugu", 6.4333333, 7.4833331, 284.0},
-	{7231, "Nigeria", "Abeokuta", 7.1500000, 3.3499999, 67.0},
-	{7232, "Nigeria", "Abuja", 9.0833333, 7.5333333, 705.0},
-	{7233, "Nigeria", "Sokoto", 13.0513889, 5.2313890, 279.0},
-	{7234, "Nigeria", "Onitsha", 6.1666667, 6.7833333, 59.0},
-	{7235, "Nigeria", "Warri", 5.5166667, 5.7500000, 21.0},
-	{7236, "Nigeria", "Okene", 7.5500000, 6.2333331, 386.0},
-	{7237, "Nigeria", "Calabar", 4.9500000, 8.3166666, 98.0},
-	{7238, "Nigeria", "Katsina", 12.9977778, 7.5994444, 465.0},
-	{7239, "Nigeria", "Akure", 7.2500000, 5.1999998, 385.0},
-	{7240, "Nigeria", "Bauchi", 10.3158333, 9.8441668, 616.0},
-	{7241, "Nigeria", "Ebute Ikorodu", 6.6027778, 3.4911110, 39.0},
-	{7242, "Nigeria", "Makurdi", 7.7333333, 8.5333328, 106.0},
-	{7243, "Nigeria", "Minna", 9.6138889, 6.5569444, 299.0},
-	{7244, "Nigeria", "Effon Alaiye", 7.6500000, 4.9166665, 611.0},
-	{7245, "Nigeria", "Ilesa", 7.6166667, 4.7333331, 462.0},
-	{7246, "Nigeria", "Owo", 7.1833333, 5.5833335, 306.0},
-	{7247, "Nigeria", "Umuahia", 5.5333333, 7.4833331, 151.0},
-	{7248, "Nigeria", "Ondo", 7.1000000, 4.8333335, 277.0},
-	{7249, "Nigeria", "Damaturu", 11.7486111, 11.9638891, 456.0},
-	{7250, "Nigeria", "Ikot Ekpene", 5.1666667, 7.7166667, 161.0},
-	{7251, "Nigeria", "Iwo", 7.6333333, 4.1833334, 231.0},
-	{7252, "Nigeria", "Gombe", 10.2894444, 11.1716671, 449.0},
-	{7253, "Nigeria", "Jimeta", 9.2833333, 12.4666672, 136.0},
-	{7254, "Nigeria", "Gusau", 12.1641667, 6.6666665, 420.0},
-	{7255, "Nigeria", "Mubi", 10.2688889, 13.2705555, 592.0},
-	{7256, "Nigeria", "Ikire", 7.3500000, 4.1833334, 228.0},
-	{7257, "Nigeria", "Owerri", 5.4833333, 7.0333333, 159.0},
-	{7258, "Nigeria", "Sagamu", 6.8500000, 3.6500001, 64.0},
-	{7259, "Nigeria", "Ijebu Ode", 6.8166667, 3.9333334, 74.0},
-	{7260, "Nigeria", "Ugep", 5.8000000, 8.0833330, 105.0},
-	{7261, "Nigeria", "Nnewi", 6.0166667, 6.9166665, 152.0},
-	{7262, "Nigeria", "Ise Ekiti", 7.4666667, 5.4333334, 393.0},
-	{7263, "Nigeria", "Ila Orangun", 8.0166667, 4.9000001, 454.0},
-	{7264, "Nigeria", "Saki", 8.6666667, 3.3833332, 457.0},
-	{7265, "Nigeria", "Bida", 9.0833333, 6.0166669, 151.0},
-	{7266, "Nigeria", "Awka", 6.2100945, 7.0741143, 136.0},
-	{7267, "Nigeria", "Ijero Ekiti", 7.8166667, 5.0833335, 465.0},
-	{7268, "Nigeria", "Inisa", 7.8500000, 4.3333335, 366.0},
-	{7269, "Nigeria", "Abuja", 9.1758333, 7.1808333, 339.0},
-	{7270, "Nigeria", "Sapele", 5.9166667, 5.6999998, 35.0},
-	{7271, "Nigeria", "Kishi", 9.0833333, 3.8499999, 373.0},
-	{7272, "Nigeria", "Gbongan", 7.4666667, 4.3499999, 228.0},
-	{7273, "Nigeria", "Ejigbo", 7.9000000, 4.3166666, 426.0},
-	{7274, "Nigeria", "Funtua", 11.5333333, 7.3166666, 615.0},
-	{7275, "Nigeria", "Igboho", 8.8333333, 3.7500000, 445.0},
-	{7276, "Nigeria", "Buguma", 4.7358333, 6.8636112, 378.0},
-	{7277, "Nigeria", "Ikirun", 7.9166667, 4.6666665, 446.0},
-	{7278, "Nigeria", "Abakaliki", 6.3333333, 8.1000004, 118.0},
-	{7279, "Nigeria", "Okrika", 4.7333333, 7.0833335, 452.0},
-	{7280, "Nigeria", "Amaigbo", 5.7833333, 7.8333335, 152.0},
-	{7281, "Nigeria", "Lafia", 8.4833333, 8.5166664, 290.0},
-	{7282, "Nigeria", "Gashua", 12.8680556, 11.0463886, 299.0},
-	{7283, "Nigeria", "Modakeke", 7.3833333, 4.2666669, 230.0},
-	{7284, "Nigeria", "Bama", 11.5183333, 13.6891670, 302.0},
-	{7285, "Nigeria", "Ilobu", 7.8333333, 4.4833331, 331.0},
-	{7286, "Nigeria", "Jalingo", 8.8833333, 11.3666668, 351.0},
-	{7287, "Nigeria", "Okigwi", 5.8166667, 7.3499999, 154.0},
-	{7288, "Nigeria", "Offa", 8.1500000, 4.7166667, 436.0},
-	{7289, "Nigeria", "Esuk Oron", 4.8000000, 8.2666664, 125.0},
-	{7290, "Nigeria", "Nsukka", 6.8666667, 7.3833332, 405.0},
-	{7291, "Nigeria", "Nguru", 12.8775000, 10.4513893, 321.0},
-	{7292, "Nigeria", "Hadejia", 12.4480556, 10.0436115, 337.0},
-	{7293, "Nigeria", "Ijebu Igbo", 6.9666667, 4.0000000, 75.0},
-	{7294, "Nigeria", "Uromi", 6.7000000, 6.3333335, 361.0},
-	{7295, "Nigeria", "Birnin-Kebbi", 12.4538889, 4.1975002, 235.0},
-	{7296, "Nigeria", "Pindiga", 9.9833333, 10.9333334, 541.0},
-	{7297, "Nigeria", "Azare", 11.6783333, 10.1919441, 436.0},
-	{7298, "Nigeria", "Nkpor", 6.1500000, 6.8333335, 96.0},
-	{7299, "Nigeria", "Ikerre", 7.5000000, 5.2333331, 446.0},
-	{7300, "Nigeria", "Lafiagi", 8.8666667, 5.4166665, 99.0},
-	{7301, "Nigeria", "Kontagora", 10.4002778, 5.4697223, 335.0},
-	{7302, "Nigeria", "Yola", 9.2000000, 12.4833336, 163.0},
-	{7303, "Nigeria", "Biu", 10.6111111, 12.1949997, 626.0},
-	{7304, "Nigeria", "Olupona", 7.6000000, 4.1833334, 224.0},
-	{7305, "Nigeria", "Lere", 10.3833333, 8.5833330, 705.0},
-	{7306, "Nigeria", "Wukari", 7.8500000, 9.7833328, 152.0},
-	{7307, "Nigeria", "Igbo Ora", 7.4333333, 3.2833333, 140.0},
-	{7308, "Nigeria", "Emure Ekiti", 7.4500000, 5.4666667, 366.0},
-	{7309, "Nigeria", "Isieke", 6.3833333, 8.0333328, 127.0},
-	{7310, "Nigeria", "Ifo", 6.8166667, 3.2000000, 75.0},
-	{7311, "Nigeria", "Igede Ekiti", 7.6500000, 5.1166668, 460.0},
-	{7312, "Nigeria", "Effium", 6.6333333, 8.0666666, 155.0},
-	{7313, "Nigeria", "Idanre", 7.1000000, 5.0999999, 453.0},
-	{7314, "Nigeria", "Potiskum", 11.7119444, 11.0697222, 475.0},
-	{7315, "Nigeria", "Keffi", 8.8486111, 7.8736110, 338.0},
-	{7316, "Nigeria", "Epe", 6.5833333, 3.9833333, 42.0},
-	{7317, "Nigeria", "Gambaru", 12.3666667, 14.2166672, 270.0},
-	{7318, "Niger", "Niamey", 13.5166667, 2.1166668, 203.0},
-	{7319, "Niger", "Zinder", 13.8000000, 8.9833336, 467.0},
-	{7320, "Niger", "Maradi", 13.4916667, 7.0963888, 374.0},
-	{7321, "Niger", "Alarsas", 17.0166667, 8.0000000, 537.0},
-	{7322, "Niger", "Tahoua", 14.8902778, 5.2677779, 382.0},
-	{7323, "Niger", "Dosso", 13.0444444, 3.1947222, 229.0},
-	{7324, "Niger", "Birnin Konni", 13.8000000, 5.2500000, 258.0},
-	{7325, "Niger", "Tessaoua", 13.7533333, 7.9863887, 409.0},
-	{7326, "Niger", "Gaya", 11.8877778, 3.4466667, 173.0},
-	{7327, "Niger", "Dogondoutchi", 13.6461111, 4.0288887, 228.0},
-	{7328, "Niger", "Diffa", 13.3155556, 12.6088886, 286.0},
-	{7329, "Niger", "Ayorou", 14.7313889, 0.9194444, 225.0},
-	{7330, "Niger", "Madaoua", 14.0758333, 5.9586110, 320.0},
-	{7331, "Niger", "Mayahi", 13.9577778, 7.6683335, 385.0},
-	{7332, "Niger", "Tera", 14.0105556, 0.7530556, 231.0},
-	{7333, "Niger", "Miria", 13.7141667, 9.1505556, 406.0},
-	{7334, "Niger", "Tibiri", 13.5705556, 7.0444446, 343.0},
-	{7335, "Niger", "Dakoro", 14.5105556, 6.7649999, 403.0},
-	{7336, "Niger", "Magaria", 12.9975000, 8.9105558, 396.0},
-	{7337, "Niger", "Tillaberi", 14.2116667, 1.4530556, 239.0},
-	{7338, "Niger", "Nguigmi", 14.2527778, 13.1108332, 310.0},
-	{7339, "Niger", "Matamey", 13.4238889, 8.4777775, 427.0},
-	{7340, "Niger", "Illela", 14.4616667, 5.2474999, 300.0},
-	{7341, "Niger", "Tanout", 14.9702778, 8.8916664, 533.0},
-	{7342, "Niger", "Goure", 13.9869444, 10.2700005, 435.0},
-	{7343, "Niger", "Abalak", 15.4522222, 6.2783332, 416.0},
-	{7344, "Niger", "Aguie", 13.5080556, 7.7772222, 435.0},
-	{7345, "Niger", "Filingue", 14.3500000, 3.3166666, 204.0},
-	{7346, "Niger", "Maine-Soroa", 13.2177778, 12.0266666, 288.0},
-	{7347, "Niger", "Say", 13.1080556, 2.3597221, 158.0},
-	{7348, "Niger", "Kollo", 13.3086111, 2.3308332, 160.0},
-	{7349, "Niger", "Madarounfa", 13.3075000, 7.1574998, 379.0},
-	{7350, "Niger", "Keita", 14.7572222, 5.7805557, 398.0},
-	{7351, "Niger", "Tchin-Tabaradene", 15.8869444, 5.7766666, 395.0},
-	{7352, "Niger", "Ouallam", 14.3166667, 2.0833333, 228.0},
-	{7353, "Niger", "Bouza", 14.4222222, 6.0452776, 427.0},
-	{7354, "Niger", "Bilma", 18.6866667, 12.9191666, 521.0},
-	{7355, "Niger", "Atako", 14.5538680, 6.2232809, 425.0},
-	{7356, "Korea, North", "Pyongyang", 39.0194444, 125.7547226, 13.0},
-	{7357, "Korea, North", "Hamhung", 39.9183333, 127.5363922, 11.0},
-	{7358, "Korea, North", "Hungnam", 39.8316667, 127.6186142, 1.0},
-	{7359, "Korea, North", "Kaesong", 37.9708333, 126.5544434, 39.0},
-	{7360, "Korea, North", "Wonsan", 39.1527778, 127.4436111, -9999.0},
-	{7361, "Korea, North", "Chongjin", 41.7955556, 129.7758331, 4.0},
-	{7362, "Korea, North", "Sinuiju", 40.1005556, 124.3980560, 1.0},
-	{7363, "Korea, North", "Haeju", 38.0405556, 125.7147217, 46.0},
-	{7364, "Korea, North", "Kanggye", 40.9872222, 126.6113892, 311.0},
-	{7365, "Korea, North", "Sariwon", 38.5072222, 125.7558365, 15.0},
-	{7366, "Korea, North", "Songnim", 38.7541667, 125.6449966, 14.0},
-	{7367, "Korea, North", "Hyesan-dong", 41.4000000, 128.1833344, 753.0},
-	{7368, "Korea, North", "Sokko-dong", 40.9561111, 126.5819473, 359.0},
-	{7369, "Korea, North", "Yuktae-dong", 40.0247222, 128.1597290, 2.0},
-	{7370, "Korea, North", "Hongwon", 40.0252778, 127.9558334, 12.0},
-	{7371, "Korea, North", "Najin", 42.2488889, 130.3002777, 9.0},
-	{7372, "Korea, North", "Ongjin", 37.9347222, 125.3619461, 20.0},
-	{7373, "Korea, North", "Kilju", 40.9641667, 129.3277740, 134.0},
-	{7374, "Korea, North", "Chaeryong-up", 38.3991667, 125.6155548, 11.0},
-	{7375, "Korea, North", "Anju", 39.6177778, 125.6647186, 6.0},
-	{7376, "Korea, North", "Uiju", 40.1994444, 124.5316696, 12.0},
-	{7377, "Korea, North", "Hoeryong", 42.4427778, 129.7508392, 218.0},
-	{7378, "Korea, North", "Sungho 1-tong", 38.9913889, 125.9777756, 25.0},
-	{7379, "Korea, North", "Changyon", 38.2508333, 125.0961075, 25.0},
-	{7380, "Korea, North", "Sunan", 39.1983333, 125.6900024, 17.0},
-	{7381, "Korea, North", "Hwangju-up", 38.6702778, 125.7761078, 25.0},
-	{7382, "Korea, North", "Kyongsong", 41.5877778, 129.6061096, 11.0},
-	{7383, "Korea, North", "Aoji-ri", 42.5205556, 130.3952789, 30.0},
-	{7384, "Korea, North", "Nanam", 41.7136111, 129.6844482, 26.0},
-	{7385, "Korea, North", "Anbyon-up", 39.0425000, 127.5238876, 9.0},
-	{7386, "Korea, North", "Kusong", 39.9811111, 125.2447205, 86.0},
-	{7387, "Korea, North", "Chongju-up", 39.6933333, 125.2102814, 30.0},
-	{7388, "Korea, North", "Kangdong-up", 39.1425000, 126.0961075, 29.0},
-	{7389, "Korea, North", "Sonbong", 42.3422222, 130.3966675, 29.0},
-	{7390, "Korea, North", "Iwon-up", 40.3230556, 128.6552734, 1.0},
-	{7391, "Korea, North", "Hukkyo-ri", 38.7986111, 125.7919464, 21.0},
-	{7392, "Korea, North", "Kosan-up", 38.8558333, 127.4180527, 192.0},
-	{7393, "Korea, North", "Yonan-up", 37.9088889, 126.1611099, 36.0},
-	{7394, "Korea, North", "Namyang-dong", 42.9500000, 129.8666687, 184.0},
-	{7395, "Korea, North", "Yonggwang-up", 40.0183333, 127.4547195, 31.0},
-	{7396, "Korea, North", "Musan-up", 42.2294444, 129.2130585, 488.0},
-	{7397, "Korea, North", "Kowon-up", 39.4380556, 127.2430573, 2.0},
-	{7398, "Korea, North", "Hoeyang", 38.7102778, 127.5983353, 359.0},
-	{7399, "Korea, North", "Kapsan-up", 41.0902778, 128.2933350, 810.0},
-	{7400, "Korea, North", "Anak", 38.5108333, 125.4941635, 21.0},
-	{7401, "Korea, North", "Onsong", 42.9572222, 129.9933319, 84.0},
-	{7402, "Korea, North", "Kujang-up", 39.8672222, 126.0302811, 27.0},
-	{7403, "Korea, North", "Sil-li", 39.4947222, 125.4736099, 3.0},
-	{7404, "Korea, North", "Chunghwa-up", 38.8638889, 125.8000031, 35.0},
-	{7405, "Korea, North", "Samho-rodongjagu", 39.9475000, 127.8711090, 1.0},
-	{7406, "Korea, North", "Sinmak", 38.4166667, 126.2333298, 115.0},
-	{7407, "Korea, North", "Sakchu-up", 40.3894444, 125.0466690, 132.0},
-	{7408, "Korea, North", "Ayang-ni", 38.2430556, 125.7799988, 104.0},
-	{7409, "Korea, North", "Sinanju", 39.5980556, 125.6097260, 11.0},
-	{7410, "Korea, North", "Sinsang-ni", 39.6502778, 127.4058304, 2.0},
-	{7411, "Korea, North", "Pukchil-lodongjagu", 40.2019444, 125.7483368, 246.0},
-	{7412, "Korea, North", "Hau-ri", 41.2005556, 129.4702759, 128.0},
-	{7413, "Korea, North", "Yonggang-up", 38.8561111, 125.4244461, 12.0},
-	{7414, "Korea, North", "Pyoksong-up", 38.0475000, 125.5566635, 3.0},
-	{7415, "Korea, North", "Koksan", 38.7819444, 126.6663895, 173.0},
-	{7416, "Korea, North", "Chasong", 41.4608333, 126.6413879, 380.0},
-	{7417, "Korea, North", "Kyongwon", 42.8136111, 130.1527710, 81.0},
-	{7418, "Korea, North", "Puryong", 42.0605556, 129.7116699, 167.0},
-	{7419, "Korea, North", "Komusan 1-tong", 42.1091667, 129.6999969, 227.0},
-	{7420, "Korea, North", "Sungjibaegam", 41.2427778, 128.7988892, 1460.0},
-	{7421, "Korea, North", "Hoemul-li", 41.4338889, 129.6699982, 4.0},
-	{7422, "Korea, North", "Sungam-nodongjagu", 41.6697222, 129.6688843, 8.0},
-	{7423, "Korea, North", "Panghyon-dong", 39.8872222, 125.2413864, 67.0},
-	{7424, "Korea, North", "Kwaksan", 39.6875000, 125.0827789, 14.0},
-	{7425, "Korea, North", "Sangsong-ni", 39.1694444, 126.8855591, 295.0},
-	{7426, "Korea, North", "Yongbyon", 39.8133333, 125.8041687, 137.0},
-	{7427, "Korea, North", "Yomju", 39.8933333, 124.5980530, 1.0},
-	{7428, "Korea, North", "Ungsang-nodongjagu", 42.3577778, 130.4622192, 30.0},
-	{7429, "Korea, North", "Sot-pong", 38.8588889, 126.0025024, 120.0},
-	{7430, "Korea, North", "Yongi-ri", 39.2780556, 125.7097244, 98.0},
-	{7431, "Oman", "Muscat", 23.6133333, 58.5933342, 69.0},
-	{7432, "Oman", "As Sib", 23.6802778, 58.1824989, 13.0},
-	{7433, "Oman", "Salalah", 17.0175000, 54.0827789, 21.0},
-	{7434, "Oman", "Bawshar", 23.5316667, 58.3894463, 110.0},
-	{7435, "Oman", "Suhar", 24.3688889, 56.7438889, 4.0},
-	{7436, "Oman", "As Suwayq", 23.8494444, 57.4386101, 18.0},
-	{7437, "Oman", "`Ibri", 23.2257298, 56.5157204, 377.0},
-	{7438, "Oman", "Saham", 24.1722222, 56.8886108, 12.0},
-	{7439, "Oman", "Rustaq", 23.3908333, 57.4244461, 367.0},
-	{7440, "Oman", "Al Buraymi", 24.2500000, 55.7500000, 285.0},
-	{7441, "Oman", "Nizwa", 22.9333333, 57.5333328, 516.0},
-	{7442, "Oman", "Sur", 22.5666667, 59.5288887, 15.0},
-	{7443, "Oman", "Al Khaburah", 23.9811111, 57.1044426, 4.0},
-	{7444, "Oman", "Shinas", 24.7433333, 56.4658318, 9.0},
-	{7445, "Oman", "Izki", 22.9333333, 57.7666664, 519.0},
-	{7446, "Oman", "Ibra", 22.6902778, 58.5469437, 458.0},
-	{7447, "Oman", "Bidbid", 23.4063889, 58.1263885, 247.0},
-	{7448, "Oman", "Badiyah", 22.4500000, 58.7999992, 291.0},
-	{7449, "Oman", "Khasab", 26.1916667, 56.2436104, 81.0},
-	{7450, "Oman", "Adam", 22.3833333, 57.5333328, 274.0},
-	{7451, "Oman", "Yanqul", 23.5855556, 56.5408325, 684.0},
-	{7452, "Oman", "Al Qabil", 22.5666667, 58.7000008, 347.0},
-	{7453, "Oman", "Bayt al `Awabi", 23.2997222, 57.5294456, 498.0},
-	{7454, "Pakistan", "Karachi", 24.8666667, 67.0500031, 14.0},
-	{7455, "Pakistan", "Lahore", 31.5497222, 74.3436127, 210.0},
-	{7456, "Pakistan", "Faisalabad", 31.4166667, 73.0833359, 175.0},
-	{7457, "Pakistan", "Rawalpindi", 33.6000000, 73.0666656, 484.0},
-	{7458, "Pakistan", "Multan", 30.1955556, 71.4752808, 125.0},
-	{7459, "Pakistan", "Hyderabad", 25.3666667, 68.3666687, 13.0},
-	{7460, "Pakistan", "Gujranwala", 32.1500000, 74.1833344, 223.0},
-	{7461, "Pakistan", "Peshawar", 34.0077778, 71.5733337, 315.0},
-	{7462, "Pakistan", "Quetta", 30.1872222, 67.0124969, 1671.0},
-	{7463, "Pakistan", "Islamabad", 33.6100446, 73.0590820, 497.0},
-	{7464, "Pakistan", "Bahawalpur", 29.4000000, 71.6833344, 116.0},
-	{7465, "Pakistan", "Sargodha", 32.0836111, 72.6711121, 193.0},
-	{7466, "Pakistan", "Sialkot", 32.5000000, 74.5166702, 256.0},
-	{7467, "Pakistan", "Sukkur", 27.7000000, 68.8666687, 79.0},
-	{7468, "Pakistan", "Larkana", 27.5500000, 68.2166672, 45.0},
-	{7469, "Pakistan", "Shekhupura", 31.7130556, 73.9783325, 214.0},
-	{7470, "Pakistan", "Jhang Sadr", 31.2741667, 72.3119431, 158.0},
-	{7471, "Pakistan", "Gujrat", 32.5666667, 74.0833359, 228.0},
-	{7472, "Pakistan", "Mardan", 34.1983333, 72.0458298, 285.0},
-	{7473, "Pakistan", "Kasur", 31.1155556, 74.4466629, 201.0},
-	{7474, "Pakistan", "Dera Ghazi Khan", 30.0561111, 70.6344452, 121.0},
-	{7475, "Pakistan", "Sahiwal", 30.6666667, 73.0999985, 172.0},
-	{7476, "Pakistan", "Nawabshah", 26.2500000, 68.4166641, 20.0},
-	{7477, "Pakistan", "Mingaora", 34.7761111, 72.3611145, 921.0},
-	{7478, "Pakistan", "Okara", 30.8080556, 73.4458313, 174.0},
-	{7479, "Pakistan", "Mirpur Khas", 25.5333333, 69.0000000, 14.0},
-	{7480, "Pakistan", "Chiniot", 31.7200000, 72.9788895, 179.0},
-	{7481, "Pakistan", "Kamoke", 31.9744444, 74.2244415, 226.0},
-	{7482, "Pakistan", "Sadiqabad", 28.3000000, 70.1333313, 76.0},
-	{7483, "Pakistan", "Burewala", 30.1666667, 72.6500015, 132.0},
-	{7484, "Pakistan", "Jacobabad", 28.2838889, 68.4361115, 59.0},
-	{7485, "Pakistan", "Muzaffargarh", 30.0666667, 71.1999969, 114.0},
-	{7486, "Pakistan", "Muridke", 31.8025000, 74.2616653, 211.0},
-	{7487, "Pakistan", "Jhelum", 32.9333333, 73.7333298, 222.0},
-	{7488, "Pakistan", "Shikarpur", 27.9500000, 68.6333313, 63.0},
-	{7489, "Pakistan", "Hafizabad", 32.0666667, 73.6833344, 207.0},
-	{7490, "Pakistan", "Kohat", 33.5869444, 71.4422226, 508.0},
-	{7491, "Pakistan", "Khanpur", 28.6500000, 70.6500015, 88.0},
-	{7492, "Pakistan", "Khuzdar", 27.8000000, 66.6166687, 1211.0},
-	{7493, "Pakistan", "Dadu", 26.7333333, 67.7833328, 24.0},
-	{7494, "Pakistan", "Gojra", 31.1500000, 72.6833344, 165.0},
-	{7495, "Pakistan", "Mandi Bahauddin", 32.5833333, 73.5000000, 223.0},
-	{7496, "Pakistan", "Tando Allahyar", 25.4666667, 68.7166672, 17.0},
-	{7497, "Pakistan", "Daska", 32.3333333, 74.3499985, 235.0},
-	{7498, "Pakistan", "Pakpattan", 30.3500000, 73.4000015, 155.0},
-	{7499, "Pakistan", "Bahawalnagar", 29.9833333, 73.2666702, 144.0},
-	{7500, "Pakistan", "Tando Adam", 25.7666667, 68.6666641, 23.0},
-	{7501, "Pakistan", "Khairpur", 27.5333333, 68.7666702, 50.0},
-	{7502, "Pakistan", "Chishtian Mandi", 29.8000000, 72.8666687, 147.0},
-	{7503, "Pakistan", "Jaranwala", 31.3333333, 73.4333344, 183.0},
-	{7504, "Pakistan", "Ahmadpur East", 29.1500000, 71.2666702, 103.0},
-	{7505, "Pakistan", "Vihari", 30.0333333, 72.3499985, 136.0},
-	{7506, "Pakistan", "Kamalia", 30.7333333, 72.6500015, 153.0},
-	{7507, "Pakistan", "Kot Addu", 30.4700000, 70.9644470, 134.0},
-	{7508, "Pakistan", "Khushab", 32.2966667, 72.3525009, 174.0},
-	{7509, "Pakistan", "Wazirabad", 32.4500000, 74.1166687, 222.0},
-	{7510, "Pakistan", "Dera Ismail Khan", 31.8327778, 70.9024963, 166.0},
-	{7511, "Pakistan", "Chakwal", 32.9333333, 72.8666687, 499.0},
-	{7512, "Pakistan", "Swabi", 34.1200000, 72.4722214, 321.0},
-	{7513, "Pakistan", "Lodhran", 29.5333333, 71.6333313, 111.0},
-	{7514, "Pakistan", "Nowshera Cantonment", 33.9966667, 72.0130539, 294.0},
-	{7515, "Pakistan", "Charsadda", 34.1452778, 71.7313919, 282.0},
-	{7516, "Pakistan", "Jalalpur", 32.6333333, 74.1999969, 233.0},
-	{7517, "Pakistan", "Mianwali", 32.5833333, 71.5500031, 212.0},
-	{7518, "Pakistan", "Chaman", 30.9169444, 66.4597244, 1336.0},
-	{7519, "Pakistan", "Kandhkot", 28.2333333, 69.1833344, 71.0},
-	{7520, "Pakistan", "Hasilpur", 29.7122222, 72.5552750, 123.0},
-	{7521, "Pakistan", "Arifwala", 30.2833333, 73.0666656, 148.0},
-	{7522, "Pakistan", "Attock City", 33.7722222, 72.3683319, 349.0},
-	{7523, "Pakistan", "Chichawatni", 30.5333333, 72.6999969, 159.0},
-	{7524, "Pakistan", "Bhakkar", 31.6333333, 71.0666656, 160.0},
-	{7525, "Pakistan", "Kharian", 32.8166667, 73.8666687, 287.0},
-	{7526, "Pakistan", "Leiah", 30.9666667, 70.9333344, 141.0},
-	{7527, "Pakistan", "Kambar", 27.6000000, 68.0000000, 41.0},
-	{7528, "Pakistan", "Moro", 26.6666667, 68.0000000, 34.0},
-	{7529, "Pakistan", "Mian Channun", 30.4500000, 72.3666687, 148.0},
-	{7530, "Pakistan", "Turbat", 26.0027778, 63.0505562, 142.0},
-	{7531, "Pakistan", "Shahdadkot", 27.8500000, 67.9000015, 48.0},
-	{7532, "Pakistan", "Bhalwal", 32.2658333, 72.9008331, 184.0},
-	{7533, "Pakistan", "Dipalpur", 30.6666667, 73.6500015, 169.0},
-	{7534, "Pakistan", "Badin", 24.6500000, 68.8333359, 4.0},
-	{7535, "Pakistan", "Pano Aqil", 27.8500000, 69.1166687, 61.0},
-	{7536, "Pakistan", "Kotri", 25.3666667, 68.3000031, 17.0},
-	{7537, "Pakistan", "Tando Muhammad Khan", 25.1333333, 68.5333328, 12.0},
-	{7538, "Pakistan", "Harunabad", 29.6133333, 73.1388855, 151.0},
-	{7539, "Pakistan", "Pattoki", 31.0166667, 73.8499985, 191.0},
-	{7540, "Pakistan", "Kahror Pakka", 29.6166667, 71.9166641, 117.0},
-	{7541, "Pakistan", "Gujar Khan", 33.2666667, 73.3166656, 438.0},
-	{7542, "Pakistan", "Kot Malik", 30.1958333, 66.9974976, 1659.0},
-	{7543, "Pakistan", "Chuhar Kana", 31.7500000, 73.8000031, 205.0},
-	{7544, "Pakistan", "Toba Tek Singh", 30.9666667, 72.4833298, 155.0},
-	{7545, "Pakistan", "Narowal", 32.1000000, 74.8833313, 229.0},
-	{7546, "Pakistan", "Shorko", 31.9102778, 70.8777771, 170.0},
-	{7547, "Pakistan", "Shahdadpur", 25.9333333, 68.6166687, 16.0},
-	{7548, "Pakistan", "Shabqadar", 34.2152778, 71.5552750, 312.0},
-	{7549, "Pakistan", "Mansehra", 34.3333333, 73.1999969, 1067.0},
-	{7550, "Pakistan", "Shujaabad", 29.8833333, 71.3000031, 107.0},
-	{7551, "Pakistan", "Haveli", 30.4500000, 73.6999969, 165.0},
-	{7552, "Pakistan", "Lala Musa", 32.7000000, 73.9666672, 254.0},
-	{7553, "Pakistan", "Mailsi", 29.8002778, 72.1758347, 123.0},
-	{7554, "Palau", "Meyungs", 7.3519444, 134.4544373, 1.0},
-	{7555, "Palau", "Airai", 7.3575000, 134.5577850, -9999.0},
-	{7556, "Palau", "Kloulklubed", 7.0394444, 134.2525024, 1.0},
-	{7557, "Palau", "Ngermechau", 7.5513889, 134.6330566, 11.0},
-	{7558, "Palau", "Melekeok", 7.4933333, 134.6341705, 1.0},
-	{7559, "Palau", "Ngaramasch", 6.9013889, 134.1294403, 1.0},
-	{7560, "Palau", "Imeong", 7.5275000, 134.5255585, 20.0},
-	{7561, "Palau", "Ulimang", 7.6216667, 134.6388855, 1.0},
-	{7562, "Palau", "Ollei", 7.7172222, 134.6122284, 1.0},
-	{7563, "Palau", "Oikull", 7.3655556, 134.5836182, 1.0},
-	{7564, "Palau", "Ngetkib", 7.3619444, 134.5122223, -9999.0},
-	{7565, "Panama", "Panama", 8.9666667, -79.5333328, 2.0},
-	{7566, "Panama", "San Miguelito", 9.0333333, -79.5000000, 35.0},
-	{7567, "Panama", "Tocumen", 9.0833333, -79.3833313, 16.0},
-	{7568, "Panama", "David", 8.4333333, -82.4333344, 56.0},
-	{7569, "Panama", "Arraijan", 8.9500000, -79.6500015, 142.0},
-	{7570, "Panama", "Colon", 9.3591667, -79.9013901, 2.0},
-	{7571, "Panama", "Las Cumbres", 9.0833333, -79.5333328, 113.0},
-	{7572, "Panama", "La Chorrera", 8.8802778, -79.7833328, 70.0},
-	{7573, "Panama", "Pacora", 9.0833333, -79.2833328, 21.0},
-	{7574, "Panama", "Santiago", 8.1000000, -80.9833298, 88.0},
-	{7575, "Panama", "Chitre", 7.9666667, -80.4333344, 20.0},
-	{7576, "Panama", "Vista Alegre", 8.9333333, -79.6999969, 38.0},
-	{7577, "Panama", "Chilibre", 9.1500000, -79.6166687, 49.0},
-	{7578, "Panama", "Cativa", 9.3605556, -79.8436127, 22.0},
-	{7579, "Panama", "Nuevo Arraijan", 8.9166667, -79.7166672, 11.0},
-	{7580, "Panama", "Changuinola", 9.4333333, -82.5166702, 24.0},
-	{7581, "Panama", "Alcaldediaz", 9.1166667, -79.5500031, 132.0},
-	{7582, "Panama", "Puerto Armuelles", 8.2833333, -82.8666687, 12.0},
-	{7583, "Panama", "La Cabima", 9.1333333, -79.5333328, 151.0},
-	{7584, "Panama", "Aguadulce", 8.2500000, -80.5500031, 29.0},
-	{7585, "Panama", "La Concepcion", 8.5166667, -82.6166687, 239.0},
-	{7586, "Panama", "Pedregal", 8.3666667, -82.4333344, 2.0},
-	{7587, "Panama", "Veracruz", 8.8833333, -79.6333313, -9999.0},
-	{7588, "Panama", "Chepo", 9.1666667, -79.0999985, 34.0},
-	{7589, "Panama", "Anton", 8.4000000, -80.2666702, 26.0},
-	{7590, "Panama", "Sabanitas", 9.3422222, -79.8011093, 17.0},
-	{7591, "Panama", "Penonome", 8.5166667, -80.3666687, 57.0},
-	{7592, "Panama", "Puerto Escondido", 9.1933333, -80.0883331, 37.0},
-	{7593, "Panama", "El Coco", 8.8702778, -79.8150024, 93.0},
-	{7594, "Panama", "Las Lomas", 8.4333333, -82.4000015, 42.0},
-	{7595, "Panama", "Pocri", 8.2666667, -80.5500031, 17.0},
-	{7596, "Panama", "Volcan", 8.7666667, -82.6333313, 1399.0},
-	{7597, "Panama", "Bocas del Toro", 9.3333333, -82.2500000, -9999.0},
-	{7598, "Panama", "Ancon", 8.9666667, -79.5500031, 6.0},
-	{7599, "Panama", "Las Tablas", 7.7666667, -80.2833328, 41.0},
-	{7600, "Panama", "Guadalupe", 8.8558333, -79.8133316, 87.0},
-	{7601, "Panama", "Almirante", 9.3000000, -82.4000015, 16.0},
-	{7602, "Panama", "Sona", 8.0166667, -81.3166656, 21.0},
-	{7603, "Panama", "Boquete", 8.7833333, -82.4333344, 1132.0},
-	{7604, "Panama", "Guabito", 9.5000000, -82.6166687, 1.0},
-	{7605, "Panama", "Los Santos", 7.9333333, -80.4166641, 35.0},
-	{7606, "Panama", "Puerto Pilon", 9.3644444, -79.7933350, 8.0},
-	{7607, "Panama", "Nata", 8.3333333, -80.5166702, 10.0},
-	{7608, "Panama", "La Mitra", 8.8422222, -79.7866669, 79.0},
-	{7609, "Panama", "Rio Hato", 8.3833333, -80.1666641, 27.0},
-	{7610, "Panama", "El Progreso", 9.2666667, -79.1166687, 238.0},
-	{7611, "Panama", "Lidice", 8.7500000, -79.9000015, 171.0},
-	{7612, "Panama", "Potrero Grande", 8.8844444, -79.8294449, 91.0},
-	{7613, "Panama", "Alto del Espino", 8.8333333, -79.8499985, 83.0},
-	{7614, "Panama", "Rio Alejandro", 9.3763889, -79.7911148, 7.0},
-	{7615, "Panama", "Bejuco", 8.6000000, -79.8833313, 25.0},
-	{7616, "Panama", "Capira", 8.7500000, -79.8833313, 119.0},
-	{7617, "Panama", "La Herradura", 8.8522222, -79.8072205, 64.0},
-	{7618, "Panama", "Margarita", 9.3313889, -79.8888855, 14.0},
-	{7619, "Panama", "Pese", 7.9000000, -80.6166687, 97.0},
-	{7620, "Panama", "Quebrada Canoa", 8.4333333, -81.7833328, 653.0},
-	{7621, "Panama", "Buena Vista", 9.2747222, -79.6952744, 81.0},
-	{7622, "Panama", "Ocu", 7.9500000, -80.7833328, 130.0},
-	{7623, "Panama", "Canazas", 8.3166667, -81.2166672, 197.0},
-	{7624, "Panama", "Cerro Punta", 8.8500000, -82.5666656, 2224.0},
-	{7625, "Panama", "Villa Rosario", 8.7833333, -79.8666687, 97.0},
-	{7626, "Panama", "Parita", 8.0000000, -80.5166702, 35.0},
-	{7627, "Panama", "Atalaya", 8.0500000, -80.9333344, 90.0},
-	{7628, "Panama", "Monte Lirio", 8.7833333, -82.8333359, 793.0},
-	{7629, "Panama", "Gualaca", 8.5333333, -82.3000031, 123.0},
-	{7630, "Panama", "Paso Blanco", 9.1333333, -79.2333298, 30.0},
-	{7631, "Panama", "Vista Hermosa", 8.5333333, -80.3666687, 78.0},
-	{7632, "Panama", "Siogui Abajo", 8.5000000, -82.6666641, 156.0},
-	{7633, "Panama", "Bugaba", 8.4833333, -82.6166687, 132.0},
-	{7634, "Panama", "Ustupo", 9.1333333, -77.9333344, 1.0},
-	{7635, "Panama", "Divala", 8.4166667, -82.7166672, 37.0},
-	{7636, "Panama", "Chiriqui", 8.4000000, -82.3166656, 16.0},
-	{7637, "Panama", "Chame", 8.5833333, -79.8833313, 14.0},
-	{7638, "Panama", "La Pena", 8.1333333, -81.0333328, 129.0},
-	{7639, "Panama", "Capellania", 8.3000000, -80.5500031, 32.0},
-	{7640, "Panama", "La Mesa", 8.1500000, -81.1833344, 203.0},
-	{7641, "Panama", "Chigore", 8.5333333, -80.3499985, 93.0},
-	{7642, "Panama", "Macaracas", 7.7333333, -80.5500031, 87.0},
-	{7643, "Panama", "Nuevo Vigia", 9.2447222, -79.6086121, 105.0},
-	{7644, "Panama", "Progreso", 8.4500000, -82.8333359, 1.0},
-	{7645, "Panama", "Guarare", 7.8166667, -80.2833328, 19.0},
-	{7646, "Panama", "Playon Chico", 9.3000000, -78.2333298, -9999.0},
-	{7647, "Panama", "Los Algarrobos", 8.5000000, -82.4333344, 123.0},
-	{7648, "Panama", "Santa Rita Arriba", 9.3377778, -79.7799988, 205.0},
-	{7649, "Panama", "Santa Marta", 8.5166667, -82.6999969, 175.0},
-	{7650, "Panama", "La Esperanza", 8.4000000, -82.8000031, 1.0},
-	{7651, "Panama", "La Pintada", 8.6000000, -80.4499969, 69.0},
-	{7652, "Panama", "Rio Sereno", 8.8166667, -82.8666687, 910.0},
-	{7653, "Panama", "San Francisco", 8.0666667, -81.3666687, 69.0},
-	{7654, "Panama", "Santo Domingo", 7.7500000, -80.2500000, 49.0},
-	{7655, "Panama", "Montijo", 7.9922222, -81.0544434, 62.0},
-	{7656, "Panama", "La Palma", 8.4027778, -78.1452789, 15.0},
-	{7657, "Panama", "Quebrada Bonita Adentro", 9.3000000, -79.6999969, 91.0},
-	{7658, "Panama", "Escobal", 9.1472222, -79.9619446, 32.0},
-	{7659, "Panama", "San Vicente de Bique", 8.9166667, -79.6833344, 69.0},
-	{7660, "Panama", "Achutupo Numero Dos", 9.2000000, -77.9833298, -9999.0},
-	{7661, "Panama", "Santa Maria", 8.1166667, -80.6666641, 10.0},
-	{7662, "Panama", "Nuevo Emperador", 8.9988889, -79.7272186, 129.0},
-	{7663, "Panama", "El Espino", 8.8394444, -79.8499985, 75.0},
-	{7664, "Panama", "Churuquita Grande", 8.6000000, -80.2666702, 183.0},
-	{7665, "Papua New Guinea", "Port Moresby", -9.4647222, 147.1925049, 50.0},
-	{7666, "Papua New Guinea", "Lae", -6.7333333, 147.0000000, 5.0},
-	{7667, "Papua New Guinea", "Arawa", -6.2166667, 155.5500031, 6.0},
-	{7668, "Papua New Guinea", "Mount Hagen", -5.8666667, 144.2166595, 1997.0},
-	{7669, "Papua New Guinea", "Popondetta", -8.7666667, 148.2333374, 161.0},
-	{7670, "Papua New Guinea", "Madang", -5.2166667, 145.8000031, 1.0},
-	{7671, "Papua New Guinea", "Kokopo", -4.3500000, 152.2666626, 1.0},
-	{7672, "Papua New Guinea", "Mendi", -6.1500000, 143.6499939, 1850.0},
-	{7673, "Papua New Guinea", "Kimbe", -5.5500000, 150.1499939, 217.0},
-	{7674, "Papua New Guinea", "Goroka", -6.0833333, 145.3833313, 1515.0},
-	{7675, "Papua New Guinea", "Wewak", -3.5500000, 143.6333313, -9999.0},
-	{7676, "Papua New Guinea", "Bulolo", -7.2000000, 146.6499939, 794.0},
-	{7677, "Papua New Guinea", "Daru", -9.0833333, 143.1999969, 1.0},
-	{7678, "Papua New Guinea", "Wau", -7.3333333, 146.7166595, 1100.0},
-	{7679, "Papua New Guinea", "Kavieng", -2.5666667, 150.8000031, 1.0},
-	{7680, "Papua New Guinea", "Kiunga", -6.1166667, 141.3000031, 15.0},
-	{7681, "Papua New Guinea", "Vanimo", -2.6833333, 141.3000031, 1.0},
-	{7682, "Papua New Guinea", "Kundiawa", -6.0166667, 144.9666595, 1525.0},
-	{7683, "Papua New Guinea", "Kainantu", -6.2833333, 145.8666687, 1558.0},
-	{7684, "Papua New Guinea", "Rabaul", -4.2000000, 152.1833344, 12.0},
-	{7685, "Papua New Guinea", "Ialibu", -6.2833333, 143.9833374, 2005.0},
-	{7686, "Papua New Guinea", "Kokoda", -8.8833333, 147.7333374, 413.0},
-	{7687, "Papua New Guinea", "Lorengau", -2.0166667, 147.2666626, -9999.0},
-	{7688, "Papua New Guinea", "Kerema", -7.9666667, 145.7666626, 1.0},
-	{7689, "Papua New Guinea", "Aitape", -3.1333333, 142.3500061, -9999.0},
-	{7690, "Papua New Guinea", "Wabag", -5.4833333, 143.6999969, 2136.0},
-	{7691, "Papua New Guinea", "Kieta", -6.2166667, 155.6333313, 64.0},
-	{7692, "Papua New Guinea", "Panguna", -6.2500000, 155.5000000, 878.0},
-	{7693, "Papua New Guinea", "Morehead", -8.5500000, 141.6499939, 37.0},
-	{7694, "Papua New Guinea", "Ambunti", -4.2333333, 142.8333282, 1.0},
-	{7695, "Papua New Guinea", "Samarai", -10.6166667, 150.6666718, -9999.0},
-	{7696, "Papua New Guinea", "Angoram", -4.0666667, 144.0666656, 6.0},
-	{7697, "Papua New Guinea", "Porgera", -5.4666667, 143.1999969, 3279.0},
-	{7698, "Papua New Guinea", "Namatanai", -3.6666667, 152.4333344, 9.0},
-	{7699, "Papua New Guinea", "Finschhafen", -6.6000000, 147.8500061, -9999.0},
-	{7700, "Papua New Guinea", "Kandrian", -6.2166667, 149.5500031, 11.0},
-	{7701, "Papua New Guinea", "Laiagam", -5.2833333, 143.7666626, 1711.0},
-	{7702, "Papua New Guinea", "Buin", -6.8333333, 155.7333374, 1.0},
-	{7703, "Paraguay", "Asuncion", -25.2666667, -57.6666679, 55.0},
-	{7704, "Paraguay", "San Lorenzo", -25.3333333, -57.5333328, 146.0},
-	{7705, "Paraguay", "Capiata", -25.3500000, -57.4166679, 112.0},
-	{7706, "Paraguay", "Lambare", -25.3500000, -57.6500015, 48.0},
-	{7707, "Paraguay", "Fernando de la Mora", -25.3166667, -57.5999985, 144.0},
-	{7708, "Paraguay", "Limpio", -25.1833333, -57.5333328, 71.0},
-	{7709, "Paraguay", "Nemby", -25.3666667, -57.5999985, 131.0},
-	{7710, "Paraguay", "Encarnacion", -27.3333333, -55.9000015, 60.0},
-	{7711, "Paraguay", "Colonia Mariano Roque Alonso", -25.1666667, -57.5499992, 56.0},
-	{7712, "Paraguay", "Itaugua", -25.3833333, -57.3333321, 120.0},
-	{7713, "Paraguay", "Villa Elisa", -25.3666667, -57.6166649, 97.0},
-	{7714, "Paraguay", "San Antonio", -25.3833333, -57.6333351, 48.0},
-	{7715, "Paraguay", "Caaguazu", -25.4500000, -56.0166664, 324.0},
-	{7716, "Paraguay", "Presidente Franco", -25.5333333, -54.6166649, 201.0},
-	{7717, "Paraguay", "Coronel Oviedo", -25.4166667, -56.4500008, 171.0},
-	{7718, "Paraguay", "Concepcion", -23.4063889, -57.4344444, 44.0},
-	{7719, "Paraguay", "Villarrica", -25.7500000, -56.4333344, 126.0},
-	{7720, "Paraguay", "Caacupe", -25.3833333, -57.1500015, 188.0},
-	{7721, "Paraguay", "Ita", -25.4833333, -57.3499985, 131.0},
-	{7722, "Paraguay", "Ayolas", -27.4000000, -56.9000015, 46.0},
-	{7723, "Paraguay", "Santa Rita", -25.7833333, -55.0666656, 269.0},
-	{7724, "Paraguay", "Colonia General Alfredo Stroessner", -25.3666667, -55.7000008, 306.0},
-	{7725, "Paraguay", "Aregua", -25.3000000, -57.4166679, 142.0},
-	{7726, "Paraguay", "San Isidro de Curuguaty", -24.5166667, -55.7000008, 186.0},
-	{7727, "Paraguay", "Horqueta", -23.3427778, -57.0597229, 83.0},
-	{7728, "Paraguay", "Piribebuy", -25.4833333, -57.0499992, 271.0},
-	{7729, "Paraguay", "Paraguari", -25.6333333, -57.1500015, 90.0},
-	{7730, "Paraguay", "Tobati", -25.2500000, -57.0666656, 96.0},
-	{7731, "Paraguay", "Ypacarai", -25.3833333, -57.2666664, 56.0},
-	{7732, "Paraguay", "San Pedro de Ycuamandiyu", -24.1000000, -57.0833321, 65.0},
-	{7733, "Paraguay", "Capitan Bado", -23.2666667, -55.5333328, 513.0},
-	{7734, "Paraguay", "Guarambare", -25.4833333, -57.4666672, 115.0},
-	{7735, "Paraguay", "Eusebio Ayala", -25.4000000, -56.9666672, 131.0},
-	{7736, "Paraguay", "Filadelfia", -22.3500000, -60.0333328, 129.0},
-	{7737, "Paraguay", "San Juan Nepomuceno", -26.1000000, -55.9666672, 113.0},
-	{7738, "Paraguay", "Benjamin Aceval", -24.9666667, -57.5666656, 62.0},
-	{7739, "Paraguay", "Doctor Juan Leon Mallorquin", -25.4000000, -55.2666664, 264.0},
-	{7740, "Paraguay", "Salto del Guaira", -24.0500000, -54.3499985, 233.0},
-	{7741, "Paraguay", "Santa Rosa", -26.8666667, -56.8499985, 143.0},
-	{7742, "Paraguay", "Yaguaron", -25.6000000, -57.2999992, 62.0},
-	{7743, "Paraguay", "Repatriacion", -25.5333333, -55.9500008, 288.0},
-	{7744, "Paraguay", "Obligado", -27.0333333, -55.6333351, 172.0},
-	{7745, "Paraguay", "Emboscada", -25.1500000, -57.3499985, 131.0},
-	{7746, "Paraguay", "Carapegua", -25.8000000, -57.2333336, 195.0},
-	{7747, "Paraguay", "San Pedro del Parana", -26.8333333, -56.2000008, 133.0},
-	{7748, "Paraguay", "Bella Vista", -22.1333333, -56.5166664, 179.0},
-	{7749, "Paraguay", "Colonia Menno", -22.3666667, -59.8166656, 119.0},
-	{7750, "Paraguay", "Nanawa", -25.2666667, -57.6666679, 55.0},
-	{7751, "Paraguay", "Arquitecto Tomas Romero Pereira", -26.4833333, -55.2500000, 346.0},
-	{7752, "Paraguay", "Hohenau", -27.0833333, -55.7500000, 147.0},
-	{7753, "Paraguay", "Quiindy", -25.9666667, -57.2666664, 109.0},
-	{7754, "Paraguay", "Puerto Rosario", -24.4500000, -57.0833321, 62.0},
-	{7755, "Paraguay", "San Jose", -25.5333333, -56.7333336, 125.0},
-	{7756, "Paraguay", "San Alberto", -24.9666667, -54.9000015, 346.0},
-	{7757, "Paraguay", "Doctor Juan Manuel Frutos", -25.3666667, -55.7999992, 261.0},
-	{7758, "Paraguay", "Yuty", -26.6000000, -56.2500000, 113.0},
-	{7759, "Paraguay", "Atyra", -25.2500000, -57.1666679, 97.0},
-	{7760, "Paraguay", "Altos", -25.2333333, -57.2500000, 148.0},
-	{7761, "Paraguay", "Puerto Mayor Otano", -26.4000000, -54.7000008, 76.0},
-	{7762, "Paraguay", "San Bernardino", -25.2666667, -57.3166656, 59.0},
-	{7763, "Paraguay", "Ybycui", -26.0166667, -57.0499992, 122.0},
-	{7764, "Paraguay", "Iturbe", -26.0500000, -56.4833336, 92.0},
-	{7765, "Paraguay", "Las Palomas", -24.0833333, -54.5166664, 276.0},
-	{7766, "Paraguay", "Carmen del Parana", -27.2333333, -56.2166672, 99.0},
-	{7767, "Paraguay", "General Artigas", -26.9333333, -56.2166672, 118.0},
-	{7768, "Paraguay", "Colonia Catuete", -24.1333333, -54.6166649, 338.0},
-	{7769, "Paraguay", "Pirayu", -25.4833333, -57.2333336, 82.0},
-	{7770, "Paraguay", "Fram", -26.9833333, -55.8833351, 230.0},
-	{7771, "Paraguay", "Itacurubi del Rosario", -24.5000000, -56.6833344, 166.0},
-	{7772, "Paraguay", "Itacurubi de la Cordillera", -25.4500000, -56.8499985, 127.0},
-	{7773, "Paraguay", "Capiibary", -24.8000000, -56.0333328, 329.0},
-	{7774, "Paraguay", "Colonia Yguazu", -25.4500000, -55.0000000, 259.0},
-	{7775, "Paraguay", "Acahay", -25.9166667, -57.1500015, 165.0},
-	{7776, "Paraguay", "Itaquyry", -24.9333333, -55.2166672, 237.0},
-	{7777, "Paraguay", "Abai", -26.0333333, -55.9333344, 136.0},
-	{7778, "Paraguay", "General Elizardo Aquino", -24.4333333, -56.7000008, 154.0},
-	{7779, "Paraguay", "Nueva Italia", -25.6166667, -57.5000000, 130.0},
-	{7780, "Paraguay", "Trinidad", -27.1166667, -55.7833328, 152.0},
-	{7781, "Paraguay", "Colonia Neuland", -22.6666667, -60.1166649, 126.0},
-	{7782, "Paraguay", "Ygatimi", -24.0833333, -55.5000000, 154.0},
-	{7783, "Paraguay", "Los Cedrales", -25.6500000, -54.7000008, 222.0},
-	{7784, "Paraguay", "Antequera", -24.1333333, -57.0833321, 56.0},
-	{7785, "Paraguay", "La Colmena", -25.8833333, -56.8166656, 156.0},
-	{7786, "Paraguay", "Jesus", -27.0500000, -55.7833328, 167.0},
-	{7787, "Paraguay", "Capitan Miranda", -27.2000000, -55.7999992, 197.0},
-	{7788, "Paraguay", "Fuerte Olimpo", -21.0415286, -57.8737679, 65.0},
-	{7789, "Paraguay", "Carayao", -25.1833333, -56.4000015, 131.0},
-	{7790, "Paraguay", "Arroyos y Esteros", -25.0666667, -57.0999985, 92.0},
-	{7791, "Paraguay", "Tacuati", -23.4500000, -56.5833321, 103.0},
-	{7792, "Paraguay", "Colonia Mauricio Jose Troche", -25.5666667, -56.2833328, 179.0},
-	{7793, "Paraguay", "Bella Vista", -27.0500000, -55.5499992, 122.0},
-	{7794, "Paraguay", "Villa Florida", -26.3833333, -57.1500015, 64.0},
-	{7795, "Paraguay", "Mbocayaty", -25.7000000, -56.4000015, 118.0},
-	{7796, "Paraguay", "Caapucu", -26.2166667, -57.2000008, 106.0},
-	{7797, "Paraguay", "Natalio", -26.7166667, -55.0833321, 227.0},
-	{7798, "Paraguay", "Guayaybi", -24.5333333, -56.4333344, 275.0},
-	{7799, "Paraguay", "San Cosme y Damian", -27.3166667, -56.3499985, 78.0},
-	{7800, "Paraguay", "Mbuyapey", -26.2000000, -56.7500000, 89.0},
-	{7801, "Paraguay", "Naranjal", -25.9666667, -55.1833344, 269.0},
-	{7802, "Paraguay", "Santiago", -27.1500000, -56.7833328, 70.0},
-	{7803, "Peru", "Lima", -12.0500000, -77.0500031, 108.0},
-	{7804, "Peru", "Arequipa", -16.3988889, -71.5350037, 2397.0},
-	{7805, "Peru", "Callao", -12.0666667, -77.1500015, 1.0},
-	{7806, "Peru", "Trujillo", -8.1159943, -79.0299759, 36.0},
-	{7807, "Peru", "Chiclayo", -6.7736111, -79.8416672, 1.0},
-	{7808, "Peru", "Iquitos", -3.7480556, -73.2472229, 107.0},
-	{7809, "Peru", "Huancayo", -12.0666667, -75.2333298, 3496.0},
-	{7810, "Peru", "Piura", -5.2000000, -80.6333313, 25.0},
-	{7811, "Peru", "Chimbote", -9.0852778, -78.5783310, 1.0},
-	{7812, "Peru", "Cusco", -13.5183333, -71.9780579, 3360.0},
-	{7813, "Peru", "Pucallpa", -8.3791474, -74.5538635, 158.0},
-	{7814, "Peru", "Tacna", -18.0055556, -70.2483368, 601.0},
-	{7815, "Peru", "Ica", -14.0680556, -75.7255554, 421.0},
-	{7816, "Peru", "Juliaca", -15.5000000, -70.1333313, 3894.0},
-	{7817, "Peru", "Sullana", -4.9038889, -80.6852798, 67.0},
-	{7818, "Peru", "Chincha Alta", -13.4500000, -76.1333313, 72.0},
-	{7819, "Peru", "Huanuco", -9.9166667, -76.2333298, 1794.0},
-	{7820, "Peru", "Ayacucho", -13.1583333, -74.2238922, 2819.0},
-	{7821, "Peru", "Cajamarca", -7.1637775, -78.5002670, 2609.0},
-	{7822, "Peru", "Puno", -15.8333333, -70.0333328, 3792.0},
-	{7823, "Peru", "Tumbes", -3.5666667, -80.4413910, 8.0},
-	{7824, "Peru", "Talara", -4.5772222, -81.2719421, 14.0},
-	{7825, "Peru", "Chosica", -11.9430556, -76.7094421, 923.0},
-	{7826, "Peru", "Huaraz", -9.5333333, -77.5333328, 2829.0},
-	{7827, "Peru", "Cerro de Pasco", -10.6833333, -76.2666702, 4363.0},
-	{7828, "Peru", "Chulucanas", -5.0925000, -80.1624985, 120.0},
-	{7829, "Peru", "Huaral", -11.4950000, -77.2077789, 221.0},
-	{7830, "Peru", "Pisco", -13.7000000, -76.2166672, 3.0},
-	{7831, "Peru", "Catacaos", -5.2666667, -80.6833344, 11.0},
-	{7832, "Peru", "Paita", -5.0891667, -81.1144409, 1.0},
-	{7833, "Peru", "Abancay", -13.6338889, -72.8813858, 2690.0},
-	{7834, "Peru", "Huacho", -11.1066667, -77.6050034, 61.0},
-	{7835, "Peru", "Moquegua", -17.1955556, -70.9352798, 1481.0},
-	{7836, "Peru", "Ilo", -17.6394444, -71.3375015, 117.0},
-	{7837, "Peru", "Tingo Maria", -9.3000000, -75.9833298, 706.0},
-	{7838, "Peru", "Jaen", -5.7080556, -78.8047256, 840.0},
-	{7839, "Peru", "Tarma", -11.4197222, -75.6908340, 4058.0},
-	{7840, "Peru", "Barranca", -10.7500000, -77.7666702, 64.0},
-	{7841, "Peru", "Moyobamba", -6.0500000, -76.9666672, 760.0},
-	{7842, "Peru", "Lambayeque", -6.7011111, -79.9061127, 2.0},
-	{7843, "Peru", "Picsi", -6.7177778, -79.7697220, 60.0},
-	{7844, "Peru", "Chepen", -7.2166667, -79.4499969, 77.0},
-	{7845, "Peru", "Yurimaguas", -5.9000000, -76.0833359, 185.0},
-	{7846, "Peru", "Huancavelica", -12.7666667, -74.9833298, 3981.0},
-	{7847, "Peru", "Zana", -6.9222222, -79.5841675, 95.0},
-	{7848, "Peru", "Tambopata", -12.7333333, -69.1833344, 200.0},
-	{7849, "Peru", "Juanjui", -7.1833333, -76.7500000, 328.0},
-	{7850, "Peru", "Puerto Maldonado", -12.6000000, -69.1833344, 193.0},
-	{7851, "Peru", "La Union", -5.4000000, -80.7500000, 6.0},
-	{7852, "Peru", "Ferrenafe", -6.6388889, -79.7888870, 127.0},
-	{7853, "Peru", "Sicuani", -14.2694444, -71.2261124, 3716.0},
-	{7854, "Peru", "La Oroya", -11.5333333, -75.9000015, 3990.0},
-	{7855, "Peru", "Chocope", -7.7913889, -79.2216644, 119.0},
-	{7856, "Peru", "Imperial", -13.0666667, -76.3499985, 111.0},
-	{7857, "Peru", "Nuevo Imperial", -13.0833333, -76.3333359, 141.0},
-	{7858, "Peru", "Tambo Grande", -4.9269444, -80.3447189, 96.0},
-	{7859, "Peru", "Pacasmayo", -7.4005556, -79.5713882, 3.0},
-	{7860, "Peru", "Tocache Nuevo", -8.1841667, -76.5124969, 457.0},
-	{7861, "Peru", "Mollendo", -17.0230556, -72.0147247, 1.0},
-	{7862, "Peru", "Chaupimarca", -10.4333333, -76.5333328, 3456.0},
-	{7863, "Peru", "Paramonga", -10.6666667, -77.8333359, 41.0},
-	{7864, "Peru", "La Peca", -5.6111111, -78.4349976, 1143.0},
-	{7865, "Peru", "Chancay", -11.5713889, -77.2672195, 77.0},
-	{7866, "Peru", "Hualmay", -11.0963889, -77.6138916, 40.0},
-	{7867, "Peru", "Monsefu", -6.8780556, -79.8722229, 1.0},
-	{7868, "Peru", "Marcavelica", -4.8777778, -80.7052765, 65.0},
-	{7869, "Peru", "San Vicente de Canete", -13.0833333, -76.4000015, 36.0},
-	{7870, "Peru", "Querecotillo", -4.8377778, -80.6455536, 76.0},
-	{7871, "Peru", "Guadalupe", -7.2500000, -79.4833298, 83.0},
-	{7872, "Peru", "Santa Ana", -12.8666667, -72.7166672, 857.0},
-	{7873, "Peru", "Rioja", -6.0586111, -77.1650009, 789.0},
-	{7874, "Peru", "Laredo", -8.1000000, -78.9499969, 83.0},
-	{7875, "Peru", "Nazca", -14.8333333, -74.9499969, 595.0},
-	{7876, "Peru", "Yanacancha", -10.2411111, -76.6455536, 3986.0},
-	{7877, "Peru", "Sechura", -5.5569444, -80.8222198, 1.0},
-	{7878, "Peru", "Moche", -8.1711111, -79.0091629, 109.0},
-	{7879, "Peru", "Santiago de Cao", -7.9588889, -79.2391663, 1.0},
-	{7880, "Peru", "Huamachuco", -7.8000000, -78.0666656, 3072.0},
-	{7881, "Peru", "Paijan", -7.7333333, -79.3022232, 117.0},
-	{7882, "Peru", "Jauja", -11.8000000, -75.5000000, 3860.0},
-	{7883, "Peru", "Viru", -8.4166667, -78.7500000, 65.0},
-	{7884, "Peru", "Huaura", -11.0700000, -77.5994415, 42.0},
-	{7885, "Peru", "Bagua Grande", -5.7561111, -78.4411087, 746.0},
-	{7886, "Peru", "Chachapoyas", -6.2316882, -77.8690338, 1851.0},
-	{7887, "Peru", "Ayaviri", -14.8863889, -70.5888901, 3919.0},
-	{7888, "Peru", "Huanta", -12.9333333, -74.2500000, 2943.0},
-	{7889, "Peru", "Uchiza", -8.4591667, -76.4633331, 615.0},
-	{7890, "Peru", "La Breita", -4.2608333, -80.8874969, 291.0},
-	{7891, "Peru", "Andahuaylas", -13.6555556, -73.3872223, 3052.0},
-	{7892, "Peru", "Mala", -12.6580556, -76.6308365, 130.0},
-	{7893, "Peru", "Zarumilla", -3.5030556, -80.2730560, 35.0},
-	{7894, "Peru", "Camana", -16.6227778, -72.7111130, 52.0},
-	{7895, "Peru", "San Pedro de Lloc", -7.4327778, -79.5058365, 1.0},
-	{7896, "Peru", "Huarmey", -10.0680556, -78.1522217, 105.0},
-	{7897, "Peru", "Yunguyo", -16.2500000, -69.0833359, 3826.0},
-	{7898, "Peru", "Chongoyape", -6.6405556, -79.3891678, 53.0},
-	{7899, "Peru", "Ilave", -16.0833333, -69.6666641, 3844.0},
-	{7900, "Peru", "San Clemente", -13.6666667, -76.1500015, 72.0},
-	{7901, "Peru", "Pimentel", -6.8366667, -79.9341660, 1.0},
-	{7902, "Peru", "Satipo", -11.2522222, -74.6386108, 627.0},
-	{7903, "Philippines", "Manila", 14.6041667, 120.9822235, 4.0},
-	{7904, "Philippines", "Davao", 7.0730556, 125.6127777, 3.0},
-	{7905, "Philippines", "Cebu City", 10.3111111, 123.8916702, 27.0},
-	{7906, "Philippines", "Antipolo", 14.5863889, 121.1752777, 200.0},
-	{7907, "Philippines", "Zamboanga", 6.9102778, 122.0738907, 2.0},
-	{7908, "Philippines", "Mansilingan", 10.6311111, 122.9788895, 50.0},
-	{7909, "Philippines", "Cagayan de Oro", 8.4822222, 124.6472244, 8.0},
-	{7910, "Philippines", "Dasmarinas", 14.3294444, 120.9366684, 78.0},
-	{7911, "Philippines", "Iloilo", 10.6969444, 122.5644455, -9999.0},
-	{7912, "Philippines", "San Jose del Monte", 14.8138889, 121.0452805, 48.0},
-	{7913, "Philippines", "Bacoor", 14.4577778, 120.9424973, 5.0},
-	{7914, "Philippines", "Calamba", 14.2116667, 121.1652756, 29.0},
-	{7915, "Philippines", "Santol", 15.1622222, 120.5674973, 120.0},
-	{7916, "Philippines", "Mandaue City", 10.3236111, 123.9222260, 6.0},
-	{7917, "Philippines", "Cainta", 14.5800000, 121.1169434, 38.0},
-	{7918, "Philippines", "Baguio", 16.4163889, 120.5930557, 1448.0},
-	{7919, "Philippines", "San Pedro", 14.3647222, 121.0555573, 16.0},
-	{7920, "Philippines", "Mantampay", 8.1666667, 124.2166672, 248.0},
-	{7921, "Philippines", "San Fernando", 15.0302778, 120.6855545, 16.0},
-	{7922, "Philippines", "Libertad", 8.9441667, 125.5019455, 17.0},
-	{7923, "Philippines", "Lapu-Lapu City", 10.3102778, 123.9494476, 1.0},
-	{7924, "Philippines", "Batangas", 13.7594444, 121.0599976, 13.0},
-	{7925, "Philippines", "Taytay", 14.5691667, 121.1324997, 37.0},
-	{7926, "Philippines", "Lucena", 13.9313889, 121.6172256, 15.0},
-	{7927, "Philippines", "Cabanatuan", 15.4869444, 120.9674988, 34.0},
-	{7928, "Philippines", "Olongapo", 14.8291667, 120.2827759, 17.0},
-	{7929, "Philippines", "Binangonan", 14.4655556, 121.1919479, 15.0},
-	{7930, "Philippines", "Santa Rosa", 14.3122222, 121.1113892, 13.0},
-	{7931, "Philippines", "Imus", 14.4297222, 120.9366684, 16.0},
-	{7932, "Philippines", "Lipa", 13.9394444, 121.1738892, 288.0},
-	{7933, "Philippines", "San Pablo", 14.0669444, 121.3258362, 101.0},
-	{7934, "Philippines", "Malolos", 14.8419444, 120.8116684, 9.0},
-	{7935, "Philippines", "Panalanoy", 11.2511111, 125.0063858, 1.0},
-	{7936, "Philippines", "Mabalacat", 15.2233333, 120.5791702, 96.0},
-	{7937, "Philippines", "Meycauayan", 14.7369444, 120.9608307, 12.0},
-	{7938, "Philippines", "Tarlac", 15.4888889, 120.5986099, 42.0},
-	{7939, "Philippines", "Cotabato", 7.2236111, 124.2463913, 4.0},
-	{7940, "Philippines", "Puerto Princesa", 9.7391667, 118.7352753, 8.0},
-	{7941, "Philippines", "Toledo", 10.3791667, 123.6419449, 24.0},
-	{7942, "Philippines", "Guyong", 14.8386111, 120.9797211, 19.0},
-	{7943, "Philippines", "Naga", 13.6191667, 123.1813889, 3.0},
-	{7944, "Philippines", "Marawi", 7.9986111, 124.2927780, 700.0},
-	{7945, "Philippines", "Legaspi Port", 13.1466667, 123.7558365, 5.0},
-	{7946, "Philippines", "Kabankalan", 9.9888889, 122.8122253, 12.0},
-	{7947, "Philippines", "Baliuag", 14.9547222, 120.8969421, 24.0},
-	{7948, "Philippines", "Rodriguez", 14.7325000, 121.1449966, 49.0},
-	{7949, "Philippines", "San Mateo", 14.6969444, 121.1219406, 47.0},
-	{7950, "Philippines", "Talisay", 10.2447222, 123.8494415, 5.0},
-	{7951, "Philippines", "Muricay", 7.8263889, 123.4797211, 11.0},
-	{7952, "Philippines", "Bulaon", 15.0883333, 120.6672211, 27.0},
-	{7953, "Philippines", "Cadiz Viejo", 10.9505556, 123.2855530, 14.0},
-	{7954, "Philippines", "Koronadal", 6.5030556, 124.8469467, 50.0},
-	{7955, "Philippines", "Hagonoy", 14.8325000, 120.7333298, 8.0},
-	{7956, "Philippines", "Silang", 14.2305556, 120.9749985, 299.0},
-	{7957, "Philippines", "Digos", 6.7497222, 125.3572235, 5.0},
-	{7958, "Philippines", "Cavite", 14.4825000, 120.9169464, -9999.0},
-	{7959, "Philippines", "Tuguegarao City", 17.6130556, 121.7269440, 58.0},
-	{7960, "Philippines", "Dumaguete", 9.3102778, 123.3080521, 13.0},
-	{7961, "Philippines", "Santiago", 16.6880800, 121.5486984, 78.0},
-	{7962, "Philippines", "Santa Cruz", 14.2786111, 121.4155579, 15.0},
-	{7963, "Philippines", "Tanza", 14.6752778, 120.9388885, 2.0},
-	{7964, "Philippines", "Roxas City", 11.5852778, 122.7511139, 8.0},
-	{7965, "Philippines", "Laoag", 18.1988889, 120.5936127, 18.0},
-	{7966, "Philippines", "Urdaneta", 15.9761111, 120.5711136, 23.0},
-	{7967, "Philippines", "Jolo", 6.0522222, 121.0022202, 21.0},
-	{7968, "Philippines", "Bocaue", 14.7983333, 120.9261093, 14.0},
-	{7969, "Philippines", "General Trias", 14.3869444, 120.8816681, 21.0},
-	{7970, "Philippines", "Los Banos", 14.1788889, 121.2255554, 28.0},
-	{7971, "Philippines", "Iriga", 13.4250000, 123.4177780, 27.0},
-	{7972, "Philippines", "Dipolog", 8.5894444, 123.3413925, -9999.0},
-	{7973, "Philippines", "Ozamis", 8.1458333, 123.8444443, 2.0},
-	{7974, "Philippines", "Ormoc", 11.0063889, 124.6074982, 1.0},
-	{7975, "Philippines", "Calumpit", 14.9163889, 120.7658310, 8.0},
-	{7976, "Philippines", "Mangaldan", 16.0691667, 120.4063873, 32.0},
-	{7977, "Philippines", "Arayat", 15.1508333, 120.7730560, 9.0},
-	{7978, "Philippines", "Surigao", 9.7838889, 125.4888916, 6.0},
-	{7979, "Philippines", "Angono", 14.5255556, 121.1497192, 19.0},
-	{7980, "Philippines", "Victorias City", 10.9000000, 123.0777740, 5.0},
-	{7981, "Philippines", "Tagbilaran", 9.6475000, 123.8555527, -9999.0},
-	{7982, "Philippines", "Panabo", 7.3080556, 125.6841660, 3.0},
-	{7983, "Philippines", "Mariveles", 14.4336111, 120.4852753, 13.0},
-	{7984, "Philippines", "Binonga", 10.7730556, 122.9827805, 12.0},
-	{7985, "Philippines", "Valencia", 7.9063889, 125.0941696, 270.0},
-	{7986, "Philippines", "Marilao", 14.7577778, 120.9483337, 8.0},
-	{7987, "Philippines", "San Fernando", 16.6186111, 120.3194427, 72.0},
-	{7988, "Philippines", "Sorsogon", 12.9738889, 123.9933319, 13.0},
-	{7989, "Philippines", "Kidapawan", 7.0083333, 125.0894470, 263.0},
-	{7990, "Philippines", "Norzagaray", 14.9105556, 121.0488892, 56.0},
-	{7991, "Philippines", "Gapan", 15.3075000, 120.9452744, 19.0},
-	{7992, "Philippines", "Daet", 14.1127778, 122.9558334, 8.0},
-	{7993, "Philippines", "Apalit", 14.9533333, 120.7699966, 9.0},
-	{7994, "Philippines", "Pulilan", 14.9016667, 120.8491669, 16.0},
-	{7995, "Philippines", "Bago", 10.5377778, 122.8333359, 1.0},
-	{7996, "Philippines", "Guiguinto", 14.8333333, 120.8833313, 11.0},
-	{7997, "Philippines", "Kawit", 14.4455556, 120.9049988, 3.0},
-	{7998, "Philippines", "Balanga", 14.6761111, 120.5361099, 23.0},
-	{7999, "Philippines", "Mati", 6.9527778, 126.2158356, 7.0},
-	{8000, "Philippines", "Ilagan", 17.1480556, 121.8908310, 63.0},
-	{8001, "Philippines", "Sagay", 10.9447222, 123.4241638, 5.0},
-	{8002, "Philippines", "Capas", 15.3275000, 120.5908356, 43.0},
-	{8003, "Poland", "Warsaw", 52.2500000, 21.0000000, 94.0},
-	{8004, "Poland", "Lodz", 51.7500000, 19.4666672, 198.0},
-	{8005, "Poland", "Krakow", 50.0833333, 19.9166660, 233.0},
-	{8006, "Poland", "Wroclaw", 51.1000000, 17.0333328, 118.0},
-	{8007, "Poland", "Poznan", 52.4166667, 16.9666672, 50.0},
-	{8008, "Poland", "Gdansk", 54.3500000, 18.6666660, 15.0},
-	{8009, "Poland", "Szczecin", 53.4293784, 14.5529366, 1.0},
-	{8010, "Poland", "Bydgoszcz", 53.1500000, 18.0000000, 65.0},
-	{8011, "Poland", "Lublin", 51.2500000, 22.5666676, 170.0},
-	{8012, "Poland", "Katowice", 50.2666667, 19.0166664, 293.0},
-	{8013, "Poland", "Bialystok", 53.1333333, 23.1499996, 168.0},
-	{8014, "Poland", "Gdynia", 54.5000000, 18.5499992, 34.0},
-	{8015, "Poland", "Czestochowa", 50.8000000, 19.1166668, 257.0},
-	{8016, "Poland", "Sosnowiec", 50.3000000, 19.1666660, 331.0},
-	{8017, "Poland", "Radom", 51.4166667, 21.1499996, 158.0},
-	{8018, "Poland", "Torun", 53.0333333, 18.6000004, 80.0},
-	{8019, "Poland", "Kielce", 50.8333333, 20.6666660, 262.0},
-	{8020, "Poland", "Gliwice", 50.2833333, 18.6666660, 226.0},
-	{8021, "Poland", "Zabrze", 50.3166667, 18.7833328, 257.0},
-	{8022, "Poland", "Bytom", 50.3500000, 18.9666672, 269.0},
-	{8023, "Poland", "Bielsko-Biala", 49.8166667, 19.0333328, 361.0},
-	{8024, "Poland", "Olsztyn", 53.7833333, 20.4833336, 147.0},
-	{8025, "Poland", "Rzeszow", 50.0500000, 22.0000000, 206.0},
-	{8026, "Poland", "Ruda Slaska", 50.3166667, 18.8500004, 253.0},
-	{8027, "Poland", "Rybnik", 50.1018634, 18.5466385, 237.0},
-	{8028, "Poland", "Nowe Tychy", 50.1500000, 19.0000000, 266.0},
-	{8029, "Poland", "Dabrowa Gornicza", 50.3333333, 19.2000008, 288.0},
-	{8030, "Poland", "Opole", 50.6666667, 17.9500008, 177.0},
-	{8031, "Poland", "Elblag", 54.1666667, 19.3833332, 10.0},
-	{8032, "Poland", "Plock", 52.5500000, 19.7000008, 95.0},
-	{8033, "Poland", "Walbrzych", 50.7666667, 16.2833328, 465.0},
-	{8034, "Poland", "Gorzow Wielkopolski", 52.7333333, 15.2333336, 18.0},
-	{8035, "Poland", "Wloclawek", 52.6500000, 19.0333328, 50.0},
-	{8036, "Poland", "Zielona Gora", 51.9333333, 15.5000000, 166.0},
-	{8037, "Poland", "Tarnow", 50.0166667, 20.9833336, 213.0},
-	{8038, "Poland", "Chorzow", 50.3000000, 18.9666672, 274.0},
-	{8039, "Poland", "Kalisz", 51.7500000, 18.0833340, 141.0},
-	{8040, "Poland", "Koszalin", 54.2000000, 16.1833324, 32.0},
-	{8041, "Poland", "Legnica", 51.2000000, 16.2000008, 109.0},
-	{8042, "Poland", "Grudziadz", 53.4833333, 18.7666664, 28.0},
-	{8043, "Poland", "Slupsk", 54.4500000, 17.0333328, 21.0},
-	{8044, "Poland", "Jaworzno", 50.2053079, 19.2749977, 342.0},
-	{8045, "Poland", "Jastrzebie Zdroj", 49.9500000, 18.5833340, 255.0},
-	{8046, "Poland", "Jelenia Gora", 50.9008333, 15.7252779, 342.0},
-	{8047, "Poland", "Nowy Sacz", 49.6333333, 20.7166672, 337.0},
-	{8048, "Poland", "Konin", 52.2166667, 18.2666664, 88.0},
-	{8049, "Poland", "Piotrkow Trybunalski", 51.4000000, 19.6833324, 206.0},
-	{8050, "Poland", "Inowroclaw", 52.8000000, 18.2666664, 76.0},
-	{8051, "Poland", "Lubin", 51.4000000, 16.2000008, 128.0},
-	{8052, "Poland", "Siedlce", 52.1666667, 22.2999992, 160.0},
-	{8053, "Poland", "Pila", 53.1500000, 16.7500000, 55.0},
-	{8054, "Poland", "Myslowice", 50.2422648, 19.1392136, 272.0},
-	{8055, "Poland", "Ostrowiec Swietokrzyski", 50.9333333, 21.3999996, 166.0},
-	{8056, "Poland", "Siemianowice Slaskie", 50.3000000, 19.0333328, 267.0},
-	{8057, "Poland", "Ostrow Wielkopolski", 51.6500000, 17.8166676, 139.0},
-	{8058, "Poland", "Stargard Szczecinski", 53.3333333, 15.0500002, 21.0},
-	{8059, "Poland", "Pabianice", 51.6666667, 19.3666668, 183.0},
-	{8060, "Poland", "Gniezno", 52.5500000, 17.6000004, 120.0},
-	{8061, "Poland", "Suwalki", 54.0994444, 22.9330559, 175.0},
-	{8062, "Poland", "Glogow", 51.6661673, 16.0844994, 90.0},
-	{8063, "Poland", "Chelm", 51.1333333, 23.5000000, 199.0},
-	{8064, "Poland", "Tomaszow Mazowiecki", 51.5333333, 20.0166664, 160.0},
-	{8065, "Poland", "Przemysl", 49.7833333, 22.7833328, 194.0},
-	{8066, "Poland", "Stalowa Wola", 50.5666667, 22.0499992, 155.0},
-	{8067, "Poland", "Zamosc", 50.7166667, 23.2500000, 223.0},
-	{8068, "Poland", "Kedzierzyn-Kozle", 50.3500000, 18.2000008, 168.0},
-	{8069, "Poland", "Lomza", 53.1833333, 22.0833340, 99.0},
-	{8070, "Poland", "Leszno", 51.8500000, 16.5833340, 90.0},
-	{8071, "Poland", "Zory", 50.0500000, 18.7000008, 259.0},
-	{8072, "Poland", "Belchatow", 51.3666667, 19.3833332, 216.0},
-	{8073, "Poland", "Mielec", 50.2833333, 21.4166660, 171.0},
-	{8074, "Poland", "Tarnowskie Gory", 50.4500000, 18.8666668, 275.0},
-	{8075, "Poland", "Swidnica", 50.8500000, 16.5000000, 209.0},
-	{8076, "Poland", "Tczew", 54.1000000, 18.7999992, 3.0},
-	{8077, "Poland", "Piekary Slaskie", 50.4000000, 18.9500008, 258.0},
-	{8078, "Poland", "Raciborz", 50.0833333, 18.2000008, 189.0},
-	{8079, "Poland", "Bedzin", 50.3236556, 19.1322613, 293.0},
-	{8080, "Poland", "Zgierz", 51.8500000, 19.4166660, 195.0},
-	{8081, "Poland", "Biala Podlaska", 52.0333333, 23.1333332, 158.0},
-	{8082, "Poland", "Elk", 53.8288257, 22.3645592, 144.0},
-	{8083, "Poland", "Swietochlowice", 50.2833333, 18.9166660, 285.0},
-	{8084, "Poland", "Pruszkow", 52.1716156, 20.8063889, 89.0},
-	{8085, "Poland", "Ostroleka", 53.0833333, 21.5666676, 92.0},
-	{8086, "Poland", "Starachowice", 51.0666667, 21.0666676, 259.0},
-	{8087, "Poland", "Zawiercie", 50.5000000, 19.4333324, 354.0},
-	{8088, "Poland", "Legionowo", 52.4000000, 20.9333324, 71.0},
-	{8089, "Poland", "Tarnobrzeg", 50.5833333, 21.6833324, 151.0},
-	{8090, "Poland", "Pulawy", 51.4166667, 21.9666672, 151.0},
-	{8091, "Poland", "Wodzislaw Slaski", 50.0000000, 18.4666672, 264.0},
-	{8092, "Poland", "Skarzysko-Kamienna", 51.1166667, 20.8999996, 237.0},
-	{8093, "Poland", "Radomsko", 51.0666667, 19.4500008, 236.0},
-	{8094, "Poland", "Skierniewice", 51.9666667, 20.1499996, 122.0},
-	{8095, "Poland", "Kutno", 52.2333333, 19.3666668, 98.0},
-	{8096, "Poland", "Starogard Gdanski", 53.9666667, 18.5499992, 95.0},
-	{8097, "Poland", "Nysa Zamlmie", 50.4666667, 17.3166676, 189.0},
-	{8098, "Poland", "Krosno", 49.6833333, 21.7833328, 267.0},
-	{8099, "Poland", "Debica", 50.0500000, 21.4166660, 217.0},
-	{8100, "Poland", "Wejherowo", 54.6000000, 18.2333336, 55.0},
-	{8101, "Poland", "Ciechanow", 52.8833333, 20.6166668, 119.0},
-	{8102, "Poland", "Rumia", 54.5833333, 18.3999996, 22.0},
-	{8103, "Portugal", "Lisbon", 38.7166667, -9.1333332, 56.0},
-	{8104, "Portugal", "Porto", 41.1500000, -8.6166668, 74.0},
-	{8105, "Portugal", "Amadora", 38.7538155, -9.2308331, 103.0},
-	{8106, "Portugal", "Braga", 41.5500000, -8.4333334, 168.0},
-	{8107, "Portugal", "Setubal", 38.5243986, -8.8881969, 1.0},
-	{8108, "Portugal", "Coimbra", 40.2000000, -8.4166670, 44.0},
-	{8109, "Portugal", "Queluz", 38.7500000, -9.2500000, 161.0},
-	{8110, "Portugal", "Funchal", 32.6333333, -16.8999996, -9999.0},
-	{8111, "Portugal", "Cacem", 38.7666667, -9.3000002, 173.0},
-	{8112, "Portugal", "Vila Nova de Gaia", 41.1333333, -8.6166668, 91.0},
-	{8113, "Portugal", "Loures", 38.8309147, -9.1684504, 23.0},
-	{8114, "Portugal", "Evora", 38.5666667, -7.9000001, 247.0},
-	{8115, "Portugal", "Rio de Mouro", 38.7666667, -9.3333330, 142.0},
-	{8116, "Portugal", "Odivelas", 38.7833333, -9.1833334, 86.0},
-	{8117, "Portugal", "Aveiro", 40.6333333, -8.6499996, 26.0},
-	{8118, "Portugal", "Amora", 38.6166667, -9.1166668, 24.0},
-	{8119, "Portugal", "Corroios", 38.6333333, -9.1499996, 26.0},
-	{8120, "Portugal", "Barreiro", 38.6666667, -9.0666666, -9999.0},
-	{8121, "Portugal", "Monsanto", 39.4666667, -8.7166672, 192.0},
-	{8122, "Portugal", "Rio Tinto", 41.1833333, -8.5666666, 81.0},
-	{8123, "Portugal", "Sao Domingos de Rana", 38.7000000, -9.3333330, 39.0},
-	{8124, "Portugal", "Leiria", 39.7500000, -8.8000002, 79.0},
-	{8125, "Portugal", "Ponte do Lima", 41.7666667, -8.5833330, 29.0},
-	{8126, "Portugal", "Faro", 37.0166667, -7.9333334, 12.0},
-	{8127, "Portugal", "Sesimbra", 38.4445141, -9.1014862, 42.0},
-	{8128, "Portugal", "Guimaraes", 41.4500000, -8.3000002, 198.0},
-	{8129, "Portugal", "Ermesinde", 41.2166667, -8.5500002, 113.0},
-	{8130, "Portugal", "Portimao", 37.1385614, -8.5377502, 16.0},
-	{8131, "Portugal", "Cascais", 38.6979034, -9.4214630, 7.0},
-	{8132, "Portugal", "Maia", 41.2333333, -8.6166668, 77.0},
-	{8133, "Portugal", "Esposende", 41.5333333, -8.7833328, -9999.0},
-	{8134, "Portugal", "Almada", 38.6833333, -9.1499996, 1.0},
-	{8135, "Portugal", "Castelo Branco", 39.8166667, -7.5000000, 384.0},
-	{8136, "Portugal", "Alcabideche", 38.7333333, -9.4166670, 119.0},
-	{8137, "Portugal", "Camara de Lobos", 32.6333333, -16.9666672, -9999.0},
-	{8138, "Portugal", "Arrentela", 38.6166667, -9.1000004, 36.0},
-	{8139, "Portugal", "Montijo", 38.7000000, -8.9666672, -9999.0},
-	{8140, "Portugal", "Santarem", 39.2333333, -8.6833334, 15.0},
-	{8141, "Portugal", "Olhao", 37.0333333, -7.8333335, 8.0},
-	{8142, "Portugal", "Povoa de Varzim", 41.3833333, -8.7666664, -9999.0},
-	{8143, "Portugal", "Senhora da Hora", 41.1833333, -8.6499996, 83.0},
-	{8144, "Portugal", "Marinha Grande", 39.7500000, -8.9333334, 114.0},
-	{8145, "Portugal", "Povoa de Santa Iria", 38.8500000, -9.0666666, 1.0},
-	{8146, "Portugal", "Sequeira", 40.5500000, -7.2333331, 815.0},
-	{8147, "Portugal", "Matosinhos", 41.1833333, -8.6999998, -9999.0},
-	{8148, "Portugal", "Gondomar", 41.1500000, -8.5333328, 164.0},
-	{8149, "Portugal", "Aguas Santas", 41.2000000, -8.5833330, 138.0},
-	{8150, "Portugal", "Vila do Conde", 41.3500000, -8.7500000, 17.0},
-	{8151, "Portugal", "Caldas da Rainha", 39.4000000, -9.1333332, 110.0},
-	{8152, "Portugal", "Canidelo", 41.1166667, -8.6499996, 83.0},
-	{8153, "Portugal", "Viseu", 40.6500000, -7.9166665, 483.0},
-	{8154, "Portugal", "Sintra", 38.8000000, -9.3833332, 272.0},
-	{8155, "Portugal", "Paco de Arcos", 38.6956928, -9.2914295, 1.0},
-	{8156, "Portugal", "Sao Mamede de Infesta", 41.2000000, -8.6000004, 113.0},
-	{8157, "Portugal", "Torres Vedras", 39.1000000, -9.2666664, 24.0},
-	{8158, "Portugal", "Oliveira do Douro", 41.1166667, -8.5833330, 26.0},
-	{8159, "Portugal", "Fanzeres", 41.1666667, -8.5333328, 166.0},
-	{8160, "Portugal", "Charneca", 38.6166667, -9.1833334, 55.0},
-	{8161, "Portugal", "Estoril", 38.7057069, -9.3977308, 24.0},
-	{8162, "Portugal", "Carnaxide", 38.7166667, -9.2500000, 90.0},
-	{8163, "Portugal", "Pinhal Novo", 38.6333333, -8.9166670, 42.0},
-	{8164, "Portugal", "Monte Estoril", 38.7027933, -9.4160557, 13.0},
-	{8165, "Portugal", "Pontinha", 38.7666667, -9.1999998, 110.0},
-	{8166, "Portugal", "Loule", 37.1333333, -8.0333328, 89.0},
-	{8167, "Portugal", "Sao Joao da Madeira", 40.9022905, -8.4896851, 204.0},
-	{8168, "Portugal", "Bougado", 41.3333333, -8.5500002, 99.0},
-	{8169, "Portugal", "Valongo", 41.1833333, -8.5000000, 184.0},
-	{8170, "Portugal", "Caparica", 38.6666667, -9.1999998, 101.0},
-	{8171, "Portugal", "Belas", 38.7666667, -9.2666664, 177.0},
-	{8172, "Portugal", "Linda-a-Velha", 38.7166667, -9.2500000, 90.0},
-	{8173, "Portugal", "Laranjeiro", 37.0666667, -7.8000002, 51.0},
-	{8174, "Portugal", "Carcavelos", 38.6833333, -9.3333330, 17.0},
-	{8175, "Portugal", "Camarate", 38.8000000, -9.1333332, 85.0},
-	{8176, "Portugal", "Ponta Delgada", 37.7333333, -25.6666660, -9999.0},
-	{8177, "Portugal", "Entroncamento", 39.4666667, -8.4666672, 49.0},
-	{8178, "Portugal", "Moncao", 42.0789226, -8.4807587, 28.0},
-	{8179, "Portugal", "Cova da Piedade", 38.6666667, -9.1499996, 1.0},
-	{8180, "Portugal", "Custoias", 41.1000000, -7.3166666, 624.0},
-	{8181, "Portugal", "Alges", 38.7000000, -9.2333336, 11.0},
-	{8182, "Portugal", "Tomar", 39.6000000, -8.4166670, 107.0},
-	{8183, "Portugal", "Barcelos", 41.5333333, -8.6166668, 24.0},
-	{8184, "Portugal", "Pedroso", 41.4166667, -8.7500000, 54.0},
-	{8185, "Portugal", "Vilar de Andorinho", 41.1000000, -8.5666666, 81.0},
-	{8186, "Portugal", "Sao Joao da Talha", 38.8166667, -9.0833330, 2.0},
-	{8187, "Portugal", "Lagos", 37.1020150, -8.6742210, 1.0},
-	{8188, "Portugal", "Quarteira", 37.0666667, -8.1000004, 31.0},
-	{8189, "Portugal", "Santa Iria da Azoia", 38.8500000, -9.0833330, 1.0},
-	{8190, "Portugal", "Ovar", 40.8666667, -8.6333332, 21.0},
-	{8191, "Portugal", "Vila Franca de Xira", 38.9500000, -8.9833336, -9999.0},
-	{8192, "Portugal", "Leca da Palmeira", 41.2000000, -8.6999998, 18.0},
-	{8193, "Portugal", "Moita", 38.6500000, -8.9833336, 29.0},
-	{8194, "Portugal", "Sacavem", 38.7833333, -9.1000004, 1.0},
-	{8195, "Portugal", "Covilha", 40.2833333, -7.5000000, 491.0},
-	{8196, "Portugal", "Ramada", 38.8000000, -9.1833334, 38.0},
-	{8197, "Portugal", "Pombal", 39.9166667, -8.6333332, 76.0},
-	{8198, "Portugal", "Ilhavo", 40.6000000, -8.6666670, 16.0},
-	{8199, "Portugal", "Parede", 38.6833333, -9.3500004, 1.0},
-	{8200, "Portugal", "Sao Pedro da Cova", 41.1500000, -8.5166664, 116.0},
-	{8201, "Portugal", "Vila Real", 41.3000000, -7.7500000, 431.0},
-	{8202, "Qatar", "Doha", 25.2866667, 51.5333328, 21.0},
-	{8203, "Qatar", "Ar Rayyan", 25.2919444, 51.4244461, 23.0},
-	{8204, "Qatar", "Umm Salal Muhammad", 25.4169444, 51.4038887, 21.0},
-	{8205, "Qatar", "Al Wakrah", 25.1677778, 51.6069450, 14.0},
-	{8206, "Qatar", "Al Khawr", 25.6838889, 51.5058327, 1.0},
-	{8207, "Qatar", "Dukhan", 25.4297222, 50.7858315, 36.0},
-	{8208, "Qatar", "Al Wukayr", 25.1508333, 51.5377769, 12.0},
-	{8209, "Qatar", "Umm Bab", 25.2141667, 50.8072205, 47.0},
-	{8210, "Qatar", "Al Ghuwayriyah", 25.8297222, 51.2452774, 24.0},
-	{8211, "Qatar", "Al Jumayliyah", 25.6094444, 51.0922203, 33.0},
-	{8212, "Qatar", "Fuwayrit", 26.0216667, 51.3669434, 3.0},
-	{8213, "Qatar", "Abu Samrah", 24.7483333, 50.8400002, -9999.0},
-	{8214, "Qatar", "Al Kharrarah", 24.9044444, 51.1749992, 43.0},
-	{8215, "Romania", "Bucharest", 44.4333333, 26.1000004, 71.0},
-	{8216, "Romania", "Iasi", 47.1666667, 27.6000004, 84.0},
-	{8217, "Romania", "Cluj-Napoca", 46.7666667, 23.6000004, 335.0},
-	{8218, "Romania", "Timisoara", 45.7494444, 21.2272224, 91.0},
-	{8219, "Romania", "Craiova", 44.3166667, 23.7999992, 108.0},
-	{8220, "Romania", "Constanta", 44.1833333, 28.6499996, 1.0},
-	{8221, "Romania", "Galati", 45.4500000, 28.0499992, 2.0},
-	{8222, "Romania", "Brasov", 45.6486084, 25.6061268, 629.0},
-	{8223, "Romania", "Ploiesti", 44.9500000, 26.0166664, 161.0},
-	{8224, "Romania", "Braila", 45.2666667, 27.9833336, 4.0},
-	{8225, "Romania", "Oradea", 47.0666667, 21.9333324, 148.0},
-	{8226, "Romania", "Bacau", 46.5666667, 26.8999996, 160.0},
-	{8227, "Romania", "Arad", 46.1833333, 21.3166676, 109.0},
-	{8228, "Romania", "Pitesti", 44.8500000, 24.8666668, 290.0},
-	{8229, "Romania", "Sibiu", 45.8000000, 24.1499996, 401.0},
-	{8230, "Romania", "Targu-Mures", 46.5500000, 24.5666676, 368.0},
-	{8231, "Romania", "Baia Mare", 47.6533050, 23.5794926, 233.0},
-	{8232, "Romania", "Baia Mare", 47.6572942, 23.5680771, 228.0},
-	{8233, "Romania", "Buzau", 45.1500000, 26.8333340, 83.0},
-	{8234, "Romania", "Botosani", 47.7500000, 26.6666660, 163.0},
-	{8235, "Romania", "Satu Mare", 47.8000000, 22.8833332, 98.0},
-	{8236, "Romania", "Ramnicu Valcea", 45.1000000, 24.3666668, 179.0},
-	{8237, "Romania", "Suceava", 47.6333333, 26.2500000, 385.0},
-	{8238, "Romania", "Focsani", 45.7000000, 27.1833324, 49.0},
-	{8239, "Romania", "Piatra Neamt", 46.9166667, 26.3333340, 522.0},
-	{8240, "Romania", "Drobeta-Turnu Severin", 44.6319444, 22.6561108, 61.0},
-	{8241, "Romania", "Targu Jiu", 45.0500000, 23.2833328, 194.0},
-	{8242, "Romania", "Tulcea", 45.1666667, 28.7999992, 35.0},
-	{8243, "Romania", "Targoviste", 44.9333333, 25.4500008, 286.0},
-	{8244, "Romania", "Bistrita", 47.1333333, 24.4833336, 329.0},
-	{8245, "Romania", "Resita", 45.3008333, 21.8891659, 258.0},
-	{8246, "Romania", "Slatina", 44.4333333, 24.3666668, 165.0},
-	{8247, "Romania", "Vaslui", 46.6333333, 27.7333336, 78.0},
-	{8248, "Romania", "Calarasi", 44.2000000, 27.3333340, 14.0},
-	{8249, "Romania", "Hunedoara", 45.7500000, 22.8999996, 287.0},
-	{8250, "Romania", "Giurgiu", 43.8833333, 25.9666672, 11.0},
-	{8251, "Romania", "Roman", 46.9166667, 26.9166660, 199.0},
-	{8252, "Romania", "Birlad", 46.2333333, 27.6666660, 69.0},
-	{8253, "Romania", "Deva", 45.8833333, 22.8999996, 202.0},
-	{8254, "Romania", "Alba Iulia", 46.0666667, 23.5833340, 203.0},
-	{8255, "Romania", "Zalau", 47.2000000, 23.0499992, 263.0},
-	{8256, "Romania", "Sfantu-Gheorghe", 45.8666667, 25.7833328, 537.0},
-	{8257, "Romania", "Turda", 46.5666667, 23.7833328, 318.0},
-	{8258, "Romania", "Medias", 46.1666667, 24.3500004, 268.0},
-	{8259, "Romania", "Slobozia", 44.5666667, 27.3666668, 27.0},
-	{8260, "Romania", "Alexandria", 43.9833333, 25.3333340, 34.0},
-	{8261, "Romania", "Petrosani", 45.4166667, 23.3666668, 689.0},
-	{8262, "Romania", "Medgidia", 44.2500000, 28.2833328, 9.0},
-	{8263, "Romania", "Lugoj", 45.6886111, 21.9030552, 120.0},
-	{8264, "Romania", "Pascani", 47.2500000, 26.7333336, 190.0},
-	{8265, "Romania", "Miercurea-Ciuc", 46.3500000, 25.7999992, 664.0},
-	{8266, "Romania", "Tecuci", 45.8666667, 27.4166660, 29.0},
-	{8267, "Romania", "Sighetu Marmatiei", 47.9333333, 23.8833332, 269.0},
-	{8268, "Romania", "Mangalia", 43.8000000, 28.5833340, 1.0},
-	{8269, "Romania", "Rimnicu Sarat", 45.3833333, 27.0499992, 107.0},
-	{8270, "Romania", "Dej", 47.1500000, 23.8666668, 217.0},
-	{8271, "Romania", "Odorheiu Secuiesc", 46.3000000, 25.2999992, 521.0},
-	{8272, "Romania", "Mioveni", 44.9500000, 24.9500008, 418.0},
-	{8273, "Romania", "Reghin-Sat", 46.7833333, 24.7166672, 366.0},
-	{8274, "Romania", "Fagaras", 45.8500000, 24.9666672, 416.0},
-	{8275, "Romania", "Caracal", 44.1166667, 24.3500004, 106.0},
-	{8276, "Romania", "Navodari", 44.3166667, 28.6000004, 31.0},
-	{8277, "Romania", "Fetesti", 44.3833333, 27.8333340, 25.0},
-	{8278, "Romania", "Curtea de Arges", 45.1333333, 24.6833324, 543.0},
-	{8279, "Romania", "Sighisoara", 46.2166667, 24.7999992, 385.0},
-	{8280, "Romania", "Dorohoi", 47.9500000, 26.3999996, 165.0},
-	{8281, "Romania", "Rosiori de Vede", 44.1166667, 24.9833336, 77.0},
-	{8282, "Romania", "Lupeni", 45.3500000, 23.2333336, 823.0},
-	{8283, "Romania", "Voluntari", 44.4666667, 26.1333332, 57.0},
-	{8284, "Romania", "Sacele", 45.6173994, 25.6942749, 634.0},
-	{8285, "Romania", "Falticeni", 47.4500000, 26.2999992, 309.0},
-	{8286, "Romania", "Husi", 46.6736111, 28.0594444, 71.0},
-	{8287, "Romania", "Vulcan", 45.3833333, 23.2666664, 637.0},
-	{8288, "Romania", "Turnu Magurele", 43.7500000, 24.8666668, 30.0},
-	{8289, "Romania", "Aiud", 46.3000000, 23.7166672, 228.0},
-	{8290, "Romania", "Caransebes", 45.4166667, 22.2166672, 198.0},
-	{8291, "Romania", "Sebes", 45.9666667, 23.5666676, 258.0},
-	{8292, "Romania", "Radauti", 47.8500000, 25.9166660, 368.0},
-	{8293, "Romania", "Borsa", 47.6500000, 24.6666660, 761.0},
-	{8294, "Romania", "Cimpia Turzii", 46.5500000, 23.8833332, 287.0},
-	{8295, "Romania", "Oltenita", 44.0833333, 26.6333332, 7.0},
-	{8296, "Romania", "Petrila", 45.4500000, 23.4166660, 717.0},
-	{8297, "Romania", "Cugir", 45.8333333, 23.3666668, 439.0},
-	{8298, "Romania", "Codlea", 45.7000000, 25.4500008, 546.0},
-	{8299, "Romania", "Moinesti", 46.4666667, 26.4833336, 493.0},
-	{8300, "Romania", "Gherla", 47.0333333, 23.9166660, 257.0},
-	{8301, "Romania", "Comanesti", 46.4166667, 26.4333324, 402.0},
-	{8302, "Romania", "Carei", 47.6833333, 22.4666672, 130.0},
-	{8303, "Romania", "Motru", 44.8033333, 22.9719448, 192.0},
-	{8304, "Romania", "Orastie", 45.8333333, 23.2000008, 246.0},
-	{8305, "Romania", "Buftea", 44.5613889, 25.9488888, 90.0},
-	{8306, "Romania", "Dragasani", 44.6500000, 24.2666664, 158.0},
-	{8307, "Romania", "Bals", 44.3500000, 24.1000004, 136.0},
-	{8308, "Romania", "Moreni", 44.9833333, 25.6499996, 317.0},
-	{8309, "Romania", "Tirgu Neamt", 47.2000000, 26.3666668, 338.0},
-	{8310, "Romania", "Blaj", 46.1833333, 23.9166660, 256.0},
-	{8311, "Romania", "Tirgu Secuiesc", 46.0000000, 26.1333332, 583.0},
-	{8312, "Romania", "Corabia", 43.7833333, 24.5000000, 54.0},
-	{8313, "Romania", "Baicoi", 45.0333333, 25.8500004, 284.0},
-	{8314, "Russia", "Moscow", 55.7522222, 37.6155548, 151.0},
-	{8315, "Russia", "Saint Petersburg", 59.8944444, 30.2641659, 5.0},
-	{8316, "Russia", "Novosibirsk", 55.0411111, 82.9344406, 145.0},
-	{8317, "Russia", "Yekaterinburg", 56.8575000, 60.6124992, 278.0},
-	{8318, "Russia", "Nizhniy Novgorod", 56.3286733, 44.0020485, 135.0},
-	{8319, "Russia", "Samara", 53.2000000, 50.1500015, 128.0},
-	{8320, "Russia", "Omsk", 55.0000000, 73.4000015, 81.0},
-	{8321, "Russia", "Rostov-na-Donu", 47.2363889, 39.7138901, 86.0},
-	{8322, "Russia", "Chelyabinsk", 55.1544444, 61.4297218, 237.0},
-	{8323, "Russia", "Ufa", 54.7750000, 56.0374985, 102.0},
-	{8324, "Russia", "Volgograd", 48.8047222, 44.5858345, 52.0},
-	{8325, "Russia", "Krasnoyarsk", 56.0097222, 92.7916641, 232.0},
-	{8326, "Russia", "Saratov", 51.5666667, 46.0333328, 145.0},
-	{8327, "Russia", "Voronezh", 51.6663889, 39.1699982, 155.0},
-	{8328, "Russia", "Krasnodar", 45.0327778, 38.9769440, 36.0},
-	{8329, "Russia", "Izhevsk", 56.8500000, 53.2333336, 133.0},
-	{8330, "Russia", "Barnaul", 53.3600000, 83.7600021, 190.0},
-	{8331, "Russia", "Vladivostok", 43.1056202, 131.8735352, 5.0},
-	{8332, "Russia", "Irkutsk", 52.2977778, 104.2963867, 426.0},
-	{8333, "Russia", "Khabarovsk", 48.4808333, 135.0927734, 81.0},
-	{8334, "Russia", "Khabarovsk Vtoroy", 48.4430556, 135.1355591, 49.0},
-	{8335, "Russia", "Orenburg", 51.7847222, 55.0986099, 107.0},
-	{8336, "Russia", "Novokuznetsk", 53.7500000, 87.0999985, 250.0},
-	{8337, "Russia", "Lipetsk", 52.6186111, 39.5688896, 178.0},
-	{8338, "Russia", "Penza", 53.1958333, 45.0000000, 164.0},
-	{8339, "Russia", "Naberezhnyye Chelny", 55.7561111, 52.4288902, 132.0},
-	{8340, "Russia", "Makhachkala", 42.9752778, 47.5022240, -10.0},
-	{8341, "Russia", "Tomsk", 56.5000000, 84.9666672, 115.0},
-	{8342, "Russia", "Kemerovo", 55.3333333, 86.0833359, 131.0},
-	{8343, "Russia", "Tula", 54.2044444, 37.6111107, 171.0},
-	{8344, "Russia", "Kirov", 58.5969444, 49.6583328, 179.0},
-	{8345, "Russia", "Cheboksary", 56.1322222, 47.2519455, 140.0},
-	{8346, "Russia", "Kaliningrad", 54.7100000, 20.5000000, 15.0},
-	{8347, "Russia", "Bryansk", 53.2875000, 34.3805542, 151.0},
-	{8348, "Russia", "Ivanovo", 56.9941667, 40.9858322, 119.0},
-	{8349, "Russia", "Magnitogorsk", 53.4186111, 59.0472221, 352.0},
-	{8350, "Russia", "Kursk", 51.7302778, 36.1938896, 184.0},
-	{8351, "Russia", "Nizhniy Tagil", 57.9194444, 59.9650002, 207.0},
-	{8352, "Russia", "Ulan-Ude", 51.8333333, 107.6166687, 531.0},
-	{8353, "Russia", "Belgorod", 50.6100000, 36.5800018, 189.0},
-	{8354, "Russia", "Kurgan", 55.4500000, 65.3333359, 72.0},
-	{8355, "Russia", "Kaluga", 54.5358333, 36.2705574, 214.0},
-	{8356, "Russia", "Sochi", 43.6000000, 39.7302780, 65.0},
-	{8357, "Russia", "Orel", 52.9658333, 36.0802765, 182.0},
-	{8358, "Russia", "Volzhskiy", 48.7905556, 44.7716675, 23.0},
-	{8359, "Russia", "Smolensk", 54.7816667, 32.0400009, 243.0},
-	{8360, "Russia", "Murmansk", 68.9716667, 33.0819435, 19.0},
-	{8361, "Russia", "Vladikavkaz", 43.0366667, 44.6677780, 681.0},
-	{8362, "Russia", "Cherepovets", 59.1333333, 37.9000015, 138.0},
-	{8363, "Russia", "Vladimir", 56.1427778, 40.3980560, 143.0},
-	{8364, "Russia", "Chita", 52.0333333, 113.5500031, 873.0},
-	{8365, "Russia", "Saransk", 54.1833333, 45.1833344, 145.0},
-	{8366, "Russia", "Surgut", 61.2500000, 73.4166641, 37.0},
-	{8367, "Russia", "Vologda", 59.2166667, 39.9000015, 108.0},
-	{8368, "Russia", "Tambov", 52.7316667, 41.4338875, 132.0},
-	{8369, "Russia", "Yoshkar-Ola", 56.6387667, 47.8907776, 99.0},
-	{8370, "Russia", "Taganrog", 47.2213889, 38.9094429, 29.0},
-	{8371, "Russia", "Kostroma", 57.7708333, 40.9344444, 112.0},
-	{8372, "Russia", "Sterlitamak", 53.6330556, 55.9463882, 148.0},
-	{8373, "Russia", "Petrozavodsk", 61.7849134, 34.3469086, 73.0},
-	{8374, "Russia", "Bratsk", 56.1325000, 101.6141663, 434.0},
-	{8375, "Russia", "Dzerzhinsk", 56.2388889, 43.4630547, 95.0},
-	{8376, "Russia", "Orsk", 51.2252778, 58.5699997, 200.0},
-	{8377, "Russia", "Nizhnevartovsk", 60.9333333, 76.5666656, 45.0},
-	{8378, "Russia", "Angarsk", 52.5300000, 103.8799973, 431.0},
-	{8379, "Russia", "Novorossiysk", 44.7238889, 37.7708321, 8.0},
-	{8380, "Russia", "Yakutsk", 62.0338889, 129.7330627, 126.0},
-	{8381, "Russia", "Nizhnekamsk", 55.6383333, 51.8169441, 121.0},
-	{8382, "Russia", "Syktyvkar", 61.6666667, 50.8122215, 154.0},
-	{8383, "Russia", "Staryy Oskol", 51.2966667, 37.8416672, 137.0},
-	{8384, "Russia", "Groznyy", 43.3083333, 45.7002792, 131.0},
-	{8385, "Russia", "Shakhty", 47.7122222, 40.2058334, 126.0},
-	{8386, "Russia", "Blagoveshchensk", 50.2666667, 127.5333328, 133.0},
-	{8387, "Russia", "Rybinsk", 58.0500000, 38.8333321, 107.0},
-	{8388, "Russia", "Zelenograd", 55.9825000, 37.1813889, 229.0},
-	{8389, "Russia", "Biysk", 52.5666667, 85.2500000, 230.0},
-	{8390, "Russia", "Velikiy Novgorod", 58.5166667, 31.2833328, 32.0},
-	{8391, "Russia", "Pskov", 57.8333333, 28.3333340, 39.0},
-	{8392, "Russia", "Balakovo", 52.0246617, 47.7941055, 23.0},
-	{8393, "Russia", "Armavir", 44.9800000, 41.1199989, 213.0},
-	{8394, "Russia", "Severodvinsk", 64.5722222, 39.8474998, 8.0},
-	{8395, "Russia", "Zlatoust", 55.1711111, 59.6508331, 466.0},
-	{8396, "Russia", "Petropavlovsk-Kamchatskiy", 53.0166667, 158.6499939, 108.0},
-	{8397, "Saint Kitts and Nevis", "Kingstown", 13.1333333, -61.2166672, 1.0},
-	{8398, "Saint Kitts and Nevis", "Kingstown Park", 13.1583333, -61.2350006, 1.0},
-	{8399, "Saint Kitts and Nevis", "Georgetown", 13.2666667, -61.1166649, 25.0},
-	{8400, "Saint Kitts and Nevis", "Byera Village", 13.2500000, -61.1166649, 1.0},
-	{8401, "Saint Kitts and Nevis", "Biabou", 13.2000000, -61.1333351, -9999.0},
-	{8402, "Saint Kitts and Nevis", "Port Elizabeth", 13.0166667, -61.2333336, 66.0},
-	{8403, "Saint Kitts and Nevis", "Chateaubelair", 13.2833333, -61.2500000, 190.0},
-	{8404, "Saint Kitts and Nevis", "Dovers", 12.8833333, -61.1833344, 32.0},
-	{8405, "El Salvador", "San Salvador", 13.7086111, -89.2030563, 699.0},
-	{8406, "El Salvador", "Soyapango", 13.7347222, -89.1513901, 601.0},
-	{8407, "El Salvador", "Santa Ana", 13.9941667, -89.5597229, 655.0},
-	{8408, "El Salvador", "San Miquel", 13.4833333, -88.1833344, 130.0},
-	{8409, "El Salvador", "Mejicanos", 13.7402778, -89.2130585, 713.0},
-	{8410, "El Salvador", "Nueva San Salvador", 13.6769444, -89.2797241, 921.0},
-	{8411, "El Salvador", "Apopa", 13.8072222, -89.1791687, 405.0},
-	{8412, "El Salvador", "Delgado", 13.7241667, -89.1702805, 599.0},
-	{8413, "El Salvador", "Sonsonate", 13.7188889, -89.7241669, 245.0},
-	{8414, "El Salvador", "San Marcos", 13.6588889, -89.1830521, 809.0},
-	{8415, "El Salvador", "Usulutan", 13.3500000, -88.4499969, 92.0},
-	{8416, "El Salvador", "Cojutepeque", 13.7166667, -88.9333344, 836.0},
-	{8417, "El Salvador", "Cuscatancingo", 13.7361111, -89.1813889, 603.0},
-	{8418, "El Salvador", "Zacatecoluca", 13.5000000, -88.8666687, 149.0},
-	{8419, "El Salvador", "San Martin", 13.7833333, -88.9166641, 603.0},
-	{8420, "El Salvador", "Ilopango", 13.7016667, -89.1094437, 602.0},
-	{8421, "El Salvador", "San Vicente", 13.6333333, -88.8000031, 574.0},
-	{8422, "El Salvador", "Ahuachapan", 13.9213889, -89.8450012, 770.0},
-	{8423, "El Salvador", "Antiguo Cuscatlan", 13.6730556, -89.2408371, 850.0},
-	{8424, "El Salvador", "Chalchuapa", 13.9866667, -89.6811142, 678.0},
-	{8425, "El Salvador", "Quezaltepeque", 13.8350000, -89.2744446, 407.0},
-	{8426, "El Salvador", "La Union", 13.3369444, -87.8438873, 10.0},
-	{8427, "El Salvador", "Ayutuxtepeque", 13.7455556, -89.2063904, 647.0},
-	{8428, "El Salvador", "Acajutla", 13.5927778, -89.8274994, 8.0},
-	{8429, "El Salvador", "Aguilares", 13.9572222, -89.1897202, 300.0},
-	{8430, "El Salvador", "Sensuntepeque", 13.8666667, -88.6333313, 704.0},
-	{8431, "El Salvador", "Chalatenango", 14.0333333, -88.9333344, 397.0},
-	{8432, "El Salvador", "Izalco", 13.7447222, -89.6730576, 442.0},
-	{8433, "El Salvador", "Metapan", 14.3333333, -89.4499969, 478.0},
-	{8434, "El Salvador", "San Rafael Oriente", 13.3833333, -88.3499985, 199.0},
-	{8435, "El Salvador", "Puerto El Triunfo", 13.2833333, -88.5500031, 20.0},
-	{8436, "El Salvador", "La Libertad", 13.4883333, -89.3222198, 31.0},
-	{8437, "El Salvador", "San Francisco", 13.7000000, -88.0999985, 302.0},
-	{8438, "El Salvador", "Sonzacate", 13.7341667, -89.7147217, 281.0},
-	{8439, "El Salvador", "Santiago de Maria", 13.4833333, -88.4666672, 936.0},
-	{8440, "El Salvador", "Armenia", 13.7436111, -89.4988861, 538.0},
-	{8441, "El Salvador", "Santo Tomas", 13.6408333, -89.1333313, 700.0},
-	{8442, "El Salvador", "Santa Rosa de Lima", 13.6247222, -87.8936081, 93.0},
-	{8443, "El Salvador", "Zaragoza", 13.5894444, -89.2888870, 607.0},
-	{8444, "El Salvador", "Berlin", 13.5000000, -88.5333328, 1021.0},
-	{8445, "El Salvador", "Guazapa", 13.8769444, -89.1730576, 406.0},
-	{8446, "El Salvador", "Jucuapa", 13.5166667, -88.3833313, 471.0},
-	{8447, "El Salvador", "Ciudad Arce", 13.8402778, -89.4472198, 553.0},
-	{8448, "El Salvador", "Nueva Concepcion", 14.1333333, -89.3000031, 339.0},
-	{8449, "El Salvador", "Juayua", 13.8413889, -89.7455521, 1023.0},
-	{8450, "El Salvador", "Santiago Nonualco", 13.5166667, -88.9499969, 137.0},
-	{8451, "El Salvador", "El Transito", 13.3500000, -88.3499985, 100.0},
-	{8452, "El Salvador", "Atiquizaya", 13.9769444, -89.7525024, 599.0},
-	{8453, "El Salvador", "San Antonio del Monte", 13.7163889, -89.7383347, 213.0},
-	{8454, "El Salvador", "Jiquilisco", 13.3166667, -88.5833359, 38.0},
-	{8455, "El Salvador", "El Congo", 13.9088889, -89.4958344, 800.0},
-	{8456, "El Salvador", "Chinameca", 13.5000000, -88.3499985, 753.0},
-	{8457, "El Salvador", "Ciudad Barrios", 13.7666667, -88.2666702, 807.0},
-	{8458, "El Salvador", "Concepcion de Ataco", 13.8702778, -89.8486099, 1196.0},
-	{8459, "El Salvador", "Nahuizalco", 13.7775000, -89.7366638, 565.0},
-	{8460, "El Salvador", "San Sebastian", 13.7333333, -88.8333359, 664.0},
-	{8461, "El Salvador", "San Juan Opico", 13.8761111, -89.3597260, 514.0},
-	{8462, "El Salvador", "Panchimalco", 13.6127778, -89.1800003, 600.0},
-	{8463, "El Salvador", "Nuevo Cuscatlan", 13.6486111, -89.2652740, 970.0},
-	{8464, "El Salvador", "Chirilagua", 13.2202778, -88.1386108, 343.0},
-	{8465, "El Salvador", "Olocuilta", 13.5697222, -89.1172256, 495.0},
-	{8466, "El Salvador", "Candelaria de La Frontera", 14.1166667, -89.6500015, 764.0},
-	{8467, "El Salvador", "Tonacatepeque", 13.7811111, -89.1186142, 605.0},
-	{8468, "El Salvador", "Santa Elena", 13.3833333, -88.4166641, 170.0},
-	{8469, "El Salvador", "Nueva Guadalupe", 13.5333333, -88.3499985, 502.0},
-	{8470, "El Salvador", "Apastepeque", 13.6666667, -88.7833328, 602.0},
-	{8471, "El Salvador", "Coatepeque", 13.9286111, -89.5041656, 730.0},
-	{8472, "El Salvador", "Suchitoto", 13.9380556, -89.0277786, 309.0},
-	{8473, "El Salvador", "El Rosario", 13.4977778, -89.0297241, 92.0},
-	{8474, "El Salvador", "Guatajiagua", 13.6666667, -88.1999969, 332.0},
-	{8475, "El Salvador", "Rosario de Mora", 13.5752778, -89.2088852, 509.0},
-	{8476, "El Salvador", "San Alejo", 13.4313889, -87.9630585, 190.0},
-	{8477, "El Salvador", "Tacuba", 13.9011111, -89.9297256, 611.0},
-	{8478, "El Salvador", "Jocoro", 13.6166667, -88.0166702, 268.0},
-	{8479, "El Salvador", "Ozatlan", 13.3833333, -88.5000000, 207.0},
-	{8480, "El Salvador", "Intipuca", 13.1969444, -88.0544434, 102.0},
-	{8481, "El Salvador", "Concepcion Batres", 13.3500000, -88.3666687, 77.0},
-	{8482, "El Salvador", "Corinto", 13.8108333, -87.9713898, 841.0},
-	{8483, "El Salvador", "Chapeltique", 13.6333333, -88.2666702, 180.0},
-	{8484, "El Salvador", "Conchagua", 13.3077778, -87.8647232, 294.0},
-	{8485, "El Salvador", "San Pablo Tacachico", 13.9755556, -89.3399963, 303.0},
-	{8486, "El Salvador", "Tecoluca", 13.7891667, -89.0052795, 744.0},
-	{8487, "El Salvador", "Anamoros", 13.7405556, -87.8736115, 167.0},
-	{8488, "El Salvador", "San Pedro Masahuat", 13.5436111, -89.0386124, 196.0},
-	{8489, "El Salvador", "Lolotique", 13.5500000, -88.3499985, 511.0},
-	{8490, "El Salvador", "Nueva Esparta", 13.7836111, -87.8386078, 312.0},
-	{8491, "El Salvador", "Cacaopera", 13.7666667, -88.0833359, 552.0},
-	{8492, "El Salvador", "Texistepeque", 14.1333333, -89.5000000, 399.0},
-	{8493, "El Salvador", "San Agustin", 13.4333333, -88.5999985, 265.0},
-	{8494, "El Salvador", "Pasaquina", 13.5844444, -87.8411102, 51.0},
-	{8495, "El Salvador", "Moncagua", 13.5333333, -88.2500000, 231.0},
-	{8496, "El Salvador", "Victoria", 13.9500000, -88.6333313, 772.0},
-	{8497, "El Salvador", "Jujutla", 13.7869444, -89.8572235, 566.0},
-	{8498, "El Salvador", "Sociedad", 13.7000000, -88.0166702, 392.0},
-	{8499, "El Salvador", "Jucuaran", 13.2538889, -88.2477798, 690.0},
-	{8500, "El Salvador", "El Paisnal", 13.9736111, -89.2186127, 337.0},
-	{8501, "El Salvador", "Guaymango", 13.7502778, -89.8422241, 395.0},
-	{8502, "El Salvador", "Sesori", 13.7166667, -88.3666687, 228.0},
-	{8503, "El Salvador", "San Francisco Menendez", 13.8430556, -90.0158310, 252.0},
-	{8504, "El Salvador", "Tenancingo", 13.8333333, -88.9833298, 598.0},
-	{8505, "Samoa", "Apia", -13.8333333, -171.7333374, -9999.0},
-	{8506, "Samoa", "Vaitele", -13.8166667, -171.7833405, 1.0},
-	{8507, "Samoa", "Faleula", -13.8000000, -171.8000031, -9999.0},
-	{8508, "Samoa", "Siusega", -13.8333333, -171.8166656, 48.0},
-	{8509, "Samoa", "Malie", -13.8000000, -171.8166656, 1.0},
-	{8510, "Samoa", "Fasitoouta", -13.8166667, -171.9166718, 90.0},
-	{8511, "Samoa", "Vaiusu", -13.8166667, -171.7666626, 1.0},
-	{8512, "Samoa", "Afenga", -13.8000000, -171.8166656, 1.0},
-	{8513, "Samoa", "Nofoalii", -13.8166667, -171.9166718, 90.0},
-	{8514, "Samoa", "Solosolo", -13.8500000, -171.6000061, -9999.0},
-	{8515, "Samoa", "Leulumoega", -13.8166667, -171.9166718, 90.0},
-	{8516, "Samoa", "Satapuala", -13.8333333, -171.9666595, 60.0},
-	{8517, "Samoa", "Falefa", -13.8833333, -171.5500031, -9999.0},
-	{8518, "Samoa", "Matavai", -14.0166667, -171.6166687, 33.0},
-	{8519, "Samoa", "Safotu", -13.4500000, -172.3999939, 31.0},
-	{8520, "Samoa", "Gataivai", -13.7500000, -172.3833313, 44.0},
-	{8521, "Samoa", "Lotofaga", -14.0166667, -171.5166626, 163.0},
-	{8522, "Samoa", "Lufilufi", -13.8500000, -171.5833282, -9999.0},
-	{8523, "Samoa", "Levi", -13.9166667, -172.0000000, 57.0},
-	{8524, "Samoa", "Sapulu", -13.8000000, -171.8833313, 28.0},
-	{8525, "Samoa", "Samatau", -13.9000000, -172.0333405, -9999.0},
-	{8526, "Samoa", "Tufulele", -13.8000000, -171.8833313, 28.0},
-	{8527, "Samoa", "Sapapalii", -13.6833333, -172.1166687, -9999.0},
-	{8528, "Samoa", "Sili", -13.7166667, -172.3500061, 232.0},
-	{8529, "Samoa", "Matautu", -13.9500000, -171.9333344, 83.0},
-	{8530, "Samoa", "Faleatiu", -13.8166667, -171.9333344, 79.0},
-	{8531, "Samoa", "Samalaeulu", -13.4666667, -172.2666626, -9999.0},
-	{8532, "Samoa", "Toamua", -13.8000000, -171.7833405, 1.0},
-	{8533, "Samoa", "Sagone", -13.6500000, -172.5833282, 733.0},
-	{8534, "Samoa", "Salepoua`e", -13.8000000, -171.8500061, 9.0},
-	{8535, "Samoa", "Sataua", -13.4666667, -172.6666718, -9999.0},
-	{8536, "Samoa", "Vaialoa", -13.7333333, -172.2833405, 98.0},
-	{8537, "Samoa", "Fusi", -14.0000000, -171.8000031, -9999.0},
-	{8538, "Samoa", "Vaigaga", -13.8166667, -171.8000031, 1.0},
-	{8539, "Samoa", "Lano", -13.6000000, -172.1333313, -9999.0},
-	{8540, "Samoa", "Saipipi", -13.6166667, -172.1333313, -9999.0},
-	{8541, "Samoa", "Lalomanu", -14.0000000, -171.3999939, -9999.0},
-	{8542, "Samoa", "Samusu", -13.9666667, -171.3666687, -9999.0},
-	{8543, "Samoa", "Taga", -13.7666667, -172.4666595, 59.0},
-	{8544, "Samoa", "Siutu", -13.6833333, -172.5500031, 640.0},
-	{8545, "Samoa", "Saleilua", -14.0166667, -171.6999969, 23.0},
-	{8546, "Samoa", "Vaisala", -13.4666667, -172.6166687, -9999.0},
-	{8547, "Samoa", "Patamea", -13.4833333, -172.2666626, -9999.0},
-	{8548, "Samoa", "Saleaula", -13.4333333, -172.3333282, -9999.0},
-	{8549, "Samoa", "Falepuna", -13.8666667, -171.5666656, -9999.0},
-	{8550, "Samoa", "Vaovai", -14.0166667, -171.6499939, 57.0},
-	{8551, "Samoa", "Auala", -13.4666667, -172.6000061, -9999.0},
-	{8552, "Samoa", "Sasina", -13.4500000, -172.4333344, 1.0},
-	{8553, "Samoa", "Falevao", -13.9000000, -171.5333405, -9999.0},
-	{8554, "Samoa", "Pata", -13.9166667, -171.9833374, 116.0},
-	{8555, "Samoa", "Saleaaumua", -13.9666667, -171.3666687, -9999.0},
-	{8556, "Samoa", "Letogo", -13.8333333, -171.6999969, -9999.0},
-	{8557, "Samoa", "Salani", -14.0000000, -171.5666656, 194.0},
-	{8558, "Samoa", "Satitoa", -13.9833333, -171.3666687, -9999.0},
-	{8559, "Samoa", "Saasaai", -13.6166667, -172.1333313, -9999.0},
-	{8560, "Samoa", "Vaijee`itai", -14.0000000, -171.8166656, -9999.0},
-	{8561, "Samoa", "Saaga", -14.0166667, -171.7833405, -9999.0},
-	{8562, "Samoa", "Aufaga", -14.0166667, -171.4666595, 319.0},
-	{8563, "Samoa", "Mulivai", -14.0166667, -171.8000031, -9999.0},
-	{8564, "Samoa", "Faiaai", -13.6333333, -172.6333313, 560.0},
-	{8565, "Samoa", "Vaipua", -13.6333333, -172.6166687, 659.0},
-	{8566, "Samoa", "Falelima", -13.5333333, -172.6833344, 292.0},
-	{8567, "Samoa", "Salelesi", -13.8666667, -171.5833282, -9999.0},
-	{8568, "Samoa", "Sapunaoa", -14.0000000, -171.5666656, 194.0},
-	{8569, "Samoa", "Lalomauga", -13.9000000, -171.5666656, 254.0},
-	{8570, "Samoa", "Tafua", -13.7833333, -172.2166595, 1.0},
-	{8571, "Samoa", "Lalomalava", -13.7000000, -172.1333313, -9999.0},
-	{8572, "Samoa", "Matatufu", -14.0166667, -171.5333405, 161.0},
-	{8573, "Samoa", "Savaia", -13.9333333, -171.9333344, 225.0},
-	{8574, "Samoa", "Tafitoala", -14.0166667, -171.8000031, -9999.0},
-	{8575, "Samoa", "Aopo", -13.4833333, -172.5000000, 14.0},
-	{8576, "Samoa", "Vavau", -14.0166667, -171.4833374, 278.0},
-	{8577, "Samoa", "Fogasavaii", -13.6333333, -172.6000061, 976.0},
-	{8578, "Samoa", "Poutasi", -14.0166667, -171.6833344, 46.0},
-	{8579, "Samoa", "Malua", -13.8000000, -171.8833313, 28.0},
-	{8580, "Samoa", "Leusoalii", -13.8500000, -171.6666718, 1.0},
-	{8581, "Samoa", "Maninoa", -14.0166667, -171.7833405, -9999.0},
-	{8582, "Samoa", "Tuasivi", -13.6666667, -172.1166687, -9999.0},
-	{8583, "Samoa", "Satalo", -14.0166667, -171.5833282, 68.0},
-	{8584, "Samoa", "Asaga", -13.5833333, -172.1333313, -9999.0},
-	{8585, "Samoa", "Vailoa", -14.0000000, -171.3833313, -9999.0},
-	{8586, "Samoa", "Nuusuatia", -13.9666667, -171.8000031, 166.0},
-	{8587, "Samoa", "Salesatele", -14.0000000, -171.5666656, 194.0},
-	{8588, "Samoa", "Tufutafoe", -13.4833333, -172.7666626, 1.0},
-	{8589, "Samoa", "Lalovi", -13.8500000, -172.0333405, 52.0},
-	{8590, "Samoa", "Tafagamanu", -13.9500000, -171.9166718, 109.0},
-	{8591, "Samoa", "Paia", -13.4666667, -172.3999939, 105.0},
-	{8592, "Samoa", "Satuiatua", -13.6833333, -172.5500031, 640.0},
-	{8593, "Samoa", "Fausaga", -14.0166667, -171.8000031, -9999.0},
-	{8594, "Samoa", "Avata", -13.4833333, -172.7833405, 2.0},
-	{8595, "Samoa", "Uafato", -13.9333333, -171.4333344, -9999.0},
-	{8596, "Samoa", "Fagamalo", -13.4166667, -172.3500061, -9999.0},
-	{8597, "Samoa", "Samauga", -13.9166667, -171.5666656, 320.0},
-	{8598, "Samoa", "Papa", -13.4666667, -172.6999969, -9999.0},
-	{8599, "Samoa", "Manunu", -13.9000000, -171.5666656, 254.0},
-	{8600, "Samoa", "Mutiatele", -13.9833333, -171.3666687, -9999.0},
-	{8601, "Samoa", "Avao", -13.4500000, -172.3666687, 26.0},
-	{8602, "Sao Tome and Principe", "Sao Tome", 0.3333333, 6.7333331, 137.0},
-	{8603, "Senegal", "Dakar", 14.6951119, -17.4438858, 14.0},
-	{8604, "Senegal", "Grand Dakar", 14.7088889, -17.4552784, 33.0},
-	{8605, "Senegal", "Thies Nones", 14.7833333, -16.9666672, 101.0},
-	{8606, "Senegal", "Saint-Louis", 16.0179310, -16.4896202, 24.0},
-	{8607, "Senegal", "Ziguinchor", 12.5833333, -16.2719440, 16.0},
-	{8608, "Senegal", "Tiebo", 14.6333333, -16.2333336, 15.0},
-	{8609, "Senegal", "Mbake", 14.7903186, -15.9080315, 43.0},
-	{8610, "Senegal", "Kolda", 12.8833333, -14.9499998, 1.0},
-	{8611, "Senegal", "Richard Toll", 16.4666667, -15.6833334, 11.0},
-	{8612, "Senegal", "Joal-Fadiout", 14.1666667, -16.8333340, 1.0},
-	{8613, "Senegal", "Dara", 15.3484448, -15.4799290, 62.0},
-	{8614, "Senegal", "Ndibene Dahra", 15.3333333, -15.4833336, 61.0},
-	{8615, "Senegal", "Bignona", 12.8102778, -16.2263889, 29.0},
-	{8616, "Senegal", "Pourham", 14.3500000, -16.4166660, 14.0},
-	{8617, "Senegal", "Velingara", 13.1500000, -14.1166668, 42.0},
-	{8618, "Senegal", "Nioro du Rip", 13.7500000, -15.8000002, 29.0},
-	{8619, "Senegal", "Sedhiou", 12.7080556, -15.5569448, 34.0},
-	{8620, "Senegal", "Mekhe", 15.1166667, -16.6333332, 43.0},
-	{8621, "Senegal", "Kedougou", 12.5500000, -12.1833334, 100.0},
-	{8622, "Senegal", "Nguekokh", 14.5127778, -17.0049992, 24.0},
-	{8623, "Senegal", "Pout", 14.7738889, -17.0602779, 17.0},
-	{8624, "Senegal", "Kayar", 14.9191667, -17.1211109, 1.0},
-	{8625, "Senegal", "Guinguineo", 14.2666667, -15.9499998, 23.0},
-	{8626, "Senegal", "Ouro Sogui", 15.6000000, -13.3166666, 27.0},
-	{8627, "Senegal", "Koungheul", 13.9833333, -14.8000002, 21.0},
-	{8628, "Senegal", "Ndioum", 16.5166667, -14.6499996, 12.0},
-	{8629, "Senegal", "Khombole", 14.7666667, -16.7000008, 14.0},
-	{8630, "Senegal", "Sokone", 13.8833333, -16.3666668, 19.0},
-	{8631, "Senegal", "Tiadiaye", 14.4166667, -16.7000008, 29.0},
-	{8632, "Senegal", "Kanel", 15.4833333, -13.1666670, 15.0},
-	{8633, "Senegal", "Ndofane", 13.9166667, -15.9333334, 28.0},
-	{8634, "Senegal", "Rosso", 16.5000000, -15.8166666, 46.0},
-	{8635, "Senegal", "Gandiaye", 14.2333333, -16.2666664, 24.0},
-	{8636, "Senegal", "Ouaounde", 15.2666667, -12.8666668, 25.0},
-	{8637, "Senegal", "Diofior", 14.1833333, -16.6666660, 7.0},
-	{8638, "Senegal", "Tionk Essil", 12.7855556, -16.5216675, 21.0},
-	{8639, "Senegal", "Diawara", 15.0333333, -12.5500002, 14.0},
-	{8640, "Senegal", "Marsassoum", 12.8275000, -15.9805555, 11.0},
-	{8641, "Senegal", "Passi", 13.9833333, -16.2666664, 25.0},
-	{8642, "Senegal", "Golere", 16.2500000, -14.1000004, 12.0},
-	{8643, "Senegal", "Foundiougne", 14.1333333, -16.4666672, 1.0},
-	{8644, "Senegal", "Semme", 15.2000000, -12.9499998, 41.0},
-	{8645, "Senegal", "Oussouye", 12.4850000, -16.5469437, 38.0},
-	{8646, "Senegal", "Warang", 14.3734946, -16.9436646, 1.0},
-	{8647, "Senegal", "Polel Diaoube-http://polel-diaoube.info", 15.2666667, -13.0000000, 33.0},
-	{8648, "Senegal", "Ranerou", 15.3000000, -13.9666672, 36.0},
-	{8649, "Seychelles", "Victoria", -4.6166667, 55.4500008, -9999.0},
-	{8650, "Slovenia", "Ljubljana", 46.0552778, 14.5144444, 284.0},
-	{8651, "Slovenia", "Maribor", 46.5547222, 15.6466665, 262.0},
-	{8652, "Slovenia", "Celje", 46.2361111, 15.2674999, 239.0},
-	{8653, "Slovenia", "Kranj", 46.2411111, 14.3616667, 380.0},
-	{8654, "Slovenia", "Velenje", 46.3591667, 15.1102781, 407.0},
-	{8655, "Slovenia", "Koper", 45.5469444, 13.7294445, 12.0},
-	{8656, "Slovenia", "Novo Mesto", 45.7925000, 15.1647224, 187.0},
-	{8657, "Slovenia", "Ptuj", 46.4213889, 15.8697224, 221.0},
-	{8658, "Slovenia", "Trbovlje", 46.1550000, 15.0533333, 409.0},
-	{8659, "Slovenia", "Podhom", 46.4000000, 14.1000004, 587.0},
-	{8660, "Slovenia", "Nova Gorica", 45.9613889, 13.6436110, 107.0},
-	{8661, "Slovenia", "Kamnik", 46.2197222, 14.6094446, 542.0},
-	{8662, "Slovenia", "Jesenice", 46.4305556, 14.0669441, 544.0},
-	{8663, "Slovenia", "Murska Sobota", 46.6625000, 16.1663895, 196.0},
-	{8664, "Slovenia", "Skofja Loka", 46.1672222, 14.3088884, 342.0},
-	{8665, "Slovenia", "Domzale", 46.1380556, 14.5977774, 288.0},
-	{8666, "Slovenia", "Izola", 45.5369444, 13.6619444, 25.0},
-	{8667, "Slovenia", "Kocevje", 45.6433333, 14.8633337, 473.0},
-	{8668, "Slovenia", "Postojna", 45.7769444, 14.2166672, 700.0},
-	{8669, "Slovenia", "Logatec", 45.9144444, 14.2258329, 471.0},
-	{8670, "Slovenia", "Slovenj Gradec", 46.5102778, 15.0805559, 428.0},
-	{8671, "Slovenia", "Ravne", 45.9625000, 15.0127773, 364.0},
-	{8672, "Slovenia", "Vrhnika", 45.9661111, 14.2955561, 291.0},
-	{8673, "Slovenia", "Krsko", 45.9530556, 15.4894447, 166.0},
-	{8674, "Slovenia", "Zagorje ob Savi", 46.1341667, 15.0013885, 323.0},
-	{8675, "Slovenia", "Slovenska Bistrica", 46.3927778, 15.5744448, 306.0},
-	{8676, "Slovenia", "Ajdovscina", 45.8875000, 13.9111109, 167.0},
-	{8677, "Slovenia", "Litija", 46.0586111, 14.8225002, 260.0},
-	{8678, "Slovenia", "Brezice", 45.9033333, 15.5911112, 166.0},
-	{8679, "Slovenia", "Grosuplje", 45.9555556, 14.6588888, 315.0},
-	{8680, "Slovenia", "Lucija", 45.4963889, 13.6136112, 67.0},
-	{8681, "Slovenia", "Radovljica", 46.3444444, 14.1744442, 482.0},
-	{8682, "Slovenia", "Crnomelj", 45.5711111, 15.1888885, 151.0},
-	{8683, "Slovenia", "Hrastnik", 46.1461111, 15.0813885, 317.0},
-	{8684, "Slovenia", "Idrija", 46.0027778, 14.0305557, 441.0},
-	{8685, "Slovenia", "Menges", 46.1669444, 14.5749998, 314.0},
-	{8686, "Slovenia", "Bled", 46.3691667, 14.1136112, 500.0},
-	{8687, "Slovenia", "Medvode", 46.1333333, 14.4333334, 298.0},
-	{8688, "Slovenia", "Zalec", 46.2536111, 15.1674995, 253.0},
-	{8689, "Slovenia", "Ilirska Bistrica", 45.5697222, 14.2408333, 438.0},
-	{8690, "Slovenia", "Sevnica", 46.0077778, 15.3155556, 183.0},
-	{8691, "Slovenia", "Slovenske Konjice", 46.3366667, 15.4258337, 315.0},
-	{8692, "Slovenia", "Sezana", 45.7069444, 13.8722219, 428.0},
-	{8693, "Slovenia", "Rogaska Slatina", 46.2375000, 15.6397219, 293.0},
-	{8694, "Slovenia", "Sentjur", 46.2172222, 15.3975000, 333.0},
-	{8695, "Slovenia", "Ruse", 46.5394444, 15.5158329, 380.0},
-	{8696, "Slovenia", "Prevalje", 46.5469444, 14.9208336, 408.0},
-	{8697, "Slovenia", "Piran", 45.5277778, 13.5705557, 1.0},
-	{8698, "Slovenia", "Trzic", 46.3666667, 14.3166666, 702.0},
-	{8699, "Slovenia", "Sempeter pri Gorici", 45.9275000, 13.6411114, 77.0},
-	{8700, "Slovenia", "Miklavz na Dravskem Polju", 46.5058333, 15.6972218, 256.0},
-	{8701, "Slovenia", "Tolmin", 46.1830395, 13.7332106, 173.0},
-	{8702, "Slovenia", "Ziri", 46.0422222, 14.1072226, 486.0},
-	{8703, "Slovenia", "Cerknica", 45.7930556, 14.3625002, 630.0},
-	{8704, "Slovenia", "Ribnica", 45.7386111, 14.7275000, 490.0},
-	{8705, "Slovenia", "Mezica", 46.5213889, 14.8544445, 555.0},
-	{8706, "Slovenia", "Trzin", 46.1333333, 14.5666666, 283.0},
-	{8707, "Slovenia", "Lasko", 46.1519444, 15.2363892, 317.0},
-	{8708, "Slovenia", "Ljutomer", 46.5208333, 16.1975002, 186.0},
-	{8709, "Slovenia", "Dravograd", 46.5880556, 15.0191669, 372.0},
-	{8710, "Slovenia", "Lendava", 46.5613889, 16.4505558, 256.0},
-	{8711, "Slovenia", "Solkan", 45.9713889, 13.6494446, 160.0},
-	{8712, "Slovenia", "Metlika", 45.6472222, 15.3141670, 151.0},
-	{8713, "Slovenia", "Gornja Radgona", 46.6733333, 15.9922218, 206.0},
-	{8714, "Slovenia", "Zelezniki", 46.2333333, 14.1666670, 599.0},
-	{8715, "Slovenia", "Trebnje", 45.9041667, 15.0216665, 278.0},
-	{8716, "Slovenia", "Vir pri Sticni", 45.9525000, 14.8177776, 344.0},
-	{8717, "Slovenia", "Ankaran", 45.5786111, 13.7361107, 80.0},
-	{8718, "Slovenia", "Zrece", 46.3822222, 15.3791666, 425.0},
-	{8719, "Slovenia", "Lesce", 46.3611111, 14.1577778, 515.0},
-	{8720, "Slovenia", "Sostanj", 46.3800000, 15.0486107, 378.0},
-	{8721, "Slovenia", "Portoroz", 45.5161111, 13.5883331, -9999.0},
-	{8722, "Slovenia", "Sencur", 46.2455556, 14.4197226, 397.0},
-	{8723, "Slovenia", "Radlje ob Dravi", 46.6141667, 15.2263889, 350.0},
-	{8724, "Slovenia", "Lenart v Slovenskih Goricah", 46.5761111, 15.8313885, 231.0},
-	{8725, "Slovenia", "Muta", 46.6113889, 15.1661110, 327.0},
-	{8726, "Slovenia", "Senovo", 46.0236111, 15.4769440, 322.0},
-	{8727, "Slovenia", "Vrtojba", 45.9125000, 13.6341667, 74.0},
-	{8728, "Slovenia", "Polzela", 46.2833333, 15.0666666, 295.0},
-	{8729, "Slovenia", "Crna na Koroskem", 46.4666667, 15.8500004, 277.0},
-	{8730, "Slovenia", "Brezovica", 46.0333333, 14.3999996, 341.0},
-	{8731, "Slovenia", "Radece", 46.0680556, 15.1838884, 502.0},
-	{8732, "Slovenia", "Beltinci", 46.6052778, 16.2405548, 185.0},
-	{8733, "Slovenia", "Race", 46.4519444, 15.6813889, 255.0},
-	{8734, "Slovenia", "Koroska-Bela", 46.4302778, 14.1033335, 786.0},
-	{8735, "Slovenia", "Ormoz", 46.4113889, 16.1544437, 228.0},
-	{8736, "Slovenia", "Radenci", 46.6472222, 16.0441666, 205.0},
-	{8737, "Slovenia", "Jagodje", 45.5322222, 13.6549997, 46.0},
-	{8738, "Slovenia", "Borovnica", 45.9158333, 14.3630552, 309.0},
-	{8739, "Slovenia", "Spodnje Hoce", 46.5000000, 15.6499996, 267.0},
-	{8740, "Slovenia", "Ig", 45.9602778, 14.5288887, 274.0},
-	{8741, "Slovenia", "Pivka", 45.6794444, 14.1966667, 534.0},
-	{8742, "Slovenia", "Lovrenc na Pohorju", 46.5405556, 15.3930559, 462.0},
-	{8743, "Slovenia", "Limbus", 46.5536111, 15.5836115, 286.0},
-	{8744, "Slovenia", "Straza", 45.7800000, 15.0727777, 167.0},
-	{8745, "Slovenia", "Skofljica", 45.9833333, 14.5766668, 300.0},
-	{8746, "Slovenia", "Rakek", 45.8133333, 14.3111115, 564.0},
-	{8747, "Slovenia", "Vojnik", 46.2933333, 15.3033333, 324.0},
-	{8748, "Slovenia", "Mozirje", 46.3394444, 14.9633331, 325.0},
-	{8749, "Slovenia", "Sempeter v Savinjski Dolini", 46.2563889, 15.1219444, 267.0},
-	{8750, "Solomon Islands", "Honiara", -9.4333333, 159.9499969, 12.0},
-	{8751, "Solomon Islands", "Gizo", -8.1000000, 156.8500061, -9999.0},
-	{8752, "Solomon Islands", "Auki", -8.7666667, 160.6999969, 158.0},
-	{8753, "Solomon Islands", "Tulaghi", -9.1000000, 160.1499939, 1.0},
-	{8754, "Solomon Islands", "Kirakira", -10.4500000, 161.9166718, 153.0},
-	{8755, "Solomon Islands", "Lata", -10.7166667, 165.8333282, 1.0},
-	{8756, "Somalia", "Mogadishu", 2.0666667, 45.3666649, 58.0},
-	{8757, "Somalia", "Hargeysa", 9.5600000, 44.0649986, 1247.0},
-	{8758, "Somalia", "Berbera", 10.4355556, 45.0163879, 21.0},
-	{8759, "Somalia", "Chisimayu", -0.3666667, 42.5333328, -9999.0},
-	{8760, "Somalia", "Jamaame", 0.0722222, 42.7505569, 11.0},
-	{8761, "Somalia", "Baidoa", 3.1166667, 43.6500015, 446.0},
-	{8762, "Somalia", "Burao", 9.5166667, 45.5333328, 1039.0},
-	{8763, "Somalia", "Bender Cassim", 11.2847222, 49.1824989, 1.0},
-	{8764, "Somalia", "Afgooye", 2.1500000, 45.1166649, 78.0},
-	{8765, "Somalia", "Galcaio", 6.7697222, 47.4308319, 293.0},
-	{8766, "Somalia", "Laascaanood", 8.4755556, 47.3577766, 722.0},
-	{8767, "Somalia", "Beledweyne", 4.7358333, 45.2036095, 174.0},
-	{8768, "Somalia", "Qoryooley", 1.7833333, 44.5333328, 65.0},
-	{8769, "Somalia", "Giohar", 2.7666667, 45.5166664, 100.0},
-	{8770, "Somalia", "Jilib", 0.4958333, 42.7727776, 17.0},
-	{8771, "Somalia", "Baardheere", 2.3500000, 42.2666664, 102.0},
-	{8772, "Somalia", "Erigavo", 10.6180556, 47.3699989, 1776.0},
-	{8773, "Somalia", "Luuq", 3.8000000, 42.5499992, 155.0},
-	{8774, "Somalia", "Buurhakaba", 2.7833333, 44.0833321, 199.0},
-	{8775, "Somalia", "Ceeldheere", 3.8500000, 47.2000008, 121.0},
-	{8776, "Somalia", "Wanlaweyn", 2.6166667, 44.9000015, 92.0},
-	{8777, "Somalia", "Eyl", 7.9833333, 49.8166656, 22.0},
-	{8778, "Somalia", "Buulobarde", 3.8500000, 45.5666656, 127.0},
-	{8779, "Somalia", "Qandala", 11.4711111, 49.8738899, 7.0},
-	{8780, "Somalia", "Bandarbeyla", 9.4833333, 50.8166656, 146.0},
-	{8781, "Somalia", "Garbahaarrey", 3.3166667, 42.2166672, 235.0},
-	{8782, "Somalia", "Hobyo", 5.3513889, 48.5255547, 120.0},
-	{8783, "Somalia", "Bereeda", 11.8500000, 51.0499992, 135.0},
-	{8784, "Somalia", "Jalalaqsi", 3.4000000, 45.5833321, 121.0},
-	{8785, "Somalia", "Ceelbuur", 4.6833333, 46.6166649, 153.0},
-	{8786, "Somalia", "Yeed", 4.5500000, 43.0333328, 353.0},
-	{8787, "Somalia", "Mahadday Weyne", 2.9666667, 45.5333328, 103.0},
-	{8788, "Somalia", "Saacow", 1.6333333, 42.4500008, 58.0},
-	{8789, "Somalia", "Laasqoray", 11.1666667, 48.2166672, 4.0},
-	{8790, "Somalia", "Bargaal", 11.2833333, 51.0833321, 1.0},
-	{8791, "Somalia", "Waajid", 3.8000000, 43.2500000, 370.0},
-	{8792, "Somalia", "Iskushuban", 10.2833333, 50.2333336, 285.0},
-	{8793, "Somalia", "Oodweyne", 9.4080556, 45.0622215, 1029.0},
-	{8794, "Somalia", "Cadale", 2.7500000, 46.3166656, -9999.0},
-	{8795, "Somalia", "Ceek", 8.9969444, 45.3586121, 858.0},
-	{8796, "Somalia", "Buur Gaabo", -1.2166667, 41.8499985, -9999.0},
-	{8797, "Somalia", "Dujuuma", 1.2533333, 42.5708351, 41.0},
-	{8798, "Somalia", "Tayeeglow", 4.0333333, 44.5166664, 413.0},
-	{8799, "Somalia", "Xarardheere", 4.6544444, 47.8574982, 216.0},
-	{8800, "Somalia", "Dusa Marreb", 5.5350000, 46.3861122, 203.0},
-	{8801, "South Africa", "Cape Town", -33.9166667, 18.4166660, 7.0},
-	{8802, "South Africa", "Durban", -29.8500000, 31.0166664, 28.0},
-	{8803, "South Africa", "Johannesburg", -26.2000000, 28.0833340, 1775.0},
-	{8804, "South Africa", "Soweto", -26.2666667, 27.8666668, 1620.0},
-	{8805, "South Africa", "Pretoria", -25.7069444, 28.2294445, 1282.0},
-	{8806, "South Africa", "Port Elizabeth", -33.9666667, 25.5833340, 35.0},
-	{8807, "South Africa", "Pietermaritzburg", -29.6166667, 30.3833332, 596.0},
-	{8808, "South Africa", "Benoni", -26.1833333, 28.3166676, 1596.0},
-	{8809, "South Africa", "Tembisa", -25.9988889, 28.2269440, 1578.0},
-	{8810, "South Africa", "Vereeniging", -26.6666667, 27.9333324, 1436.0},
-	{8811, "South Africa", "Bloemfontein", -29.1333333, 26.2000008, 1398.0},
-	{8812, "South Africa", "Boksburg", -26.2166667, 28.2500000, 1591.0},
-	{8813, "South Africa", "Welkom", -27.9833333, 26.7333336, 1365.0},
-	{8814, "South Africa", "Newcastle", -27.7500000, 29.9333324, 1165.0},
-	{8815, "South Africa", "Krugersdorp", -26.1000000, 27.7666664, 1713.0},
-	{8816, "South Africa", "Botshabelo", -29.2333333, 26.7333336, 1609.0},
-	{8817, "South Africa", "Brakpan", -26.2333333, 28.3666668, 1616.0},
-	{8818, "South Africa", "Witbank", -25.8666667, 29.2333336, 1635.0},
-	{8819, "South Africa", "Richards Bay", -28.8000000, 32.0999985, -9999.0},
-	{8820, "South Africa", "Vanderbijlpark", -26.7000000, 27.8166676, 1469.0},
-	{8821, "South Africa", "Centurion", -25.8744444, 28.1705551, 1455.0},
-	{8822, "South Africa", "Uitenhage", -33.7652778, 25.4022217, 115.0},
-	{8823, "South Africa", "Noorder-Paarl", -33.7166667, 18.9666672, 94.0},
-	{8824, "South Africa", "Springs", -26.2500000, 28.3999996, 1626.0},
-	{8825, "South Africa", "Carletonville", -26.3666667, 27.3999996, 1541.0},
-	{8826, "South Africa", "Klerksdorp", -26.8666667, 26.6666660, 1334.0},
-	{8827, "South Africa", "George", -33.9666667, 22.4500008, 178.0},
-	{8828, "South Africa", "Midrand", -25.9636111, 28.1377773, 1559.0},
-	{8829, "South Africa", "Westonaria", -26.3166667, 27.6499996, 1573.0},
-	{8830, "South Africa", "Middelburg", -25.7833333, 29.4666672, 1448.0},
-	{8831, "South Africa", "Vryheid", -27.7666667, 30.7999992, 1113.0},
-	{8832, "South Africa", "Orkney", -26.9833333, 26.6666660, 1320.0},
-	{8833, "South Africa", "Kimberley", -28.7333333, 24.7666664, 1219.0},
-	{8834, "South Africa", "eMbalenhle", -26.5333333, 29.0666676, 1585.0},
-	{8835, "South Africa", "Nigel", -26.4166667, 28.4666672, 1553.0},
-	{8836, "South Africa", "Mpumalanga", -29.8166667, 30.6166668, 494.0},
-	{8837, "South Africa", "Bisho", -32.8666667, 27.4333324, 435.0},
-	{8838, "South Africa", "Randfontein", -26.1666667, 27.7000008, 1690.0},
-	{8839, "South Africa", "Worcester", -33.6500000, 19.4333324, 222.0},
-	{8840, "South Africa", "Rustenburg", -25.6666667, 27.2500000, 1154.0},
-	{8841, "South Africa", "Polokwane", -23.9000000, 29.4500008, 1282.0},
-	{8842, "South Africa", "Potchefstroom", -26.7166667, 27.1000004, 1322.0},
-	{8843, "South Africa", "Virginia", -28.1166667, 26.8999996, 1319.0},
-	{8844, "South Africa", "Brits", -25.6333333, 27.7833328, 1105.0},
-	{8845, "South Africa", "Nelspruit", -25.4666667, 30.9666672, 677.0},
-	{8846, "South Africa", "Phalaborwa", -23.9500000, 31.1166668, 394.0},
-	{8847, "South Africa", "Queenstown", -31.9000000, 26.8833332, 1076.0},
-	{8848, "South Africa", "Kroonstad", -27.6500000, 27.2333336, 1343.0},
-	{8849, "South Africa", "Bethal", -26.4500000, 29.4666672, 1614.0},
-	{8850, "South Africa", "Potgietersrus", -24.1833333, 29.0166664, 1191.0},
-	{8851, "South Africa", "Mabopane", -25.5072222, 28.1075001, 1304.0},
-	{8852, "South Africa", "Kutloanong", -27.8333333, 26.7500000, 1328.0},
-	{8853, "South Africa", "Stellenbosch", -33.9333333, 18.8500004, 97.0},
-	{8854, "South Africa", "Stilfontein", -26.8500000, 26.7833328, 1349.0},
-	{8855, "South Africa", "Delmas", -26.1500000, 28.6833324, 1560.0},
-	{8856, "South Africa", "Grahamstown", -33.3000000, 26.5333328, 507.0},
-	{8857, "South Africa", "Warmbaths", -24.8833333, 28.2833328, 1151.0},
-	{8858, "South Africa", "Louis Trichardt", -23.0500000, 29.8999996, 955.0},
-	{8859, "South Africa", "Dundee", -28.1666667, 30.2333336, 1258.0},
-	{8860, "South Africa", "Piet Retief", -27.0000000, 30.7999992, 1269.0},
-	{8861, "South Africa", "Phuthaditjhaba", -28.5333333, 28.8166676, 1622.0},
-	{8862, "South Africa", "Bethlehem", -28.2333333, 28.2999992, 1666.0},
-	{8863, "South Africa", "Umtata", -31.5833333, 28.7833328, 693.0},
-	{8864, "South Africa", "Sasolburg", -26.8135768, 27.8169537, 1476.0},
-	{8865, "South Africa", "Mmabatho", -25.8500000, 25.6333332, 1260.0},
-	{8866, "South Africa", "Oudtshoorn", -33.5906708, 22.2013950, 329.0},
-	{8867, "South Africa", "Standerton", -26.9500000, 29.2500000, 1499.0},
-	{8868, "South Africa", "Bothaville", -27.3666667, 26.6166668, 1276.0},
-	{8869, "South Africa", "Upington", -28.4500000, 21.2500000, 812.0},
-	{8870, "South Africa", "Parys", -26.9000000, 27.4500008, 1383.0},
-	{8871, "South Africa", "Schweizer-Reineke", -27.1833333, 25.3333340, 1335.0},
-	{8872, "South Africa", "Ga-Rankuwa", -25.6166667, 27.9833336, 1247.0},
-	{8873, "South Africa", "Saldanha", -33.0166667, 17.9500008, 1.0},
-	{8874, "South Africa", "Barberton", -25.7833333, 31.0499992, 764.0},
-	{8875, "South Africa", "Lichtenburg", -26.1500000, 26.1666660, 1453.0},
-	{8876, "South Africa", "Heidelberg", -26.5000000, 28.3500004, 1482.0},
-	{8877, "South Africa", "Knysna", -34.0362664, 23.0471268, 6.0},
-	{8878, "South Africa", "Graaff-Reinet", -32.2521521, 24.5307541, 768.0},
-	{8879, "South Africa", "Fochville", -26.4833333, 27.5000000, 1499.0},
-	{8880, "South Africa", "Atlantis", -33.5666667, 18.4833336, 190.0},
-	{8881, "South Africa", "Stanger", -29.3333333, 31.2999992, 118.0},
-	{8882, "South Africa", "Fort Beaufort", -32.7833333, 26.6333332, 399.0},
-	{8883, "South Africa", "Heilbron", -27.2833333, 27.9666672, 1552.0},
-	{8884, "South Africa", "Viljoenskroon", -27.2166667, 26.9500008, 1336.0},
-	{8885, "South Africa", "Port Shepstone", -30.7500000, 30.4500008, -9999.0},
-	{8886, "South Africa", "Vryburg", -26.9500000, 24.7333336, 1214.0},
-	{8887, "South Africa", "Wolmaransstad", -27.2000000, 25.9666672, 1349.0},
-	{8888, "South Africa", "eSikhawini", -28.8833333, 31.8999996, 36.0},
-	{8889, "South Africa", "Balfour", -26.6500000, 28.5833340, 1572.0},
-	{8890, "South Africa", "Mondlo", -27.9833333, 30.7166672, 1089.0},
-	{8891, "South Africa", "Beaufort West", -32.3500000, 22.5833340, 828.0},
-	{8892, "South Africa", "Aliwal North", -30.7000000, 26.7000008, 1361.0},
-	{8893, "South Africa", "Thohoyandou", -22.9500000, 30.4833336, 734.0},
-	{8894, "South Africa", "Volksrust", -27.3666667, 29.8833332, 1661.0},
-	{8895, "South Africa", "Wesselsbron", -27.8500000, 26.3666668, 1310.0},
-	{8896, "South Africa", "Ceres", -33.3666667, 19.3166676, 462.0},
-	{8897, "South Africa", "Ermelo", -26.5333333, 29.9833336, 1667.0},
-	{8898, "South Africa", "Senekal", -28.3166667, 27.6000004, 1446.0},
-	{8899, "South Africa", "Thaba Nchu", -29.2166667, 26.8333340, 1532.0},
-	{8900, "South Africa", "Frankfort", -27.2666667, 28.4833336, 1589.0},
-	{8901, "Korea, South", "Seoul", 37.5663889, 126.9997253, 46.0},
-	{8902, "Korea, South", "Pusan", 35.1027778, 129.0402832, 7.0},
-	{8903, "Korea, South", "Taegu", 35.8702778, 128.5911102, 41.0},
-	{8904, "Korea, South", "Taejon", 36.3213889, 127.4197235, 55.0},
-	{8905, "Korea, South", "Kwangju", 35.1547222, 126.9155579, 31.0},
-	{8906, "Korea, South", "Suwon", 37.2841667, 127.0191650, 61.0},
-	{8907, "Korea, South", "Songnam", 37.4386111, 127.1377792, 50.0},
-	{8908, "Korea, South", "Ulsan", 35.5372222, 129.3166656, 6.0},
-	{8909, "Korea, South", "Chonju", 35.8219444, 127.1488876, 29.0},
-	{8910, "Korea, South", "Ansan", 37.3236111, 126.8219452, 8.0},
-	{8911, "Korea, South", "Anyang", 37.3925000, 126.9269409, 32.0},
-	{8912, "Korea, South", "Uijongbu", 37.7486111, 127.0388870, 45.0},
-	{8913, "Korea, South", "Kwangmyong", 37.4772222, 126.8663864, 29.0},
-	{8914, "Korea, South", "Kimhae", 35.2341667, 128.8811035, 21.0},
-	{8915, "Korea, South", "Masan", 35.2080556, 128.5724945, 19.0},
-	{8916, "Korea, South", "Yosu", 34.7441667, 127.7377777, 51.0},
-	{8917, "Korea, South", "Cheju", 33.5097222, 126.5219421, 8.0},
-	{8918, "Korea, South", "Chinju", 35.1927778, 128.0847168, 38.0},
-	{8919, "Korea, South", "Kumi", 36.1272222, 128.3447266, 33.0},
-	{8920, "Korea, South", "Iksan", 35.9438889, 126.9544449, 10.0},
-	{8921, "Korea, South", "Kunsan", 35.9786111, 126.7113876, 14.0},
-	{8922, "Korea, South", "Wonju", 37.3513889, 127.9452744, 131.0},
-	{8923, "Korea, South", "Kuri", 37.5947222, 127.1427765, 21.0},
-	{8924, "Korea, South", "Kangnung", 37.7555556, 128.8961182, 15.0},
-	{8925, "Korea, South", "Osan", 37.1450000, 127.0694427, 17.0},
-	{8926, "Korea, South", "Kyongju", 35.8427778, 129.2116699, 43.0},
-	{8927, "Korea, South", "Hanam", 37.5400000, 127.2055588, 31.0},
-	{8928, "Korea, South", "Chinhae", 35.1494444, 128.6597290, 29.0},
-	{8929, "Korea, South", "Kyongsan", 35.8233333, 128.7377777, 58.0},
-	{8930, "Korea, South", "Andong", 36.5655556, 128.7250061, 94.0},
-	{8931, "Korea, South", "Hwado", 37.6525000, 127.3075027, 83.0},
-	{8932, "Korea, South", "Tonghae", 37.5438889, 129.1069489, 30.0},
-	{8933, "Korea, South", "Asan", 36.7836111, 127.0041656, 15.0},
-	{8934, "Korea, South", "Wabu", 37.5897222, 127.2202759, 24.0},
-	{8935, "Korea, South", "Namyangju", 37.6366667, 127.2141647, 56.0},
-	{8936, "Korea, South", "Kwangyang", 34.9752778, 127.5891647, 8.0},
-	{8937, "Korea, South", "Yongju", 36.8216667, 128.6308289, 136.0},
-	{8938, "Korea, South", "Ungsang", 35.4061111, 129.1686096, 115.0},
-	{8939, "Korea, South", "Sinhyon", 34.8825000, 128.6266632, 10.0},
-	{8940, "Korea, South", "Kwangju", 37.4091667, 127.2591629, 42.0},
-	{8941, "Korea, South", "Naeso", 35.2497222, 128.5200043, 136.0},
-	{8942, "Korea, South", "Sosan", 36.7816667, 126.4522247, 19.0},
-	{8943, "Korea, South", "Kongju", 36.4555556, 127.1247253, 27.0},
-	{8944, "Korea, South", "Koje", 34.8502778, 128.5886078, 3.0},
-	{8945, "Korea, South", "Yangsan", 35.3386111, 129.0386047, 11.0},
-	{8946, "Korea, South", "Ansong", 37.0108333, 127.2702789, 38.0},
-	{8947, "Korea, South", "Hwawon", 35.8016667, 128.5008392, 44.0},
-	{8948, "Korea, South", "Pubal", 37.2916667, 127.5077744, 49.0},
-	{8949, "Korea, South", "Poryong", 36.3466667, 126.6047211, 12.0},
-	{8950, "Korea, South", "Hwasun", 35.0594444, 126.9850006, 69.0},
-	{8951, "Korea, South", "Namwon", 35.4100000, 127.3858337, 93.0},
-	{8952, "Korea, South", "Sangju", 36.4152778, 128.1605530, 57.0},
-	{8953, "Korea, South", "Miryang", 35.4933333, 128.7488861, 18.0},
-	{8954, "Korea, South", "Taebaek", 37.1730556, 128.9861145, 735.0},
-	{8955, "Korea, South", "Kimje", 35.8016667, 126.8888855, 13.0},
-	{8956, "Korea, South", "Nonsan", 36.2038889, 127.0847244, 1.0},
-	{8957, "Korea, South", "Munsan", 37.8594444, 126.7850037, 10.0},
-	{8958, "Korea, South", "Kijang", 35.2441667, 129.2138824, 27.0},
-	{8959, "Korea, South", "Naju", 35.0283333, 126.7174988, 17.0},
-	{8960, "Korea, South", "Yonil", 35.9941667, 129.3450012, 2.0},
-	{8961, "Korea, South", "Hayang", 35.9133333, 128.8200073, 52.0},
-	{8962, "Korea, South", "Yesan", 36.6791667, 126.8450012, 23.0},
-	{8963, "Korea, South", "Hongsong", 36.5994444, 126.6627808, 22.0},
-	{8964, "Korea, South", "Yoju", 37.2938889, 127.6377792, 45.0},
-	{8965, "Korea, South", "Hunghae", 36.1125000, 129.3522186, 9.0},
-	{8966, "Korea, South", "Tangjin", 36.8944444, 126.6297226, 8.0},
-	{8967, "Korea, South", "Puyo", 36.2819444, 126.9124985, 8.0},
-	{8968, "Korea, South", "Waegwan", 35.9888889, 128.3972168, 28.0},
-	{8969, "Korea, South", "Songhwan", 36.9155556, 127.1313858, 9.0},
-	{8970, "Korea, South", "Chinyong", 35.3041667, 128.7316742, 20.0},
-	{8971, "Korea, South", "Kosong", 34.9727778, 128.3236084, 4.0},
-	{8972, "Korea, South", "Kanghwa", 37.7472222, 126.4855576, 12.0},
-	{8973, "Korea, South", "Yongwol", 37.1872222, 128.4733276, 215.0},
-	{8974, "Korea, South", "Kumsan", 36.1030556, 127.4888916, 146.0},
-	{8975, "Korea, South", "Yonmu", 36.1294444, 127.0999985, 14.0},
-	{8976, "Korea, South", "Yongdong", 36.1750000, 127.7763901, 126.0},
-	{8977, "Korea, South", "Haenam", 34.5711111, 126.5988922, 24.0},
-	{8978, "Korea, South", "Aewol-li", 33.4644444, 126.3183365, 6.0},
-	{8979, "Korea, South", "Taesan", 36.9388889, 126.4349976, 31.0},
-	{8980, "Korea, South", "Puan", 35.7280556, 126.7319412, 7.0},
-	{8981, "Korea, South", "Sonsan", 36.2408333, 128.2975006, 41.0},
-	{8982, "Korea, South", "Yonggwang", 35.2750000, 126.5094452, 42.0},
-	{8983, "Korea, South", "Polgyo", 34.8430556, 127.3433304, 7.0},
-	{8984, "Spain", "Madrid", 40.4165021, -3.7025642, 638.0},
-	{8985, "Spain", "Barcelona", 41.3887869, 2.1589851, 41.0},
-	{8986, "Spain", "Valencia", 39.4697524, -0.3773868, 10.0},
-	{8987, "Spain", "Sevilla", 37.3772222, -5.9869442, 2.0},
-	{8988, "Spain", "Zaragoza", 41.6560643, -0.8773398, 183.0},
-	{8989, "Spain", "Malaga", 36.7201559, -4.4203448, 1.0},
-	{8990, "Spain", "Murcia", 37.9833333, -1.1166667, 28.0},
-	{8991, "Spain", "Las Palmas de Gran Canaria", 28.1000000, -15.4166670, 114.0},
-	{8992, "Spain", "Palma", 39.5693908, 2.6502371, 13.0},
-	{8993, "Spain", "Bilbao", 43.2627062, -2.9252815, 33.0},
-	{8994, "Spain", "Valladolid", 41.6500000, -4.7166667, 694.0},
-	{8995, "Spain", "Cordoba", 37.8833333, -4.7666669, 116.0},
-	{8996, "Spain", "Alacant", 38.3451735, -0.4814887, 1.0},
-	{8997, "Spain", "Vigo", 42.2333333, -8.7166672, 115.0},
-	{8998, "Spain", "Gijon", 43.5411111, -5.6644444, 11.0},
-	{8999, "Spain", "Eixample", 41.3889553, 2.1617899, 41.0},
-	{9000, "Spain", "A Coruna", 43.3713496, -8.3960009, 1.0},
-	{9001, "Spain", "Granada", 37.1881684, -3.6066699, 658.0},
-	{9002, "Spain", "Vitoria-Gasteiz", 42.8500000, -2.6666667, 516.0},
-	{9003, "Spain", "Santa Cruz de Tenerife", 28.4682386, -16.2546158, 159.0},
-	{9004, "Spain", "Sant Marti", 41.4181438, 2.1993256, 13.0},
-	{9005, "Spain", "Badalona", 41.4500447, 2.2474122, 6.0},
-	{9006, "Spain", "Elx", 38.2621760, -0.7010651, 77.0},
-	{9007, "Spain", "Oviedo", 43.3666667, -5.8333335, 179.0},
-	{9008, "Spain", "Mostoles", 40.3223361, -3.8649559, 651.0},
-	{9009, "Spain", "Cartagena", 37.6000000, -0.9833333, -9999.0},
-	{9010, "Spain", "Terrassa", 41.5666667, 2.0166667, 313.0},
-	{9011, "Spain", "Fuenlabrada", 40.2833333, -3.8000000, 647.0},
-	{9012, "Spain", "Sabadell", 41.5500000, 2.0999999, 193.0},
-	{9013, "Spain", "Alcala de Henares", 40.4833333, -3.3666668, 578.0},
-	{9014, "Spain", "Jerez de la Frontera", 36.6833333, -6.1333332, 50.0},
-	{9015, "Spain", "Pamplona", 42.8168735, -1.6432285, 425.0},
-	{9016, "Spain", "San Sebastian", 43.3128301, -1.9749933, 33.0},
-	{9017, "Spain", "Santander", 43.4647222, -3.8044443, 1.0},
-	{9018, "Spain", "Leganes", 40.3271784, -3.7635040, 628.0},
-	{9019, "Spain", "Almeria", 36.8381412, -2.4597359, -9999.0},
-	{9020, "Spain", "Castello de la Plana", 39.9833333, -0.0333333, 18.0},
-	{9021, "Spain", "Burgos", 42.3500000, -3.7000000, 861.0},
-	{9022, "Spain", "Nou Barris", 41.4416326, 2.1772671, 45.0},
-	{9023, "Spain", "Salamanca", 40.9666667, -5.6500001, 795.0},
-	{9024, "Spain", "Getafe", 40.3057127, -3.7329483, 601.0},
-	{9025, "Spain", "Alcorcon", 40.3458245, -3.8248730, 668.0},
-	{9026, "Spain", "Albacete", 38.9833333, -1.8500000, 677.0},
-	{9027, "Spain", "Huelva", 37.2583333, -6.9508333, 1.0},
-	{9028, "Spain", "Logrono", 42.4666667, -2.4500000, 364.0},
-	{9029, "Spain", "Badajoz", 38.8833333, -6.9666667, 168.0},
-	{9030, "Spain", "La Laguna", 28.4833333, -16.3166676, 625.0},
-	{9031, "Spain", "Leon", 42.6000000, -5.5666666, 816.0},
-	{9032, "Spain", "Cadiz", 36.5336111, -6.2994442, 36.0},
-	{9033, "Spain", "Tarragona", 41.1166667, 1.2500000, 56.0},
-	{9034, "Spain", "Lleida", 41.6166667, 0.6166667, 147.0},
-	{9035, "Spain", "Marbella", 36.5154308, -4.8858261, 75.0},
-	{9036, "Spain", "Santa Coloma de Gramenet", 41.4515243, 2.2081017, 49.0},
-	{9037, "Spain", "Jaen", 37.7666667, -3.7833333, 537.0},
-	{9038, "Spain", "Mataro", 41.5333333, 2.4500000, -9999.0},
-	{9039, "Spain", "Dos Hermanas", 37.2844444, -5.9241667, 32.0},
-	{9040, "Spain", "Ciutat Vella", 41.3802220, 2.1731901, 24.0},
-	{9041, "Spain", "Algeciras", 36.1275000, -5.4538889, 1.0},
-	{9042, "Spain", "Delicias", 41.6477432, -0.9147406, 206.0},
-	{9043, "Spain", "Torrejon de Ardoz", 40.4500000, -3.4833333, 568.0},
-	{9044, "Spain", "Ourense", 42.3333333, -7.8499999, 327.0},
-	{9045, "Spain", "Alcobendas", 40.5474611, -3.6419678, 674.0},
-	{9046, "Spain", "Reus", 41.1561204, 1.1068726, 126.0},
-	{9047, "Spain", "Telde", 28.0000000, -15.4166670, 144.0},
-	{9048, "Spain", "Barakaldo", 43.2956367, -2.9972935, 17.0},
-	{9049, "Spain", "Santiago de Compostela", 42.8805241, -8.5456896, 267.0},
-	{9050, "Spain", "Lugo", 43.0000000, -7.5666666, 370.0},
-	{9051, "Spain", "San Fernando", 36.4666667, -6.1999998, 48.0},
-	{9052, "Spain", "Parla", 40.2333333, -3.7666667, 625.0},
-	{9053, "Spain", "Girona", 41.9831130, 2.8249347, 117.0},
-	{9054, "Spain", "Caceres", 39.4764856, -6.3722420, 403.0},
-	{9055, "Spain", "Lorca", 37.6666667, -1.7000000, 307.0},
-	{9056, "Spain", "Cornella de Llobregat", 41.3500000, 2.0833333, 13.0},
-	{9057, "Spain", "Aviles", 43.5569444, -5.9247222, 5.0},
-	{9058, "Spain", "Coslada", 40.4333333, -3.5666666, 599.0},
-	{9059, "Spain", "Algorta", 43.3492745, -3.0093956, 30.0},
-	{9060, "Spain", "El Puerto de Santa Maria", 36.5938924, -6.2329817, 1.0},
-	{9061, "Spain", "Palencia", 42.0166667, -4.5333333, 737.0},
-	{9062, "Spain", "Talavera de la Reina", 39.9500000, -4.8333335, 365.0},
-	{9063, "Spain", "Pontevedra", 42.4309957, -8.6443520, 27.0},
-	{9064, "Spain", "Pozuelo de Alarcon", 40.4333333, -3.8166666, 695.0},
-	{9065, "Spain", "Torrevieja", 37.9833333, -0.6833333, 7.0},
-	{9066, "Spain", "Ferrol", 43.4833333, -8.2333336, 5.0},
-	{9067, "Spain", "Ceuta", 35.8893282, -5.3197861, -9999.0},
-	{9068, "Spain", "Toledo", 39.8581006, -4.0226269, 507.0},
-	{9069, "Spain", "Guadalajara", 40.6333333, -3.1666667, 708.0},
-	{9070, "Spain", "Torrent", 39.4370548, -0.4654598, 36.0},
-	{9071, "Spain", "Orihuela", 38.0833333, -0.9500000, 20.0},
-	{9072, "Spain", "Chiclana de la Frontera", 36.4166667, -6.1333332, 42.0},
-	{9073, "Spain", "Manresa", 41.7249813, 1.8265629, 273.0},
-	{9074, "Spain", "Las Rozas de Madrid", 40.4929150, -3.8737106, 696.0},
-	{9075, "Spain", "Ciudad Real", 38.9833333, -3.9333334, 630.0},
-	{9076, "Spain", "Rubi", 41.4833333, 2.0333333, 120.0},
-	{9077, "Spain", "Melilla", 35.2936912, -2.9383278, 70.0},
-	{9078, "Spain", "Sant Cugat del Valles", 41.4666667, 2.0833333, 120.0},
-	{9079, "Spain", "Benidorm", 38.5381628, -0.1309776, 1.0},
-	{9080, "Spain", "El Ejido", 36.7762925, -2.8145599, 95.0},
-	{9081, "Spain", "Zamora", 41.5000000, -5.7500000, 617.0},
-	{9082, "Spain", "Ponferrada", 42.5500000, -6.5833335, 508.0},
-	{9083, "Sri Lanka", "Colombo", 6.9319444, 79.8477783, 1.0},
-	{9084, "Sri Lanka", "Galkissa", 6.8333333, 79.8666687, 3.0},
-	{9085, "Sri Lanka", "Moratuwa", 6.7733333, 79.8824997, -9999.0},
-	{9086, "Sri Lanka", "Jaffna", 9.6666667, 80.0000000, 1.0},
-	{9087, "Sri Lanka", "Negombo", 7.2086111, 79.8358307, 2.0},
-	{9088, "Sri Lanka", "Pita Kotte", 6.8833333, 79.9000015, 37.0},
-	{9089, "Sri Lanka", "Sri Jayewardenepura Kotte", 6.9027778, 79.9083328, 35.0},
-	{9090, "Sri Lanka", "Kandy", 7.2963889, 80.6350021, 473.0},
-	{9091, "Sri Lanka", "Trincomalee", 8.5666667, 81.2333298, 1.0},
-	{9092, "Sri Lanka", "Kalmunai", 7.4166667, 81.8166656, 1.0},
-	{9093, "Sri Lanka", "Galle", 6.0536111, 80.2116699, 13.0},
-	{9094, "Sri Lanka", "Point Pedro", 9.8166667, 80.2333298, 1.0},
-	{9095, "Sri Lanka", "Batticaloa", 7.7166667, 81.6999969, 1.0},
-	{9096, "Sri Lanka", "Katunayaka", 7.1647222, 79.8730545, 2.0},
-	{9097, "Sri Lanka", "Valvedditturai", 9.8166667, 80.1666641, 1.0},
-	{9098, "Sri Lanka", "Battaramulla", 6.9000000, 79.9166641, 47.0},
-	{9099, "Sri Lanka", "Dambulla", 7.8600000, 80.6516647, 213.0},
-	{9100, "Sri Lanka", "Maharagama", 6.8461111, 79.9280548, 49.0},
-	{9101, "Sri Lanka", "Kotikawatta", 6.9333333, 79.9166641, 22.0},
-	{9102, "Sri Lanka", "Anuradhapura", 8.3564746, 80.4172592, 79.0},
-	{9103, "Sri Lanka", "Vavuniya", 8.7500000, 80.5000000, 89.0},
-	{9104, "Sri Lanka", "Kolonnawa", 6.9316667, 79.8944473, 19.0},
-	{9105, "Sri Lanka", "Hendala", 6.9838889, 79.8763885, 1.0},
-	{9106, "Sri Lanka", "Ratnapura", 6.6827778, 80.3991699, 128.0},
-	{9107, "Sri Lanka", "Puttalam", 8.0333333, 79.8166656, -9999.0},
-	{9108, "Sri Lanka", "Badulla", 6.9833333, 81.0500031, 774.0},
-	{9109, "Sri Lanka", "Welisara", 7.0333333, 79.9000015, 7.0},
-	{9110, "Sri Lanka", "Kalutara", 6.5761111, 79.9658356, 3.0},
-	{9111, "Sri Lanka", "Bentota", 6.4211111, 79.9988861, -9999.0},
-	{9112, "Sri Lanka", "Homagama", 6.8408333, 80.0138855, 112.0},
-	{9113, "Sri Lanka", "Beruwala", 6.4738889, 79.9841690, 2.0},
-	{9114, "Sri Lanka", "Panadura", 6.7133333, 79.9041672, 1.0},
-	{9115, "Sri Lanka", "Mulleriyawa", 6.9333333, 79.9333344, 23.0},
-	{9116, "Sri Lanka", "Kandana", 7.0477778, 79.8938904, 6.0},
-	{9117, "Sri Lanka", "Ja-Ela", 7.0844444, 79.8947220, 5.0},
-	{9118, "Sri Lanka", "Wattala", 6.9897222, 79.8933334, 2.0},
-	{9119, "Sri Lanka", "Peliyagoda", 6.9500000, 79.9000015, 3.0},
-	{9120, "Sri Lanka", "Kelaniya", 6.9600000, 79.8977814, 4.0},
-	{9121, "Sri Lanka", "Kurunegala", 7.4866667, 80.3647232, 161.0},
-	{9122, "Sri Lanka", "Nuwara Eliya", 6.9707789, 80.7828598, 2085.0},
-	{9123, "Sri Lanka", "Gampola", 7.1647222, 80.5766678, 568.0},
-	{9124, "Sri Lanka", "Chilaw", 7.5758333, 79.7952805, 3.0},
-	{9125, "Sri Lanka", "Eravur", 7.7666667, 81.5999985, 14.0},
-	{9126, "Sri Lanka", "Hanwella Ihala", 6.9000000, 80.0833359, 81.0},
-	{9127, "Sri Lanka", "Weligama", 5.9666667, 80.4166641, 9.0},
-	{9128, "Sri Lanka", "Ambalangoda", 6.2250000, 80.0566635, -9999.0},
-	{9129, "Sri Lanka", "Ampara", 7.2833333, 81.6666641, 68.0},
-	{9130, "Sri Lanka", "Hatton", 6.8972222, 80.5958328, 1187.0},
-	{9131, "Sri Lanka", "Kilinochchi", 9.4000000, 80.4000015, 15.0},
-	{9132, "Sri Lanka", "Tangalla", 6.0230556, 80.7888870, 2.0},
-	{9133, "Sri Lanka", "Monaragala", 6.8666667, 81.3499985, 163.0},
-	{9134, "Sri Lanka", "Gampaha", 7.0916667, 79.9941635, 14.0},
-	{9135, "Sri Lanka", "Horana", 6.7166667, 80.0599976, 93.0},
-	{9136, "Sri Lanka", "Wattegama", 6.8000000, 81.5000000, 123.0},
-	{9137, "Sri Lanka", "Minuwangoda", 7.1733333, 79.9619446, 20.0},
-	{9138, "Sri Lanka", "Horawala Junction", 6.4808795, 80.1270828, 129.0},
-	{9139, "Sri Lanka", "Kuliyapitiya", 7.4708333, 80.0455551, 91.0},
-	{9140, "Sri Lanka", "Haputale", 6.7666667, 80.9666672, 1516.0},
-	{9141, "Sri Lanka", "Talawakele", 6.9366667, 80.6611099, 1238.0},
-	{9142, "Sri Lanka", "Hikkaduwa", 6.1402778, 80.1038895, 2.0},
-	{9143, "Sri Lanka", "Kadugannawa", 7.2536111, 80.5274963, 604.0},
-	{9144, "Sri Lanka", "Sigiriya", 7.9500000, 80.7500000, 310.0},
-	{9145, "Sudan", "Khartoum", 15.5880556, 32.5341682, 378.0},
-	{9146, "Sudan", "Omdurman", 15.6361111, 32.4372215, 389.0},
-	{9147, "Sudan", "Port Sudan", 19.6158333, 37.2163887, 66.0},
-	{9148, "Sudan", "Kassala", 15.4558333, 36.3988876, 532.0},
-	{9149, "Sudan", "Al Ubayyid", 13.1833333, 30.2166672, 610.0},
-	{9150, "Sudan", "Kusti", 13.1666667, 32.6666679, 378.0},
-	{9151, "Sudan", "Wad Madani", 14.4000000, 33.5333328, 412.0},
-	{9152, "Sudan", "Al Fashir", 13.6333333, 25.3500004, 753.0},
-	{9153, "Sudan", "Ad Damazin", 11.7666667, 34.3499985, 493.0},
-	{9154, "Sudan", "Al Junaynah", 13.4500000, 22.4500008, 757.0},
-	{9155, "Sudan", "Malakal", 9.5369444, 31.6561108, 277.0},
-	{9156, "Sudan", "Rabak", 13.1500000, 32.7333336, 362.0},
-	{9157, "Sudan", "Sannar", 13.5500000, 33.6333351, 396.0},
-	{9158, "Sudan", "Al Manaqil", 14.2500000, 32.9833336, 412.0},
-	{9159, "Sudan", "Waw", 7.7000000, 28.0000000, 430.0},
-	{9160, "Sudan", "An Nuhud", 12.7000000, 28.4333324, 570.0},
-	{9161, "Sudan", "Atbara", 17.6972222, 33.9783325, 357.0},
-	{9162, "Sudan", "Ad-Damar", 17.5927778, 33.9591675, 362.0},
-	{9163, "Sudan", "Kadugli", 11.0166667, 29.7166672, 493.0},
-	{9164, "Sudan", "Ad Duwaym", 14.0000000, 32.3166656, 377.0},
-	{9165, "Sudan", "Umm Ruwabah", 12.9000000, 31.2166672, 447.0},
-	{9166, "Sudan", "Shendi", 16.6913889, 33.4341660, 377.0},
-	{9167, "Sudan", "Sinjah", 13.1500000, 33.9333344, 407.0},
-	{9168, "Sudan", "Yei", 4.0913889, 30.6786118, 831.0},
-	{9169, "Sudan", "Yambio", 4.5705556, 28.4163895, 632.0},
-	{9170, "Sudan", "Uwayl", 8.7666667, 27.3999996, 473.0},
-	{9171, "Sudan", "Gogrial", 8.5333333, 28.1166668, 398.0},
-	{9172, "Sudan", "Dilling", 12.0500000, 29.6499996, 688.0},
-	{9173, "Sudan", "As Suki", 13.3166667, 33.8833351, 399.0},
-	{9174, "Sudan", "Rumbek", 6.8000000, 29.6833324, 407.0},
-	{9175, "Sudan", "Ar Rusayris", 11.8500000, 34.3833351, 459.0},
-	{9176, "Sudan", "Al Hasahisa", 14.7333333, 33.2999992, 402.0},
-	{9177, "Sudan", "Maiurno", 13.4166667, 33.6666679, 399.0},
-	{9178, "Sudan", "Zalingei", 12.9000000, 23.4833336, 880.0},
-	{9179, "Sudan", "Sawakin", 19.1038889, 37.3300018, 64.0},
-	{9180, "Sudan", "Tandalti", 13.0166667, 31.8666668, 409.0},
-	{9181, "Sudan", "Bor", 6.2072222, 31.5591660, 407.0},
-	{9182, "Sudan", "Ar Rahad", 12.7166667, 30.6499996, 490.0},
-	{9183, "Sudan", "Kinanah", 14.0333333, 33.1666679, 433.0},
-	{9184, "Sudan", "Al Hawatah", 13.4166667, 34.6333351, 431.0},
-	{9185, "Sudan", "Barbar", 18.0166667, 33.9833336, 334.0},
-	{9186, "Sudan", "Tawkar", 18.4333333, 37.7333336, 31.0},
-	{9187, "Sudan", "Abu Jubayhah", 11.4500000, 31.2333336, 564.0},
-	{9188, "Sudan", "Torit", 4.4133333, 32.5677795, 830.0},
-	{9189, "Sudan", "Al Mijlad", 11.0333333, 27.7333336, 428.0},
-	{9190, "Sudan", "Doka", 13.5166667, 35.7666664, 683.0},
-	{9191, "Sudan", "Kuraymah", 18.5500000, 31.8500004, 242.0},
-	{9192, "Sudan", "Al Bauga", 18.2666667, 33.9166679, 320.0},
-	{9193, "Sudan", "Al Qutaynah", 14.8666667, 32.3499985, 390.0},
-	{9194, "Sudan", "Al Hilaliyah", 14.9333333, 33.2333336, 400.0},
-	{9195, "Sudan", "Tonj", 7.2733333, 28.6838894, 428.0},
-	{9196, "Sudan", "Barah", 13.7000000, 30.3666668, 614.0},
-	{9197, "Sudan", "Abu Zabad", 12.3500000, 29.2500000, 544.0},
-	{9198, "Sudan", "Ad Dindar", 13.2000000, 34.1666679, 398.0},
-	{9199, "Sudan", "Maridi", 4.9147222, 29.4769440, 699.0},
-	{9200, "Sudan", "Talawdi", 10.6363889, 30.3797226, 500.0},
-	{9201, "Sudan", "Aroma", 15.8166667, 36.1333351, 459.0},
-	{9202, "Sudan", "Marabba", 12.3500000, 32.1833344, 394.0},
-	{9203, "Sudan", "Wagar", 16.1527778, 36.2041664, 452.0},
-	{9204, "Sudan", "Ad Dabbah", 18.0500000, 30.9500008, 267.0},
-	{9205, "Sudan", "El Matama", 16.7097222, 33.3538895, 379.0},
-	{9206, "Sudan", "Al Fulah", 11.8000000, 28.3999996, 543.0},
-	{9207, "Sudan", "Gebeit", 21.0666667, 36.3166656, 619.0},
-	{9208, "Sudan", "Al Lagowa", 11.4000000, 29.1333332, 557.0},
-	{9209, "Sudan", "Umm Kaddadah", 13.6000000, 26.7000008, 635.0},
-	{9210, "Sudan", "Ler", 8.3000000, 30.1333332, 382.0},
-	{9211, "Sudan", "Kurmuk", 10.5500000, 34.2833328, 651.0},
-	{9212, "Sudan", "Wad Rawah", 15.1602778, 33.1397209, 394.0},
-	{9213, "Sudan", "Marawi", 18.4833333, 31.8166676, 276.0},
-	{9214, "Sudan", "Al Kawah", 13.7333333, 32.5000000, 378.0},
-	{9215, "Sudan", "Kutum", 14.2000000, 24.6666660, 1128.0},
-	{9216, "Sudan", "Al Kereimet", 14.3500000, 32.9500008, 411.0},
-	{9217, "Sudan", "Tambura", 5.6000000, 27.4666672, 609.0},
-	{9218, "Sudan", "Galgani", 12.4500000, 34.1833344, 430.0},
-	{9219, "Sudan", "Al Musallamiyah", 14.5666667, 33.3499985, 407.0},
-	{9220, "Sudan", "Wad az Zaki", 14.4666667, 32.2166672, 379.0},
-	{9221, "Sudan", "Umm Jarr", 13.8166667, 32.4000015, 362.0},
-	{9222, "Sudan", "Argo", 19.5166667, 30.4166660, 222.0},
-	{9223, "Sudan", "Bentiu", 9.2333333, 29.8333340, 345.0},
-	{9224, "Sudan", "Kapoeta", 4.7716667, 33.5902786, 642.0},
-	{9225, "Sudan", "Karmah an Nuzul", 19.6333333, 30.4166660, 228.0},
-	{9226, "Sudan", "Raga", 8.4666667, 25.6833324, 604.0},
-	{9227, "Sudan", "Ombusi", 3.8894444, 30.6105556, 858.0},
-	{9228, "Suriname", "Paramaribo", 5.8333333, -55.1666679, 3.0},
-	{9229, "Suriname", "Lelydorp", 5.7000000, -55.2333336, 9.0},
-	{9230, "Suriname", "Nieuw Nickerie", 5.9500000, -56.9833336, 1.0},
-	{9231, "Suriname", "Moengo", 5.6166667, -54.4000015, 22.0},
-	{9232, "Suriname", "Nieuw Amsterdam", 5.8833333, -55.0833321, 1.0},
-	{9233, "Suriname", "Marienburg", 5.8833333, -55.0499992, 1.0},
-	{9234, "Suriname", "Wageningen", 5.7666667, -56.6833344, 2.0},
-	{9235, "Suriname", "Albina", 5.5000000, -54.0499992, 6.0},
-	{9236, "Suriname", "Groningen", 5.8000000, -55.4666672, 10.0},
-	{9237, "Suriname", "Brownsweg", 5.0166667, -55.1666679, 76.0},
-	{9238, "Suriname", "Onverwacht", 5.5833333, -55.1833344, 11.0},
-	{9239, "Suriname", "Totness", 5.8833333, -56.3166656, 1.0},
-	{9240, "Suriname", "Benzdorp (Monte Hermon}", 3.6720670, -54.1035461, 105.0},
-	{9241, "Suriname", "Kabana-vo", 3.6741067, -54.0848846, 101.0},
-	{9242, "Swaziland", "Manzini", -26.4833333, 31.3666668, 617.0},
-	{9243, "Swaziland", "Mbabane", -26.3166667, 31.1333332, 1156.0},
-	{9244, "Swaziland", "Big Bend", -26.8166667, 31.9333324, 138.0},
-	{9245, "Swaziland", "Malkerns", -26.5666667, 31.1833324, 731.0},
-	{9246, "Swaziland", "Mhlume", -26.0333333, 31.8500004, 273.0},
-	{9247, "Swaziland", "Hluti", -27.2166667, 31.6166668, 611.0},
-	{9248, "Swaziland", "Siteki", -26.4500000, 31.9500008, 620.0},
-	{9249, "Swaziland", "Piggs Peak", -25.9666667, 31.2500000, 1058.0},
-	{9250, "Swaziland", "Lobamba", -26.4666667, 31.2000008, 651.0},
-	{9251, "Swaziland", "Kwaluseni", -26.4833333, 31.3333340, 638.0},
-	{9252, "Swaziland", "Bhunya", -26.5500000, 31.0166664, 1019.0},
-	{9253, "Swaziland", "Mhlambanyatsi", -26.4500000, 31.0166664, 1266.0},
-	{9254, "Swaziland", "Hlatikulu", -26.9666667, 31.3166676, 1115.0},
-	{9255, "Swaziland", "Bulembu", -25.9666667, 31.1333332, 1028.0},
-	{9256, "Swaziland", "Kubuta", -26.8833333, 31.4833336, 555.0},
-	{9257, "Swaziland", "Tshaneni", -25.9833333, 31.7166672, 304.0},
-	{9258, "Swaziland", "Sidvokodvo", -26.6166667, 31.4500008, 341.0},
-	{9259, "Swaziland", "Lavumisa", -27.3166667, 31.8999996, 167.0},
-	{9260, "Swaziland", "Darkton", -26.2333333, 31.0333328, 1396.0},
-	{9261, "Swaziland", "Nsoko", -27.0333333, 31.9500008, 185.0},
-	{9262, "Sweden", "Stockholm", 59.3325765, 18.0649033, 20.0},
-	{9263, "Sweden", "Goteborg", 57.7071623, 11.9667931, 6.0},
-	{9264, "Sweden", "Malmoe", 55.6058691, 13.0007315, 10.0},
-	{9265, "Sweden", "Uppsala", 59.8585015, 17.6454277, 10.0},
-	{9266, "Sweden", "Kista", 59.4031612, 17.9447937, 24.0},
-	{9267, "Sweden", "Vasteras", 59.6161738, 16.5527592, 17.0},
-	{9268, "Sweden", "Orebro", 59.2741206, 15.2066011, 30.0},
-	{9269, "Sweden", "Linkoping", 58.4166667, 15.6166668, 33.0},
-	{9270, "Sweden", "Helsingborg", 56.0467266, 12.6943693, 28.0},
-	{9271, "Sweden", "Huddinge", 59.2370497, 17.9819164, 28.0},
-	{9272, "Sweden", "Jonkoping", 57.7814509, 14.1561842, 114.0},
-	{9273, "Sweden", "Norrkoping", 58.5941863, 16.1826038, 2.0},
-	{9274, "Sweden", "Lund", 55.7028025, 13.1927347, 47.0},
-	{9275, "Sweden", "Umea", 63.8284245, 20.2597218, 24.0},
-	{9276, "Sweden", "Gavle", 60.6745238, 17.1417408, 3.0},
-	{9277, "Sweden", "Boras", 57.7210078, 12.9401026, 166.0},
-	{9278, "Sweden", "Soedertaelje", 59.1955377, 17.6252460, 35.0},
-	{9279, "Sweden", "Vaexjoe", 56.8776684, 14.8090553, 185.0},
-	{9280, "Sweden", "Moelndal", 57.6553954, 12.0137749, 6.0},
-	{9281, "Sweden", "Karlstad", 59.3792996, 13.5035706, 49.0},
-	{9282, "Sweden", "Eskilstuna", 59.3666080, 16.5076981, 22.0},
-	{9283, "Sweden", "Sollentuna", 59.4280429, 17.9509315, 10.0},
-	{9284, "Sweden", "Taby", 59.4438970, 18.0687218, 8.0},
-	{9285, "Sweden", "Halmstad", 56.6744622, 12.8567610, 4.0},
-	{9286, "Sweden", "Sundsvall", 62.3912893, 17.3062992, 26.0},
-	{9287, "Sweden", "Lulea", 65.5841501, 22.1546497, 17.0},
-	{9288, "Sweden", "Trollhattan", 58.2836544, 12.2886372, 56.0},
-	{9289, "Sweden", "Ostersund", 63.1792043, 14.6356564, 328.0},
-	{9290, "Sweden", "Borlaenge", 60.4858035, 15.4371424, 124.0},
-	{9291, "Sweden", "Upplands-Vasby", 59.5204002, 17.9082718, 8.0},
-	{9292, "Sweden", "Falun", 60.6035655, 15.6259708, 97.0},
-	{9293, "Sweden", "Tumba", 59.1985759, 17.8331718, 48.0},
-	{9294, "Sweden", "Kalmar", 56.6615749, 16.3616257, 6.0},
-	{9295, "Sweden", "Sundbyberg", 59.3612775, 17.9711437, 27.0},
-	{9296, "Sweden", "Skoevde", 58.3911811, 13.8450584, 139.0},
-	{9297, "Sweden", "Karlskrona", 56.1615642, 15.5866060, 1.0},
-	{9298, "Sweden", "Kristianstad", 56.0312875, 14.1524181, 5.0},
-	{9299, "Sweden", "Lidingo", 59.3666667, 18.1333332, 22.0},
-	{9300, "Sweden", "Skelleftea", 64.7506673, 20.9527950, 34.0},
-	{9301, "Sweden", "Uddevalla", 58.3478392, 11.9423962, 25.0},
-	{9302, "Sweden", "Motala", 58.5370581, 15.0364857, 94.0},
-	{9303, "Sweden", "Majorna", 57.6919468, 11.9160461, 21.0},
-	{9304, "Sweden", "Partille", 57.7394984, 12.1064186, 29.0},
-	{9305, "Sweden", "Landskrona", 55.8707964, 12.8301640, 7.0},
-	{9306, "Sweden", "Ornskoldsvik", 63.2909140, 18.7152538, 1.0},
-	{9307, "Sweden", "Nykoping", 58.7530045, 17.0078773, 17.0},
-	{9308, "Sweden", "Karlskoga", 59.3266660, 14.5238609, 106.0},
-	{9309, "Sweden", "Akersberga", 59.4794352, 18.2996712, 10.0},
-	{9310, "Sweden", "Vallentuna", 59.5343615, 18.0775852, 15.0},
-	{9311, "Sweden", "Varberg", 57.1055683, 12.2507753, 31.0},
-	{9312, "Sweden", "Trelleborg", 55.3751360, 13.1569109, 9.0},
-	{9313, "Sweden", "Nacka", 59.3105270, 18.1637154, 57.0},
-	{9314, "Sweden", "Alingsas", 57.9303341, 12.5334473, 79.0},
-	{9315, "Sweden", "Lidkoping", 58.5051747, 13.1576509, 45.0},
-	{9316, "Sweden", "Jakobsberg", 59.4226784, 17.8350811, 27.0},
-	{9317, "Sweden", "Marsta", 59.6166667, 17.8500004, 24.0},
-	{9318, "Sweden", "Angelholm", 56.2428014, 12.8621902, 7.0},
-	{9319, "Sweden", "Sandviken", 60.6166667, 16.7666664, 66.0},
-	{9320, "Sweden", "Pitea", 65.3171748, 21.4794407, 6.0},
-	{9321, "Sweden", "Visby", 57.6408932, 18.2960243, 46.0},
-	{9322, "Sweden", "Vanersborg", 58.3807485, 12.3233986, 60.0},
-	{9323, "Sweden", "Kungalv", 57.8709620, 11.9805374, 52.0},
-	{9324, "Sweden", "Katrineholm", 58.9958694, 16.2072048, 51.0},
-	{9325, "Sweden", "Vaestervik", 57.7583974, 16.6373348, 5.0},
-	{9326, "Sweden", "Enkoping", 59.6360670, 17.0776787, 15.0},
-	{9327, "Sweden", "Kungsbacka", 57.4871855, 12.0761204, 17.0},
-	{9328, "Sweden", "Falkenberg", 56.9055168, 12.4911757, 3.0},
-	{9329, "Sweden", "Boden", 65.8251808, 21.6886425, 10.0},
-	{9330, "Sweden", "Karlshamn", 56.1706024, 14.8618841, 14.0},
-	{9331, "Sweden", "Kiruna", 67.8557214, 20.2251320, 579.0},
-	{9332, "Sweden", "Varnamo", 57.1860415, 14.0400124, 162.0},
-	{9333, "Sweden", "Kristinehamn", 59.3097768, 14.1080761, 43.0},
-	{9334, "Sweden", "Ystad", 55.4296588, 13.8204145, 8.0},
-	{9335, "Sweden", "Hassleholm", 56.1590549, 13.7663841, 77.0},
-	{9336, "Sweden", "Harnosand", 62.6322752, 17.9379387, 25.0},
-	{9337, "Sweden", "Norrtalje", 59.7579892, 18.7049637, 26.0},
-	{9338, "Sweden", "Koping", 59.5140379, 15.9925528, 22.0},
-	{9339, "Sweden", "Oskarshamn", 57.2645529, 16.4483681, 25.0},
-	{9340, "Sweden", "Nassjo", 57.6530707, 14.6967564, 307.0},
-	{9341, "Sweden", "Lerum", 57.7705068, 12.2690353, 48.0},
-	{9342, "Sweden", "Eslov", 55.8392774, 13.3039284, 56.0},
-	{9343, "Sweden", "Falkoeping", 58.1734749, 13.5506811, 229.0},
-	{9344, "Sweden", "Mariestad", 58.7097125, 13.8236656, 46.0},
-	{9345, "Sweden", "Molnlycke", 57.6589311, 12.1179199, 91.0},
-	{9346, "Sweden", "Hudiksvall", 61.7289748, 17.1035786, 2.0},
-	{9347, "Sweden", "Vasterhaninge", 59.1166667, 18.1000004, 39.0},
-	{9348, "Sweden", "Ljungby", 56.8332391, 13.9408245, 139.0},
-	{9349, "Sweden", "Kinna", 57.5072824, 12.6946268, 53.0},
-	{9350, "Sweden", "Staffanstorp", 55.6440561, 13.2081518, 13.0},
-	{9351, "Sweden", "Avesta", 60.1454465, 16.1678524, 90.0},
-	{9352, "Sweden", "Kaevlinge", 55.7918786, 13.1102085, 14.0},
-	{9353, "Sweden", "Tranas", 58.0371717, 14.9781952, 154.0},
-	{9354, "Sweden", "Arvika", 59.6552818, 12.5851822, 48.0},
-	{9355, "Sweden", "Ludvika", 60.1495907, 15.1877623, 143.0},
-	{9356, "Sweden", "Nynashamn", 58.9033657, 17.9479256, 1.0},
-	{9357, "Sweden", "Balsta", 59.5670983, 17.5278072, 47.0},
-	{9358, "Sweden", "Hoganas", 56.1997096, 12.5579519, 9.0},
-	{9359, "Sweden", "Skoghall", 59.3232395, 13.4655151, 50.0},
-	{9360, "Sweden", "Kumla", 59.1277032, 15.1434088, 40.0},
-	{9361, "Sweden", "Strangnas", 59.3774087, 17.0311909, 1.0},
-	{9362, "Switzerland", "Zurich", 47.3666667, 8.5500002, 472.0},
-	{9363, "Switzerland", "Geneve", 46.2022157, 6.1456919, 375.0},
-	{9364, "Switzerland", "Basel", 47.5666667, 7.5999999, 257.0},
-	{9365, "Switzerland", "Bern", 46.9480943, 7.4474430, 527.0},
-	{9366, "Switzerland", "Lausanne", 46.5159963, 6.6328239, 429.0},
-	{9367, "Switzerland", "Winterthur", 47.5000000, 8.7500000, 456.0},
-	{9368, "Switzerland", "Sankt Gallen", 47.4297131, 9.3761444, 671.0},
-	{9369, "Switzerland", "Luzern", 47.0504758, 8.3063507, 435.0},
-	{9370, "Switzerland", "Zuerich (Kreis 11}", 47.4232576, 8.5216589, 450.0},
-	{9371, "Switzerland", "Biel", 47.1324032, 7.2441101, 433.0},
-	{9372, "Switzerland", "Zuerich (Kreis 3}", 47.3578517, 8.5029612, 462.0},
-	{9373, "Switzerland", "Zuerich (Kreis 9}", 47.3824493, 8.4799318, 418.0},
-	{9374, "Switzerland", "Thun", 46.7500000, 7.6166668, 560.0},
-	{9375, "Switzerland", "Koniz", 46.9243588, 7.4145699, 606.0},
-	{9376, "Switzerland", "La Chaux-de-Fonds", 47.0999278, 6.8258572, 1034.0},
-	{9377, "Switzerland", "Zuerich (Kreis 10}", 47.4077332, 8.5004988, 540.0},
-	{9378, "Switzerland", "Rapperswil", 47.2255722, 8.8222790, 414.0},
-	{9379, "Switzerland", "Schaffhausen", 47.7000000, 8.6333332, 399.0},
-	{9380, "Switzerland", "Zuerich (Kreis 7}", 47.3732807, 8.5803766, 662.0},
-	{9381, "Switzerland", "Fribourg", 46.8000000, 7.1500001, 562.0},
-	{9382, "Switzerland", "Chur", 46.8498607, 9.5328712, 631.0},
-	{9383, "Switzerland", "Neuchatel", 47.0000000, 6.9666667, 429.0},
-	{9384, "Switzerland", "Vernier", 46.2170203, 6.0849667, 421.0},
-	{9385, "Switzerland", "Zuerich (Kreis 6}", 47.3922318, 8.5438070, 530.0},
-	{9386, "Switzerland", "Zuerich (Kreis 2}", 47.3375644, 8.5211010, 434.0},
-	{9387, "Switzerland", "Zuerich (Kreis 9} / Altstetten", 47.3894555, 8.4853287, 401.0},
-	{9388, "Switzerland", "Zuerich (Kreis 12}", 47.4037214, 8.5760794, 428.0},
-	{9389, "Switzerland", "Sion", 46.2333333, 7.3499999, 496.0},
-	{9390, "Switzerland", "Lancy", 46.1898136, 6.1144066, 394.0},
-	{9391, "Switzerland", "Aussersihl", 47.3775187, 8.5212688, 409.0},
-	{9392, "Switzerland", "Emmen", 47.0833333, 8.3000002, 433.0},
-	{9393, "Switzerland", "Lugano", 46.0100778, 8.9600372, 315.0},
-	{9394, "Switzerland", "Kriens", 47.0333333, 8.2833328, 474.0},
-	{9395, "Switzerland", "Yverdon-les-Bains", 46.7833333, 6.6500001, 459.0},
-	{9396, "Switzerland", "Zug", 47.1666667, 8.5166664, 503.0},
-	{9397, "Switzerland", "Uster", 47.3471260, 8.7209110, 478.0},
-	{9398, "Switzerland", "Le Chatelard", 46.4500000, 6.9166665, 822.0},
-	{9399, "Switzerland", "Montreux", 46.4330064, 6.9114304, 394.0},
-	{9400, "Switzerland", "Frauenfeld", 47.5500000, 8.8999996, 478.0},
-	{9401, "Switzerland", "Zuerich (Kreis 3} / Sihlfeld", 47.3738224, 8.5116386, 416.0},
-	{9402, "Switzerland", "Dietikon", 47.4016538, 8.4001455, 386.0},
-	{9403, "Switzerland", "Baar", 47.2000000, 8.5166664, 445.0},
-	{9404, "Switzerland", "Zuerich (Kreis 6} / Unterstrass", 47.3952963, 8.5372124, 485.0},
-	{9405, "Switzerland", "Dubendorf", 47.3972428, 8.6187162, 440.0},
-	{9406, "Switzerland", "Meyrin", 46.2284218, 6.0709763, 435.0},
-	{9407, "Switzerland", "Carouge", 46.1809595, 6.1392117, 412.0},
-	{9408, "Switzerland", "Wettingen", 47.4666667, 8.3166666, 420.0},
-	{9409, "Switzerland", "Allschwil", 47.5500000, 7.5333333, 311.0},
-	{9410, "Switzerland", "Zuerich (Kreis 11} / Oerlikon", 47.4082269, 8.5425758, 458.0},
-	{9411, "Switzerland", "Zuerich (Kreis 11} / Seebach", 47.4218142, 8.5477915, 430.0},
-	{9412, "Switzerland", "Renens", 46.5333333, 6.5833335, 411.0},
-	{9413, "Switzerland", "Kreuzlingen", 47.6500000, 9.1833334, 407.0},
-	{9414, "Switzerland", "Jona", 47.2298271, 8.8388443, 417.0},
-	{9415, "Switzerland", "Onex", 46.1833333, 6.0999999, 395.0},
-	{9416, "Switzerland", "Zuerich (Kreis 11} / Affoltern", 47.4181409, 8.5122004, 450.0},
-	{9417, "Switzerland", "Zuerich (Kreis 10} / Hoengg", 47.4031287, 8.4971046, 504.0},
-	{9418, "Switzerland", "Gossau", 47.4166667, 9.2500000, 669.0},
-	{9419, "Switzerland", "Muttenz", 47.5227073, 7.6451111, 363.0},
-	{9420, "Switzerland", "Wil", 47.4615225, 9.0455246, 575.0},
-	{9421, "Switzerland", "Nyon", 46.3831754, 6.2395477, 370.0},
-	{9422, "Switzerland", "Bellinzona", 46.1927844, 9.0170288, 228.0},
-	{9423, "Switzerland", "Zuerich (Kreis 9} / Albisrieden", 47.3739795, 8.4900742, 432.0},
-	{9424, "Switzerland", "Olten", 47.3500000, 7.9166665, 459.0},
-	{9425, "Switzerland", "Oberwinterthur (Kreis 2}", 47.5169195, 8.7686348, 463.0},
-	{9426, "Switzerland", "Kloten", 47.4515162, 8.5849066, 450.0},
-	{9427, "Switzerland", "Pully", 46.5102668, 6.6618347, 448.0},
-	{9428, "Switzerland", "Stadt Winterthur (Kreis 1}", 47.4949431, 8.7195454, 457.0},
-	{9429, "Switzerland", "Littau", 47.0500000, 8.2500000, 616.0},
-	{9430, "Switzerland", "Baden", 47.4733301, 8.3059216, 369.0},
-	{9431, "Switzerland", "Zuerich (Kreis 2} / Wollishofen", 47.3400952, 8.5313387, 426.0},
-	{9432, "Switzerland", "Horgen", 47.2557905, 8.6002722, 425.0},
-	{9433, "Switzerland", "Grenchen", 47.1921039, 7.3958588, 465.0},
-	{9434, "Switzerland", "Vevey", 46.4611568, 6.8432808, 412.0},
-	{9435, "Switzerland", "Zuerich (Kreis 10} / Wipkingen", 47.3950250, 8.5252914, 466.0},
-	{9436, "Switzerland", "Zuerich (Kreis 8}", 47.3547981, 8.5609732, 467.0},
-	{9437, "Switzerland", "Aarau", 47.3833333, 8.0500002, 406.0},
-	{9438, "Switzerland", "Herisau", 47.3861471, 9.2791557, 804.0},
-	{9439, "Switzerland", "Seen (Kreis 3}", 47.4764576, 8.7699652, 511.0},
-	{9440, "Switzerland", "Adliswil", 47.3099690, 8.5246248, 452.0},
-	{9441, "Switzerland", "Steffisburg", 46.7833333, 7.6333332, 589.0},
-	{9442, "Switzerland", "Monthey", 46.2500000, 6.9499998, 466.0},
-	{9443, "Switzerland", "Zuerich (Kreis 3} / Alt-Wiedikon", 47.3620094, 8.5149727, 420.0},
-	{9444, "Switzerland", "Pratteln", 47.5207076, 7.6935625, 292.0},
-	{9445, "Switzerland", "Solothurn", 47.2079077, 7.5371361, 435.0},
-	{9446, "Switzerland", "Sierre", 46.2919177, 7.5355911, 543.0},
-	{9447, "Switzerland", "Burgdorf", 47.0590134, 7.6278591, 592.0},
-	{9448, "Switzerland", "Martigny-Ville", 46.1027566, 7.0724487, 468.0},
-	{9449, "Switzerland", "Locarno", 46.1708559, 8.7995338, 196.0},
-	{9450, "Switzerland", "Freienbach", 47.2053421, 8.7584209, 411.0},
-	{9451, "Switzerland", "Langenthal", 47.2166667, 7.7833333, 468.0},
-	{9452, "Switzerland", "Schwyz", 47.0166667, 8.6499996, 564.0},
-	{9453, "Switzerland", "Binningen", 47.5402087, 7.5693226, 314.0},
-	{9454, "Switzerland", "Morges", 46.5112710, 6.4985418, 370.0},
-	{9455, "Switzerland", "Wohlen", 47.3666667, 8.2833328, 456.0},
-	{9456, "Switzerland", "Cham", 47.1833333, 8.4666672, 413.0},
-	{9457, "Switzerland", "Thalwil", 47.2917531, 8.5635052, 406.0},
-	{9458, "Switzerland", "Wadenswil", 47.2268320, 8.6687040, 406.0},
-	{9459, "Switzerland", "Bulach", 47.5219672, 8.5404949, 421.0},
-	{9460, "Switzerland", "Einsiedeln", 47.1166667, 8.7500000, 1036.0},
-	{9461, "Switzerland", "Thonex", 46.1881498, 6.1990356, 417.0},
-	{9462, "Syria", "Aleppo", 36.2027778, 37.1586113, 380.0},
-	{9463, "Syria", "Damascus", 33.5000000, 36.2999992, 692.0},
-	{9464, "Syria", "Hims", 34.7333333, 36.7166672, 510.0},
-	{9465, "Syria", "Hamah", 35.1333333, 36.7500000, 264.0},
-	{9466, "Syria", "Latakia", 35.5166667, 35.7833328, -9999.0},
-	{9467, "Syria", "Dayr az Zawr", 35.3333333, 40.1500015, 194.0},
-	{9468, "Syria", "Ar Raqqah", 35.9500000, 39.0166664, 226.0},
-	{9469, "Syria", "Al Bab", 36.3725000, 37.5177765, 472.0},
-	{9470, "Syria", "Idlib", 35.9297222, 36.6316681, 449.0},
-	{9471, "Syria", "Duma", 33.5833333, 36.4000015, 661.0},
-	{9472, "Syria", "As Safirah", 36.0666667, 37.3666649, 341.0},
-	{9473, "Syria", "Salamiyah", 35.0166667, 37.0499992, 463.0},
-	{9474, "Syria", "Tartus", 34.8833333, 35.8833351, -9999.0},
-	{9475, "Syria", "Ath Thawrah", 35.8366667, 38.5480537, 343.0},
-	{9476, "Syria", "Al Hasakah", 36.4833333, 40.7500000, 314.0},
-	{9477, "Syria", "Darayya", 33.4500000, 36.2500000, 690.0},
-	{9478, "Syria", "Manbij", 36.5266667, 37.9563904, 472.0},
-	{9479, "Syria", "Jablah", 35.3500000, 35.9166679, -9999.0},
-	{9480, "Syria", "Abu Kamal", 34.4500000, 40.9186096, 175.0},
-	{9481, "Syria", "At Tall", 33.6000000, 36.2999992, 914.0},
-	{9482, "Syria", "Al Mayadin", 35.0166667, 40.4500008, 193.0},
-	{9483, "Syria", "Ar Rastan", 34.9166667, 36.7333336, 394.0},
-	{9484, "Syria", "Tadmur", 34.5500000, 38.2833328, 411.0},
-	{9485, "Syria", "An Nabk", 34.0166667, 36.7333336, 1266.0},
-	{9486, "Syria", "Khan Shaykhun", 35.4333333, 36.6333351, 350.0},
-	{9487, "Syria", "`Afrin", 36.5166667, 36.8666649, 223.0},
-	{9488, "Syria", "`Irbin", 33.5333333, 36.3666649, 671.0},
-	{9489, "Syria", "Al Qusayr", 34.5166667, 36.5833321, 544.0},
-	{9490, "Syria", "Yabrud", 33.9666667, 36.6666679, 1367.0},
-	{9491, "Syria", "Jisr ash Shughur", 35.8155556, 36.3175011, 129.0},
-	{9492, "Syria", "Baniyas", 35.1833333, 35.9500008, 10.0},
-	{9493, "Syria", "Talbisah", 34.8333333, 36.7333336, 462.0},
-	{9494, "Syria", "Harasta al Basal", 33.5666667, 36.3666649, 686.0},
-	{9495, "Syria", "Al Qunaytirah", 33.1252778, 35.8236122, 928.0},
-	{9496, "Syria", "Tadif", 36.3333333, 37.5333328, 438.0},
-	{9497, "Syria", "Saraqib", 35.8636111, 36.8005562, 371.0},
-	{9498, "Syria", "Jayrud", 33.8166667, 36.7333336, 804.0},
-	{9499, "Syria", "Masyaf", 35.0500000, 36.3499985, 432.0},
-	{9500, "Syria", "Ma`arrat Misrin", 36.0166667, 36.6666679, 322.0},
-	{9501, "Syria", "Al Qaryatayn", 34.2333333, 37.2333336, 752.0},
-	{9502, "Syria", "Salqin", 36.1372222, 36.4541664, 448.0},
-	{9503, "Syria", "Tafas", 32.7380556, 36.0686111, 493.0},
-	{9504, "Syria", "Suran", 35.2833333, 36.7500000, 351.0},
-	{9505, "Syria", "Binnish", 35.9333333, 36.7166672, 352.0},
-	{9506, "Syria", "Qasim", 32.9833333, 36.0833321, 650.0},
-	{9507, "Syria", "Tall Kalakh", 34.6666667, 36.2500000, 313.0},
-	{9508, "Syria", "Az Zabadani", 33.7166667, 36.0833321, 1234.0},
-	{9509, "Syria", "Tayyibat al Imam", 35.2666667, 36.7166672, 329.0},
-	{9510, "Syria", "Hajin", 34.6897222, 40.8300018, 169.0},
-	{9511, "Syria", "Inkhil", 33.0000000, 36.1166649, 629.0},
-	{9512, "Syria", "Dayr Hafir", 36.1536111, 37.7066650, 355.0},
-	{9513, "Syria", "Safita", 34.8166667, 36.1166649, 349.0},
-	{9514, "Syria", "Shaykh Miskin", 32.8166667, 36.1500015, 529.0},
-	{9515, "Syria", "As Sanamayn", 33.0833333, 36.1666679, 641.0},
-	{9516, "Syria", "Tall Rif`at", 36.4666667, 37.0999985, 446.0},
-	{9517, "Syria", "Nubl", 36.3666667, 36.9833336, 457.0},
-	{9518, "Syria", "Subaykhan", 34.8544444, 40.6083336, 167.0},
-	{9519, "Syria", "Jarabulus", 36.8175000, 38.0111122, 356.0},
-	{9520, "Syria", "Kafr Nabl", 35.6000000, 36.5666656, 637.0},
-	{9521, "Syria", "Al Harak", 32.7333333, 36.3002777, 650.0},
-	{9522, "Syria", "Al Kiswah", 33.3500000, 36.2333336, 728.0},
-	{9523, "Syria", "Halfaya", 35.2666667, 36.5999985, 226.0},
-	{9524, "Syria", "Kafr Takharim", 36.1166667, 36.5166664, 587.0},
-	{9525, "Syria", "Ad Dana", 36.2166667, 36.7666664, 383.0},
-	{9526, "Syria", "Kafr Zayta", 35.3666667, 36.5833321, 289.0},
-	{9527, "Syria", "Kafr Lahah", 34.8833333, 36.5000000, 378.0},
-	{9528, "Syria", "Darbasiyah", 37.0700000, 40.6525002, 478.0},
-	{9529, "Syria", "Qarah", 34.1500000, 36.7333336, 1294.0},
-	{9530, "Syria", "Qatana", 33.4333333, 36.0833321, 875.0},
-	{9531, "Syria", "Al Qutayfah", 33.7500000, 36.5999985, 927.0},
-	{9532, "Syria", "Izra`", 32.8577778, 36.2544441, 599.0},
-	{9533, "Syria", "Salakhid", 32.8666667, 36.5666656, 875.0},
-	{9534, "Syria", "Duraykish", 34.8833333, 36.1333351, 339.0},
-	{9535, "Syria", "Mukharram al Fawqani", 34.8166667, 37.0833321, 602.0},
-	{9536, "Syria", "Ma`lula", 33.8333333, 36.5499992, 1296.0},
-	{9537, "Syria", "Busra ash Sham", 32.5166667, 36.4833336, 827.0},
-	{9538, "Tajikistan", "Dushanbe", 38.5600000, 68.7738876, 808.0},
-	{9539, "Tajikistan", "Khujand", 40.2800000, 69.6316681, 321.0},
-	{9540, "Tajikistan", "Kulob", 37.9091667, 69.7819443, 631.0},
-	{9541, "Tajikistan", "Qurghonteppa", 37.8363889, 68.7802811, 415.0},
-	{9542, "Tajikistan", "Istaravshan", 39.9108333, 69.0063858, 1013.0},
-	{9543, "Tajikistan", "Konibodom", 40.2833333, 70.4166641, 388.0},
-	{9544, "Tajikistan", "Kofarnihon", 38.5530556, 69.0197220, 881.0},
-	{9545, "Tajikistan", "Tursunzoda", 38.5108333, 68.2302780, 715.0},
-	{9546, "Tajikistan", "Isfara", 40.1166667, 70.6333313, 825.0},
-	{9547, "Tajikistan", "Panjakent", 39.5030556, 67.6149979, 964.0},
-	{9548, "Tajikistan", "Khorugh", 37.4916667, 71.5558319, 2152.0},
-	{9549, "Tajikistan", "Ishkashim", 36.7272222, 71.6116638, 2562.0},
-	{9550, "Tajikistan", "Hisor", 38.5247222, 68.5513916, 794.0},
-	{9551, "Tajikistan", "Boshkengash", 38.4647222, 68.8036118, 755.0},
-	{9552, "Tajikistan", "Danghara", 38.0963889, 69.3355560, 659.0},
-	{9553, "Tajikistan", "Farkhor", 37.4922222, 69.4016647, 469.0},
-	{9554, "Tajikistan", "Chkalovsk", 40.2386111, 69.6966629, 370.0},
-	{9555, "Tajikistan", "Chubek", 37.6127778, 69.7061081, 506.0},
-	{9556, "Tajikistan", "Kolkhozobod", 37.5894444, 68.6608353, 396.0},
-	{9557, "Tajikistan", "Norak", 38.3883333, 69.3249969, 670.0},
-	{9558, "Tajikistan", "Yovan", 38.3130556, 69.0430527, 699.0},
-	{9559, "Tajikistan", "Proletarsk", 40.1686111, 69.5083313, 354.0},
-	{9560, "Tajikistan", "Vakhsh", 37.7108333, 68.8311081, 425.0},
-	{9561, "Tajikistan", "Nov", 40.1538889, 69.3730545, 364.0},
-	{9562, "Tajikistan", "Adrasmon", 40.6486111, 69.9855576, 1625.0},
-	{9563, "Tajikistan", "Buston", 40.5216667, 69.3308334, 317.0},
-	{9564, "Tajikistan", "Shahrtuz", 37.2594444, 68.1347198, 343.0},
-	{9565, "Tajikistan", "Leningrad", 38.1077778, 70.0308304, 1231.0},
-	{9566, "Tajikistan", "Asht", 40.6666667, 70.3333359, 897.0},
-	{9567, "Tajikistan", "Taboshar", 40.5688889, 69.6402740, 1182.0},
-	{9568, "Tajikistan", "Murghob", 38.1688889, 73.9649963, 3641.0},
-	{9569, "Tajikistan", "Gharm", 39.0241667, 70.3794479, 1298.0},
-	{9570, "Tajikistan", "Obigarm", 38.7200000, 69.7094421, 1360.0},
-	{9571, "Tajikistan", "Kuybishevsk", 37.9500000, 68.8166656, 432.0},
-	{9572, "Tajikistan", "Khodzha-Maston", 38.7333333, 68.6166687, 1776.0},
-	{9573, "Tajikistan", "Dusty", 37.3486111, 68.6733322, 378.0},
-	{9574, "Tajikistan", "Sovety", 38.0458333, 69.5880585, 661.0},
-	{9575, "Tajikistan", "Garavuti", 37.5602778, 68.4524994, 385.0},
-	{9576, "Tajikistan", "Pakhtakoron", 40.1666667, 68.7500000, 373.0},
-	{9577, "Tajikistan", "Panj", 37.2383333, 69.0969467, 363.0},
-	{9578, "Tajikistan", "Roghun", 38.7811111, 69.8719406, 1306.0},
-	{9579, "Tajikistan", "Kirovskiy", 37.8166667, 68.8499985, 426.0},
-	{9580, "Tajikistan", "Orzu", 37.6000000, 68.8166656, 420.0},
-	{9581, "Tajikistan", "Jilikul", 37.4936111, 68.5311127, 348.0},
-	{9582, "Tajikistan", "Shakhrinav", 38.5736111, 68.3330536, 856.0},
-	{9583, "Tajikistan", "Novobod", 39.0077778, 70.1555557, 1234.0},
-	{9584, "Tajikistan", "Kaindisoy", 40.4927778, 69.7024994, 934.0},
-	{9585, "Tajikistan", "Oltintopkan", 40.6450000, 69.5966644, 1423.0},
-	{9586, "Tajikistan", "Nefteobod", 40.2000000, 70.5666656, 844.0},
-	{9587, "Tajikistan", "Chorbog", 37.4166667, 68.1999969, 427.0},
-	{9588, "Tajikistan", "Shurob", 40.0500000, 70.5500031, 1244.0},
-	{9589, "Tajikistan", "Vorukh", 39.8533333, 70.5769424, 1362.0},
-	{9590, "Tajikistan", "Palas", 40.3136111, 69.7444458, 332.0},
-	{9591, "Tajikistan", "Quruqsoy", 40.5786111, 69.3777771, 452.0},
-	{9592, "Tajikistan", "Karakenja", 39.2422222, 71.5261078, 2126.0},
-	{9593, "Tajikistan", "Tagob", 38.8411111, 68.9302750, 1735.0},
-	{9594, "Tajikistan", "Kim", 40.2000000, 70.4666672, 891.0},
-	{9595, "Tajikistan", "Tartki", 37.5833333, 68.1333313, 418.0},
-	{9596, "Tajikistan", "Varzob", 38.7758333, 68.8308334, 1286.0},
-	{9597, "Tajikistan", "Ayny", 39.3975000, 68.5405579, 1578.0},
-	{9598, "Tajikistan", "Komsomolobod", 38.8669444, 69.9622192, 1307.0},
-	{9599, "Tajikistan", "Naugarzan", 40.9333333, 70.5500031, 1377.0},
-	{9600, "Tanzania", "Dar es Salaam", -6.8000000, 39.2833328, -9999.0},
-	{9601, "Tanzania", "Mwanza", -2.5166667, 32.9000015, 1210.0},
-	{9602, "Tanzania", "Zanzibar", -6.1639369, 39.1979332, 2.0},
-	{9603, "Tanzania", "Arusha", -3.3666667, 36.6833344, 1265.0},
-	{9604, "Tanzania", "Mbeya", -8.9000000, 33.4500008, 1662.0},
-	{9605, "Tanzania", "Morogoro", -6.8166667, 37.6666679, 511.0},
-	{9606, "Tanzania", "Tanga", -5.0666667, 39.0999985, 1.0},
-	{9607, "Tanzania", "Dodoma", -6.1833333, 35.7500000, 1129.0},
-	{9608, "Tanzania", "Kigoma", -4.8769444, 29.6266670, 776.0},
-	{9609, "Tanzania", "Moshi", -3.3500000, 37.3333321, 888.0},
-	{9610, "Tanzania", "Tabora", -5.0166667, 32.7999992, 1213.0},
-	{9611, "Tanzania", "Songea", -10.6833333, 35.6500015, 1166.0},
-	{9612, "Tanzania", "Musoma", -1.5000000, 33.7999992, 1173.0},
-	{9613, "Tanzania", "Iringa", -7.7666667, 35.7000008, 1575.0},
-	{9614, "Tanzania", "Katumba", -9.2333333, 33.6166649, 1364.0},
-	{9615, "Tanzania", "Shinyanga", -3.6666667, 33.4333344, 1135.0},
-	{9616, "Tanzania", "Mtwara", -10.2666667, 40.1833344, 6.0},
-	{9617, "Tanzania", "Ushirombo", -3.4919444, 31.9638882, 1233.0},
-	{9618, "Tanzania", "Kilosa", -6.8333333, 36.9833336, 584.0},
-	{9619, "Tanzania", "Sumbawanga", -7.9666667, 31.6166668, 1769.0},
-	{9620, "Tanzania", "Bagamoyo", -6.4333333, 38.9000015, 1.0},
-	{9621, "Tanzania", "Mpanda", -6.3666667, 31.0333328, 1161.0},
-	{9622, "Tanzania", "Bukoba", -1.3316667, 31.8122215, 1160.0},
-	{9623, "Tanzania", "Singida", -4.8166667, 34.7500000, 1547.0},
-	{9624, "Tanzania", "Uyovu", -3.2833333, 31.5258331, 1233.0},
-	{9625, "Tanzania", "Makumbako", -8.8500000, 34.8333321, 1723.0},
-	{9626, "Tanzania", "Buseresere", -3.0236111, 31.8747215, 1238.0},
-	{9627, "Tanzania", "Bunda", -2.0500000, 33.8666649, 1142.0},
-	{9628, "Tanzania", "Merelani", -3.5618230, 36.9789505, 914.0},
-	{9629, "Tanzania", "Katoro", -3.0202778, 31.8958340, 1253.0},
-	{9630, "Tanzania", "Ifakara", -8.1333333, 36.6833344, 199.0},
-	{9631, "Tanzania", "Njombe", -9.3333333, 34.7666664, 1581.0},
-	{9632, "Tanzania", "Lindi", -10.0000000, 39.7166672, 1.0},
-	{9633, "Tanzania", "Vwawa", -9.1166667, 32.9333344, 1229.0},
-	{9634, "Tanzania", "Nguruka", -5.1091667, 31.0419445, 1063.0},
-	{9635, "Tanzania", "Newala Kisimani", -10.9500000, 39.2833328, 624.0},
-	{9636, "Tanzania", "Geiro", -6.1500000, 36.8666649, 1467.0},
-	{9637, "Tanzania", "Kidatu", -7.7000000, 36.9500008, 295.0},
-	{9638, "Tanzania", "Kasulu", -4.5766667, 30.1025009, 1459.0},
-	{9639, "Tanzania", "Tunduma", -9.3000000, 32.7666664, 1302.0},
-	{9640, "Tanzania", "Masasi", -10.7166667, 38.7999992, 504.0},
-	{9641, "Tanzania", "Kahama", -3.8333333, 32.5999985, 1220.0},
-	{9642, "Tanzania", "Kidodi", -7.6000000, 36.9833336, 472.0},
-	{9643, "Tanzania", "Igunga", -4.2833333, 33.8833351, 1083.0},
-	{9644, "Tanzania", "Missungwi", -2.8500000, 33.0833321, 1150.0},
-	{9645, "Tanzania", "Mlimba", -8.8000000, 35.8166656, 304.0},
-	{9646, "Tanzania", "Mafinga", -7.2500000, 35.0666656, 907.0},
-	{9647, "Tanzania", "Masumbwe", -3.6333333, 32.1833344, 1198.0},
-	{9648, "Tanzania", "Chalinze", -6.6000000, 38.3499985, 157.0},
-	{9649, "Tanzania", "Babati", -4.2166667, 35.7500000, 1392.0},
-	{9650, "Tanzania", "Biharamulo", -2.6319444, 31.3088894, 1492.0},
-	{9651, "Tanzania", "Somanda", -3.3666667, 33.9500008, 1224.0},
-	{9652, "Tanzania", "Bariadi", -2.8000000, 33.9833336, 1272.0},
-	{9653, "Tanzania", "Kirando", -7.4166667, 30.6000004, 769.0},
-	{9654, "Tanzania", "Tarime", -1.3500000, 34.3666649, 1531.0},
-	{9655, "Tanzania", "Tumbi", -5.0666667, 32.7333336, 1160.0},
-	{9656, "Tanzania", "Bugarama", -2.8705556, 30.5280552, 1471.0},
-	{9657, "Tanzania", "Mvomero", -6.2500000, 38.6666679, 54.0},
-	{9658, "Tanzania", "Chanika", -5.4166667, 38.0166664, 722.0},
-	{9659, "Tanzania", "Kyela", -9.5833333, 33.8499985, 505.0},
-	{9660, "Tanzania", "Kibiti", -7.7333333, 38.9500008, 152.0},
-	{9661, "Tanzania", "Kisesa", -3.0833333, 34.1500015, 1356.0},
-	{9662, "Tanzania", "Lukuledi", -10.5666667, 38.7999992, 310.0},
-	{9663, "Tanzania", "Mlandizi", -6.7000000, 38.7333336, 73.0},
-	{9664, "Tanzania", "Rujewa", -8.7000000, 34.3833351, 1138.0},
-	{9665, "Tanzania", "Ilula", -7.6833333, 36.0333328, 1505.0},
-	{9666, "Tanzania", "Kibondo", -3.5863889, 30.7202778, 1456.0},
-	{9667, "Tanzania", "Tukuyu", -9.2500000, 33.6500015, 1297.0},
-	{9668, "Tanzania", "Namanyere", -7.5166667, 31.0499992, 1588.0},
-	{9669, "Tanzania", "Mkuranga", -7.1166667, 39.2000008, 48.0},
-	{9670, "Tanzania", "Bungu", -7.6333333, 39.0499992, 153.0},
-	{9671, "Tanzania", "Chato", -2.6377778, 31.7669449, 1138.0},
-	{9672, "Tanzania", "Kasamwa", -2.8500000, 32.4333344, 1227.0},
-	{9673, "Tanzania", "Sikonge", -5.6333333, 32.7666664, 1167.0},
-	{9674, "Tanzania", "Malinyi", -8.9333333, 36.1333351, 275.0},
-	{9675, "Tanzania", "Mtinko", -4.5500000, 34.8499985, 1588.0},
-	{9676, "Tanzania", "Nzega", -4.2166667, 33.1833344, 1210.0},
-	{9677, "Tanzania", "Maramba", -5.0500000, 38.6166649, 877.0},
-	{9678, "Tanzania", "Wete", -5.0666667, 39.7166672, -9999.0},
-	{9679, "Tanzania", "Magugu", -4.0166667, 35.7666664, 1177.0},
-	{9680, "Tanzania", "Sokoni", -6.4333333, 39.5499992, 1.0},
-	{9681, "Tanzania", "Sepuka", -4.7500000, 34.5333328, 1494.0},
-	{9682, "Tanzania", "Mbinga", -10.9333333, 35.0166664, 1483.0},
-	{9683, "Tanzania", "Luchingu", -10.9000000, 39.3333321, 587.0},
-	{9684, "Tanzania", "Igurusi", -8.8500000, 33.8499985, 1291.0},
-	{9685, "Tanzania", "Shelui", -4.3666667, 34.2000008, 1080.0},
-	{9686, "Tanzania", "Chimala", -8.8500000, 34.0166664, 1201.0},
-	{9687, "Tanzania", "Kakonko", -3.2827778, 30.9641666, 1497.0},
-	{9688, "Tanzania", "Uvinza", -5.1036111, 30.3911114, 971.0},
-	{9689, "Tanzania", "Nyalikungu", -3.1833333, 33.7833328, 1374.0},
-	{9690, "Tanzania", "Sirari", -1.2333333, 34.4333344, 1518.0},
-	{9691, "Tanzania", "Lushoto", -4.7833333, 38.2833328, 1498.0},
-	{9692, "Tanzania", "Matai", -8.3000000, 31.5166664, 1670.0},
-	{9693, "Tanzania", "Old Shinyanga", -3.5500000, 33.4000015, 1187.0},
-	{9694, "Tanzania", "Urambo", -5.0666667, 32.0499992, 1109.0},
-	{9695, "Tanzania", "Mgandu", -5.9500000, 34.1333351, 1454.0},
-	{9696, "Tanzania", "Kibaha", -6.7666667, 38.9166679, 156.0},
-	{9697, "Tanzania", "Songwa", -3.5166667, 33.5166664, 1157.0},
-	{9698, "Tanzania", "Kiomboi", -4.2666667, 34.3666649, 1528.0},
-	{9699, "Tanzania", "Kondoa", -4.9000000, 35.7833328, 1400.0},
-	{9700, "Thailand", "Bangkok", 13.7500000, 100.5166702, 2.0},
-	{9701, "Thailand", "Samut Prakan", 13.5993364, 100.5967560, 2.0},
-	{9702, "Thailand", "Udon Thani", 17.4075000, 102.7930527, 175.0},
-	{9703, "Thailand", "Chon Buri", 13.3666667, 100.9833298, -9999.0},
-	{9704, "Thailand", "Nakhon Ratchasima", 14.9706567, 102.1019516, 183.0},
-	{9705, "Thailand", "Chiang Mai", 18.7902778, 98.9816666, 312.0},
-	{9706, "Thailand", "Hat Yai", 7.0166667, 100.4666672, 71.0},
-	{9707, "Thailand", "Pak Kret", 13.9166667, 100.5000000, 2.0},
-	{9708, "Thailand", "Si Racha", 13.1666667, 100.9333344, -9999.0},
-	{9709, "Thailand", "Phra Pradaeng", 13.6666667, 100.5333328, 2.0},
-	{9710, "Thailand", "Lampang", 18.2983333, 99.5072250, 224.0},
-	{9711, "Thailand", "Khon Kaen", 16.4333333, 102.8333359, 162.0},
-	{9712, "Thailand", "Surat Thani", 9.1333333, 99.3166656, 2.0},
-	{9713, "Thailand", "Ban Rangsit", 14.0166667, 100.7333298, 10.0},
-	{9714, "Thailand", "Ubon Ratchathani", 15.2330556, 104.8630524, 128.0},
-	{9715, "Thailand", "Nakhon Si Thammarat", 8.4333333, 99.9666672, 10.0},
-	{9716, "Thailand", "Changwat Rayong", 12.6833333, 101.2833328, 8.0},
-	{9717, "Thailand", "Khlong Luang", 14.0666667, 100.6500015, 8.0},
-	{9718, "Thailand", "Nakhon Pathom", 13.8166667, 100.0500031, 4.0},
-	{9719, "Thailand", "Phitsanulok", 16.8333333, 100.2500000, 41.0},
-	{9720, "Thailand", "Chanthaburi", 12.6000000, 102.1500015, 6.0},
-	{9721, "Thailand", "Ban Phattha Ya", 12.9333333, 100.8833313, 8.0},
-	{9722, "Thailand", "Yala", 6.5427778, 101.2836075, 33.0},
-	{9723, "Thailand", "Ratchaburi", 13.5333333, 99.8000031, 6.0},
-	{9724, "Thailand", "Phuket", 7.8833333, 98.4000015, 9.0},
-	{9725, "Thailand", "Ban Mai", 7.2000000, 100.5500031, 181.0},
-	{9726, "Thailand", "Phra Nakhon Si Ayutthaya", 14.3500000, 100.5500031, 14.0},
-	{9727, "Thailand", "Chiang Rai", 19.9000000, 99.8333359, 386.0},
-	{9728, "Thailand", "Bang Kruai", 13.8000000, 100.4833298, 2.0},
-	{9729, "Thailand", "Sakon Nakhon", 17.1666667, 104.1500015, 174.0},
-	{9730, "Thailand", "Krathum Baen", 13.6500000, 100.2666702, 2.0},
-	{9731, "Thailand", "Trang", 7.5563344, 99.6114044, 33.0},
-	{9732, "Thailand", "Sattahip", 12.6666667, 100.9000015, 13.0},
-	{9733, "Thailand", "Kanchanaburi", 14.0041160, 99.5483246, 21.0},
-	{9734, "Thailand", "Nong Khai", 17.8761111, 102.7444458, 164.0},
-	{9735, "Thailand", "Samut Sakhon", 13.5333333, 100.2833328, 2.0},
-	{9736, "Thailand", "Ban Lam Luk Ka", 13.9773817, 100.7777634, 2.0},
-	{9737, "Thailand", "Kamphaeng Phet", 16.4666667, 99.5000000, 72.0},
-	{9738, "Thailand", "Chaiyaphum", 15.8000000, 102.0333328, 198.0},
-	{9739, "Thailand", "Uttaradit", 17.6311111, 100.1008301, 75.0},
-	{9740, "Thailand", "Lop Buri", 14.8000000, 100.6166687, 6.0},
-	{9741, "Thailand", "Ban Pong", 13.8166667, 99.8833313, 19.0},
-	{9742, "Thailand", "Phra Phutthabat", 14.7166667, 100.8000031, 51.0},
-	{9743, "Thailand", "Chumphon", 10.5000000, 99.1666641, 11.0},
-	{9744, "Thailand", "Klaeng", 12.7833333, 101.6500015, 9.0},
-	{9745, "Thailand", "Kalasin", 16.4333333, 103.5000000, 152.0},
-	{9746, "Thailand", "Suphan Buri", 14.4666667, 100.1166687, 10.0},
-	{9747, "Thailand", "Tha Maka", 13.9000000, 99.7666702, 20.0},
-	{9748, "Thailand", "Maha Sarakham", 16.1833333, 103.3000031, 143.0},
-	{9749, "Thailand", "Phetchabun", 16.4190435, 101.1605606, 122.0},
-	{9750, "Thailand", "Hua Hin", 12.5706485, 99.9587631, -9999.0},
-	{9751, "Thailand", "Chachoengsao", 13.7000000, 101.0833359, 2.0},
-	{9752, "Thailand", "Cha-am", 12.8000000, 99.9666672, 8.0},
-	{9753, "Thailand", "Pak Chong", 14.7041667, 101.4130554, 319.0},
-	{9754, "Thailand", "Narathiwat", 6.4244444, 101.8236084, 1.0},
-	{9755, "Thailand", "Pran Buri", 12.3833333, 99.9166641, 3.0},
-	{9756, "Thailand", "Surin", 14.8833333, 103.4833298, 158.0},
-	{9757, "Thailand", "Phetchaburi", 13.1000000, 99.9499969, 5.0},
-	{9758, "Thailand", "Chum Phae", 16.5333333, 102.0999985, 217.0},
-	{9759, "Thailand", "Sadao", 6.6333333, 100.4333344, 71.0},
-	{9760, "Thailand", "Si Sa Ket", 15.1166667, 104.3333359, 120.0},
-	{9761, "Thailand", "Mae Sot", 16.7166667, 98.5666656, 208.0},
-	{9762, "Thailand", "Phatthalung", 7.6166667, 100.0833359, 77.0},
-	{9763, "Thailand", "Warin Chamrap", 15.2000000, 104.8833313, 138.0},
-	{9764, "Thailand", "Sungai Kolok", 6.0266667, 101.9677811, 54.0},
-	{9765, "Thailand", "Tha Yang", 12.9577209, 99.9055481, 13.0},
-	{9766, "Thailand", "Ban Phaeo", 13.5833333, 100.1166687, 2.0},
-	{9767, "Thailand", "Bang Bua Thong", 13.9166667, 100.4333344, 2.0},
-	{9768, "Thailand", "Nong Khae", 14.3333333, 100.8666687, 8.0},
-	{9769, "Thailand", "Mukdahan", 16.5333333, 104.7166672, 154.0},
-	{9770, "Thailand", "Ban Na", 17.0305556, 99.7525024, 54.0},
-	{9771, "Thailand", "Ko Samui", 9.5342254, 99.9353714, 17.0},
-	{9772, "Thailand", "Bang Lamung", 13.0470378, 100.9289093, 4.0},
-	{9773, "Thailand", "Roi Et", 16.0567014, 103.6530838, 149.0},
-	{9774, "Thailand", "Wichian Buri", 15.6588889, 101.1125031, 107.0},
-	{9775, "Thailand", "Phrae", 18.1500000, 100.1333313, 154.0},
-	{9776, "Thailand", "Ban Mae Chan", 20.1472222, 99.8538895, 420.0},
-	{9777, "Thailand", "Sam Phran", 13.7166667, 100.2166672, 2.0},
-	{9778, "Thailand", "Kantharalak", 14.6500000, 104.6500015, 147.0},
-	{9779, "Thailand", "Photharam", 13.6833333, 99.8499985, 8.0},
-	{9780, "Thailand", "Phichit", 16.4333333, 100.3666687, 42.0},
-	{9781, "Thailand", "Det Udom", 14.9050000, 105.0772247, 140.0},
-	{9782, "Thailand", "Ban Phai", 16.0666667, 102.7333298, 173.0},
-	{9783, "Thailand", "Amphoe Tha Bo", 17.8450000, 102.5841675, 149.0},
-	{9784, "Thailand", "Loei", 17.4833333, 101.7166672, 254.0},
-	{9785, "Thailand", "Samut Songkhram", 13.4000000, 100.0000000, 5.0},
-	{9786, "Thailand", "Kathu", 7.9166667, 98.3499985, 159.0},
-	{9787, "Thailand", "Amnat Charoen", 15.8500000, 104.6333313, 149.0},
-	{9788, "Thailand", "Nakhon Phanom", 17.4000000, 104.7833328, 140.0},
-	{9789, "Thailand", "Satun", 6.6166667, 100.0666656, 18.0},
-	{9790, "Thailand", "Prachuap Khiri Khan", 11.8209811, 99.7840958, 13.0},
-	{9791, "Thailand", "San Kamphaeng", 18.7422222, 99.1224976, 296.0},
-	{9792, "Thailand", "Prachin Buri", 14.0502778, 101.3702774, 23.0},
-	{9793, "Thailand", "Krabi", 8.0666667, 98.9166641, 2.0},
-	{9794, "Thailand", "Ban Bang Sao Thong", 13.6666667, 100.8166656, 2.0},
-	{9795, "Thailand", "Yaring", 6.8616667, 101.3649979, 1.0},
-	{9796, "Thailand", "Amphoe Na Klang", 17.3075000, 102.1794434, 254.0},
-	{9797, "Thailand", "Kaeng Khoi", 14.5880556, 101.0030594, 27.0},
-	{9798, "Thailand", "Betong", 5.7500000, 101.0833359, 284.0},
-	{9799, "Thailand", "Bang Phae", 13.7000000, 99.9333344, 6.0},
-	{9800, "Togo", "Lome", 6.1319444, 1.2227778, 64.0},
-	{9801, "Togo", "Sokode", 8.9833333, 1.1333333, 417.0},
-	{9802, "Togo", "Kara", 9.5511111, 1.1861111, 321.0},
-	{9803, "Togo", "Kpalime", 6.9000000, 0.6333333, 246.0},
-	{9804, "Togo", "Atakpame", 7.5333333, 1.1333333, 425.0},
-	{9805, "Togo", "Bassar", 9.2500000, 0.7833333, 463.0},
-	{9806, "Togo", "Tsevie", 6.4261111, 1.2133332, 77.0},
-	{9807, "Togo", "Aneho", 6.2333333, 1.6000000, 64.0},
-	{9808, "Togo", "Sansanne-Mango", 10.3591667, 0.4708333, 143.0},
-	{9809, "Togo", "Dapaong", 10.8638889, 0.2050000, 305.0},
-	{9810, "Togo", "Tchamba", 9.0333333, 1.4166667, 320.0},
-	{9811, "Togo", "Niamtougou", 9.7680556, 1.1052778, 391.0},
-	{9812, "Togo", "Bafilo", 9.3500000, 1.2666667, 454.0},
-	{9813, "Togo", "Notse", 6.9500000, 1.1666667, 147.0},
-	{9814, "Togo", "Sotouboua", 8.5666667, 0.9833333, 374.0},
-	{9815, "Togo", "Vogan", 6.3333333, 1.5333333, 67.0},
-	{9816, "Togo", "Badou", 7.5833333, 0.6000000, 361.0},
-	{9817, "Togo", "Tabligbo", 6.5833333, 1.5000000, 76.0},
-	{9818, "Togo", "Kande", 9.9577778, 1.0447222, 309.0},
-	{9819, "Togo", "Amlame", 7.4666667, 0.9000000, 489.0},
-	{9820, "Togo", "Pagouda", 9.7525000, 1.3277777, 455.0},
-	{9821, "Tonga", "Nuku`alofa", -21.1333333, -175.1999969, -9999.0},
-	{9822, "Tonga", "Neiafu", -18.6500000, -173.9833374, 1.0},
-	{9823, "Tonga", "Havelu", -21.1500000, -175.2166595, 1.0},
-	{9824, "Tonga", "Vaini", -21.2000000, -175.1999969, 1.0},
-	{9825, "Tonga", "Pangai", -19.8000000, -174.3500061, -9999.0},
-	{9826, "Tonga", "`Ohonua", -21.3333333, -174.9499969, 11.0},
-	{9827, "Tonga", "Hihifo", -15.9500000, -173.7500000, 1.0},
-	{9828, "Tunisia", "Tunis", 36.8027778, 10.1797218, 13.0},
-	{9829, "Tunisia", "Sfax", 34.7405556, 10.7602777, 13.0},
-	{9830, "Tunisia", "Sousse", 35.8255556, 10.6411114, 25.0},
-	{9831, "Tunisia", "Midoun", 33.8083333, 10.9938889, 18.0},
-	{9832, "Tunisia", "Kairouan", 35.6744444, 10.1016665, 67.0},
-	{9833, "Tunisia", "Bizerte", 37.2744444, 9.8738890, 33.0},
-	{9834, "Tunisia", "Gabes", 33.8833333, 10.1166668, 1.0},
-	{9835, "Tunisia", "Kasserine", 35.1672222, 8.8288889, 657.0},
-	{9836, "Tunisia", "Gafsa", 34.4250000, 8.7841663, 298.0},
-	{9837, "Tunisia", "La Goulette", 36.8180556, 10.3050003, 1.0},
-	{9838, "Tunisia", "Zarzis", 33.5000000, 11.1166668, 4.0},
-	{9839, "Tunisia", "Monastir", 35.7833333, 10.8333330, 1.0},
-	{9840, "Tunisia", "La Mohammedia", 36.6769444, 10.1555557, 79.0},
-	{9841, "Tunisia", "Al Marsa", 36.8781788, 10.3246593, 8.0},
-	{9842, "Tunisia", "Masakin", 35.7333333, 10.5833330, 44.0},
-	{9843, "Tunisia", "Saqanis", 35.7833333, 10.8000002, 26.0},
-	{9844, "Tunisia", "Houmt Souk", 33.8747222, 10.8591671, 7.0},
-	{9845, "Tunisia", "Tataouine", 32.9333333, 10.4499998, 247.0},
-	{9846, "Tunisia", "Douane", 36.4447222, 10.7508335, 1.0},
-	{9847, "Tunisia", "Beja", 36.7333333, 9.1833334, 222.0},
-	{9848, "Tunisia", "Al Hammamat", 36.4000000, 10.6166668, -9999.0},
-	{9849, "Tunisia", "Jendouba", 36.5011111, 8.7794447, 141.0},
-	{9850, "Tunisia", "El Kef", 36.1822222, 8.7147226, 684.0},
-	{9851, "Tunisia", "Hammam-Lif", 36.7330556, 10.3361111, 1.0},
-	{9852, "Tunisia", "Oued Lill", 36.8341667, 10.0422220, 65.0},
-	{9853, "Tunisia", "Menzel Bourguiba", 37.1558333, 9.7936115, 32.0},
-	{9854, "Tunisia", "Zouila", 35.5005556, 11.0605555, 13.0},
-	{9855, "Tunisia", "Rades", 36.7680556, 10.2752781, 4.0},
-	{9856, "Tunisia", "Sidi Bouzid", 35.0402778, 9.4936113, 313.0},
-	{9857, "Tunisia", "Al Metlaoui", 34.3333333, 8.3999996, 231.0},
-	{9858, "Tunisia", "Jammal", 35.6333333, 10.7666664, 24.0},
-	{9859, "Tunisia", "Qasr Hallal", 35.6500000, 10.8999996, 21.0},
-	{9860, "Tunisia", "El Hamma", 33.9000000, 9.8000002, 59.0},
-	{9861, "Tunisia", "Tozeur", 33.9205556, 8.1333332, 42.0},
-	{9862, "Tunisia", "Dar Chabanne", 36.4694444, 10.7511110, 19.0},
-	{9863, "Tunisia", "Hammam Sousse", 35.8588889, 10.5938892, 5.0},
-	{9864, "Tunisia", "Al Qarmadah", 34.7500000, 10.7833328, -9999.0},
-	{9865, "Tunisia", "Korba", 36.5786111, 10.8586111, 1.0},
-	{9866, "Tunisia", "La Sebala du Mornag", 36.6816667, 10.2888889, 26.0},
-	{9867, "Tunisia", "Mateur", 37.0400000, 9.6663885, 51.0},
-	{9868, "Tunisia", "Ar Rudayyif", 34.3833333, 8.1499996, 575.0},
-	{9869, "Tunisia", "Douz", 33.4575000, 9.0216665, 64.0},
-	{9870, "Tunisia", "Ksour Essaf", 35.4202778, 10.9980555, 1.0},
-	{9871, "Tunisia", "Siliana", 36.0833333, 9.3666668, 420.0},
-	{9872, "Tunisia", "Manouba", 36.8077778, 10.1011114, 32.0},
-	{9873, "Tunisia", "Nefta", 33.8741667, 7.8797221, 43.0},
-	{9874, "Tunisia", "Chebba", 35.2372222, 11.1149998, 14.0},
-	{9875, "Tunisia", "Menzel Jemil", 37.2358333, 9.9174995, 50.0},
-	{9876, "Tunisia", "Taklisah", 36.7833333, 10.6333332, 103.0},
-	{9877, "Tunisia", "Majaz al Bab", 36.6500000, 9.6166668, 42.0},
-	{9878, "Tunisia", "El Jem", 35.3000000, 10.7166672, 107.0},
-	{9879, "Tunisia", "Akouda", 35.8708333, 10.5683336, 13.0},
-	{9880, "Tunisia", "Kebili", 33.7019444, 8.9736109, 49.0},
-	{9881, "Tunisia", "Tajerouine", 35.8913889, 8.5555553, 688.0},
-	{9882, "Tunisia", "Dawwar Tinjah", 37.1666667, 9.7500000, 66.0},
-	{9883, "Tunisia", "Al Wardanin", 35.7166667, 10.6666670, 54.0},
-	{9884, "Tunisia", "El Fahs", 36.3669444, 9.9049997, 170.0},
-	{9885, "Tunisia", "Beni Khiar", 36.4691667, 10.7822218, 33.0},
-	{9886, "Tunisia", "Zaghouan", 36.4000000, 10.1499996, 198.0},
-	{9887, "Tunisia", "Manzil Bu Zalafah", 36.6833333, 10.5833330, 43.0},
-	{9888, "Tunisia", "Al `Aliyah", 37.1666667, 10.0333328, 54.0},
-	{9889, "Tunisia", "Thala", 35.5744444, 8.6722221, 969.0},
-	{9890, "Tunisia", "Al Baqalitah", 35.6166667, 11.0000000, 13.0},
-	{9891, "Tunisia", "Menzel Abderhaman", 37.2336111, 9.8633337, 47.0},
-	{9892, "Tunisia", "Maktar", 35.8605556, 9.2058334, 908.0},
-	{9893, "Tunisia", "Sahline", 35.7533333, 10.7116671, 15.0},
-	{9894, "Tunisia", "As Sayyadah", 35.6666667, 10.8999996, 1.0},
-	{9895, "Tunisia", "Tabarka", 36.9544444, 8.7580557, 79.0},
-	{9896, "Tunisia", "Tastur", 36.5500000, 9.4499998, 74.0},
-	{9897, "Tunisia", "Bin Qirdan", 33.1333333, 11.2166672, 9.0},
-	{9898, "Tunisia", "Tabursuq", 36.4666667, 9.2500000, 350.0},
-	{9899, "Tunisia", "Banbalah", 35.7000000, 10.8000002, 30.0},
-	{9900, "Tunisia", "Bu `Aradah", 36.3500000, 9.6166668, 273.0},
-	{9901, "Tunisia", "Qusaybat al Madyuni", 35.6833333, 10.8500004, 6.0},
-	{9902, "Tunisia", "Bani Khallad", 36.6500000, 10.6000004, 70.0},
-	{9903, "Tunisia", "As Sars", 36.0833333, 9.0166664, 487.0},
-	{9904, "Tunisia", "Qa`fur", 36.3333333, 9.3166666, 286.0},
-	{9905, "Tunisia", "Bu `Urqub", 36.5333333, 10.5500002, 77.0},
-	{9906, "Tunisia", "Rafraf", 37.1833333, 10.1833334, 246.0},
-	{9907, "Tunisia", "Skhira", 34.2833333, 10.1000004, -9999.0},
-	{9908, "Tunisia", "Sidi Bou Ali", 35.9566667, 10.4730558, 8.0},
-	{9909, "Tunisia", "Manzil Kamil", 35.6333333, 10.6666670, 48.0},
-	{9910, "Tunisia", "Bani Hassan", 35.5666667, 10.8166666, 58.0},
-	{9911, "Tunisia", "Degache", 33.9858333, 8.2202778, 74.0},
-	{9912, "Tunisia", "As Sanad", 34.4666667, 9.2666664, 642.0},
-	{9913, "Tunisia", "Haffouz", 35.6350000, 9.6769447, 278.0},
-	{9914, "Tunisia", "Al Karib", 36.3333333, 9.1333332, 507.0},
-	{9915, "Tunisia", "Jabinyanah", 35.0333333, 10.9166670, 31.0},
-	{9916, "Tunisia", "El Golaa", 33.4833333, 9.0036116, 51.0},
-	{9917, "Tunisia", "Sbikha", 35.9338889, 10.0208330, 83.0},
-	{9918, "Tunisia", "Sidi `Ulwan", 35.3833333, 10.9333334, 52.0},
-	{9919, "Tunisia", "El Maamoura", 36.4672222, 10.8058329, 20.0},
-	{9920, "Tunisia", "Harqalah", 36.0333333, 10.5000000, 1.0},
-	{9921, "Tunisia", "Ar Riqab", 34.8666667, 9.7833328, 201.0},
-	{9922, "Tunisia", "Zawiyat al Jadidi", 36.6500000, 10.5833330, 44.0},
-	{9923, "Tunisia", "Sakiet Sidi Youssef", 36.2247222, 8.3561115, 822.0},
-	{9924, "Tunisia", "Mellouleche", 35.1666667, 11.0363894, 6.0},
-	{9925, "Tunisia", "Shurban", 35.2833333, 10.3833332, 98.0},
-	{9926, "Tunisia", "Sbiba", 35.5455556, 9.0802774, 643.0},
-	{9927, "Tunisia", "Jemna", 33.5777778, 9.0147219, 37.0},
-	{9928, "Turkey", "Istanbul", 41.0138430, 28.9496613, 28.0},
-	{9929, "Turkey", "Ankara", 39.9272222, 32.8644447, 889.0},
-	{9930, "Turkey", "Izmir", 38.4072222, 27.1502781, 132.0},
-	{9931, "Turkey", "Bursa", 40.1916667, 29.0611115, 155.0},
-	{9932, "Turkey", "Adana", 37.0016667, 35.3288879, 27.0},
-	{9933, "Turkey", "Gaziantep", 37.0594444, 37.3824997, 859.0},
-	{9934, "Turkey", "Konya", 37.8655556, 32.4824982, 1031.0},
-	{9935, "Turkey", "Antalya", 36.9125000, 30.6897221, 115.0},
-	{9936, "Turkey", "Eskisehir Ili", 39.6666667, 31.1666660, 766.0},
-	{9937, "Turkey", "Diyarbakir", 37.9188889, 40.2105560, 676.0},
-	{9938, "Turkey", "Kayseri", 38.7322222, 35.4852791, 1055.0},
-	{9939, "Turkey", "Mercin", 36.7952647, 34.6179199, 6.0},
-	{9940, "Turkey", "Eskisehir", 39.7766667, 30.5205555, 799.0},
-	{9941, "Turkey", "Sanliurfa", 37.1511111, 38.7927780, 484.0},
-	{9942, "Turkey", "Malatya", 38.3533333, 38.3119431, 954.0},
-	{9943, "Turkey", "Erzurum", 39.9086111, 41.2769432, 1913.0},
-	{9944, "Turkey", "Samsun", 41.2866667, 36.3300018, 14.0},
-	{9945, "Turkey", "Kahramanmaras", 37.5875000, 36.9316673, 561.0},
-	{9946, "Turkey", "Van", 38.4941667, 43.3800011, 1720.0},
-	{9947, "Turkey", "Denizli", 37.7741667, 29.0874996, 403.0},
-	{9948, "Turkey", "Batman", 37.8827778, 41.1205559, 603.0},
-	{9949, "Turkey", "Batikent", 39.9683333, 32.7308350, 866.0},
-	{9950, "Turkey", "Elazig", 38.6752778, 39.2205544, 1088.0},
-	{9951, "Turkey", "Sakarya", 40.7805556, 30.4033337, 48.0},
-	{9952, "Turkey", "Gebze", 40.7977778, 29.4305553, 162.0},
-	{9953, "Turkey", "Sivas", 39.7483333, 37.0161095, 1276.0},
-	{9954, "Turkey", "Tarsus", 36.9177778, 34.8916664, 38.0},
-	{9955, "Turkey", "Trabzon", 41.0050000, 39.7269440, 1.0},
-	{9956, "Turkey", "Manisa", 38.6130556, 27.4261112, 81.0},
-	{9957, "Turkey", "Balikesir", 39.6491667, 27.8861103, 118.0},
-	{9958, "Turkey", "Adiyaman", 37.7591667, 38.2783318, 685.0},
-	{9959, "Turkey", "Esenyurt", 41.0333333, 28.6752777, 111.0},
-	{9960, "Turkey", "Kirikkale", 39.8452778, 33.5063896, 740.0},
-	{9961, "Turkey", "Osmaniye", 37.0741667, 36.2477760, 125.0},
-	{9962, "Turkey", "Corlu", 41.1591667, 27.7999992, 161.0},
-	{9963, "Turkey", "Kocaeli", 40.7669444, 29.9169445, 95.0},
-	{9964, "Turkey", "Kutahya", 39.4241667, 29.9833336, 947.0},
-	{9965, "Turkey", "Corum", 40.5488889, 34.9533348, 821.0},
-	{9966, "Turkey", "Siverek", 37.7500000, 39.3166656, 767.0},
-	{9967, "Turkey", "Isparta", 37.7644444, 30.5522213, 1084.0},
-	{9968, "Turkey", "Aydin", 37.8444444, 27.8458328, 78.0},
-	{9969, "Turkey", "Iskenderun", 36.5816667, 36.1650009, 2.0},
-	{9970, "Turkey", "Antioch", 36.1988889, 36.1605568, 68.0},
-	{9971, "Turkey", "Viransehir", 37.2352778, 39.7630539, 578.0},
-	{9972, "Turkey", "Usak", 38.6800000, 29.4080563, 914.0},
-	{9973, "Turkey", "Aksaray", 38.3741667, 34.0288887, 961.0},
-	{9974, "Turkey", "Kiziltepe", 37.1933333, 40.5849991, 472.0},
-	{9975, "Turkey", "Afyonkarahisar", 38.7566667, 30.5433331, 1017.0},
-	{9976, "Turkey", "Inegol", 40.0780556, 29.5133324, 292.0},
-	{9977, "Turkey", "Tokat", 40.3138889, 36.5544434, 705.0},
-	{9978, "Turkey", "Edirne", 41.6771911, 26.5559731, 50.0},
-	{9979, "Turkey", "Tekirdag", 40.9805556, 27.5149994, 2.0},
-	{9980, "Turkey", "Karaman", 37.1811111, 33.2150002, 1020.0},
-	{9981, "Turkey", "Nazilli", 37.9125000, 28.3205547, 94.0},
-	{9982, "Turkey", "Ordu", 40.9847222, 37.8788872, 1.0},
-	{9983, "Turkey", "Siirt", 37.9272222, 41.9452782, 866.0},
-	{9984, "Turkey", "Erzincan", 39.7522222, 39.4927788, 1202.0},
-	{9985, "Turkey", "Alanya", 36.5525000, 32.0024986, 10.0},
-	{9986, "Turkey", "Turhal", 40.3875000, 36.0811119, 543.0},
-	{9987, "Turkey", "Bandirma", 40.3522222, 27.9766674, 72.0},
-	{9988, "Turkey", "Turgutlu", 38.5008333, 27.7058334, 56.0},
-	{9989, "Turkey", "Zonguldak", 41.4555556, 31.7897224, 56.0},
-	{9990, "Turkey", "Giresun", 40.9166667, 38.4000015, -9999.0},
-	{9991, "Turkey", "Karabuk", 41.1963889, 32.6255569, 355.0},
-	{9992, "Turkey", "Bolu", 40.7358333, 31.6061115, 760.0},
-	{9993, "Turkey", "Ceyhan", 37.0247222, 35.8175011, 25.0},
-	{9994, "Turkey", "Manavgat", 36.7866667, 31.4430561, 3.0},
-	{9995, "Turkey", "Kirsehir", 39.1458333, 34.1638870, 1014.0},
-	{9996, "Turkey", "Polatli", 39.5841667, 32.1472206, 900.0},
-	{9997, "Turkey", "Bafra", 41.5677778, 35.9069443, 10.0},
-	{9998, "Turkey", "Rize", 41.0208333, 40.5219460, 53.0},
-	{9999, "Turkey", "Eregli", 37.5133343, 34.0467186, 1043.0},
-	{10000, "Turkey", "Agri", 39.7194444, 43.0513878, 1635.0},
-	{10001, "Turkey", "Ercis", 39.0238889, 43.3616676, 1670.0},
-	{10002, "Turkey", "Nigde", 37.9691667, 34.6824989, 1269.0},
-	{10003, "Turkey", "Luleburgaz", 41.4066667, 27.3552780, 76.0},
-	{10004, "Turkey", "Korfez", 40.7766667, 29.7297230, -9999.0},
-	{10005, "Turkey", "Nusaybin", 37.0700000, 41.2172203, 483.0},
-	{10006, "Turkey", "Salihli", 38.4833333, 28.1383324, 99.0},
-	{10007, "Turkey", "Eregli", 41.2894444, 31.4180565, 49.0},
-	{10008, "Turkey", "Kozan", 37.4505556, 35.8163872, 117.0},
-	{10009, "Turkey", "Yozgat", 39.8200000, 34.8044434, 1297.0},
-	{10010, "Turkey", "Canakkale", 40.1455556, 26.4063892, 18.0},
-	{10011, "Turkey", "Patnos", 39.2347222, 42.8675003, 1667.0},
-	{10012, "Turkey", "Akhisar", 38.9219444, 27.8374996, 111.0},
-	{10013, "Turkey", "Amasya", 40.6533333, 35.8330574, 409.0},
-	{10014, "Turkey", "Mus", 38.7486111, 41.4969444, 1315.0},
-	{10015, "Turkey", "Nizip", 37.0097222, 37.7941666, 530.0},
-	{10016, "Turkey", "Kilis", 36.7161111, 37.1150017, 644.0},
-	{10017, "Turkey", "Fatsa", 41.0277778, 37.5013885, 1.0},
-	{10018, "Turkey", "Cizre", 37.3272222, 42.1902771, 356.0},
-	{10019, "Turkey", "Bingol", 38.8847222, 40.4963875, 1117.0},
-	{10020, "Turkey", "Elbistan", 38.2019444, 37.1927795, 1141.0},
-	{10021, "Turkey", "Hakkari", 37.5744444, 43.7408333, 1639.0},
-	{10022, "Turkey", "Unye", 41.1313889, 37.2825012, 1.0},
-	{10023, "Turkey", "Kars", 40.6080556, 43.0974998, 1746.0},
-	{10024, "Turkey", "Midyat", 37.4261111, 41.3375015, 909.0},
-	{10025, "Turkey", "Nevsehir", 38.6250000, 34.7122231, 1225.0},
-	{10026, "Turkey", "Silifke", 36.3777778, 33.9344444, 33.0},
-	{10027, "Turkey", "Silopi", 37.2497222, 42.4694443, 475.0},
-	{10028, "Tuvalu", "Funafuti", -8.5166667, 179.2166595, -9999.0},
-	{10029, "Tuvalu", "Lolua", -5.6666667, 176.1333313, -9999.0},
-	{10030, "Tuvalu", "Asau", -7.5000000, 178.6666718, -9999.0},
-	{10031, "Tuvalu", "Tanrake", -7.2500000, 177.1499939, -9999.0},
-	{10032, "Tuvalu", "Tonga", -6.2833333, 176.3166656, -9999.0},
-	{10033, "Uganda", "Kampala", 0.3155556, 32.5655556, 1206.0},
-	{10034, "Uganda", "Gulu", 2.7666667, 32.3055573, 1104.0},
-	{10035, "Uganda", "Lira", 2.2350000, 32.9097214, 1063.0},
-	{10036, "Uganda", "Jinja", 0.4244444, 33.2041664, 1192.0},
-	{10037, "Uganda", "Bwizibwera", -0.5916667, 30.6286106, 1442.0},
-	{10038, "Uganda", "Mbale", 1.0644444, 34.1794434, 1161.0},
-	{10039, "Uganda", "Mukono", 0.3533333, 32.7552795, 1246.0},
-	{10040, "Uganda", "Kasese", 0.2300000, 29.9883327, 1780.0},
-	{10041, "Uganda", "Masaka", -0.3127778, 31.7130547, 1329.0},
-	{10042, "Uganda", "Entebbe", 0.0644444, 32.4469452, 1133.0},
-	{10043, "Uganda", "Njeru", 0.4186111, 33.1730537, 1267.0},
-	{10044, "Uganda", "Kitgum", 3.2783333, 32.8866653, 929.0},
-	{10045, "Uganda", "Arua", 3.0191667, 30.9308338, 1157.0},
-	{10046, "Uganda", "Iganga", 0.6091667, 33.4686127, 1082.0},
-	{10047, "Uganda", "Fort Portal", 0.6938889, 30.2663898, 1535.0},
-	{10048, "Uganda", "Mityana", 0.4175000, 32.0227776, 1209.0},
-	{10049, "Uganda", "Hoima", 1.4355556, 31.3436108, 1139.0},
-	{10050, "Uganda", "Lugazi", 0.3772222, 32.9197235, 1204.0},
-	{10051, "Uganda", "Masindi", 1.6744444, 31.7150002, 1227.0},
-	{10052, "Uganda", "Pallisa", 1.1450000, 33.7094460, 1043.0},
-	{10053, "Uganda", "Nebbi", 2.4758333, 31.1025009, 1002.0},
-	{10054, "Uganda", "Paidha", 2.4166667, 30.9833336, 1438.0},
-	{10055, "Uganda", "Luwero", 0.8491667, 32.4730568, 1086.0},
-	{10056, "Uganda", "Wobulenzi", 0.7283333, 32.5122223, 1107.0},
-	{10057, "Uganda", "Namasuba", 0.6894444, 32.4213905, 1207.0},
-	{10058, "Uganda", "Kayunga", 0.7025000, 32.8886108, 1063.0},
-	{10059, "Uganda", "Wakiso", 0.4044444, 32.4594460, 1218.0},
-	{10060, "Uganda", "Mubende", 0.5891667, 31.3600006, 1324.0},
-	{10061, "Uganda", "Kireka", 0.3475000, 32.6491661, 1155.0},
-	{10062, "Uganda", "Kamwenge", 0.2111111, 30.4208336, 1227.0},
-	{10063, "Uganda", "Bundibugyo", 0.7413889, 30.0416660, 778.0},
-	{10064, "Uganda", "Ntungamo", -0.8833333, 29.6499996, 1179.0},
-	{10065, "Uganda", "Busembatia", 0.7697222, 33.6130562, 1122.0},
-	{10066, "Uganda", "Buwenge", 0.6422222, 33.1744461, 1136.0},
-	{10067, "Uganda", "Kiboga", 0.9161111, 31.7741661, 1159.0},
-	{10068, "Uganda", "Kamuli", 0.9472222, 33.1197205, 1127.0},
-	{10069, "Uganda", "Apac", 1.9755556, 32.5386124, 1038.0},
-	{10070, "Uganda", "Bugembe", 0.4797222, 33.2344437, 1329.0},
-	{10071, "Uganda", "Mayuge", 0.4597222, 33.4802780, 1158.0},
-	{10072, "Uganda", "Bweyogerere", 0.3558333, 32.6672211, 1179.0},
-	{10073, "Uganda", "Pader Palwo", 2.8005556, 33.1349983, 1016.0},
-	{10074, "Uganda", "Mpigi", 0.2250000, 32.3136101, 1217.0},
-	{10075, "Uganda", "Kyotera", -0.6155556, 31.5174999, 1218.0},
-	{10076, "Uganda", "Lyantonde", -0.4030556, 31.1572227, 1336.0},
-	{10077, "Uganda", "Kilembe", 0.2000000, 30.0000000, 1559.0},
-	{10078, "Uganda", "Masindi Port", 1.6858333, 32.0830574, 1041.0},
-	{10079, "Uganda", "Byakabanda", -0.7425000, 31.4063892, 1380.0},
-	{10080, "Uganda", "Kajansi", 0.2080556, 32.5222206, 1217.0},
-	{10081, "Uganda", "Nakasongola", 1.3088889, 32.4563904, 1130.0},
-	{10082, "Uganda", "Kigorobya", 1.6200000, 31.3108330, 1101.0},
-	{10083, "Uganda", "Kibale", 0.8000000, 31.0666676, 1413.0},
-	{10084, "Uganda", "Margherita", 0.4186111, 29.8911114, 4302.0},
-	{10085, "Uganda", "Sembabule", -0.0772222, 31.4566669, 1229.0},
-	{10086, "Uganda", "Kagadi", 0.9605556, 30.7966671, 1170.0},
-	{10087, "Uganda", "Amudat", 1.9500000, 34.9500008, 1224.0},
-	{10088, "Uganda", "Muhororo", 0.9380556, 30.7594452, 1232.0},
-	{10089, "Ukraine", "Kiev", 50.4333333, 30.5166664, 169.0},
-	{10090, "Ukraine", "Kharkiv", 50.0000000, 36.2500000, 114.0},
-	{10091, "Ukraine", "Odesa", 46.4774726, 30.7326221, 47.0},
-	{10092, "Ukraine", "Zaporizhzhya", 47.8166667, 35.1833344, 97.0},
-	{10093, "Ukraine", "Kryvyy Rih", 47.9166667, 33.3499985, 81.0},
-	{10094, "Ukraine", "Mykolayiv", 46.9666667, 32.0000000, 46.0},
-	{10095, "Ukraine", "Makiyivka", 48.0333333, 37.9666672, 156.0},
-	{10096, "Ukraine", "Vinnytsya", 49.2333333, 28.4833336, 245.0},
-	{10097, "Ukraine", "Kherson", 46.6333333, 32.5999985, 17.0},
-	{10098, "Ukraine", "Poltava", 49.5833333, 34.5666656, 78.0},
-	{10099, "Ukraine", "Chernihiv", 51.5000000, 31.2999992, 107.0},
-	{10100, "Ukraine", "Cherkasy", 49.4333333, 32.0666656, 95.0},
-	{10101, "Ukraine", "Sumy", 50.9197222, 34.7819443, 156.0},
-	{10102, "Ukraine", "Zhytomyr", 50.2500000, 28.6666660, 222.0},
-	{10103, "Ukraine", "Horlivka", 48.3000000, 38.0499992, 236.0},
-	{10104, "Ukraine", "Rivne", 50.6166667, 26.2500000, 205.0},
-	{10105, "Ukraine", "Kirovohrad", 48.5041667, 32.2630539, 119.0},
-	{10106, "Ukraine", "Chernivtsi", 48.3000000, 25.9333324, 216.0},
-	{10107, "Ukraine", "Kremenchuk", 49.0666667, 33.4166679, 70.0},
-	{10108, "Ukraine", "Bila Tserkva", 49.7833333, 30.1166668, 149.0},
-	{10109, "Ukraine", "Kerch", 45.3583333, 36.4758339, 1.0},
-	{10110, "Ukraine", "", 48.8666667, 37.6166649, 55.0},
-	{10111, "Ukraine", "Uzhhorod", 48.6166667, 22.2999992, 118.0},
-	{10112, "Ukraine", "Pavlohrad", 48.5166667, 35.8666649, 62.0},
-	{10113, "Ukraine", "Lisichansk", 48.9191667, 38.4158325, 167.0},
-	{10114, "Ukraine", "Yevpatoriya", 45.1972222, 33.3558350, 7.0},
-	{10115, "Ukraine", "Yenakiyeve", 48.2322222, 38.2161102, 184.0},
-	{10116, "Ukraine", "Oleksandriya", 48.6697222, 33.1205559, 113.0},
-	{10117, "Ukraine", "", 48.6666667, 26.5666676, 189.0},
-	{10118, "Ukraine", "Konotop", 51.2333333, 33.2000008, 150.0},
-	{10119, "Ukraine", "Kostyantynivka", 48.5333333, 37.7166672, 124.0},
-	{10120, "Ukraine", "Krasnyy Luch", 48.1330556, 38.9324989, 234.0},
-	{10121, "Ukraine", "Brovary", 50.5000000, 30.7666664, 107.0},
-	{10122, "Ukraine", "Berdychiv", 49.9000000, 28.5833340, 242.0},
-	{10123, "Ukraine", "Shostka", 51.8666667, 33.4833336, 172.0},
-	{10124, "Ukraine", "Stakhanov", 48.5633333, 38.6508331, 178.0},
-	{10125, "Ukraine", "Chervonograd", 50.3833333, 24.2333336, 165.0},
-	{10126, "Ukraine", "Izmayil", 45.3500000, 28.8333340, 32.0},
-	{10127, "Ukraine", "Mukacheve", 48.4500000, 22.7166672, 123.0},
-	{10128, "Ukraine", "Yalta", 44.5000000, 34.1666679, 14.0},
-	{10129, "Ukraine", "Drogobych", 49.3500000, 23.5000000, 296.0},
-	{10130, "Ukraine", "Nizhyn", 51.0500000, 31.8833332, 118.0},
-	{10131, "Ukraine", "Feodosiya", 45.0333333, 35.3833351, 1.0},
-	{10132, "Ukraine", "Shakhtersk", 48.0469444, 38.4686127, 277.0},
-	{10133, "Ukraine", "Torez", 48.0383333, 38.5877762, 258.0},
-	{10134, "Ukraine", "Kalush", 49.0166667, 24.3666668, 283.0},
-	{10135, "Ukraine", "Smila", 49.2333333, 31.8833332, 62.0},
-	{10136, "Ukraine", "Khartsyzsk", 48.0352778, 38.1549988, 250.0},
-	{10137, "Ukraine", "Rubizhne", 49.0158333, 38.3669434, 88.0},
-	{10138, "Ukraine", "Pryluky", 50.6000000, 32.4000015, 115.0},
-	{10139, "Ukraine", "Druzhkovka", 48.6166667, 37.5499992, 82.0},
-	{10140, "Ukraine", "Lozova", 48.8833333, 36.3833351, 155.0},
-	{10141, "Ukraine", "Kolomyya", 48.5305556, 25.0402775, 279.0},
-	{10142, "Ukraine", "Antratsit", 48.1191667, 39.0900002, 250.0},
-	{10143, "Ukraine", "Stryy", 49.2500000, 23.8500004, 292.0},
-	{10144, "Ukraine", "Energodar", 47.5000000, 34.4666672, 21.0},
-	{10145, "Ukraine", "Snizhne", 48.0169444, 38.7658348, 281.0},
-	{10146, "Ukraine", "Izyum", 49.2127778, 37.2569427, 81.0},
-	{10147, "Ukraine", "Lubny", 50.0166667, 33.0000000, 166.0},
-	{10148, "Ukraine", "Bryanka", 48.5069444, 38.6616669, 201.0},
-	{10149, "Ukraine", "Komsomolsk", 49.0097264, 33.6454582, 77.0},
-	{10150, "Ukraine", "Zhovti Vody", 48.3436111, 33.5077782, 173.0},
-	{10151, "Ukraine", "Fastiv", 50.0833333, 29.9166660, 217.0},
-	{10152, "Ukraine", "Nova Kakhovka", 46.7508333, 33.3802795, 19.0},
-	{10153, "Ukraine", "Okhtyrka", 50.3086111, 34.8941650, 125.0},
-	{10154, "Ukraine", "Krasnodon", 48.2950000, 39.7400017, 130.0},
-	{10155, "Ukraine", "Romny", 50.7500000, 33.4666672, 157.0},
-	{10156, "Ukraine", "Shepetivka", 50.1833333, 27.0666676, 242.0},
-	{10157, "Ukraine", "Bucha", 50.5666667, 30.2166672, 105.0},
-	{10158, "Uruguay", "Montevideo", -34.8580556, -56.1708336, 78.0},
-	{10159, "Uruguay", "Salto", -31.3833333, -57.9666672, 47.0},
-	{10160, "Uruguay", "Paysandu", -32.3213889, -58.0755539, 50.0},
-	{10161, "Uruguay", "Las Piedras", -34.7263889, -56.2200012, 81.0},
-	{10162, "Uruguay", "Rivera", -30.9000000, -55.5166664, 204.0},
-	{10163, "Uruguay", "Maldonado", -34.9000000, -54.9500008, 21.0},
-	{10164, "Uruguay", "Tacuarembo", -31.7333333, -55.9833336, 140.0},
-	{10165, "Uruguay", "Melo", -32.3666667, -54.1833344, 81.0},
-	{10166, "Uruguay", "Mercedes", -33.2558333, -58.0191650, 41.0},
-	{10167, "Uruguay", "Artigas", -30.4000000, -56.4666672, 151.0},
-	{10168, "Uruguay", "Minas", -34.3700000, -55.2249985, 148.0},
-	{10169, "Uruguay", "San Jose de Mayo", -34.3375000, -56.7136116, 53.0},
-	{10170, "Uruguay", "Durazno", -33.4130556, -56.5005569, 91.0},
-	{10171, "Uruguay", "Florida", -34.0955556, -56.2141685, 74.0},
-	{10172, "Uruguay", "Treinta y Tres", -33.2333333, -54.3833351, 63.0},
-	{10173, "Uruguay", "Rocha", -34.4833333, -54.3333321, 8.0},
-	{10174, "Uruguay", "San Carlos", -34.8000000, -54.9166679, 2.0},
-	{10175, "Uruguay", "Pando", -34.7166667, -55.9500008, 11.0},
-	{10176, "Uruguay", "Fray Bentos", -33.1325000, -58.2955551, 43.0},
-	{10177, "Uruguay", "Colonia del Sacramento", -34.4666667, -57.8499985, 28.0},
-	{10178, "Uruguay", "Trinidad", -33.5388889, -56.8886108, 134.0},
-	{10179, "Uruguay", "La Paz", -34.7616667, -56.2236099, 50.0},
-	{10180, "Uruguay", "Canelones", -34.5227778, -56.2777786, 29.0},
-	{10181, "Uruguay", "Delta del Tigre", -34.7633333, -56.3852768, 19.0},
-	{10182, "Uruguay", "Carmelo", -33.9891667, -58.2855568, 19.0},
-	{10183, "Uruguay", "Santa Lucia", -34.4533333, -56.3905563, 35.0},
-	{10184, "Uruguay", "Progreso", -34.6650000, -56.2194443, 53.0},
-	{10185, "Uruguay", "Young", -32.6833333, -57.6333351, 63.0},
-	{10186, "Uruguay", "Dolores", -33.5441667, -58.1972237, 30.0},
-	{10187, "Uruguay", "Paso de Carrasco", -34.8602778, -56.0522232, 20.0},
-	{10188, "Uruguay", "Rio Branco", -32.5666667, -53.4166679, 17.0},
-	{10189, "Uruguay", "Juan L. Lacaze", -34.4333333, -57.4166679, 5.0},
-	{10190, "Uruguay", "Paso de los Toros", -32.8166667, -56.5166664, 54.0},
-	{10191, "Uruguay", "Bella Union", -30.2500000, -57.5833321, 48.0},
-	{10192, "Uruguay", "Chuy", -33.6833333, -53.4500008, 2.0},
-	{10193, "Uruguay", "Nueva Helvecia", -34.3000000, -57.2333336, 42.0},
-	{10194, "Uruguay", "Nueva Palmira", -33.8833333, -58.4166679, 9.0},
-	{10195, "Uruguay", "Libertad", -34.6333333, -56.6191673, 40.0},
-	{10196, "Uruguay", "Rosario", -34.3166667, -57.3499985, 19.0},
-	{10197, "Uruguay", "Colonia Nicolich", -34.8130556, -56.0447235, 9.0},
-	{10198, "Uruguay", "Piriapolis", -34.8681866, -55.2743645, 23.0},
-	{10199, "Uruguay", "Castillos", -34.1666667, -53.8333321, 44.0},
-	{10200, "Uruguay", "Tranqueras", -31.2000000, -55.7500000, 164.0},
-	{10201, "Uruguay", "Sarandi del Yi", -33.3500000, -55.6333351, 105.0},
-	{10202, "Uruguay", "Punta del Este", -34.9666667, -54.9500008, 1.0},
-	{10203, "Uruguay", "Pan de Azucar", -34.8000000, -55.2333336, 79.0},
-	{10204, "Uruguay", "San Ramon", -34.3000000, -55.9666672, 51.0},
-	{10205, "Uruguay", "Lascano", -33.6666667, -54.2000008, 3.0},
-	{10206, "Uruguay", "Sarandi Grande", -33.7333333, -56.3333321, 130.0},
-	{10207, "Uruguay", "Joaquin Suarez", -34.7336111, -56.0366669, 58.0},
-	{10208, "Uruguay", "Tarariras", -34.2833333, -57.6166649, 100.0},
-	{10209, "Uruguay", "Sauce", -34.6469444, -56.0627785, 65.0},
-	{10210, "Uruguay", "Jose Pedro Varela", -33.4500000, -54.5333328, 60.0},
-	{10211, "Uruguay", "Guichon", -32.3500000, -57.2000008, 92.0},
-	{10212, "Uruguay", "Tala", -34.3500000, -55.7666664, 49.0},
-	{10213, "Uruguay", "Barra de Carrasco", -34.8772222, -56.0297203, 2.0},
-	{10214, "Uruguay", "Cardona", -33.8833333, -57.3833351, 163.0},
-	{10215, "Uruguay", "Atlantida", -34.7666667, -55.7500000, 20.0},
-	{10216, "Uruguay", "Vichadero", -31.8000000, -54.7166672, 184.0},
-	{10217, "Uruguay", "Toledo", -34.7422222, -56.0983315, 69.0},
-	{10218, "Uruguay", "Empalme Olmos", -34.7000000, -55.9000015, 40.0},
-	{10219, "Uruguay", "Vergara", -32.9333333, -53.9500008, 17.0},
-	{10220, "Uruguay", "San Jacinto", -34.5500000, -55.8833351, 48.0},
-	{10221, "Uruguay", "Santa Rosa", -34.4975000, -56.0372238, 63.0},
-	{10222, "Uruguay", "Florencio Sanchez", -33.8833333, -57.4000015, 156.0},
-	{10223, "Uruguay", "Minas de Corrales", -31.5833333, -55.4666672, 111.0},
-	{10224, "Uruguay", "Ombues de Lavalle", -33.9166667, -57.7833328, 120.0},
-	{10225, "Uruguay", "La Paloma", -34.6666667, -54.1666679, 1.0},
-	{10226, "Uruguay", "Tomas Gomensoro", -30.4333333, -57.4333344, 82.0},
-	{10227, "Uruguay", "Quebracho", -31.9500000, -57.8833351, 39.0},
-	{10228, "Uruguay", "Constitucion", -31.0833333, -57.8333321, 52.0},
-	{10229, "Uruguay", "Solis de Mataojo", -34.6000000, -55.4833336, 12.0},
-	{10230, "Uruguay", "Villa del Carmen", -33.2500000, -56.0166664, 156.0},
-	{10231, "Uruguay", "Aigua", -34.2000000, -54.7500000, 112.0},
-	{10232, "Uruguay", "Casupa", -34.0333333, -55.6500015, 150.0},
-	{10233, "Uruguay", "Rodriguez", -34.3827778, -56.5416679, 52.0},
-	{10234, "Uruguay", "Isidoro Noblia", -31.8333333, -54.1666679, 152.0},
-	{10235, "Uruguay", "Baltasar Brum", -30.7333333, -57.3166656, 118.0},
-	{10236, "Uruguay", "Nuevo Berlin", -32.9833333, -58.0499992, 20.0},
-	{10237, "Uruguay", "Jose Batlle y Ordonez", -33.4666667, -55.1166649, 277.0},
-	{10238, "Uruguay", "Ecilda Paullier", -34.3666667, -57.0666656, 61.0},
-	{10239, "Uruguay", "Santa Clara de Olimar", -32.9166667, -54.9666672, 304.0},
-	{10240, "Uruguay", "Las Toscas", -34.7333333, -55.7166672, 5.0},
-	{10241, "Uruguay", "Migues", -34.4833333, -55.6500015, 59.0},
-	{10242, "Uruguay", "Las Piedras", -30.2333333, -57.5833321, 43.0},
-	{10243, "Uruguay", "Jose Enrique Rodo", -33.6833333, -57.5666656, 132.0},
-	{10244, "Uruguay", "Los Cerrillos", -34.6050000, -56.3563881, 62.0},
-	{10245, "Uruguay", "Belen", -30.7833333, -57.7833328, 32.0},
-	{10246, "Uruguay", "Pajas Blancas", -34.8016667, -56.3341675, 44.0},
-	{10247, "Uruguay", "Palmitas", -33.5166667, -57.8333321, 89.0},
-	{10248, "Uruguay", "San Bautista", -34.4333333, -55.9833336, 71.0},
-	{10249, "Uruguay", "Veinticinco de Mayo", -34.1891667, -56.3394432, 58.0},
-	{10250, "Uruguay", "Veinticinco de Agosto", -34.4116667, -56.4022217, 29.0},
-	{10251, "Uruguay", "Soca", -34.6833333, -55.6833344, 28.0},
-	{10252, "Uruguay", "San Javier", -32.6833333, -58.1333351, 2.0},
-	{10253, "Uruguay", "Mariscala", -34.0500000, -54.7833328, 92.0},
-	{10254, "Uruguay", "Montes", -34.5000000, -55.5833321, 50.0},
-	{10255, "Uruguay", "Cebollati", -33.2666667, -53.7833328, 4.0},
-	{10256, "Uruguay", "La Paloma", -32.7166667, -55.5999985, 71.0},
-	{10257, "Uruguay", "Acegua", -31.8666667, -54.2000008, 235.0},
-	{10258, "Venezuela", "Maracaibo", 10.6316667, -71.6405563, 3.0},
-	{10259, "Venezuela", "Caracas", 10.5000000, -66.9166641, 920.0},
-	{10260, "Venezuela", "Valencia", 10.1805556, -68.0038910, 558.0},
-	{10261, "Venezuela", "Barquisimeto", 10.0738889, -69.3227768, 573.0},
-	{10262, "Venezuela", "Ciudad Guayana", 8.3533333, -62.6527786, 7.0},
-	{10263, "Venezuela", "Barcelona", 10.1333333, -64.6999969, 3.0},
-	{10264, "Venezuela", "Maturin", 9.7500000, -63.1766663, 55.0},
-	{10265, "Venezuela", "Puerto La Cruz", 10.2166667, -64.6166687, 64.0},
-	{10266, "Venezuela", "Petare", 10.4833333, -66.8166656, 867.0},
-	{10267, "Venezuela", "Turmero", 10.2333333, -67.4833298, 602.0},
-	{10268, "Venezuela", "Ciudad Bolivar", 8.1222222, -63.5497208, 73.0},
-	{10269, "Venezuela", "Merida", 8.5983333, -71.1449966, 1473.0},
-	{10270, "Venezuela", "Alto Barinas", 8.5944444, -70.2222214, 207.0},
-	{10271, "Venezuela", "Santa Teresa", 10.2313889, -66.6636124, 135.0},
-	{10272, "Venezuela", "Cumana", 10.4666667, -64.1666641, 4.0},
-	{10273, "Venezuela", "San Cristobal", 7.7669444, -72.2249985, 881.0},
-	{10274, "Venezuela", "Baruta", 10.4333333, -66.8833313, 1179.0},
-	{10275, "Venezuela", "Mucumpiz", 8.4166667, -71.1333313, 2163.0},
-	{10276, "Venezuela", "Cabimas", 10.4019444, -71.4461136, 11.0},
-	{10277, "Venezuela", "Coro", 11.4091667, -69.6672211, 28.0},
-	{10278, "Venezuela", "Guatire", 10.4666667, -66.5333328, 408.0},
-	{10279, "Venezuela", "Cua", 10.1622222, -66.8852768, 246.0},
-	{10280, "Venezuela", "Guarenas", 10.4666667, -66.6166687, 523.0},
-	{10281, "Venezuela", "Puerto Cabello", 10.4730556, -68.0124969, 49.0},
-	{10282, "Venezuela", "Ocumare del Tuy", 10.1216667, -66.7716675, 178.0},
-	{10283, "Venezuela", "Guacara", 10.2297222, -67.8738861, 581.0},
-	{10284, "Venezuela", "El Tigre", 8.8925000, -64.2527771, 276.0},
-	{10285, "Venezuela", "El Limon", 10.3091667, -67.6324997, 597.0},
-	{10286, "Venezuela", "Acarigua", 9.5597222, -69.2019424, 199.0},
-	{10287, "Venezuela", "Punto Fijo", 11.6997222, -70.1991653, 4.0},
-	{10288, "Venezuela", "Charallave", 10.2483333, -66.8566666, 405.0},
-	{10289, "Venezuela", "Palo Negro", 10.1738889, -67.5419464, 593.0},
-	{10290, "Venezuela", "Cagua", 10.1833333, -67.4499969, 576.0},
-	{10291, "Venezuela", "Anaco", 9.4388889, -64.4727783, 205.0},
-	{10292, "Venezuela", "Calabozo", 8.9344444, -67.4266663, 78.0},
-	{10293, "Venezuela", "Guanare", 9.0500000, -69.7500000, 173.0},
-	{10294, "Venezuela", "Carupano", 10.6697222, -63.2491684, 38.0},
-	{10295, "Venezuela", "Ejido", 8.5513889, -71.2375031, 1163.0},
-	{10296, "Venezuela", "Catia La Mar", 10.6000000, -67.0333328, 82.0},
-	{10297, "Venezuela", "Mariara", 10.2983333, -67.7161102, 608.0},
-	{10298, "Venezuela", "Carora", 10.1777778, -70.0805588, 508.0},
-	{10299, "Venezuela", "Valera", 9.3177778, -70.6036148, 537.0},
-	{10300, "Venezuela", "Yaritagua", 10.0800000, -69.1261139, 506.0},
-	{10301, "Venezuela", "Valle de La Pascua", 9.2166667, -66.0000000, 154.0},
-	{10302, "Venezuela", "San Juan de los Morros", 9.9111111, -67.3583298, 512.0},
-	{10303, "Venezuela", "Porlamar", 10.9500000, -63.8499985, -9999.0},
-	{10304, "Venezuela", "La Victoria", 10.2333333, -67.3333359, 612.0},
-	{10305, "Venezuela", "Tinaquillo", 9.9186111, -68.3047256, 510.0},
-	{10306, "Venezuela", "El Cafetal", 10.4666667, -66.8277740, 874.0},
-	{10307, "Venezuela", "San Fernando Apure", 7.8966667, -67.4672241, 27.0},
-	{10308, "Venezuela", "San Carlos", 9.6666667, -68.5999985, 138.0},
-	{10309, "Venezuela", "San Felipe", 10.3405556, -68.7372208, 207.0},
-	{10310, "Venezuela", "Villa de Cura", 10.0333333, -67.4833298, 556.0},
-	{10311, "Venezuela", "Araure", 9.5666667, -69.2166672, 207.0},
-	{10312, "Venezuela", "Guigue", 10.0852778, -67.7791672, 590.0},
-	{10313, "Venezuela", "Rosario", 10.3166667, -72.3166656, 101.0},
-	{10314, "Venezuela", "Chacao", 10.5000000, -66.8499985, 898.0},
-	{10315, "Venezuela", "San Antonio de Los Altos", 10.3666667, -66.9333344, 1220.0},
-	{10316, "Venezuela", "Machiques", 10.0644444, -72.5449982, 130.0},
-	{10317, "Venezuela", "San Jose de Guanipa", 8.8933333, -64.1591644, 248.0},
-	{10318, "Venezuela", "El Vigia", 8.6219444, -71.6505585, 152.0},
-	{10319, "Venezuela", "Punta Cardon", 11.6580556, -70.2149963, 4.0},
-	{10320, "Venezuela", "Los Dos Caminos", 10.5166667, -66.8333359, 1466.0},
-	{10321, "Venezuela", "El Hatillo", 10.4333333, -66.8166656, 1211.0},
-	{10322, "Venezuela", "La Dolorita", 10.4905556, -66.7833328, 973.0},
-	{10323, "Venezuela", "San Carlos del Zulia", 9.0041667, -71.9163895, 6.0},
-	{10324, "Venezuela", "Upata", 8.0086111, -62.3988876, 326.0},
-	{10325, "Venezuela", "El Tocuyo", 9.7861111, -69.7894440, 690.0},
-	{10326, "Venezuela", "Maiquetia", 10.6000000, -66.9499969, 105.0},
-	{10327, "Venezuela", "Caucaguito", 10.4833333, -66.7333298, 570.0},
-	{10328, "Venezuela", "Puerto Ayacucho", 5.6638889, -67.6236115, 69.0},
-	{10329, "Venezuela", "Rubio", 7.7072222, -72.3538895, 869.0},
-	{10330, "Venezuela", "Tucupita", 9.0686111, -62.0491676, 1.0},
-	{10331, "Venezuela", "San Mateo", 10.2166667, -67.4166641, 620.0},
-	{10332, "Venezuela", "Chivacoa", 10.1602778, -68.8949966, 305.0},
-	{10333, "Venezuela", "Moron", 10.4888889, -68.2002792, 19.0},
-	{10334, "Venezuela", "Lagunillas", 10.1408333, -71.2613907, 1.0},
-	{10335, "Venezuela", "San Joaquin", 10.2625000, -67.7922211, 533.0},
-	{10336, "Venezuela", "Quibor", 9.9255556, -69.6202774, 799.0},
-	{10337, "Venezuela", "Zaraza", 9.3500000, -65.3166656, 52.0},
-	{10338, "Venezuela", "Carrizal", 10.3500000, -66.9833298, 1161.0},
-	{10339, "Venezuela", "Altagracia de Orituco", 9.8622222, -66.3805542, 499.0},
-	{10340, "Venezuela", "Tacarigua", 10.0900000, -67.9197235, 596.0},
-	{10341, "Venezuela", "San Juan de Colon", 8.0377778, -72.2519455, 809.0},
-	{10342, "Venezuela", "Trujillo", 9.3666667, -70.4333344, 1170.0},
-	{10343, "Venezuela", "Caraballeda", 10.6166667, -66.8333359, -9999.0},
-	{10344, "Venezuela", "La Asuncion", 11.0333333, -63.8627777, 67.0},
-	{10345, "Venezuela", "Los Rastrojos", 10.0227778, -69.2444458, 467.0},
-	{10346, "Venezuela", "Barinitas", 8.7622222, -70.4111099, 463.0},
-	{10347, "Venezuela", "Las Tejerias", 10.2500000, -67.1666641, 496.0},
-	{10348, "Venezuela", "Cantaura", 9.3105556, -64.3594437, 248.0},
-	{10349, "Venezuela", "San Antonio del Tachira", 7.8177778, -72.4424973, 610.0},
-	{10350, "Venezuela", "Santa Rita", 10.5380556, -71.5169449, 1.0},
-	{10351, "Venezuela", "Guasdalito", 7.2472222, -70.7294464, 125.0},
-	{10352, "Venezuela", "Nirgua", 10.1550000, -68.5630569, 874.0},
-	{10353, "Venezuela", "Villa Bruzual", 9.3327778, -69.1208344, 122.0},
-	{10354, "Venezuela", "Tariba", 7.8236111, -72.2213898, 910.0},
-	{10355, "Venezuela", "La Fria", 8.2188889, -72.2483368, 152.0},
-	{10356, "Venezuela", "La Guaira", 10.5990110, -66.9346008, 150.0},
-	{10357, "Venezuela", "El Diario de Yaracuy", 10.3416920, -68.7328033, 207.0},
-	{10358, "Vietnam", "Thanh pho Ho Chi Minh", 10.7500000, 106.6666641, 4.0},
-	{10359, "Vietnam", "Ha Noi", 21.0333333, 105.8499985, 26.0},
-	{10360, "Vietnam", "Da Nang", 16.0677778, 108.2208328, 7.0},
-	{10361, "Vietnam", "Haiphong", 20.8561111, 106.6822205, 3.0},
-	{10362, "Vietnam", "Bien Hoa", 10.9500000, 106.8166656, 16.0},
-	{10363, "Vietnam", "Hue", 16.4666667, 107.5999985, 3.0},
-	{10364, "Vietnam", "Nha Trang", 12.2500000, 109.1833344, 3.0},
-	{10365, "Vietnam", "Can Tho", 10.0333333, 105.7833328, 1.0},
-	{10366, "Vietnam", "Rach Gia", 10.0166667, 105.0833359, 1.0},
-	{10367, "Vietnam", "Quy Nhon", 13.7666667, 109.2333298, 8.0},
-	{10368, "Vietnam", "Vung Tau", 10.3500000, 107.0666656, 1.0},
-	{10369, "Vietnam", "Nam Dinh", 20.4166667, 106.1666641, 1.0},
-	{10370, "Vietnam", "Phan Thiet", 10.9333333, 108.0999985, 10.0},
-	{10371, "Vietnam", "Long Xuyen", 10.3833333, 105.4166641, 2.0},
-	{10372, "Vietnam", "Ha Long", 20.9511111, 107.0800018, 70.0},
-	{10373, "Vietnam", "Buon Ma Thuot", 12.6666667, 108.0500031, 467.0},
-	{10374, "Vietnam", "Cam Ranh", 11.9214391, 109.1591263, 20.0},
-	{10375, "Vietnam", "Cam Pha Mines", 21.0166667, 107.3000031, 306.0},
-	{10376, "Vietnam", "Thai Nguyen", 21.5927778, 105.8441696, 32.0},
-	{10377, "Vietnam", "Da Lat", 11.9333333, 108.4166641, 1464.0},
-	{10378, "Vietnam", "My Tho", 10.3500000, 106.3499985, 1.0},
-	{10379, "Vietnam", "Soc Trang", 9.6033333, 105.9800034, 1.0},
-	{10380, "Vietnam", "Pleiku", 13.9833333, 108.0000000, 756.0},
-	{10381, "Vietnam", "Thanh Hoa", 19.8000000, 105.7666702, 18.0},
-	{10382, "Vietnam", "Ca Mau", 9.1769444, 105.1500015, 1.0},
-	{10383, "Vietnam", "Bac Lieu", 9.2850000, 105.7244415, 1.0},
-	{10384, "Vietnam", "Yen Vinh", 18.6666667, 105.6666641, 10.0},
-	{10385, "Vietnam", "Hoa Binh", 20.8133333, 105.3383331, 23.0},
-	{10386, "Vietnam", "Vinh Long", 10.2500000, 105.9666672, 1.0},
-	{10387, "Vietnam", "Yen Bai", 21.7000000, 104.8666687, 46.0},
-	{10388, "Vietnam", "Viet Tri", 21.3019444, 105.4308319, 20.0},
-	{10389, "Vietnam", "Phan Rang-Thap Cham", 11.5666667, 108.9833298, 6.0},
-	{10390, "Vietnam", "Chau Doc", 10.7000000, 105.1166687, 1.0},
-	{10391, "Vietnam", "Tuy Hoa", 13.0833333, 109.3000031, 2.0},
-	{10392, "Vietnam", "Tan An", 10.5333333, 106.4166641, 1.0},
-	{10393, "Vietnam", "Uong Bi", 21.0333333, 106.7833328, 37.0},
-	{10394, "Vietnam", "Sa Dec", 10.3000000, 105.7666702, 1.0},
-	{10395, "Vietnam", "Ben Tre", 10.2333333, 106.3833313, 1.0},
-	{10396, "Vietnam", "Tam Ky", 15.5666667, 108.4833298, 14.0},
-	{10397, "Vietnam", "Hai Duong", 20.9333333, 106.3166656, 1.0},
-	{10398, "Vietnam", "Tra Vinh", 9.9347222, 106.3452759, 1.0},
-	{10399, "Vietnam", "Bim Son", 20.0780556, 105.8602753, 9.0},
-	{10400, "Vietnam", "Bac Giang", 21.2666667, 106.1999969, 29.0},
-	{10401, "Vietnam", "Thai Binh", 20.4500000, 106.3333359, 3.0},
-	{10402, "Vietnam", "Ha Dong", 20.9725000, 105.7772217, 27.0},
-	{10403, "Vietnam", "Phu Khuong", 11.2833333, 106.1333313, 19.0},
-	{10404, "Vietnam", "Kon Tum", 14.3500000, 108.0000000, 530.0},
-	{10405, "Vietnam", "Bac Ninh", 21.1833333, 106.0500031, 13.0},
-	{10406, "Vietnam", "Cao Bang", 22.6666667, 106.2500000, 197.0},
-	{10407, "Vietnam", "Son Tay", 21.1377778, 105.5049973, 23.0},
-	{10408, "Vietnam", "Dien Bien Phu", 21.3833333, 103.0166702, 490.0},
-	{10409, "Vietnam", "Ninh Binh", 20.2538889, 105.9749985, 12.0},
-	{10410, "Vietnam", "Lao Cai", 22.4833333, 103.9499969, 233.0},
-	{10411, "Vietnam", "Tuyen Quang", 21.8233333, 105.2180557, 30.0},
-	{10412, "Vietnam", "Quang Ngai", 15.1166667, 108.8000031, 14.0},
-	{10413, "Vietnam", "Hoi An", 15.8794444, 108.3349991, 13.0},
-	{10414, "Vietnam", "Ha Giang", 22.8333333, 104.9833298, 143.0},
-	{10415, "Vietnam", "Phu Ly", 20.5411111, 105.9138870, 22.0},
-	{10416, "Vietnam", "Dong Hoi", 17.4833333, 106.5999985, 13.0},
-	{10417, "Vietnam", "Dong Xoai", 11.5333333, 106.9166641, 85.0},
-	{10418, "Vietnam", "Son La", 21.3166667, 103.9000015, 673.0},
-	{10419, "Vietnam", "Vinh Yen", 21.3100000, 105.5966644, 18.0},
-	{10420, "Vietnam", "Dong Ha", 16.8166667, 107.1333313, 6.0},
-	{10421, "Yemen", "Sanaa", 15.3547222, 44.2066650, 2245.0},
-	{10422, "Yemen", "Al Hudaydah", 14.7977778, 42.9522209, 18.0},
-	{10423, "Yemen", "Ta`izz", 13.5666667, 44.0333328, 1455.0},
-	{10424, "Yemen", "`Adan", 12.7794444, 45.0366669, 7.0},
-	{10425, "Yemen", "Al Mukalla", 14.5300000, 49.1313896, 1.0},
-	{10426, "Yemen", "Ibb", 13.9666667, 44.1833344, 1929.0},
-	{10427, "Yemen", "Sayyan", 15.1713889, 44.3247223, 2452.0},
-	{10428, "Yemen", "Zabid", 14.1950000, 43.3152771, 80.0},
-	{10429, "Yemen", "Bajil", 15.0666667, 43.2833328, 191.0},
-	{10430, "Yemen", "Hajjah", 15.6950000, 43.5974998, 1717.0},
-	{10431, "Yemen", "Dhi as Sufal", 13.8500000, 44.1166649, 2192.0},
-	{10432, "Yemen", "Bayt al Faqih", 14.5161111, 43.3244438, 125.0},
-	{10433, "Yemen", "Yarim", 14.2972222, 44.3802795, 2623.0},
-	{10434, "Yemen", "Sahar", 15.3155556, 44.3097229, 2379.0},
-	{10435, "Yemen", "Lahij", 13.0566667, 44.8819427, 145.0},
-	{10436, "Yemen", "Jawf al Maqbabah", 13.8383333, 45.8358345, 895.0},
-	{10437, "Zambia", "Lusaka", -15.4166667, 28.2833328, 1277.0},
-	{10438, "Zambia", "Kitwe", -12.8166667, 28.2000008, 1213.0},
-	{10439, "Zambia", "Ndola", -12.9666667, 28.6333332, 1269.0},
-	{10440, "Zambia", "Kabwe", -14.4357192, 28.4442043, 1203.0},
-	{10441, "Zambia", "Chingola", -12.5333333, 27.8500004, 1363.0},
-	{10442, "Zambia", "Mufulira", -12.5500000, 28.2333336, 1250.0},
-	{10443, "Zambia", "Luanshya", -13.1333333, 28.3999996, 1246.0},
-	{10444, "Zambia", "Livingstone", -17.8500000, 25.8666668, 897.0},
-	{10445, "Zambia", "Kasama", -10.2000000, 31.2000008, 1241.0},
-	{10446, "Zambia", "Chipata", -13.6333333, 32.6500015, 1181.0},
-	{10447, "Zambia", "Kalulushi", -12.8333333, 28.0833340, 1270.0},
-	{10448, "Zambia", "Mazabuka", -15.8666667, 27.7666664, 1102.0},
-	{10449, "Zambia", "Chililabombwe", -12.3666667, 27.8333340, 1353.0},
-	{10450, "Zambia", "Mongu", -15.2500000, 23.1333332, 1018.0},
-	{10451, "Zambia", "Kafue", -15.7666667, 28.1833324, 1008.0},
-	{10452, "Zambia", "Choma", -16.8166667, 26.9833336, 1325.0},
-	{10453, "Zambia", "Mansa", -11.2000000, 28.8833332, 1228.0},
-	{10454, "Zambia", "Kansanshi", -12.1000000, 26.4333324, 1444.0},
-	{10455, "Zambia", "Kapiri Mposhi", -13.9666667, 28.6833324, 1286.0},
-	{10456, "Zambia", "Monze", -16.2833333, 27.4833336, 1103.0},
-	{10457, "Zambia", "Mpika", -11.8333333, 31.4500008, 1520.0},
-	{10458, "Zambia", "Nchelenge", -9.3500000, 28.7333336, 919.0},
-	{10459, "Zambia", "Kawambwa", -9.7833333, 29.0833340, 1308.0},
-	{10460, "Zambia", "Mbala", -8.8333333, 31.3833332, 1622.0},
-	{10461, "Zambia", "Samfya", -11.3500000, 29.5499992, 1155.0},
-	{10462, "Zambia", "Sesheke", -17.4666667, 24.2999992, 800.0},
-	{10463, "Zambia", "Petauke", -14.2500000, 31.3333340, 939.0},
-	{10464, "Zambia", "Mumbwa", -14.9833333, 27.0666676, 1158.0},
-	{10465, "Zambia", "Siavonga", -16.5333333, 28.7166672, 481.0},
-	{10466, "Zambia", "Kaoma", -14.7833333, 24.7999992, 1127.0},
-	{10467, "Zambia", "Chinsali", -10.5500000, 32.0666656, 1307.0},
-	{10468, "Zambia", "Mwinilunga", -11.7333333, 24.4333324, 1385.0},
-	{10469, "Zambia", "Isoka", -10.1333333, 32.6333351, 1480.0},
-	{10470, "Zambia", "Mkushi", -13.6166667, 29.3999996, 1278.0},
-	{10471, "Zambia", "Maamba", -17.3666667, 27.1499996, 881.0},
-	{10472, "Zambia", "Lundazi", -12.3000000, 33.1833344, 1105.0},
-	{10473, "Zambia", "Sinazongwe", -17.2500000, 27.4666672, 539.0},
-	{10474, "Zambia", "Chambishi", -12.6500000, 28.0833340, 1286.0},
-	{10475, "Zambia", "Nakonde", -9.3333333, 32.7666664, 1319.0},
-	{10476, "Zambia", "Nakambala", -15.8333333, 27.7666664, 1027.0},
-	{10477, "Zambia", "Senanga", -16.1166667, 23.2666664, 968.0},
-	{10478, "Zambia", "Mpongwe", -13.5333333, 28.1499996, 1199.0},
-	{10479, "Zambia", "Serenje", -13.2333333, 30.2333336, 1402.0},
-	{10480, "Zambia", "Mpulungu", -8.7666667, 31.1333332, 789.0},
-	{10481, "Zambia", "Kalabo", -14.9666667, 22.6833324, 1019.0},
-	{10482, "Zambia", "Kalengwa", -13.4666667, 25.0000000, 1196.0},
-	{10483, "Zambia", "Limulunga", -15.1000000, 23.1499996, 1025.0},
-	{10484, "Zambia", "Zambezi", -13.5500000, 23.1166668, 1091.0},
-	{10485, "Zambia", "Mungwi", -10.1666667, 31.3833332, 1292.0},
-	{10486, "Zambia", "Kabompo", -13.6000000, 24.2000008, 1028.0},
-	{10487, "Zambia", "Luwingu", -10.2666667, 29.8999996, 1307.0},
-	{10488, "Zambia", "Mufumbwe", -13.6833333, 24.7999992, 1072.0},
-	{10489, "Zambia", "Chongwe", -15.3500000, 28.7000008, 1069.0},
-	{10490, "Zambia", "Kasempa", -13.4500000, 25.8333340, 1264.0},
-	{10491, "Zambia", "Solwezi", -12.1833333, 26.3999996, 1373.0},
-	{10492, "Zambia", "Chibombo", -14.6500000, 28.0666676, 1149.0},
-	{10493, "Zambia", "Namwala", -15.7500000, 26.4500008, 1008.0},
-	{10494, "Zambia", "Mwense", -10.3833333, 28.6833324, 960.0},
-	{10495, "Zambia", "Chama", -11.2000000, 33.1666679, 979.0},
-	{10496, "Zambia", "Chadiza", -14.0666667, 32.4333344, 1177.0},
-	{10497, "Zambia", "Mporokoso", -9.3833333, 30.1333332, 1384.0},
-	{10498, "Zambia", "Lukulu", -14.3833333, 23.2500000, 1071.0},
-	{10499, "Zambia", "Luangwa", -15.6166667, 30.4166660, 314.0},
-	{10500, "Zambia", "Kaputa", -8.4666667, 29.6666660, 940.0},
-	{10501, "Zambia", "Gwembe", -16.5000000, 27.6166668, 1217.0},
-	{10502, "Zambia", "Nyimba", -14.5500000, 30.8333340, 637.0},
-	{10503, "Zimbabwe", "Harare", -17.8177778, 31.0447216, 1481.0},
-	{10504, "Zimbabwe", "Bulawayo", -20.1500000, 28.5833340, 1353.0},
-	{10505, "Zimbabwe", "Chitungwiza", -17.9938889, 31.0480556, 1413.0},
-	{10506, "Zimbabwe", "Mutare", -18.9666667, 32.6666679, 1095.0},
-	{10507, "Zimbabwe", "Gweru", -19.4500000, 29.8166676, 1425.0},
-	{10508, "Zimbabwe", "Epworth", -17.8900000, 31.1474991, 1473.0},
-	{10509, "Zimbabwe", "Zvishavane District", -20.2500000, 30.0833340, 950.0},
-	{10510, "Zimbabwe", "Kwekwe", -18.9166667, 29.8166676, 1197.0},
-	{10511, "Zimbabwe", "Kadoma", -18.3500000, 29.9166660, 1126.0},
-	{10512, "Zimbabwe", "Masvingo", -20.0833333, 30.8333340, 1029.0},
-	{10513, "Zimbabwe", "Chinhoyi", -17.3666667, 30.2000008, 1187.0},
-	{10514, "Zimbabwe", "Marondera", -18.1833333, 31.5499992, 1692.0},
-	{10515, "Zimbabwe", "Norton", -17.8833333, 30.7000008, 1360.0},
-	{10516, "Zimbabwe", "Chegutu", -18.1333333, 30.1499996, 1166.0},
-	{10517, "Zimbabwe", "Bindura", -17.2963889, 31.3297215, 1130.0},
-	{10518, "Zimbabwe", "Zvishavane", -20.3333333, 30.0333328, 912.0},
-	{10519, "Zimbabwe", "Victoria Falls", -17.9333333, 25.8333340, 983.0},
-	{10520, "Zimbabwe", "Hwange", -18.3666667, 26.4833336, 780.0},
-	{10521, "Zimbabwe", "Redcliff", -19.0333333, 29.7833328, 1211.0},
-	{10522, "Zimbabwe", "Rusape", -18.5333333, 32.1166649, 1350.0},
-	{10523, "Zimbabwe", "Chiredzi", -21.0500000, 31.6666660, 438.0},
-	{10524, "Zimbabwe", "Beitbridge", -22.2166667, 30.0000000, 468.0},
-	{10525, "Zimbabwe", "Kariba", -16.5166667, 28.7999992, 619.0},
-	{10526, "Zimbabwe", "Karoi", -16.8166667, 29.6833324, 1241.0},
-	{10527, "Zimbabwe", "Gokwe", -18.2166667, 28.9333324, 1227.0},
-	{10528, "Zimbabwe", "Chipinge", -20.2000000, 32.6166649, 1016.0},
-	{10529, "Zimbabwe", "Shurugwi", -19.6666667, 30.0000000, 1502.0},
-	{10530, "Zimbabwe", "Gwanda", -20.9333333, 29.0000000, 974.0},
-	{10531, "Zimbabwe", "Mashava", -20.0500000, 30.4833336, 969.0},
-	{10532, "Zimbabwe", "Chivhu", -19.0166667, 30.8999996, 1460.0},
-	{10533, "Zimbabwe", "Shamva", -17.3155556, 31.5705547, 914.0},
-	{10534, "Zimbabwe", "Mazowe", -17.5166667, 30.9666672, 1232.0},
-	{10535, "Zimbabwe", "Glendale", -17.3605556, 31.0597229, 1143.0},
-	{10536, "Zimbabwe", "Banket", -17.3833333, 30.3999996, 1229.0},
-	{10537, "Zimbabwe", "Mutoko", -17.4000000, 32.2166672, 1285.0},
-	{10538, "Zimbabwe", "Murewa", -17.6469444, 31.7775002, 1363.0},
-	{10539, "Zimbabwe", "Inyati", -19.6833333, 28.8500004, 1351.0},
-	{10540, "Zimbabwe", "Mvurwi", -17.0333333, 30.8500004, 1484.0},
-	{10541, "Zimbabwe", "Penhalonga", -18.8833333, 32.6833344, 1108.0},
-	{10542, "Zimbabwe", "Mhangura", -16.9000000, 30.1499996, 1128.0},
-	{10543, "Zimbabwe", "Chakari", -18.0666667, 29.8500004, 1095.0},
-	{10544, "Zimbabwe", "Mount Darwin", -16.7833333, 31.5833340, 984.0},
-	{10545, "Zimbabwe", "Concession", -17.3833333, 30.9500008, 1348.0},
-	{10546, "Zimbabwe", "Nyanga", -18.2166667, 32.7500000, 1738.0},
-	{10547, "Zimbabwe", "Mvuma", -19.2833333, 30.5333328, 1406.0},
-	{10548, "Zimbabwe", "Binga", -17.6166667, 27.3333340, 371.0},
-	{10549, "Zimbabwe", "Shangani", -19.7833333, 29.3666668, 1355.0},
-	{10550, "Zimbabwe", "Macheke", -18.1500000, 31.8500004, 1587.0},
-	{10551, "Zimbabwe", "Odzi", -18.9666667, 32.3833351, 1033.0},
-	{10552, "Zimbabwe", "Centenary", -16.8000000, 31.1166668, 1176.0},
-	{10553, "Zimbabwe", "Dete", -18.6166667, 26.8666668, 1092.0},
-	{10554, "Zimbabwe", "Chimanimani", -19.8000000, 32.8666649, 1470.0},
-	{10555, "Zimbabwe", "Insiza", -19.7833333, 29.2000008, 1427.0},
-	{10556, "Zimbabwe", "Esigodini", -20.3000000, 28.9333324, 1193.0},
-	{10557, "Zimbabwe", "Plumtree", -20.4833333, 27.8166676, 1374.0},
-	{10558, "Zimbabwe", "Nyazura", -18.7166667, 32.1666679, 1220.0},
-	{10559, "Zimbabwe", "Raffingora", -17.0333333, 30.4333324, 1090.0},
-	{10560, "Zimbabwe", "Chirundu", -16.0333333, 28.8500004, 352.0},
-	{10561, "Zimbabwe", "Filabusi", -20.5333333, 29.2833328, 1082.0},
-	{10562, "Zimbabwe", "Headlands", -18.2833333, 32.0499992, 1585.0},
-	{10563, "Zimbabwe", "Dorowa Mining Lease", -19.0666667, 31.7500000, 890.0},
-	{10564, "Zimbabwe", "Beatrice", -18.2666667, 30.8666668, 1288.0},
-	{10565, "Zimbabwe", "Kamativi", -18.3166667, 27.0666676, 941.0},
-	{10566, "Zimbabwe", "Lalapansi", -19.3333333, 30.1833324, 1493.0},
-	{10567, "Zimbabwe", "Madziwa", -16.9166667, 31.5333328, 1108.0},
-	{10568, "Norway", "Oslo", 59.911491, 10.757933, 4.0},
-	{10569, "Norway", "Bergen", 60.397076, 5.324383, 2.0},
-	{10570, "Norway", "Stavanger/Sandnes", 58.96304, 5.759453, 4.48},
-	{10571, "Norway", "Trondheim", 63.4305149, 10.39505280000003, 18.0},
-	{10572, "Norway", "Drammen", 59.744074, 10.204456, 11.32},
-	{10573, "Norway", "Fredrikstad/Sarpsborg", 59.232447, 11.002244, 16.6},
-	{10574, "Norway", "Porsgrunn/Skien", 59.232447, 11.002244, 16.6},
-	{10575, "Norway", "Kristiansand", 58.159912, 8.018206, 8.13},
-	{10576, "Norway", "Ålesund", 62.4722284, 6.149482, 24.17},
-	{10577, "Norway", "Tønsberg", 59.26757, 10.407561, 17.84},
-	{10578, "Norway", "Moss", 59.434091, 10.658383, 18.12},
-	{10579, "Norway", "Haugesund", 59.413581, 5.267987, 15.47},
-	{10580, "Norway", "Arendal", 58.461757, 8.77245, 19.22},
-	{10581, "Norway", "Sandefjord", 59.13131, 10.216595, 12.22},
-	{10582, "Norway", "Bodø", 67.2803556, 14.404915999999957, 7.96},
-	{10583, "Norway", "Tromsø", 69.6492047, 18.9553238, 13.32},
-	{10584, "Norway", "Hamar", 60.794533, 11.067998, 129.82},
-	{10585, "Norway", "Halden", 59.1329964, 11.3874569, 54.31},
-	{10586, "Norway", "Larvik", 59.053836, 10.029546, 40.63},
-	{10587, "Norway", "Askøy", 60.461873, 5.08933, 6.0},
-	{10588, "Norway", "Kongsberg", 59.668878, 9.650189, 164.1},
-	{10589, "Norway", "Harstad", 68.798634, 16.54145, 9.31},
-	{10590, "Norway", "Molde", 62.737235, 7.160731, 8.8},
-	{10591, "Norway", "Horten", 59.417084, 10.483213, 17.03},
-	{10592, "Norway", "Gjøvik", 60.79543, 10.69163, 130.84},
-	{10593, "Norway", "Lillehammer", 61.115271, 10.466231, 208.36},
-	{10594, "Norway", "Mo i Rana", 66.3137122, 14.141974900000037, 18.23},
-	{10595, "Norway", "Kristiansund", 63.110335, 7.728079, 40.26},
-	{10596, "China", "Hong Kong", 22.3193, 114.1694, 4.0},
+// Columns is the header of the cities CSV, in order.
+var Columns = []string{"id", "country", "city", "latitude", "longitude", "altitude"}
+
+// cities.csv.gz is written by the generate-cities command from cities.csv,
+// the reviewable source committed next to it; edit the CSV and regenerate
+// rather than touching the gzip by hand.
+//
+//go:embed cities.csv.gz
+var embedded []byte
+
+var (
+	loadOnce sync.Once
+	all      []City
+)
+
+// All returns every city in the embedded dataset, parsing it on first use.
+// The slice is shared between callers and must not be modified.
+func All() []City {
+	loadOnce.Do(func() {
+		reader, err := gzip.NewReader(bytes.NewReader(embedded))
+		if err != nil {
+			panic("cities: embedded dataset: " + err.Error())
+		}
+		all, err = ReadCSV(reader)
+		if err != nil {
+			panic("cities: embedded dataset: " + err.Error())
+		}
+	})
+	return all
+}
+
+// ReadCSV parses cities from a CSV with the Columns header. Columns are
+// matched by name, so their order does not matter.
+func ReadCSV(r io.Reader) ([]City, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("empty cities dataset")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range Columns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+
+	var cities []City
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		city, err := parseCity(columns, record)
+		if err != nil {
+			line, _ := reader.FieldPos(0)
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		cities = append(cities, city)
+	}
+	return cities, nil
+}
+
+func parseCity(columns map[string]int, record []string) (City, error) {
+	field := func(name string) string {
+		return strings.TrimSpace(record[columns[name]])
+	}
+
+	id, err := strconv.Atoi(field("id"))
+	if err != nil {
+		return City{}, fmt.Errorf("invalid id: %w", err)
+	}
+	city := City{ID: id, Country: field("country"), City: field("city")}
+	for _, value := range []struct {
+		name string
+		dst  *float64
+	}{
+		{"latitude", &city.Latitude},
+		{"longitude", &city.Longitude},
+		{"altitude", &city.Altitude},
+	} {
+		if *value.dst, err = strconv.ParseFloat(field(value.name), 64); err != nil {
+			return City{}, fmt.Errorf("invalid %s: %w", value.name, err)
+		}
+	}
+	return city, nil
+}
+
+// WriteCSV writes cities in the layout ReadCSV reads. Floats are written in
+// their shortest exact form so a read/write round trip is lossless.
+func WriteCSV(w io.Writer, cities []City) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(Columns); err != nil {
+		return err
+	}
+	for _, city := range cities {
+		record := []string{
+			strconv.Itoa(city.ID), city.Country, city.City,
+			strconv.FormatFloat(city.Latitude, 'f', -1, 64),
+			strconv.FormatFloat(city.Longitude, 'f', -1, 64),
+			strconv.FormatFloat(city.Altitude, 'f', -1, 64),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteDataset writes cities as the gzipped CSV that All embeds. The gzip
+// header carries no name or timestamp, so the same data always produces the
+// same bytes.
+func WriteDataset(w io.Writer, cities []City) error {
+	zw, err := gzip.NewWriterLevel(w, gzip.BestCompression)
+	if err != nil {
+		return err
+	}
+	if err := WriteCSV(zw, cities); err != nil {
+		return err
+	}
+	return zw.Close()
 }
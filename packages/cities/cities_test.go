@@ -0,0 +1,27 @@
+package cities
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestEmbeddedDatasetMatchesSource(t *testing.T) {
+	file, err := os.Open("cities.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	source, err := ReadCSV(file)
+	if err != nil {
+		t.Fatalf("cities.csv: %v", err)
+	}
+	var regenerated bytes.Buffer
+	if err := WriteDataset(&regenerated, source); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(regenerated.Bytes(), embedded) {
+		t.Error("cities.csv.gz does not match cities.csv; run go run . generate-cities")
+	}
+}
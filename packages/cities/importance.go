@@ -0,0 +1,219 @@
+package cities
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"backend.travel.intercogni.com/packages/countries"
+	"backend.travel.intercogni.com/packages/geo"
+)
+
+// populations holds approximate urban populations for cities whose names are
+// shared with other cities in the dataset, plus a few large hubs. They are
+// keyed by ID because some names repeat within a country (there are two San
+// Joses in Costa Rica). Validate reports IDs that are not in the dataset.
+var populations = map[int]int{
+	1565:  9_000_000,  // London, United Kingdom
+	1962:  420_000,    // London, Canada
+	3672:  11_000_000, // Paris, France
+	608:   5_300_000,  // Sydney, Australia
+	610:   2_100_000,  // Perth, Australia
+	8315:  5_400_000,  // Saint Petersburg, Russia
+	356:   260_000,    // Saint Petersburg, United States
+	297:   3_300_000,  // San Francisco, United States
+	289:   1_900_000,  // San Antonio, United States
+	2167:  90_000,     // San Antonio, Chile
+	293:   1_000_000,  // San Jose, United States
+	2538:  1_400_000,  // San Jose, Costa Rica
+	335:   310_000,    // Santa Ana, United States
+	8407:  260_000,    // Santa Ana, El Salvador
+	318:   2_300_000,  // Las Vegas, United States
+	342:   270_000,    // Toledo, United States
+	9068:  85_000,     // Toledo, Spain
+	7941:  180_000,    // Toledo, Philippines
+	358:   290_000,    // Lincoln, United States
+	1643:  100_000,    // Lincoln, United Kingdom
+	365:   1_100_000,  // Birmingham, United States
+	1566:  2_600_000,  // Birmingham, United Kingdom
+	1617:  150_000,    // Cambridge, United Kingdom
+	1963:  400_000,    // Victoria, Canada
+	8649:  26_000,     // Victoria, Seychelles
+	5402:  670_000,    // Kingston, Jamaica
+	1977:  130_000,    // Kingston, Canada
+	4344:  200_000,    // Georgetown, Guyana
+	2140:  6_300_000,  // Santiago, Chile
+	2149:  1_000_000,  // Concepcion, Chile
+	7718:  75_000,     // Concepcion, Paraguay
+	1267:  1_800_000,  // La Paz, Bolivia
+	6765:  250_000,    // La Paz, Mexico
+	423:   1_500_000,  // Cordoba, Argentina
+	8995:  320_000,    // Cordoba, Spain
+	6778:  200_000,    // Cordoba, Mexico
+	424:   1_300_000,  // Rosario, Argentina
+	432:   500_000,    // San Juan, Argentina
+	443:   200_000,    // San Luis, Argentina
+	6699:  1_700_000,  // Leon, Mexico
+	7119:  200_000,    // Leon, Nicaragua
+	9031:  120_000,    // Leon, Spain
+	8986:  800_000,    // Valencia, Spain
+	10260: 1_500_000,  // Valencia, Venezuela
+	7806:  1_000_000,  // Trujillo, Peru
+	1268:  300_000,    // Sucre, Bolivia
+	1273:  130_000,    // Trinidad, Bolivia
+	8984:  6_700_000,  // Madrid, Spain
+	8985:  1_600_000,  // Barcelona, Spain
+	10263: 450_000,    // Barcelona, Venezuela
+	1959:  570_000,    // Hamilton, Canada
+	7024:  180_000,    // Hamilton, New Zealand
+	2354:  300_000,    // Armenia, Colombia
+	4749:  10_000_000, // Hyderabad, India
+	7459:  1_700_000,  // Hyderabad, Pakistan
+	5988:  1_100_000,  // Tripoli, Libya
+	5967:  230_000,    // Tripoli, Lebanon
+	4001:  240_000,    // Halle, Germany
+	1147:  40_000,     // Halle, Belgium
+	8393:  190_000,    // Armavir, Russia
+	1602:  160_000,    // Oxford, United Kingdom
+	9023:  145_000,    // Salamanca, Spain
+	6776:  160_000,    // Salamanca, Mexico
+}
+
+// Without a population, importance comes from where the city stands in its
+// country: the dataset lists each country's cities largest first, so the
+// first row is usually the capital or the largest city. Countries with fewer
+// rows are smaller and weigh less. The estimate stays within
+// [minEstimatedImportance, maxEstimatedImportance], below any known
+// population.
+const (
+	minEstimatedImportance = 0.1
+	maxEstimatedImportance = 0.45
+	// fullCountryRows is the row count at which a country counts as large;
+	// the dataset holds at most about a hundred cities per country.
+	fullCountryRows = 100
+)
+
+// Population returns the approximate urban population of c, when known.
+func Population(c City) (int, bool) {
+	population, ok := populations[c.ID]
+	return population, ok
+}
+
+// Importance estimates how prominent c is, from 0 to 1. Cities with a known
+// population score at least 0.5, growing with the logarithm of the
+// population; others get a lower estimate from their rank in their country.
+func Importance(c City) float64 {
+	idx := loadIndexes()
+	pos, ok := idx.byID[c.ID]
+	if !ok {
+		return 0
+	}
+	return idx.importances()[pos]
+}
+
+func (idx *indexes) importances() []float64 {
+	idx.importanceOnce.Do(func() {
+		rank := make([]int, len(idx.cities))
+		rows := make(map[string]int)
+		for i, city := range idx.cities {
+			rank[i] = rows[city.Country]
+			rows[city.Country]++
+		}
+
+		idx.importance = make([]float64, len(idx.cities))
+		for i, city := range idx.cities {
+			if population, ok := populations[city.ID]; ok {
+				scaled := (math.Log10(float64(population)) - 4) / 3.5
+				idx.importance[i] = 0.5 + 0.5*math.Max(0, math.Min(1, scaled))
+				continue
+			}
+			// Rank 0 scores 1, rank 9 half of that and rank 99 nothing;
+			// a single-city country weighs half a full one.
+			standing := math.Max(0, 1-math.Log10(float64(rank[i]+1))/2)
+			size := 0.5 + 0.5*math.Min(1, math.Log10(float64(rows[city.Country]))/math.Log10(fullCountryRows))
+			idx.importance[i] = minEstimatedImportance + (maxEstimatedImportance-minEstimatedImportance)*standing*size
+		}
+	})
+	return idx.importance
+}
+
+// Bias tells Rank which city a caller most likely means. Country, given as a
+// name, alias or ISO code, limits the candidates to that country. Origin,
+// when set, favours cities near the traveller's starting point.
+type Bias struct {
+	Country string
+	Origin  *geo.Point
+}
+
+// Candidate is one city a name may refer to. DistanceKm is measured from
+// Bias.Origin and is 0 without one.
+type Candidate struct {
+	City       City
+	Importance float64
+	Score      float64
+	DistanceKm float64
+}
+
+// Resolution is the ranked outcome of Rank. Confident is set when there is a
+// single candidate or the best one clearly outranks the rest.
+type Resolution struct {
+	Candidates []Candidate
+	Confident  bool
+}
+
+// Best returns the top candidate when the resolution is confident.
+func (r Resolution) Best() (City, bool) {
+	if !r.Confident {
+		return City{}, false
+	}
+	return r.Candidates[0].City, true
+}
+
+const (
+	// originBiasWeight is the score added for a city at the origin, fading
+	// with distance over originBiasScaleKm.
+	originBiasWeight  = 0.5
+	originBiasScaleKm = 1000
+	// confidenceMargin is how far the best candidate must lead the runner-up
+	// for Rank to pick it on its own.
+	confidenceMargin = 0.15
+)
+
+// Rank returns the cities called name (see ByNameFold), most likely first.
+// Candidates are scored by Importance plus, with an origin, a bonus that
+// falls off with distance from it; ties are broken by name, then ID.
+func Rank(name string, bias Bias) Resolution {
+	var candidates []Candidate
+	for _, city := range ByNameFold(name) {
+		if strings.TrimSpace(bias.Country) != "" && !countries.Same(city.Country, bias.Country) {
+			continue
+		}
+		candidate := Candidate{City: city, Importance: Importance(city)}
+		candidate.Score = candidate.Importance
+		if bias.Origin != nil {
+			candidate.DistanceKm = geo.Haversine(bias.Origin.Lat, bias.Origin.Long, city.Latitude, city.Longitude)
+			candidate.Score += originBiasWeight * math.Exp(-candidate.DistanceKm/originBiasScaleKm)
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	sort.Slice(candidates, func(a, b int) bool {
+		ca, cb := candidates[a], candidates[b]
+		if ca.Score != cb.Score {
+			return ca.Score > cb.Score
+		}
+		if ca.City.City != cb.City.City {
+			return ca.City.City < cb.City.City
+		}
+		return ca.City.ID < cb.City.ID
+	})
+
+	resolution := Resolution{Candidates: candidates}
+	switch {
+	case len(candidates) == 1:
+		resolution.Confident = true
+	case len(candidates) > 1:
+		resolution.Confident = candidates[0].Score-candidates[1].Score >= confidenceMargin
+	}
+	return resolution
+}
@@ -0,0 +1,78 @@
+package cities
+
+import (
+	"testing"
+
+	"backend.travel.intercogni.com/packages/geo"
+)
+
+func TestRankPrefersCapitalsAndLargeCities(t *testing.T) {
+	tests := []struct {
+		name   string
+		wantID int
+		want   string
+	}{
+		{"Kabul", 1, "Kabul, Afghanistan"},
+		{"Berlin", 3970, "Berlin, Germany"},
+		{"Buenos Aires", 422, "Buenos Aires, Argentina"},
+		{"Lagos", 7218, "Lagos, Nigeria"},
+		{"Guadalajara", 6693, "Guadalajara, Mexico"},
+		{"London", 1565, "London, United Kingdom"},
+		{"Santiago", 2140, "Santiago, Chile"},
+	}
+	for _, test := range tests {
+		city, ok := Rank(test.name, Bias{}).Best()
+		if !ok {
+			t.Errorf("Rank(%q) is not confident, want %s", test.name, test.want)
+			continue
+		}
+		if city.ID != test.wantID {
+			t.Errorf("Rank(%q) = %s, %s (id %d), want %s (id %d)", test.name, city.City, city.Country, city.ID, test.want, test.wantID)
+		}
+	}
+}
+
+// Namesakes of comparable size stay ambiguous, but the larger one leads.
+func TestRankOrdersCloseNamesakes(t *testing.T) {
+	for name, wantID := range map[string]int{"Barcelona": 8985, "Hamilton": 1959} {
+		resolution := Rank(name, Bias{})
+		if len(resolution.Candidates) < 2 {
+			t.Fatalf("Rank(%q) found %d candidates, want several", name, len(resolution.Candidates))
+		}
+		if got := resolution.Candidates[0].City; got.ID != wantID {
+			t.Errorf("Rank(%q) ranks %s, %s first, want id %d", name, got.City, got.Country, wantID)
+		}
+	}
+}
+
+func TestRankWithOrigin(t *testing.T) {
+	paris := &geo.Point{Lat: 48.8566, Long: 2.3522}
+	city, ok := Rank("Berlin", Bias{Origin: paris}).Best()
+	if !ok || city.ID != 3970 {
+		t.Errorf("Rank(Berlin, origin Paris) = %v %v, want Berlin, Germany", city, ok)
+	}
+
+	// Near the smaller namesake the origin outweighs importance.
+	sanSalvador := &geo.Point{Lat: 13.69, Long: -89.19}
+	if resolution := Rank("Berlin", Bias{Origin: sanSalvador}); resolution.Candidates[0].City.Country != "El Salvador" {
+		t.Errorf("Rank(Berlin, origin San Salvador) ranks %s first, want El Salvador", resolution.Candidates[0].City.Country)
+	}
+}
+
+func TestRankCountryBias(t *testing.T) {
+	city, ok := Rank("Kabul", Bias{Country: "IL"}).Best()
+	if !ok || city.ID != 5270 {
+		t.Errorf("Rank(Kabul, IL) = %v %v, want Kabul, Israel", city, ok)
+	}
+}
+
+func TestImportanceKnownPopulationOutranksEstimate(t *testing.T) {
+	kabul, _ := ByID(1)
+	london, _ := ByID(1565)
+	if Importance(kabul) > maxEstimatedImportance || Importance(kabul) < minEstimatedImportance {
+		t.Errorf("Importance(Kabul) = %v, want an estimate within [%v, %v]", Importance(kabul), minEstimatedImportance, maxEstimatedImportance)
+	}
+	if Importance(london) <= maxEstimatedImportance {
+		t.Errorf("Importance(London) = %v, want above every estimate", Importance(london))
+	}
+}
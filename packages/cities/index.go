@@ -0,0 +1,218 @@
+package cities
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"backend.travel.intercogni.com/packages/countries"
+	"backend.travel.intercogni.com/packages/geo"
+	"backend.travel.intercogni.com/packages/textnorm"
+)
+
+// Match is a city together with its distance from a query point.
+type Match struct {
+	City       City
+	DistanceKm float64
+}
+
+// nameEntry is one searchable name, canonical or alternate, of the city at
+// position pos.
+type nameEntry struct {
+	key string
+	pos int
+}
+
+type indexes struct {
+	cities     []City
+	byID       map[int]int
+	byName     map[string][]int
+	byFold     map[string][]int
+	byLooseKey map[string][]int
+	byCountry  map[string][]int
+	alternates map[int][]string
+	names      []nameEntry
+	geo        *geo.Index
+	searchOnce sync.Once
+	search     []searchEntry
+
+	importanceOnce sync.Once
+	importance     []float64
+}
+
+var (
+	indexOnce sync.Once
+	index     indexes
+)
+
+// loadIndexes builds the lookup indexes over All on first use. Names are
+// indexed folded (see textnorm.Fold) together with their alternate names.
+func loadIndexes() *indexes {
+	indexOnce.Do(func() {
+		cities := All()
+		index = indexes{
+			cities:     cities,
+			byID:       make(map[int]int, len(cities)),
+			byName:     make(map[string][]int),
+			byFold:     make(map[string][]int),
+			byLooseKey: make(map[string][]int),
+			byCountry:  make(map[string][]int),
+			alternates: make(map[int][]string),
+		}
+
+		alternates := make(map[string][]string, len(alternateNames))
+		for _, entry := range alternateNames {
+			key := entry.Country + "\x00" + textnorm.Fold(entry.City)
+			alternates[key] = append(alternates[key], entry.Names...)
+		}
+
+		points := make([]geo.Point, len(cities))
+		for i, city := range cities {
+			index.byID[city.ID] = i
+			index.byName[city.City] = append(index.byName[city.City], i)
+			index.byCountry[countryKey(city.Country)] = append(index.byCountry[countryKey(city.Country)], i)
+			points[i] = geo.Point{Lat: city.Latitude, Long: city.Longitude}
+
+			folded := textnorm.Fold(city.City)
+			index.addName(folded, i)
+			for _, name := range alternates[city.Country+"\x00"+folded] {
+				index.alternates[i] = append(index.alternates[i], name)
+				index.addName(textnorm.Fold(name), i)
+			}
+		}
+		sort.Slice(index.names, func(a, b int) bool {
+			if index.names[a].key != index.names[b].key {
+				return index.names[a].key < index.names[b].key
+			}
+			return index.names[a].pos < index.names[b].pos
+		})
+		index.geo = geo.NewIndex(points)
+	})
+	return &index
+}
+
+func (idx *indexes) addName(key string, pos int) {
+	if key == "" {
+		return
+	}
+	for _, existing := range idx.byFold[key] {
+		if existing == pos {
+			return
+		}
+	}
+	idx.byFold[key] = append(idx.byFold[key], pos)
+	loose := transliterationKey(key)
+	idx.byLooseKey[loose] = append(idx.byLooseKey[loose], pos)
+	idx.names = append(idx.names, nameEntry{key: key, pos: pos})
+}
+
+// countryKey groups cities by ISO code when their country resolves, so
+// "Korea, South", "South Korea" and "KR" all find the same cities.
+func countryKey(country string) string {
+	if resolved, ok := countries.Lookup(country); ok {
+		return resolved.Alpha2
+	}
+	return textnorm.Fold(country)
+}
+
+func (idx *indexes) collect(positions []int) []City {
+	if len(positions) == 0 {
+		return nil
+	}
+	result := make([]City, 0, len(positions))
+	seen := make(map[int]bool, len(positions))
+	for _, pos := range positions {
+		if !seen[pos] {
+			seen[pos] = true
+			result = append(result, idx.cities[pos])
+		}
+	}
+	return result
+}
+
+func ByID(id int) (City, bool) {
+	idx := loadIndexes()
+	pos, ok := idx.byID[id]
+	if !ok {
+		return City{}, false
+	}
+	return idx.cities[pos], true
+}
+
+// ByName returns the cities whose name is exactly name.
+func ByName(name string) []City {
+	idx := loadIndexes()
+	return idx.collect(idx.byName[name])
+}
+
+// ByNameFold returns the cities called name, ignoring case, accents and
+// punctuation, and including alternate names, so "gjovik", "Den Haag" and
+// "München" all match. Transliterated spellings such as "Muenchen" for
+// "München" are tried only when nothing else matches.
+func ByNameFold(name string) []City {
+	idx := loadIndexes()
+	folded := textnorm.Fold(name)
+	if positions := idx.byFold[folded]; len(positions) > 0 {
+		return idx.collect(positions)
+	}
+	return idx.collect(idx.byLooseKey[transliterationKey(folded)])
+}
+
+// InCountry returns every city in a country given as a name, alias or ISO
+// code.
+func InCountry(country string) []City {
+	idx := loadIndexes()
+	return idx.collect(idx.byCountry[countryKey(country)])
+}
+
+// WithPrefix returns up to limit cities with a name or alternate name that
+// starts with prefix, compared folded, in alphabetical order of the matching
+// name. A limit of zero or less means no limit.
+func WithPrefix(prefix string, limit int) []City {
+	prefix = textnorm.Fold(prefix)
+	if prefix == "" {
+		return nil
+	}
+
+	idx := loadIndexes()
+	start := sort.Search(len(idx.names), func(i int) bool {
+		return idx.names[i].key >= prefix
+	})
+
+	var positions []int
+	seen := make(map[int]bool)
+	for _, entry := range idx.names[start:] {
+		if !strings.HasPrefix(entry.key, prefix) {
+			break
+		}
+		if limit > 0 && len(positions) == limit {
+			break
+		}
+		if !seen[entry.pos] {
+			seen[entry.pos] = true
+			positions = append(positions, entry.pos)
+		}
+	}
+	return idx.collect(positions)
+}
+
+// Nearest returns the city closest to lat/long.
+func Nearest(lat, long float64) (Match, bool) {
+	idx := loadIndexes()
+	neighbor, ok := idx.geo.Nearest(lat, long, nil)
+	if !ok {
+		return Match{}, false
+	}
+	return Match{City: idx.cities[neighbor.Index], DistanceKm: neighbor.DistanceKm}, true
+}
+
+// WithinRadius returns the cities within radiusKm of lat/long, closest first.
+func WithinRadius(lat, long, radiusKm float64) []Match {
+	idx := loadIndexes()
+	neighbors := idx.geo.WithinRadius(lat, long, radiusKm, nil)
+	matches := make([]Match, len(neighbors))
+	for i, neighbor := range neighbors {
+		matches[i] = Match{City: idx.cities[neighbor.Index], DistanceKm: neighbor.DistanceKm}
+	}
+	return matches
+}
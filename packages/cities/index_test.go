@@ -0,0 +1,135 @@
+package cities
+
+import (
+	"sort"
+	"testing"
+)
+
+func ids(cities []City) []int {
+	result := make([]int, len(cities))
+	for i, city := range cities {
+		result[i] = city.ID
+	}
+	sort.Ints(result)
+	return result
+}
+
+func sameIDs(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestByID(t *testing.T) {
+	city, ok := ByID(1)
+	if !ok || city.City != "Kabul" || city.Country != "Afghanistan" {
+		t.Errorf("ByID(1) = %v %v, want Kabul, Afghanistan", city, ok)
+	}
+	city, ok = ByID(10596)
+	if !ok || city.City != "Hong Kong" {
+		t.Errorf("ByID(10596) = %v %v, want Hong Kong", city, ok)
+	}
+	if _, ok := ByID(0); ok {
+		t.Error("ByID(0) found a city")
+	}
+}
+
+func TestByName(t *testing.T) {
+	if got := ids(ByName("Kabul")); !sameIDs(got, []int{1, 5270}) {
+		t.Errorf("ByName(Kabul) = %v, want [1 5270]", got)
+	}
+	if got := ByName("kabul"); len(got) != 0 {
+		t.Errorf("ByName(kabul) = %v, want no exact match", got)
+	}
+}
+
+func TestByNameFold(t *testing.T) {
+	tests := []struct {
+		name string
+		want []int
+	}{
+		{"Kabul", []int{1, 5270}},
+		{"KABUL", []int{1, 5270}},
+		{"  kábul ", []int{1, 5270}},
+		{"hong kong", []int{10596}},
+		{"Hong-Kong", []int{10596}},
+		{"München", []int{3972}},
+		{"muenchen", []int{3972}},
+		{"Munich", []int{3972}},
+		{"Nowhere Springs", nil},
+	}
+	for _, test := range tests {
+		if got := ids(ByNameFold(test.name)); !sameIDs(got, test.want) {
+			t.Errorf("ByNameFold(%q) = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestInCountry(t *testing.T) {
+	byName := InCountry("Afghanistan")
+	if len(byName) == 0 || byName[0].ID != 1 {
+		t.Fatalf("InCountry(Afghanistan) = %d cities starting with %v, want Kabul first", len(byName), byName)
+	}
+	for _, country := range []string{"AF", "AFG", "afghanistan"} {
+		if got := InCountry(country); !sameIDs(ids(got), ids(byName)) {
+			t.Errorf("InCountry(%q) = %d cities, want the %d of Afghanistan", country, len(got), len(byName))
+		}
+	}
+	for _, city := range InCountry("Israel") {
+		if city.Country != "Israel" {
+			t.Errorf("InCountry(Israel) returned %s, %s", city.City, city.Country)
+		}
+	}
+	if got := InCountry("Narnia"); len(got) != 0 {
+		t.Errorf("InCountry(Narnia) = %d cities, want none", len(got))
+	}
+}
+
+func TestWithPrefix(t *testing.T) {
+	got := WithPrefix("Kabu", 0)
+	found := map[int]bool{}
+	for _, city := range got {
+		found[city.ID] = true
+	}
+	if !found[1] || !found[5270] {
+		t.Errorf("WithPrefix(Kabu) = %v, want both Kabuls", ids(got))
+	}
+
+	if got := WithPrefix("hong k", 0); !sameIDs(ids(got), []int{10596}) {
+		t.Errorf("WithPrefix(hong k) = %v, want [10596]", ids(got))
+	}
+	if got := WithPrefix("s", 5); len(got) != 5 {
+		t.Errorf("WithPrefix(s, 5) returned %d cities, want 5", len(got))
+	}
+	if got := WithPrefix("", 0); got != nil {
+		t.Errorf("WithPrefix(\"\") = %v, want nil", got)
+	}
+}
+
+func TestNearest(t *testing.T) {
+	tests := []struct {
+		name      string
+		lat, long float64
+		wantID    int
+	}{
+		{"Kabul", 34.5166667, 69.1833344, 1},
+		{"near Kabul", 34.53, 69.17, 1},
+		{"Hong Kong", 22.3193, 114.1694, 10596},
+		{"Kabul, Israel", 32.8666667, 35.2130547, 5270},
+	}
+	for _, test := range tests {
+		match, ok := Nearest(test.lat, test.long)
+		if !ok || match.City.ID != test.wantID {
+			t.Errorf("Nearest(%s) = %s (id %d) %v, want id %d", test.name, match.City.City, match.City.ID, ok, test.wantID)
+		}
+		if match.DistanceKm > 5 {
+			t.Errorf("Nearest(%s) is %.1f km away", test.name, match.DistanceKm)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package cities
+
+import (
+	"strings"
+
+	"backend.travel.intercogni.com/packages/countries"
+	"backend.travel.intercogni.com/packages/timezones"
+)
+
+// ResolveCountry returns the reference country for the city's free-text
+// country name.
+func (c City) ResolveCountry() (countries.Country, bool) {
+	return countries.Lookup(c.Country)
+}
+
+// Timezone returns the IANA timezone name for the city, or "" when none is
+// known.
+func (c City) Timezone() string {
+	country, _ := c.ResolveCountry()
+	return timezones.Name(c.Latitude, c.Longitude, country.Alpha2)
+}
+
+// Find returns the cities called name, ignoring case and surrounding spaces.
+// A non-empty country limits the result to that country, given as a name,
+// alias or ISO code.
+func Find(name, country string) []City {
+	matches := ByNameFold(name)
+	if strings.TrimSpace(country) == "" {
+		return matches
+	}
+
+	var inCountry []City
+	for _, city := range matches {
+		if countries.Same(city.Country, country) {
+			inCountry = append(inCountry, city)
+		}
+	}
+	return inCountry
+}
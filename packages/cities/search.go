@@ -0,0 +1,136 @@
+package cities
+
+import (
+	"sort"
+	"strings"
+
+	"backend.travel.intercogni.com/packages/textnorm"
+)
+
+const (
+	scoreExactName      = 1000
+	scoreExactAlternate = 900
+	scorePrefixName     = 700
+	scorePrefixAlt      = 650
+	scoreWordPrefix     = 550
+	scoreContains       = 400
+	scoreFuzzy          = 300
+	scoreFuzzyPerEdit   = 100
+)
+
+type SearchResult struct {
+	City      City
+	Score     int
+	MatchedOn string
+}
+
+// searchEntry holds the folded names of one city so searches don't normalise
+// the whole dataset on every keystroke.
+type searchEntry struct {
+	name       string
+	alternates []string
+	words      []string
+	// fuzzy holds the strings a misspelt query is compared with.
+	fuzzy []string
+}
+
+func (idx *indexes) searchEntries() []searchEntry {
+	idx.searchOnce.Do(func() {
+		idx.search = make([]searchEntry, len(idx.cities))
+		for i, city := range idx.cities {
+			entry := searchEntry{name: textnorm.Fold(city.City)}
+			for _, name := range idx.alternates[i] {
+				entry.alternates = append(entry.alternates, textnorm.Fold(name))
+			}
+			entry.words = strings.Fields(entry.name + " " + strings.Join(entry.alternates, " "))
+			entry.fuzzy = append(append([]string{entry.name}, entry.alternates...), entry.words...)
+			idx.search[i] = entry
+		}
+	})
+	return idx.search
+}
+
+// Search matches query against city names and alternate names, ignoring
+// case, accents and punctuation, optionally within one country given as a
+// name, alias or ISO code. Exact matches rank above prefix matches, prefix
+// above substring, and substring above fuzzy matches within one or two edits;
+// ties are broken by Importance, then name, then ID. An empty query with a
+// country lists every city in it, most important first.
+func Search(query, country string) []SearchResult {
+	q := textnorm.Fold(query)
+	idx := loadIndexes()
+	if q == "" && strings.TrimSpace(country) == "" {
+		return nil
+	}
+
+	var inCountry map[int]bool
+	if strings.TrimSpace(country) != "" {
+		positions := idx.byCountry[countryKey(country)]
+		inCountry = make(map[int]bool, len(positions))
+		for _, pos := range positions {
+			inCountry[pos] = true
+		}
+	}
+
+	var results []SearchResult
+	for i, entry := range idx.searchEntries() {
+		if inCountry != nil && !inCountry[i] {
+			continue
+		}
+		if q == "" {
+			results = append(results, SearchResult{City: idx.cities[i], MatchedOn: "country"})
+			continue
+		}
+		if score, matchedOn := entry.score(q); score > 0 {
+			results = append(results, SearchResult{City: idx.cities[i], Score: score, MatchedOn: matchedOn})
+		}
+	}
+
+	importances := idx.importances()
+	sort.Slice(results, func(a, b int) bool {
+		ra, rb := results[a], results[b]
+		if ra.Score != rb.Score {
+			return ra.Score > rb.Score
+		}
+		if ia, ib := importances[idx.byID[ra.City.ID]], importances[idx.byID[rb.City.ID]]; ia != ib {
+			return ia > ib
+		}
+		if ra.City.City != rb.City.City {
+			return ra.City.City < rb.City.City
+		}
+		return ra.City.ID < rb.City.ID
+	})
+	return results
+}
+
+func (e searchEntry) score(q string) (int, string) {
+	if e.name == q {
+		return scoreExactName, "name"
+	}
+	for _, alternate := range e.alternates {
+		if alternate == q {
+			return scoreExactAlternate, "alternate_name"
+		}
+	}
+	if strings.HasPrefix(e.name, q) {
+		return scorePrefixName, "name"
+	}
+	for _, alternate := range e.alternates {
+		if strings.HasPrefix(alternate, q) {
+			return scorePrefixAlt, "alternate_name"
+		}
+	}
+	if textnorm.WordPrefixes(q, e.words) {
+		return scoreWordPrefix, "name"
+	}
+	if strings.Contains(e.name, q) {
+		return scoreContains, "name"
+	}
+	if transliterationKey(e.name) == transliterationKey(q) {
+		return scoreContains, "name"
+	}
+	if edits := textnorm.FewestEdits(q, e.fuzzy...); edits >= 0 {
+		return scoreFuzzy - scoreFuzzyPerEdit*edits, "fuzzy"
+	}
+	return 0, ""
+}
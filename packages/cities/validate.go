@@ -0,0 +1,150 @@
+package cities
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"backend.travel.intercogni.com/packages/countries"
+	"backend.travel.intercogni.com/packages/geo"
+	"backend.travel.intercogni.com/packages/textnorm"
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+
+	// duplicateWithinKm is how close two same-named cities in one country must
+	// be to count as the same city entered twice. Distinct towns can share a
+	// name a few kilometres apart (the two Marices in Albania are 5.6 km
+	// apart), so anything further is only a warning.
+	duplicateWithinKm = 2
+
+	// The lowest and highest permanently inhabited places are around the Dead
+	// Sea and in the Andes; altitudes outside that band are likely typos.
+	minPlausibleAltitude = -450
+	maxPlausibleAltitude = 5500
+)
+
+type Issue struct {
+	Severity string
+	ID       int
+	Message  string
+}
+
+func (i Issue) String() string {
+	if i.ID == 0 {
+		return fmt.Sprintf("%s: %s", i.Severity, i.Message)
+	}
+	return fmt.Sprintf("%s: id %d: %s", i.Severity, i.ID, i.Message)
+}
+
+// Validate checks cities for out-of-range coordinates, duplicate or missing
+// IDs, unknown countries, rows outside their country's block, implausible
+// altitudes, cities listed twice and alternate names or populations that
+// point at no city.
+// Issues come back ordered by ID.
+func Validate(cities []City) []Issue {
+	var issues []Issue
+	report := func(severity string, id int, format string, args ...interface{}) {
+		issues = append(issues, Issue{Severity: severity, ID: id, Message: fmt.Sprintf(format, args...)})
+	}
+
+	seenIDs := make(map[int]bool, len(cities))
+	byName := make(map[string][]City)
+	blockStart := make(map[string]int)
+	previousCountry := ""
+	maxID := 0
+	for _, city := range cities {
+		switch {
+		case city.ID <= 0:
+			report(SeverityError, city.ID, "id must be positive")
+		case seenIDs[city.ID]:
+			report(SeverityError, city.ID, "duplicate id")
+		}
+		seenIDs[city.ID] = true
+		if city.ID > maxID {
+			maxID = city.ID
+		}
+
+		if textnorm.Fold(city.City) == "" {
+			report(SeverityError, city.ID, "empty city name")
+		}
+		if _, ok := countries.Lookup(city.Country); !ok {
+			report(SeverityError, city.ID, "unknown country %q", city.Country)
+		}
+		// Importance ranks a city by its position among its country's cities,
+		// so a row appended away from them would rank as a large city.
+		if city.Country != previousCountry {
+			if start, ok := blockStart[city.Country]; ok {
+				report(SeverityError, city.ID, "listed apart from the other cities of %s, which start at id %d", city.Country, start)
+			} else {
+				blockStart[city.Country] = city.ID
+			}
+			previousCountry = city.Country
+		}
+
+		lat, long := city.Latitude, city.Longitude
+		switch {
+		case math.IsNaN(lat) || lat < -90 || lat > 90:
+			report(SeverityError, city.ID, "latitude %v out of range", lat)
+		case math.IsNaN(long) || long < -180 || long > 180:
+			report(SeverityError, city.ID, "longitude %v out of range", long)
+		case lat == 0 && long == 0:
+			report(SeverityError, city.ID, "coordinates are the 0,0 placeholder")
+		}
+
+		if math.IsNaN(city.Altitude) || city.Altitude < minPlausibleAltitude || city.Altitude > maxPlausibleAltitude {
+			report(SeverityWarning, city.ID, "suspicious altitude %v m", city.Altitude)
+		}
+
+		key := countryKey(city.Country) + "\x00" + textnorm.Fold(city.City)
+		for _, other := range byName[key] {
+			distance := geo.Haversine(lat, long, other.Latitude, other.Longitude)
+			if distance <= duplicateWithinKm {
+				report(SeverityError, city.ID, "duplicate of id %d (%s, %s, %.1f km away)", other.ID, other.City, other.Country, distance)
+			} else {
+				report(SeverityWarning, city.ID, "same name as id %d in %s, %.0f km away", other.ID, other.Country, distance)
+			}
+		}
+		byName[key] = append(byName[key], city)
+	}
+
+	for _, entry := range alternateNames {
+		if len(byName[countryKey(entry.Country)+"\x00"+textnorm.Fold(entry.City)]) == 0 {
+			report(SeverityError, 0, "alternate names %v are for %s, %s, which is not in the dataset", entry.Names, entry.City, entry.Country)
+		}
+	}
+
+	populationIDs := make([]int, 0, len(populations))
+	for id := range populations {
+		populationIDs = append(populationIDs, id)
+	}
+	sort.Ints(populationIDs)
+	for _, id := range populationIDs {
+		if !seenIDs[id] {
+			report(SeverityError, id, "has a population of %d but is not in the dataset", populations[id])
+		}
+	}
+
+	for id := 1; id <= maxID; id++ {
+		if seenIDs[id] {
+			continue
+		}
+		end := id
+		for end+1 <= maxID && !seenIDs[end+1] {
+			end++
+		}
+		if end == id {
+			report(SeverityWarning, 0, "id %d is missing", id)
+		} else {
+			report(SeverityWarning, 0, "ids %d-%d are missing", id, end)
+		}
+		id = end
+	}
+
+	sort.SliceStable(issues, func(a, b int) bool {
+		return issues[a].ID < issues[b].ID
+	})
+	return issues
+}
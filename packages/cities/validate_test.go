@@ -0,0 +1,58 @@
+package cities
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEmbeddedDatasetHasNoErrors(t *testing.T) {
+	for _, issue := range Validate(All()) {
+		if issue.Severity == SeverityError {
+			t.Error(issue)
+		}
+	}
+}
+
+func TestValidateDuplicateDistance(t *testing.T) {
+	source := []City{
+		{ID: 1, Country: "Czech Republic", City: "Frydek-Mistek", Latitude: 49.6853662, Longitude: 18.3483772},
+		{ID: 2, Country: "Czech Republic", City: "Frydek-Mistek", Latitude: 49.6833333, Longitude: 18.3500004},
+		{ID: 3, Country: "Albania", City: "Marice", Latitude: 41.4216667, Longitude: 19.9591675},
+		{ID: 4, Country: "Albania", City: "Marice", Latitude: 41.4380556, Longitude: 20.0222225},
+	}
+	severities := make(map[int]string)
+	for _, issue := range Validate(source) {
+		if strings.Contains(issue.Message, "duplicate of") || strings.Contains(issue.Message, "same name as") {
+			severities[issue.ID] = issue.Severity
+		}
+	}
+	if severities[2] != SeverityError {
+		t.Errorf("Frydek-Mistek 0.3 km apart: severity %q, want %q", severities[2], SeverityError)
+	}
+	if severities[4] != SeverityWarning {
+		t.Errorf("Marice 5.6 km apart: severity %q, want %q", severities[4], SeverityWarning)
+	}
+}
+
+func TestValidateFlagsRowsOutsideTheirCountry(t *testing.T) {
+	source := []City{
+		{ID: 1, Country: "China", City: "Shanghai", Latitude: 31.22, Longitude: 121.46},
+		{ID: 2, Country: "China", City: "Beijing", Latitude: 39.93, Longitude: 116.39},
+		{ID: 3, Country: "Colombia", City: "Bogota", Latitude: 4.6, Longitude: -74.08},
+		{ID: 4, Country: "China", City: "Hong Kong", Latitude: 22.32, Longitude: 114.17},
+	}
+	var found bool
+	for _, issue := range Validate(source) {
+		if !strings.Contains(issue.Message, "apart from the other cities") {
+			continue
+		}
+		if issue.ID == 4 && issue.Severity == SeverityError {
+			found = true
+		} else {
+			t.Errorf("unexpected %v", issue)
+		}
+	}
+	if !found {
+		t.Error("Validate did not flag the Hong Kong row listed after Colombia")
+	}
+}
@@ -0,0 +1,129 @@
+package countries
+
+import (
+	"strings"
+
+	"backend.travel.intercogni.com/packages/textnorm"
+)
+
+// Continent codes as used by OurAirports.
+const (
+	Africa       = "AF"
+	Antarctica   = "AN"
+	Asia         = "AS"
+	Europe       = "EU"
+	NorthAmerica = "NA"
+	Oceania      = "OC"
+	SouthAmerica = "SA"
+)
+
+var continentNames = map[string]string{
+	Africa:       "Africa",
+	Antarctica:   "Antarctica",
+	Asia:         "Asia",
+	Europe:       "Europe",
+	NorthAmerica: "North America",
+	Oceania:      "Oceania",
+	SouthAmerica: "South America",
+}
+
+type Country struct {
+	Alpha2      string
+	Alpha3      string
+	Numeric     string
+	Name        string
+	Aliases     []string
+	Demonyms    []string
+	Continent   string
+	Currency    string
+	CallingCode string
+}
+
+func (c Country) ContinentName() string {
+	return continentNames[c.Continent]
+}
+
+var (
+	byCode    = make(map[string]int, 2*len(countries))
+	byName    = make(map[string]int)
+	byDemonym = make(map[string]int)
+)
+
+func init() {
+	for i, country := range countries {
+		byCode[country.Alpha2] = i
+		byCode[country.Alpha3] = i
+		byName[textnorm.Fold(country.Name)] = i
+		for _, alias := range country.Aliases {
+			byName[textnorm.Fold(alias)] = i
+		}
+		for _, demonym := range country.Demonyms {
+			byDemonym[textnorm.Fold(demonym)] = i
+		}
+	}
+}
+
+// All returns every country ordered by alpha-2 code. The slice is shared and
+// must not be modified.
+func All() []Country {
+	return countries
+}
+
+// ByCode finds a country by its ISO 3166-1 alpha-2 or alpha-3 code, ignoring
+// case.
+func ByCode(code string) (Country, bool) {
+	i, ok := byCode[strings.ToUpper(strings.TrimSpace(code))]
+	if !ok {
+		return Country{}, false
+	}
+	return countries[i], true
+}
+
+// ByName finds a country by its name or an alias such as "Korea, South" or
+// "Czech Republic", ignoring case and accents.
+func ByName(name string) (Country, bool) {
+	i, ok := byName[textnorm.Fold(name)]
+	if !ok {
+		return Country{}, false
+	}
+	return countries[i], true
+}
+
+// ByDemonym finds a country from a nationality such as "british" or
+// "south korean".
+func ByDemonym(demonym string) (Country, bool) {
+	i, ok := byDemonym[textnorm.Fold(demonym)]
+	if !ok {
+		return Country{}, false
+	}
+	return countries[i], true
+}
+
+// Lookup resolves s as a code, then a name. It is the one place callers
+// should go to turn free-text country input into a Country.
+func Lookup(s string) (Country, bool) {
+	if country, ok := ByCode(s); ok {
+		return country, true
+	}
+	return ByName(s)
+}
+
+// Nationality resolves a traveler nationality given as a demonym, a country
+// name or a code.
+func Nationality(s string) (Country, bool) {
+	if country, ok := ByDemonym(s); ok {
+		return country, true
+	}
+	return Lookup(s)
+}
+
+// Same reports whether a and b resolve to the same country. Inputs that do
+// not resolve are compared as text, ignoring case.
+func Same(a, b string) bool {
+	ca, okA := Lookup(a)
+	cb, okB := Lookup(b)
+	if okA && okB {
+		return ca.Alpha2 == cb.Alpha2
+	}
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
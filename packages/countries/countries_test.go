@@ -0,0 +1,76 @@
+package countries
+
+import "testing"
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"NL", "NL"},
+		{"nl", "NL"},
+		{" gbr ", "GB"},
+		{"Netherlands", "NL"},
+		{"united kingdom", "GB"},
+		{"Cote d'Ivoire", "CI"},
+		{"Ivory Coast", "CI"},
+		{"Korea, South", "KR"},
+		{"Czech Republic", "CZ"},
+		{"England", "GB"},
+		{"Narnia", ""},
+		{"", ""},
+		// Demonyms are nationalities, not places.
+		{"British", ""},
+	}
+	for _, test := range tests {
+		country, ok := Lookup(test.in)
+		if ok != (test.want != "") || country.Alpha2 != test.want {
+			t.Errorf("Lookup(%q) = %q, %v, want %q", test.in, country.Alpha2, ok, test.want)
+		}
+	}
+}
+
+func TestNationality(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"british", "GB"},
+		{"Scottish", "GB"},
+		{"south korean", "KR"},
+		{"Ivorian", "CI"},
+		{"Kazakh", "KZ"},
+		{"Dominican", "DM"},
+		{"Dominican Republic", "DO"},
+		{"Germany", "DE"},
+		{"DEU", "DE"},
+		{"fr", "FR"},
+		{"martian", ""},
+	}
+	for _, test := range tests {
+		country, ok := Nationality(test.in)
+		if ok != (test.want != "") || country.Alpha2 != test.want {
+			t.Errorf("Nationality(%q) = %q, %v, want %q", test.in, country.Alpha2, ok, test.want)
+		}
+	}
+}
+
+func TestSame(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"NL", "Netherlands", true},
+		{"GBR", "Great Britain", true},
+		{"USA", "United States", true},
+		{"NL", "DE", false},
+		{"Narnia", " narnia ", true},
+		{"Narnia", "Atlantis", false},
+		{"Narnia", "NL", false},
+	}
+	for _, test := range tests {
+		if got := Same(test.a, test.b); got != test.want {
+			t.Errorf("Same(%q, %q) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package countries
+
+// countries is derived from ISO 3166-1. Continents follow the OurAirports
+// convention, so transcontinental countries such as Russia and Turkey match
+// the continent on their airports.
+var countries = []Country{
+	{Alpha2: "AD", Alpha3: "AND", Numeric: "020", Name: "Andorra", Aliases: []string{"Principality of Andorra"}, Demonyms: []string{"Andorran"}, Continent: "EU", Currency: "EUR", CallingCode: "376"},
+	{Alpha2: "AE", Alpha3: "ARE", Numeric: "784", Name: "United Arab Emirates", Aliases: []string{"UAE", "Emirates"}, Demonyms: []string{"Emirati"}, Continent: "AS", Currency: "AED", CallingCode: "971"},
+	{Alpha2: "AF", Alpha3: "AFG", Numeric: "004", Name: "Afghanistan", Aliases: []string{"Islamic Republic of Afghanistan"}, Demonyms: []string{"Afghan"}, Continent: "AS", Currency: "AFN", CallingCode: "93"},
+	{Alpha2: "AG", Alpha3: "ATG", Numeric: "028", Name: "Antigua and Barbuda", Demonyms: []string{"Antiguan"}, Continent: "NA", Currency: "XCD", CallingCode: "1-268"},
+	{Alpha2: "AI", Alpha3: "AIA", Numeric: "660", Name: "Anguilla", Demonyms: []string{"Anguillan"}, Continent: "NA", Currency: "XCD", CallingCode: "1-264"},
+	{Alpha2: "AL", Alpha3: "ALB", Numeric: "008", Name: "Albania", Aliases: []string{"Republic of Albania"}, Demonyms: []string{"Albanian"}, Continent: "EU", Currency: "ALL", CallingCode: "355"},
+	{Alpha2: "AM", Alpha3: "ARM", Numeric: "051", Name: "Armenia", Aliases: []string{"Republic of Armenia"}, Demonyms: []string{"Armenian"}, Continent: "AS", Currency: "AMD", CallingCode: "374"},
+	{Alpha2: "AO", Alpha3: "AGO", Numeric: "024", Name: "Angola", Aliases: []string{"Republic of Angola"}, Demonyms: []string{"Angolan"}, Continent: "AF", Currency: "AOA", CallingCode: "244"},
+	{Alpha2: "AQ", Alpha3: "ATA", Numeric: "010", Name: "Antarctica", Continent: "AN", CallingCode: "672"},
+	{Alpha2: "AR", Alpha3: "ARG", Numeric: "032", Name: "Argentina", Aliases: []string{"Argentine Republic"}, Demonyms: []string{"Argentinian", "Argentine", "Argentinean"}, Continent: "SA", Currency: "ARS", CallingCode: "54"},
+	{Alpha2: "AS", Alpha3: "ASM", Numeric: "016", Name: "American Samoa", Demonyms: []string{"American Samoan"}, Continent: "OC", Currency: "USD", CallingCode: "1-684"},
+	{Alpha2: "AT", Alpha3: "AUT", Numeric: "040", Name: "Austria", Aliases: []string{"Republic of Austria"}, Demonyms: []string{"Austrian"}, Continent: "EU", Currency: "EUR", CallingCode: "43"},
+	{Alpha2: "AU", Alpha3: "AUS", Numeric: "036", Name: "Australia", Demonyms: []string{"Australian"}, Continent: "OC", Currency: "AUD", CallingCode: "61"},
+	{Alpha2: "AW", Alpha3: "ABW", Numeric: "533", Name: "Aruba", Demonyms: []string{"Aruban"}, Continent: "NA", Currency: "AWG", CallingCode: "297"},
+	{Alpha2: "AX", Alpha3: "ALA", Numeric: "248", Name: "Åland Islands", Demonyms: []string{"Ålandish"}, Continent: "EU", Currency: "EUR", CallingCode: "358"},
+	{Alpha2: "AZ", Alpha3: "AZE", Numeric: "031", Name: "Azerbaijan", Aliases: []string{"Republic of Azerbaijan"}, Demonyms: []string{"Azerbaijani"}, Continent: "AS", Currency: "AZN", CallingCode: "994"},
+	{Alpha2: "BA", Alpha3: "BIH", Numeric: "070", Name: "Bosnia and Herzegovina", Aliases: []string{"Republic of Bosnia and Herzegovina"}, Demonyms: []string{"Bosnian", "Bosnian and Herzegovinian", "Herzegovinian"}, Continent: "EU", Currency: "BAM", CallingCode: "387"},
+	{Alpha2: "BB", Alpha3: "BRB", Numeric: "052", Name: "Barbados", Demonyms: []string{"Barbadian"}, Continent: "NA", Currency: "BBD", CallingCode: "1-246"},
+	{Alpha2: "BD", Alpha3: "BGD", Numeric: "050", Name: "Bangladesh", Aliases: []string{"People's Republic of Bangladesh"}, Demonyms: []string{"Bangladeshi"}, Continent: "AS", Currency: "BDT", CallingCode: "880"},
+	{Alpha2: "BE", Alpha3: "BEL", Numeric: "056", Name: "Belgium", Aliases: []string{"Kingdom of Belgium"}, Demonyms: []string{"Belgian"}, Continent: "EU", Currency: "EUR", CallingCode: "32"},
+	{Alpha2: "BF", Alpha3: "BFA", Numeric: "854", Name: "Burkina Faso", Demonyms: []string{"Burkinabe"}, Continent: "AF", Currency: "XOF", CallingCode: "226"},
+	{Alpha2: "BG", Alpha3: "BGR", Numeric: "100", Name: "Bulgaria", Aliases: []string{"Republic of Bulgaria"}, Demonyms: []string{"Bulgarian"}, Continent: "EU", Currency: "BGN", CallingCode: "359"},
+	{Alpha2: "BH", Alpha3: "BHR", Numeric: "048", Name: "Bahrain", Aliases: []string{"Kingdom of Bahrain"}, Demonyms: []string{"Bahraini"}, Continent: "AS", Currency: "BHD", CallingCode: "973"},
+	{Alpha2: "BI", Alpha3: "BDI", Numeric: "108", Name: "Burundi", Aliases: []string{"Republic of Burundi"}, Demonyms: []string{"Burundian"}, Continent: "AF", Currency: "BIF", CallingCode: "257"},
+	{Alpha2: "BJ", Alpha3: "BEN", Numeric: "204", Name: "Benin", Aliases: []string{"Republic of Benin"}, Demonyms: []string{"Beninese"}, Continent: "AF", Currency: "XOF", CallingCode: "229"},
+	{Alpha2: "BL", Alpha3: "BLM", Numeric: "652", Name: "Saint Barthélemy", Continent: "NA", Currency: "EUR", CallingCode: "590"},
+	{Alpha2: "BM", Alpha3: "BMU", Numeric: "060", Name: "Bermuda", Demonyms: []string{"Bermudian"}, Continent: "NA", Currency: "BMD", CallingCode: "1-441"},
+	{Alpha2: "BN", Alpha3: "BRN", Numeric: "096", Name: "Brunei Darussalam", Aliases: []string{"Brunei"}, Demonyms: []string{"Bruneian"}, Continent: "AS", Currency: "BND", CallingCode: "673"},
+	{Alpha2: "BO", Alpha3: "BOL", Numeric: "068", Name: "Bolivia", Aliases: []string{"Bolivia, Plurinational State of", "Plurinational State of Bolivia"}, Demonyms: []string{"Bolivian"}, Continent: "SA", Currency: "BOB", CallingCode: "591"},
+	{Alpha2: "BQ", Alpha3: "BES", Numeric: "535", Name: "Bonaire, Sint Eustatius and Saba", Continent: "NA", Currency: "USD", CallingCode: "599"},
+	{Alpha2: "BR", Alpha3: "BRA", Numeric: "076", Name: "Brazil", Aliases: []string{"Federative Republic of Brazil"}, Demonyms: []string{"Brazilian"}, Continent: "SA", Currency: "BRL", CallingCode: "55"},
+	{Alpha2: "BS", Alpha3: "BHS", Numeric: "044", Name: "Bahamas", Aliases: []string{"Commonwealth of the Bahamas"}, Demonyms: []string{"Bahamian"}, Continent: "NA", Currency: "BSD", CallingCode: "1-242"},
+	{Alpha2: "BT", Alpha3: "BTN", Numeric: "064", Name: "Bhutan", Aliases: []string{"Kingdom of Bhutan"}, Demonyms: []string{"Bhutanese"}, Continent: "AS", Currency: "BTN", CallingCode: "975"},
+	{Alpha2: "BV", Alpha3: "BVT", Numeric: "074", Name: "Bouvet Island", Continent: "AN", Currency: "NOK", CallingCode: "47"},
+	{Alpha2: "BW", Alpha3: "BWA", Numeric: "072", Name: "Botswana", Aliases: []string{"Republic of Botswana"}, Demonyms: []string{"Motswana", "Botswanan"}, Continent: "AF", Currency: "BWP", CallingCode: "267"},
+	{Alpha2: "BY", Alpha3: "BLR", Numeric: "112", Name: "Belarus", Aliases: []string{"Republic of Belarus"}, Demonyms: []string{"Belarusian"}, Continent: "EU", Currency: "BYN", CallingCode: "375"},
+	{Alpha2: "BZ", Alpha3: "BLZ", Numeric: "084", Name: "Belize", Demonyms: []string{"Belizean"}, Continent: "NA", Currency: "BZD", CallingCode: "501"},
+	{Alpha2: "CA", Alpha3: "CAN", Numeric: "124", Name: "Canada", Demonyms: []string{"Canadian"}, Continent: "NA", Currency: "CAD", CallingCode: "1"},
+	{Alpha2: "CC", Alpha3: "CCK", Numeric: "166", Name: "Cocos (Keeling) Islands", Continent: "AS", Currency: "AUD", CallingCode: "61"},
+	{Alpha2: "CD", Alpha3: "COD", Numeric: "180", Name: "Congo, The Democratic Republic of the", Aliases: []string{"DR Congo", "Congo-Kinshasa", "Zaire"}, Demonyms: []string{"Congolese"}, Continent: "AF", Currency: "CDF", CallingCode: "243"},
+	{Alpha2: "CF", Alpha3: "CAF", Numeric: "140", Name: "Central African Republic", Demonyms: []string{"Central African"}, Continent: "AF", Currency: "XAF", CallingCode: "236"},
+	{Alpha2: "CG", Alpha3: "COG", Numeric: "178", Name: "Congo", Aliases: []string{"Republic of the Congo", "Congo-Brazzaville"}, Continent: "AF", Currency: "XAF", CallingCode: "242"},
+	{Alpha2: "CH", Alpha3: "CHE", Numeric: "756", Name: "Switzerland", Aliases: []string{"Swiss Confederation"}, Demonyms: []string{"Swiss"}, Continent: "EU", Currency: "CHF", CallingCode: "41"},
+	{Alpha2: "CI", Alpha3: "CIV", Numeric: "384", Name: "Côte d'Ivoire", Aliases: []string{"Republic of Côte d'Ivoire", "Ivory Coast"}, Demonyms: []string{"Ivorian"}, Continent: "AF", Currency: "XOF", CallingCode: "225"},
+	{Alpha2: "CK", Alpha3: "COK", Numeric: "184", Name: "Cook Islands", Demonyms: []string{"Cook Islander"}, Continent: "OC", Currency: "NZD", CallingCode: "682"},
+	{Alpha2: "CL", Alpha3: "CHL", Numeric: "152", Name: "Chile", Aliases: []string{"Republic of Chile"}, Demonyms: []string{"Chilean"}, Continent: "SA", Currency: "CLP", CallingCode: "56"},
+	{Alpha2: "CM", Alpha3: "CMR", Numeric: "120", Name: "Cameroon", Aliases: []string{"Republic of Cameroon"}, Demonyms: []string{"Cameroonian"}, Continent: "AF", Currency: "XAF", CallingCode: "237"},
+	{Alpha2: "CN", Alpha3: "CHN", Numeric: "156", Name: "China", Aliases: []string{"People's Republic of China"}, Demonyms: []string{"Chinese"}, Continent: "AS", Currency: "CNY", CallingCode: "86"},
+	{Alpha2: "CO", Alpha3: "COL", Numeric: "170", Name: "Colombia", Aliases: []string{"Republic of Colombia"}, Demonyms: []string{"Colombian"}, Continent: "SA", Currency: "COP", CallingCode: "57"},
+	{Alpha2: "CR", Alpha3: "CRI", Numeric: "188", Name: "Costa Rica", Aliases: []string{"Republic of Costa Rica"}, Demonyms: []string{"Costa Rican"}, Continent: "NA", Currency: "CRC", CallingCode: "506"},
+	{Alpha2: "CU", Alpha3: "CUB", Numeric: "192", Name: "Cuba", Aliases: []string{"Republic of Cuba"}, Demonyms: []string{"Cuban"}, Continent: "NA", Currency: "CUP", CallingCode: "53"},
+	{Alpha2: "CV", Alpha3: "CPV", Numeric: "132", Name: "Cabo Verde", Aliases: []string{"Republic of Cabo Verde", "Cape Verde"}, Demonyms: []string{"Cape Verdean"}, Continent: "AF", Currency: "CVE", CallingCode: "238"},
+	{Alpha2: "CW", Alpha3: "CUW", Numeric: "531", Name: "Curaçao", Demonyms: []string{"Curaçaoan"}, Continent: "NA", Currency: "ANG", CallingCode: "599"},
+	{Alpha2: "CX", Alpha3: "CXR", Numeric: "162", Name: "Christmas Island", Continent: "AS", Currency: "AUD", CallingCode: "61"},
+	{Alpha2: "CY", Alpha3: "CYP", Numeric: "196", Name: "Cyprus", Aliases: []string{"Republic of Cyprus"}, Demonyms: []string{"Cypriot"}, Continent: "AS", Currency: "EUR", CallingCode: "357"},
+	{Alpha2: "CZ", Alpha3: "CZE", Numeric: "203", Name: "Czechia", Aliases: []string{"Czech Republic"}, Demonyms: []string{"Czech"}, Continent: "EU", Currency: "CZK", CallingCode: "420"},
+	{Alpha2: "DE", Alpha3: "DEU", Numeric: "276", Name: "Germany", Aliases: []string{"Federal Republic of Germany"}, Demonyms: []string{"German"}, Continent: "EU", Currency: "EUR", CallingCode: "49"},
+	{Alpha2: "DJ", Alpha3: "DJI", Numeric: "262", Name: "Djibouti", Aliases: []string{"Republic of Djibouti"}, Demonyms: []string{"Djiboutian"}, Continent: "AF", Currency: "DJF", CallingCode: "253"},
+	{Alpha2: "DK", Alpha3: "DNK", Numeric: "208", Name: "Denmark", Aliases: []string{"Kingdom of Denmark"}, Demonyms: []string{"Danish"}, Continent: "EU", Currency: "DKK", CallingCode: "45"},
+	{Alpha2: "DM", Alpha3: "DMA", Numeric: "212", Name: "Dominica", Aliases: []string{"Commonwealth of Dominica"}, Demonyms: []string{"Dominican"}, Continent: "NA", Currency: "XCD", CallingCode: "1-767"},
+	{Alpha2: "DO", Alpha3: "DOM", Numeric: "214", Name: "Dominican Republic", Demonyms: []string{"Dominican Republic"}, Continent: "NA", Currency: "DOP", CallingCode: "1-809"},
+	{Alpha2: "DZ", Alpha3: "DZA", Numeric: "012", Name: "Algeria", Aliases: []string{"People's Democratic Republic of Algeria"}, Demonyms: []string{"Algerian"}, Continent: "AF", Currency: "DZD", CallingCode: "213"},
+	{Alpha2: "EC", Alpha3: "ECU", Numeric: "218", Name: "Ecuador", Aliases: []string{"Republic of Ecuador"}, Demonyms: []string{"Ecuadorian"}, Continent: "SA", Currency: "USD", CallingCode: "593"},
+	{Alpha2: "EE", Alpha3: "EST", Numeric: "233", Name: "Estonia", Aliases: []string{"Republic of Estonia"}, Demonyms: []string{"Estonian"}, Continent: "EU", Currency: "EUR", CallingCode: "372"},
+	{Alpha2: "EG", Alpha3: "EGY", Numeric: "818", Name: "Egypt", Aliases: []string{"Arab Republic of Egypt"}, Demonyms: []string{"Egyptian"}, Continent: "AF", Currency: "EGP", CallingCode: "20"},
+	{Alpha2: "EH", Alpha3: "ESH", Numeric: "732", Name: "Western Sahara", Demonyms: []string{"Sahrawi"}, Continent: "AF", Currency: "MAD", CallingCode: "212"},
+	{Alpha2: "ER", Alpha3: "ERI", Numeric: "232", Name: "Eritrea", Aliases: []string{"the State of Eritrea"}, Demonyms: []string{"Eritrean"}, Continent: "AF", Currency: "ERN", CallingCode: "291"},
+	{Alpha2: "ES", Alpha3: "ESP", Numeric: "724", Name: "Spain", Aliases: []string{"Kingdom of Spain"}, Demonyms: []string{"Spanish"}, Continent: "EU", Currency: "EUR", CallingCode: "34"},
+	{Alpha2: "ET", Alpha3: "ETH", Numeric: "231", Name: "Ethiopia", Aliases: []string{"Federal Democratic Republic of Ethiopia"}, Demonyms: []string{"Ethiopian"}, Continent: "AF", Currency: "ETB", CallingCode: "251"},
+	{Alpha2: "FI", Alpha3: "FIN", Numeric: "246", Name: "Finland", Aliases: []string{"Republic of Finland"}, Demonyms: []string{"Finnish"}, Continent: "EU", Currency: "EUR", CallingCode: "358"},
+	{Alpha2: "FJ", Alpha3: "FJI", Numeric: "242", Name: "Fiji", Aliases: []string{"Republic of Fiji"}, Demonyms: []string{"Fijian"}, Continent: "OC", Currency: "FJD", CallingCode: "679"},
+	{Alpha2: "FK", Alpha3: "FLK", Numeric: "238", Name: "Falkland Islands (Malvinas)", Aliases: []string{"Falkland Islands"}, Demonyms: []string{"Falkland Islander"}, Continent: "SA", Currency: "FKP", CallingCode: "500"},
+	{Alpha2: "FM", Alpha3: "FSM", Numeric: "583", Name: "Micronesia, Federated States of", Aliases: []string{"Federated States of Micronesia", "Micronesia"}, Demonyms: []string{"Micronesian"}, Continent: "OC", Currency: "USD", CallingCode: "691"},
+	{Alpha2: "FO", Alpha3: "FRO", Numeric: "234", Name: "Faroe Islands", Demonyms: []string{"Faroese"}, Continent: "EU", Currency: "DKK", CallingCode: "298"},
+	{Alpha2: "FR", Alpha3: "FRA", Numeric: "250", Name: "France", Aliases: []string{"French Republic"}, Demonyms: []string{"French"}, Continent: "EU", Currency: "EUR", CallingCode: "33"},
+	{Alpha2: "GA", Alpha3: "GAB", Numeric: "266", Name: "Gabon", Aliases: []string{"Gabonese Republic"}, Demonyms: []string{"Gabonese"}, Continent: "AF", Currency: "XAF", CallingCode: "241"},
+	{Alpha2: "GB", Alpha3: "GBR", Numeric: "826", Name: "United Kingdom", Aliases: []string{"United Kingdom of Great Britain and Northern Ireland", "UK", "Great Britain", "Britain", "England", "Scotland", "Wales", "Northern Ireland"}, Demonyms: []string{"British", "English", "Scottish", "Welsh"}, Continent: "EU", Currency: "GBP", CallingCode: "44"},
+	{Alpha2: "GD", Alpha3: "GRD", Numeric: "308", Name: "Grenada", Demonyms: []string{"Grenadian"}, Continent: "NA", Currency: "XCD", CallingCode: "1-473"},
+	{Alpha2: "GE", Alpha3: "GEO", Numeric: "268", Name: "Georgia", Demonyms: []string{"Georgian"}, Continent: "AS", Currency: "GEL", CallingCode: "995"},
+	{Alpha2: "GF", Alpha3: "GUF", Numeric: "254", Name: "French Guiana", Continent: "SA", Currency: "EUR", CallingCode: "594"},
+	{Alpha2: "GG", Alpha3: "GGY", Numeric: "831", Name: "Guernsey", Continent: "EU", Currency: "GBP", CallingCode: "44"},
+	{Alpha2: "GH", Alpha3: "GHA", Numeric: "288", Name: "Ghana", Aliases: []string{"Republic of Ghana"}, Demonyms: []string{"Ghanaian"}, Continent: "AF", Currency: "GHS", CallingCode: "233"},
+	{Alpha2: "GI", Alpha3: "GIB", Numeric: "292", Name: "Gibraltar", Demonyms: []string{"Gibraltarian"}, Continent: "EU", Currency: "GIP", CallingCode: "350"},
+	{Alpha2: "GL", Alpha3: "GRL", Numeric: "304", Name: "Greenland", Demonyms: []string{"Greenlandic"}, Continent: "NA", Currency: "DKK", CallingCode: "299"},
+	{Alpha2: "GM", Alpha3: "GMB", Numeric: "270", Name: "Gambia", Aliases: []string{"Republic of the Gambia", "Gambia, The", "The Gambia"}, Demonyms: []string{"Gambian"}, Continent: "AF", Currency: "GMD", CallingCode: "220"},
+	{Alpha2: "GN", Alpha3: "GIN", Numeric: "324", Name: "Guinea", Aliases: []string{"Republic of Guinea"}, Demonyms: []string{"Guinean"}, Continent: "AF", Currency: "GNF", CallingCode: "224"},
+	{Alpha2: "GP", Alpha3: "GLP", Numeric: "312", Name: "Guadeloupe", Continent: "NA", Currency: "EUR", CallingCode: "590"},
+	{Alpha2: "GQ", Alpha3: "GNQ", Numeric: "226", Name: "Equatorial Guinea", Aliases: []string{"Republic of Equatorial Guinea"}, Demonyms: []string{"Equatoguinean"}, Continent: "AF", Currency: "XAF", CallingCode: "240"},
+	{Alpha2: "GR", Alpha3: "GRC", Numeric: "300", Name: "Greece", Aliases: []string{"Hellenic Republic"}, Demonyms: []string{"Greek"}, Continent: "EU", Currency: "EUR", CallingCode: "30"},
+	{Alpha2: "GS", Alpha3: "SGS", Numeric: "239", Name: "South Georgia and the South Sandwich Islands", Continent: "AN", Currency: "GBP", CallingCode: "500"},
+	{Alpha2: "GT", Alpha3: "GTM", Numeric: "320", Name: "Guatemala", Aliases: []string{"Republic of Guatemala"}, Demonyms: []string{"Guatemalan"}, Continent: "NA", Currency: "GTQ", CallingCode: "502"},
+	{Alpha2: "GU", Alpha3: "GUM", Numeric: "316", Name: "Guam", Demonyms: []string{"Guamanian"}, Continent: "OC", Currency: "USD", CallingCode: "1-671"},
+	{Alpha2: "GW", Alpha3: "GNB", Numeric: "624", Name: "Guinea-Bissau", Aliases: []string{"Republic of Guinea-Bissau"}, Demonyms: []string{"Bissau-Guinean"}, Continent: "AF", Currency: "XOF", CallingCode: "245"},
+	{Alpha2: "GY", Alpha3: "GUY", Numeric: "328", Name: "Guyana", Aliases: []string{"Republic of Guyana"}, Demonyms: []string{"Guyanese"}, Continent: "SA", Currency: "GYD", CallingCode: "592"},
+	{Alpha2: "HK", Alpha3: "HKG", Numeric: "344", Name: "Hong Kong", Aliases: []string{"Hong Kong Special Administrative Region of China", "Hong Kong SAR"}, Demonyms: []string{"Hongkonger"}, Continent: "AS", Currency: "HKD", CallingCode: "852"},
+	{Alpha2: "HM", Alpha3: "HMD", Numeric: "334", Name: "Heard Island and McDonald Islands", Continent: "AN", Currency: "AUD", CallingCode: "672"},
+	{Alpha2: "HN", Alpha3: "HND", Numeric: "340", Name: "Honduras", Aliases: []string{"Republic of Honduras"}, Demonyms: []string{"Honduran"}, Continent: "NA", Currency: "HNL", CallingCode: "504"},
+	{Alpha2: "HR", Alpha3: "HRV", Numeric: "191", Name: "Croatia", Aliases: []string{"Republic of Croatia"}, Demonyms: []string{"Croatian"}, Continent: "EU", Currency: "EUR", CallingCode: "385"},
+	{Alpha2: "HT", Alpha3: "HTI", Numeric: "332", Name: "Haiti", Aliases: []string{"Republic of Haiti"}, Demonyms: []string{"Haitian"}, Continent: "NA", Currency: "HTG", CallingCode: "509"},
+	{Alpha2: "HU", Alpha3: "HUN", Numeric: "348", Name: "Hungary", Demonyms: []string{"Hungarian"}, Continent: "EU", Currency: "HUF", CallingCode: "36"},
+	{Alpha2: "ID", Alpha3: "IDN", Numeric: "360", Name: "Indonesia", Aliases: []string{"Republic of Indonesia"}, Demonyms: []string{"Indonesian"}, Continent: "AS", Currency: "IDR", CallingCode: "62"},
+	{Alpha2: "IE", Alpha3: "IRL", Numeric: "372", Name: "Ireland", Demonyms: []string{"Irish"}, Continent: "EU", Currency: "EUR", CallingCode: "353"},
+	{Alpha2: "IL", Alpha3: "ISR", Numeric: "376", Name: "Israel", Aliases: []string{"State of Israel"}, Demonyms: []string{"Israeli"}, Continent: "AS", Currency: "ILS", CallingCode: "972"},
+	{Alpha2: "IM", Alpha3: "IMN", Numeric: "833", Name: "Isle of Man", Demonyms: []string{"Manx"}, Continent: "EU", Currency: "GBP", CallingCode: "44"},
+	{Alpha2: "IN", Alpha3: "IND", Numeric: "356", Name: "India", Aliases: []string{"Republic of India"}, Demonyms: []string{"Indian"}, Continent: "AS", Currency: "INR", CallingCode: "91"},
+	{Alpha2: "IO", Alpha3: "IOT", Numeric: "086", Name: "British Indian Ocean Territory", Continent: "AS", Currency: "USD", CallingCode: "246"},
+	{Alpha2: "IQ", Alpha3: "IRQ", Numeric: "368", Name: "Iraq", Aliases: []string{"Republic of Iraq"}, Demonyms: []string{"Iraqi"}, Continent: "AS", Currency: "IQD", CallingCode: "964"},
+	{Alpha2: "IR", Alpha3: "IRN", Numeric: "364", Name: "Iran", Aliases: []string{"Iran, Islamic Republic of", "Islamic Republic of Iran"}, Demonyms: []string{"Iranian"}, Continent: "AS", Currency: "IRR", CallingCode: "98"},
+	{Alpha2: "IS", Alpha3: "ISL", Numeric: "352", Name: "Iceland", Aliases: []string{"Republic of Iceland"}, Demonyms: []string{"Icelandic"}, Continent: "EU", Currency: "ISK", CallingCode: "354"},
+	{Alpha2: "IT", Alpha3: "ITA", Numeric: "380", Name: "Italy", Aliases: []string{"Italian Republic"}, Demonyms: []string{"Italian"}, Continent: "EU", Currency: "EUR", CallingCode: "39"},
+	{Alpha2: "JE", Alpha3: "JEY", Numeric: "832", Name: "Jersey", Continent: "EU", Currency: "GBP", CallingCode: "44"},
+	{Alpha2: "JM", Alpha3: "JAM", Numeric: "388", Name: "Jamaica", Demonyms: []string{"Jamaican"}, Continent: "NA", Currency: "JMD", CallingCode: "1-876"},
+	{Alpha2: "JO", Alpha3: "JOR", Numeric: "400", Name: "Jordan", Aliases: []string{"Hashemite Kingdom of Jordan"}, Demonyms: []string{"Jordanian"}, Continent: "AS", Currency: "JOD", CallingCode: "962"},
+	{Alpha2: "JP", Alpha3: "JPN", Numeric: "392", Name: "Japan", Demonyms: []string{"Japanese"}, Continent: "AS", Currency: "JPY", CallingCode: "81"},
+	{Alpha2: "KE", Alpha3: "KEN", Numeric: "404", Name: "Kenya", Aliases: []string{"Republic of Kenya"}, Demonyms: []string{"Kenyan"}, Continent: "AF", Currency: "KES", CallingCode: "254"},
+	{Alpha2: "KG", Alpha3: "KGZ", Numeric: "417", Name: "Kyrgyzstan", Aliases: []string{"Kyrgyz Republic"}, Demonyms: []string{"Kyrgyz"}, Continent: "AS", Currency: "KGS", CallingCode: "996"},
+	{Alpha2: "KH", Alpha3: "KHM", Numeric: "116", Name: "Cambodia", Aliases: []string{"Kingdom of Cambodia"}, Demonyms: []string{"Cambodian"}, Continent: "AS", Currency: "KHR", CallingCode: "855"},
+	{Alpha2: "KI", Alpha3: "KIR", Numeric: "296", Name: "Kiribati", Aliases: []string{"Republic of Kiribati"}, Demonyms: []string{"I-Kiribati"}, Continent: "OC", Currency: "AUD", CallingCode: "686"},
+	{Alpha2: "KM", Alpha3: "COM", Numeric: "174", Name: "Comoros", Aliases: []string{"Union of the Comoros"}, Demonyms: []string{"Comoran"}, Continent: "AF", Currency: "KMF", CallingCode: "269"},
+	{Alpha2: "KN", Alpha3: "KNA", Numeric: "659", Name: "Saint Kitts and Nevis", Demonyms: []string{"Kittitian"}, Continent: "NA", Currency: "XCD", CallingCode: "1-869"},
+	{Alpha2: "KP", Alpha3: "PRK", Numeric: "408", Name: "North Korea", Aliases: []string{"Korea, Democratic People's Republic of", "Democratic People's Republic of Korea", "Korea, North", "DPRK"}, Demonyms: []string{"North Korean"}, Continent: "AS", Currency: "KPW", CallingCode: "850"},
+	{Alpha2: "KR", Alpha3: "KOR", Numeric: "410", Name: "South Korea", Aliases: []string{"Korea, Republic of", "Korea, South", "Korea", "Republic of Korea"}, Demonyms: []string{"South Korean"}, Continent: "AS", Currency: "KRW", CallingCode: "82"},
+	{Alpha2: "KW", Alpha3: "KWT", Numeric: "414", Name: "Kuwait", Aliases: []string{"State of Kuwait"}, Demonyms: []string{"Kuwaiti"}, Continent: "AS", Currency: "KWD", CallingCode: "965"},
+	{Alpha2: "KY", Alpha3: "CYM", Numeric: "136", Name: "Cayman Islands", Demonyms: []string{"Caymanian"}, Continent: "NA", Currency: "KYD", CallingCode: "1-345"},
+	{Alpha2: "KZ", Alpha3: "KAZ", Numeric: "398", Name: "Kazakhstan", Aliases: []string{"Republic of Kazakhstan"}, Demonyms: []string{"Kazakhstani", "Kazakh"}, Continent: "AS", Currency: "KZT", CallingCode: "7"},
+	{Alpha2: "LA", Alpha3: "LAO", Numeric: "418", Name: "Laos", Aliases: []string{"Lao People's Democratic Republic"}, Demonyms: []string{"Lao", "Laotian"}, Continent: "AS", Currency: "LAK", CallingCode: "856"},
+	{Alpha2: "LB", Alpha3: "LBN", Numeric: "422", Name: "Lebanon", Aliases: []string{"Lebanese Republic"}, Demonyms: []string{"Lebanese"}, Continent: "AS", Currency: "LBP", CallingCode: "961"},
+	{Alpha2: "LC", Alpha3: "LCA", Numeric: "662", Name: "Saint Lucia", Demonyms: []string{"Saint Lucian"}, Continent: "NA", Currency: "XCD", CallingCode: "1-758"},
+	{Alpha2: "LI", Alpha3: "LIE", Numeric: "438", Name: "Liechtenstein", Aliases: []string{"Principality of Liechtenstein"}, Demonyms: []string{"Liechtensteiner"}, Continent: "EU", Currency: "CHF", CallingCode: "423"},
+	{Alpha2: "LK", Alpha3: "LKA", Numeric: "144", Name: "Sri Lanka", Aliases: []string{"Democratic Socialist Republic of Sri Lanka"}, Demonyms: []string{"Sri Lankan"}, Continent: "AS", Currency: "LKR", CallingCode: "94"},
+	{Alpha2: "LR", Alpha3: "LBR", Numeric: "430", Name: "Liberia", Aliases: []string{"Republic of Liberia"}, Demonyms: []string{"Liberian"}, Continent: "AF", Currency: "LRD", CallingCode: "231"},
+	{Alpha2: "LS", Alpha3: "LSO", Numeric: "426", Name: "Lesotho", Aliases: []string{"Kingdom of Lesotho"}, Demonyms: []string{"Basotho", "Mosotho"}, Continent: "AF", Currency: "LSL", CallingCode: "266"},
+	{Alpha2: "LT", Alpha3: "LTU", Numeric: "440", Name: "Lithuania", Aliases: []string{"Republic of Lithuania"}, Demonyms: []string{"Lithuanian"}, Continent: "EU", Currency: "EUR", CallingCode: "370"},
+	{Alpha2: "LU", Alpha3: "LUX", Numeric: "442", Name: "Luxembourg", Aliases: []string{"Grand Duchy of Luxembourg"}, Demonyms: []string{"Luxembourgish"}, Continent: "EU", Currency: "EUR", CallingCode: "352"},
+	{Alpha2: "LV", Alpha3: "LVA", Numeric: "428", Name: "Latvia", Aliases: []string{"Republic of Latvia"}, Demonyms: []string{"Latvian"}, Continent: "EU", Currency: "EUR", CallingCode: "371"},
+	{Alpha2: "LY", Alpha3: "LBY", Numeric: "434", Name: "Libya", Demonyms: []string{"Libyan"}, Continent: "AF", Currency: "LYD", CallingCode: "218"},
+	{Alpha2: "MA", Alpha3: "MAR", Numeric: "504", Name: "Morocco", Aliases: []string{"Kingdom of Morocco"}, Demonyms: []string{"Moroccan"}, Continent: "AF", Currency: "MAD", CallingCode: "212"},
+	{Alpha2: "MC", Alpha3: "MCO", Numeric: "492", Name: "Monaco", Aliases: []string{"Principality of Monaco"}, Demonyms: []string{"Monegasque"}, Continent: "EU", Currency: "EUR", CallingCode: "377"},
+	{Alpha2: "MD", Alpha3: "MDA", Numeric: "498", Name: "Moldova", Aliases: []string{"Moldova, Republic of", "Republic of Moldova"}, Demonyms: []string{"Moldovan"}, Continent: "EU", Currency: "MDL", CallingCode: "373"},
+	{Alpha2: "ME", Alpha3: "MNE", Numeric: "499", Name: "Montenegro", Demonyms: []string{"Montenegrin"}, Continent: "EU", Currency: "EUR", CallingCode: "382"},
+	{Alpha2: "MF", Alpha3: "MAF", Numeric: "663", Name: "Saint Martin (French part)", Continent: "NA", Currency: "EUR", CallingCode: "590"},
+	{Alpha2: "MG", Alpha3: "MDG", Numeric: "450", Name: "Madagascar", Aliases: []string{"Republic of Madagascar"}, Demonyms: []string{"Malagasy"}, Continent: "AF", Currency: "MGA", CallingCode: "261"},
+	{Alpha2: "MH", Alpha3: "MHL", Numeric: "584", Name: "Marshall Islands", Aliases: []string{"Republic of the Marshall Islands"}, Demonyms: []string{"Marshallese"}, Continent: "OC", Currency: "USD", CallingCode: "692"},
+	{Alpha2: "MK", Alpha3: "MKD", Numeric: "807", Name: "North Macedonia", Aliases: []string{"Republic of North Macedonia", "Macedonia"}, Demonyms: []string{"Macedonian"}, Continent: "EU", Currency: "MKD", CallingCode: "389"},
+	{Alpha2: "ML", Alpha3: "MLI", Numeric: "466", Name: "Mali", Aliases: []string{"Republic of Mali"}, Demonyms: []string{"Malian"}, Continent: "AF", Currency: "XOF", CallingCode: "223"},
+	{Alpha2: "MM", Alpha3: "MMR", Numeric: "104", Name: "Myanmar", Aliases: []string{"Republic of Myanmar", "Burma"}, Demonyms: []string{"Burmese", "Myanmar"}, Continent: "AS", Currency: "MMK", CallingCode: "95"},
+	{Alpha2: "MN", Alpha3: "MNG", Numeric: "496", Name: "Mongolia", Demonyms: []string{"Mongolian"}, Continent: "AS", Currency: "MNT", CallingCode: "976"},
+	{Alpha2: "MO", Alpha3: "MAC", Numeric: "446", Name: "Macao", Aliases: []string{"Macao Special Administrative Region of China", "Macau"}, Demonyms: []string{"Macanese"}, Continent: "AS", Currency: "MOP", CallingCode: "853"},
+	{Alpha2: "MP", Alpha3: "MNP", Numeric: "580", Name: "Northern Mariana Islands", Aliases: []string{"Commonwealth of the Northern Mariana Islands"}, Continent: "OC", Currency: "USD", CallingCode: "1-670"},
+	{Alpha2: "MQ", Alpha3: "MTQ", Numeric: "474", Name: "Martinique", Continent: "NA", Currency: "EUR", CallingCode: "596"},
+	{Alpha2: "MR", Alpha3: "MRT", Numeric: "478", Name: "Mauritania", Aliases: []string{"Islamic Republic of Mauritania"}, Demonyms: []string{"Mauritanian"}, Continent: "AF", Currency: "MRU", CallingCode: "222"},
+	{Alpha2: "MS", Alpha3: "MSR", Numeric: "500", Name: "Montserrat", Demonyms: []string{"Montserratian"}, Continent: "NA", Currency: "XCD", CallingCode: "1-664"},
+	{Alpha2: "MT", Alpha3: "MLT", Numeric: "470", Name: "Malta", Aliases: []string{"Republic of Malta"}, Demonyms: []string{"Maltese"}, Continent: "EU", Currency: "EUR", CallingCode: "356"},
+	{Alpha2: "MU", Alpha3: "MUS", Numeric: "480", Name: "Mauritius", Aliases: []string{"Republic of Mauritius"}, Demonyms: []string{"Mauritian"}, Continent: "AF", Currency: "MUR", CallingCode: "230"},
+	{Alpha2: "MV", Alpha3: "MDV", Numeric: "462", Name: "Maldives", Aliases: []string{"Republic of Maldives"}, Demonyms: []string{"Maldivian"}, Continent: "AS", Currency: "MVR", CallingCode: "960"},
+	{Alpha2: "MW", Alpha3: "MWI", Numeric: "454", Name: "Malawi", Aliases: []string{"Republic of Malawi"}, Demonyms: []string{"Malawian"}, Continent: "AF", Currency: "MWK", CallingCode: "265"},
+	{Alpha2: "MX", Alpha3: "MEX", Numeric: "484", Name: "Mexico", Aliases: []string{"United Mexican States"}, Demonyms: []string{"Mexican"}, Continent: "NA", Currency: "MXN", CallingCode: "52"},
+	{Alpha2: "MY", Alpha3: "MYS", Numeric: "458", Name: "Malaysia", Demonyms: []string{"Malaysian"}, Continent: "AS", Currency: "MYR", CallingCode: "60"},
+	{Alpha2: "MZ", Alpha3: "MOZ", Numeric: "508", Name: "Mozambique", Aliases: []string{"Republic of Mozambique"}, Demonyms: []string{"Mozambican"}, Continent: "AF", Currency: "MZN", CallingCode: "258"},
+	{Alpha2: "NA", Alpha3: "NAM", Numeric: "516", Name: "Namibia", Aliases: []string{"Republic of Namibia"}, Demonyms: []string{"Namibian"}, Continent: "AF", Currency: "NAD", CallingCode: "264"},
+	{Alpha2: "NC", Alpha3: "NCL", Numeric: "540", Name: "New Caledonia", Continent: "OC", Currency: "XPF", CallingCode: "687"},
+	{Alpha2: "NE", Alpha3: "NER", Numeric: "562", Name: "Niger", Aliases: []string{"Republic of the Niger"}, Demonyms: []string{"Nigerien"}, Continent: "AF", Currency: "XOF", CallingCode: "227"},
+	{Alpha2: "NF", Alpha3: "NFK", Numeric: "574", Name: "Norfolk Island", Continent: "OC", Currency: "AUD", CallingCode: "672"},
+	{Alpha2: "NG", Alpha3: "NGA", Numeric: "566", Name: "Nigeria", Aliases: []string{"Federal Republic of Nigeria"}, Demonyms: []string{"Nigerian"}, Continent: "AF", Currency: "NGN", CallingCode: "234"},
+	{Alpha2: "NI", Alpha3: "NIC", Numeric: "558", Name: "Nicaragua", Aliases: []string{"Republic of Nicaragua"}, Demonyms: []string{"Nicaraguan"}, Continent: "NA", Currency: "NIO", CallingCode: "505"},
+	{Alpha2: "NL", Alpha3: "NLD", Numeric: "528", Name: "Netherlands", Aliases: []string{"Kingdom of the Netherlands", "Holland", "The Netherlands"}, Demonyms: []string{"Dutch", "Netherlandish"}, Continent: "EU", Currency: "EUR", CallingCode: "31"},
+	{Alpha2: "NO", Alpha3: "NOR", Numeric: "578", Name: "Norway", Aliases: []string{"Kingdom of Norway"}, Demonyms: []string{"Norwegian"}, Continent: "EU", Currency: "NOK", CallingCode: "47"},
+	{Alpha2: "NP", Alpha3: "NPL", Numeric: "524", Name: "Nepal", Aliases: []string{"Federal Democratic Republic of Nepal"}, Demonyms: []string{"Nepali", "Nepalese"}, Continent: "AS", Currency: "NPR", CallingCode: "977"},
+	{Alpha2: "NR", Alpha3: "NRU", Numeric: "520", Name: "Nauru", Aliases: []string{"Republic of Nauru"}, Demonyms: []string{"Nauruan"}, Continent: "OC", Currency: "AUD", CallingCode: "674"},
+	{Alpha2: "NU", Alpha3: "NIU", Numeric: "570", Name: "Niue", Demonyms: []string{"Niuean"}, Continent: "OC", Currency: "NZD", CallingCode: "683"},
+	{Alpha2: "NZ", Alpha3: "NZL", Numeric: "554", Name: "New Zealand", Demonyms: []string{"New Zealander", "Kiwi"}, Continent: "OC", Currency: "NZD", CallingCode: "64"},
+	{Alpha2: "OM", Alpha3: "OMN", Numeric: "512", Name: "Oman", Aliases: []string{"Sultanate of Oman"}, Demonyms: []string{"Omani"}, Continent: "AS", Currency: "OMR", CallingCode: "968"},
+	{Alpha2: "PA", Alpha3: "PAN", Numeric: "591", Name: "Panama", Aliases: []string{"Republic of Panama"}, Demonyms: []string{"Panamanian"}, Continent: "NA", Currency: "PAB", CallingCode: "507"},
+	{Alpha2: "PE", Alpha3: "PER", Numeric: "604", Name: "Peru", Aliases: []string{"Republic of Peru"}, Demonyms: []string{"Peruvian"}, Continent: "SA", Currency: "PEN", CallingCode: "51"},
+	{Alpha2: "PF", Alpha3: "PYF", Numeric: "258", Name: "French Polynesia", Demonyms: []string{"French Polynesian"}, Continent: "OC", Currency: "XPF", CallingCode: "689"},
+	{Alpha2: "PG", Alpha3: "PNG", Numeric: "598", Name: "Papua New Guinea", Aliases: []string{"Independent State of Papua New Guinea"}, Demonyms: []string{"Papua New Guinean"}, Continent: "OC", Currency: "PGK", CallingCode: "675"},
+	{Alpha2: "PH", Alpha3: "PHL", Numeric: "608", Name: "Philippines", Aliases: []string{"Republic of the Philippines"}, Demonyms: []string{"Filipino", "Philippine"}, Continent: "AS", Currency: "PHP", CallingCode: "63"},
+	{Alpha2: "PK", Alpha3: "PAK", Numeric: "586", Name: "Pakistan", Aliases: []string{"Islamic Republic of Pakistan"}, Demonyms: []string{"Pakistani"}, Continent: "AS", Currency: "PKR", CallingCode: "92"},
+	{Alpha2: "PL", Alpha3: "POL", Numeric: "616", Name: "Poland", Aliases: []string{"Republic of Poland"}, Demonyms: []string{"Polish"}, Continent: "EU", Currency: "PLN", CallingCode: "48"},
+	{Alpha2: "PM", Alpha3: "SPM", Numeric: "666", Name: "Saint Pierre and Miquelon", Continent: "NA", Currency: "EUR", CallingCode: "508"},
+	{Alpha2: "PN", Alpha3: "PCN", Numeric: "612", Name: "Pitcairn", Continent: "OC", Currency: "NZD", CallingCode: "64"},
+	{Alpha2: "PR", Alpha3: "PRI", Numeric: "630", Name: "Puerto Rico", Demonyms: []string{"Puerto Rican"}, Continent: "NA", Currency: "USD", CallingCode: "1-787"},
+	{Alpha2: "PS", Alpha3: "PSE", Numeric: "275", Name: "Palestine, State of", Aliases: []string{"the State of Palestine", "Palestine"}, Demonyms: []string{"Palestinian"}, Continent: "AS", Currency: "ILS", CallingCode: "970"},
+	{Alpha2: "PT", Alpha3: "PRT", Numeric: "620", Name: "Portugal", Aliases: []string{"Portuguese Republic"}, Demonyms: []string{"Portuguese"}, Continent: "EU", Currency: "EUR", CallingCode: "351"},
+	{Alpha2: "PW", Alpha3: "PLW", Numeric: "585", Name: "Palau", Aliases: []string{"Republic of Palau"}, Demonyms: []string{"Palauan"}, Continent: "OC", Currency: "USD", CallingCode: "680"},
+	{Alpha2: "PY", Alpha3: "PRY", Numeric: "600", Name: "Paraguay", Aliases: []string{"Republic of Paraguay"}, Demonyms: []string{"Paraguayan"}, Continent: "SA", Currency: "PYG", CallingCode: "595"},
+	{Alpha2: "QA", Alpha3: "QAT", Numeric: "634", Name: "Qatar", Aliases: []string{"State of Qatar"}, Demonyms: []string{"Qatari"}, Continent: "AS", Currency: "QAR", CallingCode: "974"},
+	{Alpha2: "RE", Alpha3: "REU", Numeric: "638", Name: "Réunion", Continent: "AF", Currency: "EUR", CallingCode: "262"},
+	{Alpha2: "RO", Alpha3: "ROU", Numeric: "642", Name: "Romania", Demonyms: []string{"Romanian"}, Continent: "EU", Currency: "RON", CallingCode: "40"},
+	{Alpha2: "RS", Alpha3: "SRB", Numeric: "688", Name: "Serbia", Aliases: []string{"Republic of Serbia"}, Demonyms: []string{"Serbian"}, Continent: "EU", Currency: "RSD", CallingCode: "381"},
+	{Alpha2: "RU", Alpha3: "RUS", Numeric: "643", Name: "Russian Federation", Aliases: []string{"Russia"}, Demonyms: []string{"Russian"}, Continent: "EU", Currency: "RUB", CallingCode: "7"},
+	{Alpha2: "RW", Alpha3: "RWA", Numeric: "646", Name: "Rwanda", Aliases: []string{"Rwandese Republic"}, Demonyms: []string{"Rwandan"}, Continent: "AF", Currency: "RWF", CallingCode: "250"},
+	{Alpha2: "SA", Alpha3: "SAU", Numeric: "682", Name: "Saudi Arabia", Aliases: []string{"Kingdom of Saudi Arabia"}, Demonyms: []string{"Saudi", "Saudi Arabian"}, Continent: "AS", Currency: "SAR", CallingCode: "966"},
+	{Alpha2: "SB", Alpha3: "SLB", Numeric: "090", Name: "Solomon Islands", Demonyms: []string{"Solomon Islander"}, Continent: "OC", Currency: "SBD", CallingCode: "677"},
+	{Alpha2: "SC", Alpha3: "SYC", Numeric: "690", Name: "Seychelles", Aliases: []string{"Republic of Seychelles"}, Demonyms: []string{"Seychellois"}, Continent: "AF", Currency: "SCR", CallingCode: "248"},
+	{Alpha2: "SD", Alpha3: "SDN", Numeric: "729", Name: "Sudan", Aliases: []string{"Republic of the Sudan"}, Demonyms: []string{"Sudanese"}, Continent: "AF", Currency: "SDG", CallingCode: "249"},
+	{Alpha2: "SE", Alpha3: "SWE", Numeric: "752", Name: "Sweden", Aliases: []string{"Kingdom of Sweden"}, Demonyms: []string{"Swedish"}, Continent: "EU", Currency: "SEK", CallingCode: "46"},
+	{Alpha2: "SG", Alpha3: "SGP", Numeric: "702", Name: "Singapore", Aliases: []string{"Republic of Singapore"}, Demonyms: []string{"Singaporean"}, Continent: "AS", Currency: "SGD", CallingCode: "65"},
+	{Alpha2: "SH", Alpha3: "SHN", Numeric: "654", Name: "Saint Helena, Ascension and Tristan da Cunha", Continent: "AF", Currency: "SHP", CallingCode: "290"},
+	{Alpha2: "SI", Alpha3: "SVN", Numeric: "705", Name: "Slovenia", Aliases: []string{"Republic of Slovenia"}, Demonyms: []string{"Slovenian"}, Continent: "EU", Currency: "EUR", CallingCode: "386"},
+	{Alpha2: "SJ", Alpha3: "SJM", Numeric: "744", Name: "Svalbard and Jan Mayen", Continent: "EU", Currency: "NOK", CallingCode: "47"},
+	{Alpha2: "SK", Alpha3: "SVK", Numeric: "703", Name: "Slovakia", Aliases: []string{"Slovak Republic"}, Demonyms: []string{"Slovak"}, Continent: "EU", Currency: "EUR", CallingCode: "421"},
+	{Alpha2: "SL", Alpha3: "SLE", Numeric: "694", Name: "Sierra Leone", Aliases: []string{"Republic of Sierra Leone"}, Demonyms: []string{"Sierra Leonean"}, Continent: "AF", Currency: "SLE", CallingCode: "232"},
+	{Alpha2: "SM", Alpha3: "SMR", Numeric: "674", Name: "San Marino", Aliases: []string{"Republic of San Marino"}, Demonyms: []string{"Sammarinese"}, Continent: "EU", Currency: "EUR", CallingCode: "378"},
+	{Alpha2: "SN", Alpha3: "SEN", Numeric: "686", Name: "Senegal", Aliases: []string{"Republic of Senegal"}, Demonyms: []string{"Senegalese"}, Continent: "AF", Currency: "XOF", CallingCode: "221"},
+	{Alpha2: "SO", Alpha3: "SOM", Numeric: "706", Name: "Somalia", Aliases: []string{"Federal Republic of Somalia"}, Demonyms: []string{"Somali"}, Continent: "AF", Currency: "SOS", CallingCode: "252"},
+	{Alpha2: "SR", Alpha3: "SUR", Numeric: "740", Name: "Suriname", Aliases: []string{"Republic of Suriname"}, Demonyms: []string{"Surinamese"}, Continent: "SA", Currency: "SRD", CallingCode: "597"},
+	{Alpha2: "SS", Alpha3: "SSD", Numeric: "728", Name: "South Sudan", Aliases: []string{"Republic of South Sudan"}, Demonyms: []string{"South Sudanese"}, Continent: "AF", Currency: "SSP", CallingCode: "211"},
+	{Alpha2: "ST", Alpha3: "STP", Numeric: "678", Name: "Sao Tome and Principe", Aliases: []string{"Democratic Republic of Sao Tome and Principe"}, Demonyms: []string{"Santomean"}, Continent: "AF", Currency: "STN", CallingCode: "239"},
+	{Alpha2: "SV", Alpha3: "SLV", Numeric: "222", Name: "El Salvador", Aliases: []string{"Republic of El Salvador"}, Demonyms: []string{"Salvadoran"}, Continent: "NA", Currency: "USD", CallingCode: "503"},
+	{Alpha2: "SX", Alpha3: "SXM", Numeric: "534", Name: "Sint Maarten (Dutch part)", Continent: "NA", Currency: "ANG", CallingCode: "1-721"},
+	{Alpha2: "SY", Alpha3: "SYR", Numeric: "760", Name: "Syria", Aliases: []string{"Syrian Arab Republic"}, Demonyms: []string{"Syrian"}, Continent: "AS", Currency: "SYP", CallingCode: "963"},
+	{Alpha2: "SZ", Alpha3: "SWZ", Numeric: "748", Name: "Eswatini", Aliases: []string{"Kingdom of Eswatini", "Swaziland"}, Demonyms: []string{"Swazi"}, Continent: "AF", Currency: "SZL", CallingCode: "268"},
+	{Alpha2: "TC", Alpha3: "TCA", Numeric: "796", Name: "Turks and Caicos Islands", Continent: "NA", Currency: "USD", CallingCode: "1-649"},
+	{Alpha2: "TD", Alpha3: "TCD", Numeric: "148", Name: "Chad", Aliases: []string{"Republic of Chad"}, Demonyms: []string{"Chadian"}, Continent: "AF", Currency: "XAF", CallingCode: "235"},
+	{Alpha2: "TF", Alpha3: "ATF", Numeric: "260", Name: "French Southern Territories", Continent: "AN", Currency: "EUR", CallingCode: "262"},
+	{Alpha2: "TG", Alpha3: "TGO", Numeric: "768", Name: "Togo", Aliases: []string{"Togolese Republic"}, Demonyms: []string{"Togolese"}, Continent: "AF", Currency: "XOF", CallingCode: "228"},
+	{Alpha2: "TH", Alpha3: "THA", Numeric: "764", Name: "Thailand", Aliases: []string{"Kingdom of Thailand"}, Demonyms: []string{"Thai"}, Continent: "AS", Currency: "THB", CallingCode: "66"},
+	{Alpha2: "TJ", Alpha3: "TJK", Numeric: "762", Name: "Tajikistan", Aliases: []string{"Republic of Tajikistan"}, Demonyms: []string{"Tajik"}, Continent: "AS", Currency: "TJS", CallingCode: "992"},
+	{Alpha2: "TK", Alpha3: "TKL", Numeric: "772", Name: "Tokelau", Demonyms: []string{"Tokelauan"}, Continent: "OC", Currency: "NZD", CallingCode: "690"},
+	{Alpha2: "TL", Alpha3: "TLS", Numeric: "626", Name: "Timor-Leste", Aliases: []string{"Democratic Republic of Timor-Leste", "East Timor"}, Demonyms: []string{"Timorese"}, Continent: "AS", Currency: "USD", CallingCode: "670"},
+	{Alpha2: "TM", Alpha3: "TKM", Numeric: "795", Name: "Turkmenistan", Demonyms: []string{"Turkmen"}, Continent: "AS", Currency: "TMT", CallingCode: "993"},
+	{Alpha2: "TN", Alpha3: "TUN", Numeric: "788", Name: "Tunisia", Aliases: []string{"Republic of Tunisia"}, Demonyms: []string{"Tunisian"}, Continent: "AF", Currency: "TND", CallingCode: "216"},
+	{Alpha2: "TO", Alpha3: "TON", Numeric: "776", Name: "Tonga", Aliases: []string{"Kingdom of Tonga"}, Demonyms: []string{"Tongan"}, Continent: "OC", Currency: "TOP", CallingCode: "676"},
+	{Alpha2: "TR", Alpha3: "TUR", Numeric: "792", Name: "Türkiye", Aliases: []string{"Republic of Türkiye", "Turkey"}, Demonyms: []string{"Turkish"}, Continent: "AS", Currency: "TRY", CallingCode: "90"},
+	{Alpha2: "TT", Alpha3: "TTO", Numeric: "780", Name: "Trinidad and Tobago", Aliases: []string{"Republic of Trinidad and Tobago"}, Demonyms: []string{"Trinidadian"}, Continent: "NA", Currency: "TTD", CallingCode: "1-868"},
+	{Alpha2: "TV", Alpha3: "TUV", Numeric: "798", Name: "Tuvalu", Demonyms: []string{"Tuvaluan"}, Continent: "OC", Currency: "AUD", CallingCode: "688"},
+	{Alpha2: "TW", Alpha3: "TWN", Numeric: "158", Name: "Taiwan", Aliases: []string{"Taiwan, Province of China"}, Demonyms: []string{"Taiwanese"}, Continent: "AS", Currency: "TWD", CallingCode: "886"},
+	{Alpha2: "TZ", Alpha3: "TZA", Numeric: "834", Name: "Tanzania", Aliases: []string{"Tanzania, United Republic of", "United Republic of Tanzania"}, Demonyms: []string{"Tanzanian"}, Continent: "AF", Currency: "TZS", CallingCode: "255"},
+	{Alpha2: "UA", Alpha3: "UKR", Numeric: "804", Name: "Ukraine", Demonyms: []string{"Ukrainian"}, Continent: "EU", Currency: "UAH", CallingCode: "380"},
+	{Alpha2: "UG", Alpha3: "UGA", Numeric: "800", Name: "Uganda", Aliases: []string{"Republic of Uganda"}, Demonyms: []string{"Ugandan"}, Continent: "AF", Currency: "UGX", CallingCode: "256"},
+	{Alpha2: "UM", Alpha3: "UMI", Numeric: "581", Name: "United States Minor Outlying Islands", Continent: "OC", Currency: "USD", CallingCode: "1"},
+	{Alpha2: "US", Alpha3: "USA", Numeric: "840", Name: "United States", Aliases: []string{"United States of America", "USA", "America"}, Demonyms: []string{"American", "US"}, Continent: "NA", Currency: "USD", CallingCode: "1"},
+	{Alpha2: "UY", Alpha3: "URY", Numeric: "858", Name: "Uruguay", Aliases: []string{"Eastern Republic of Uruguay"}, Demonyms: []string{"Uruguayan"}, Continent: "SA", Currency: "UYU", CallingCode: "598"},
+	{Alpha2: "UZ", Alpha3: "UZB", Numeric: "860", Name: "Uzbekistan", Aliases: []string{"Republic of Uzbekistan"}, Demonyms: []string{"Uzbek"}, Continent: "AS", Currency: "UZS", CallingCode: "998"},
+	{Alpha2: "VA", Alpha3: "VAT", Numeric: "336", Name: "Holy See (Vatican City State)", Aliases: []string{"Vatican", "Vatican City"}, Continent: "EU", Currency: "EUR", CallingCode: "39"},
+	{Alpha2: "VC", Alpha3: "VCT", Numeric: "670", Name: "Saint Vincent and the Grenadines", Demonyms: []string{"Vincentian"}, Continent: "NA", Currency: "XCD", CallingCode: "1-784"},
+	{Alpha2: "VE", Alpha3: "VEN", Numeric: "862", Name: "Venezuela", Aliases: []string{"Venezuela, Bolivarian Republic of", "Bolivarian Republic of Venezuela"}, Demonyms: []string{"Venezuelan"}, Continent: "SA", Currency: "VES", CallingCode: "58"},
+	{Alpha2: "VG", Alpha3: "VGB", Numeric: "092", Name: "Virgin Islands, British", Aliases: []string{"British Virgin Islands"}, Continent: "NA", Currency: "USD", CallingCode: "1-284"},
+	{Alpha2: "VI", Alpha3: "VIR", Numeric: "850", Name: "Virgin Islands, U.S.", Aliases: []string{"Virgin Islands of the United States", "US Virgin Islands", "U.S. Virgin Islands"}, Continent: "NA", Currency: "USD", CallingCode: "1-340"},
+	{Alpha2: "VN", Alpha3: "VNM", Numeric: "704", Name: "Vietnam", Aliases: []string{"Viet Nam", "Socialist Republic of Viet Nam"}, Demonyms: []string{"Vietnamese"}, Continent: "AS", Currency: "VND", CallingCode: "84"},
+	{Alpha2: "VU", Alpha3: "VUT", Numeric: "548", Name: "Vanuatu", Aliases: []string{"Republic of Vanuatu"}, Demonyms: []string{"Ni-Vanuatu"}, Continent: "OC", Currency: "VUV", CallingCode: "678"},
+	{Alpha2: "WF", Alpha3: "WLF", Numeric: "876", Name: "Wallis and Futuna", Continent: "OC", Currency: "XPF", CallingCode: "681"},
+	{Alpha2: "WS", Alpha3: "WSM", Numeric: "882", Name: "Samoa", Aliases: []string{"Independent State of Samoa"}, Demonyms: []string{"Samoan"}, Continent: "OC", Currency: "WST", CallingCode: "685"},
+	{Alpha2: "YE", Alpha3: "YEM", Numeric: "887", Name: "Yemen", Aliases: []string{"Republic of Yemen"}, Demonyms: []string{"Yemeni"}, Continent: "AS", Currency: "YER", CallingCode: "967"},
+	{Alpha2: "YT", Alpha3: "MYT", Numeric: "175", Name: "Mayotte", Continent: "AF", Currency: "EUR", CallingCode: "262"},
+	{Alpha2: "ZA", Alpha3: "ZAF", Numeric: "710", Name: "South Africa", Aliases: []string{"Republic of South Africa"}, Demonyms: []string{"South African"}, Continent: "AF", Currency: "ZAR", CallingCode: "27"},
+	{Alpha2: "ZM", Alpha3: "ZMB", Numeric: "894", Name: "Zambia", Aliases: []string{"Republic of Zambia"}, Demonyms: []string{"Zambian"}, Continent: "AF", Currency: "ZMW", CallingCode: "260"},
+	{Alpha2: "ZW", Alpha3: "ZWE", Numeric: "716", Name: "Zimbabwe", Aliases: []string{"Republic of Zimbabwe"}, Demonyms: []string{"Zimbabwean"}, Continent: "AF", Currency: "ZWG", CallingCode: "263"},
+}
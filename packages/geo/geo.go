@@ -0,0 +1,35 @@
+package geo
+
+import "math"
+
+const EarthRadiusKm = 6371
+
+func Haversine(lat1, lon1, lat2, lon2 float64) float64 {
+	dLat := (lat2 - lat1) * math.Pi / 180.0
+	dLon := (lon2 - lon1) * math.Pi / 180.0
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1*math.Pi/180.0)*math.Cos(lat2*math.Pi/180.0)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+	return EarthRadiusKm * c
+}
+
+type Point struct {
+	Lat  float64
+	Long float64
+}
+
+func unitVector(lat, lon float64) [3]float64 {
+	phi := lat * math.Pi / 180.0
+	lambda := lon * math.Pi / 180.0
+	return [3]float64{
+		math.Cos(phi) * math.Cos(lambda),
+		math.Cos(phi) * math.Sin(lambda),
+		math.Sin(phi),
+	}
+}
+
+// chordForKm converts a great-circle distance into the straight-line distance
+// between the two points on the unit sphere.
+func chordForKm(km float64) float64 {
+	angle := math.Min(km/EarthRadiusKm, math.Pi)
+	return 2 * math.Sin(angle/2)
+}
@@ -0,0 +1,224 @@
+package geo
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+)
+
+// searchSlack widens the pruning test so rounding in the unit-vector
+// projection can only make the search visit more nodes, never fewer.
+const searchSlack = 1e-12
+
+// Index is a static k-d tree over points projected onto the unit sphere.
+// Straight-line distance between unit vectors grows monotonically with
+// great-circle distance, so the tree prunes in 3D while candidates are ranked
+// by Haversine with ties broken by input position. Every query therefore
+// returns exactly what a linear Haversine scan over the input would.
+type Index struct {
+	points  []Point
+	vectors [][3]float64
+	order   []int
+	axes    []uint8
+}
+
+type Neighbor struct {
+	Index      int
+	DistanceKm float64
+}
+
+func NewIndex(points []Point) *Index {
+	idx := &Index{
+		points:  points,
+		vectors: make([][3]float64, len(points)),
+		order:   make([]int, len(points)),
+		axes:    make([]uint8, len(points)),
+	}
+	for i, p := range points {
+		idx.vectors[i] = unitVector(p.Lat, p.Long)
+		idx.order[i] = i
+	}
+	idx.build(0, len(points))
+	return idx
+}
+
+func (idx *Index) Len() int {
+	return len(idx.points)
+}
+
+func (idx *Index) build(lo, hi int) {
+	if hi-lo <= 1 {
+		return
+	}
+
+	axis := idx.widestAxis(lo, hi)
+	nodes := idx.order[lo:hi]
+	sort.Slice(nodes, func(a, b int) bool {
+		return idx.vectors[nodes[a]][axis] < idx.vectors[nodes[b]][axis]
+	})
+
+	mid := (lo + hi) / 2
+	idx.axes[mid] = axis
+	idx.build(lo, mid)
+	idx.build(mid+1, hi)
+}
+
+func (idx *Index) widestAxis(lo, hi int) uint8 {
+	var best uint8
+	bestSpread := -1.0
+	for axis := uint8(0); axis < 3; axis++ {
+		low, high := math.Inf(1), math.Inf(-1)
+		for _, i := range idx.order[lo:hi] {
+			v := idx.vectors[i][axis]
+			low = math.Min(low, v)
+			high = math.Max(high, v)
+		}
+		if high-low > bestSpread {
+			best, bestSpread = axis, high-low
+		}
+	}
+	return best
+}
+
+// Nearest returns the closest point accepted by keep. A nil keep accepts
+// every point.
+func (idx *Index) Nearest(lat, lon float64, keep func(int) bool) (Neighbor, bool) {
+	neighbors := idx.KNearest(lat, lon, 1, keep)
+	if len(neighbors) == 0 {
+		return Neighbor{}, false
+	}
+	return neighbors[0], true
+}
+
+// KNearest returns up to k points accepted by keep, closest first.
+func (idx *Index) KNearest(lat, lon float64, k int, keep func(int) bool) []Neighbor {
+	if k <= 0 {
+		return nil
+	}
+	return idx.search(lat, lon, k, math.Inf(1), keep)
+}
+
+// KNearestWithin is KNearest restricted to points at most radiusKm away.
+func (idx *Index) KNearestWithin(lat, lon float64, k int, radiusKm float64, keep func(int) bool) []Neighbor {
+	if k <= 0 || radiusKm < 0 {
+		return nil
+	}
+	return idx.search(lat, lon, k, radiusKm, keep)
+}
+
+// WithinRadius returns every point accepted by keep at most radiusKm away,
+// closest first.
+func (idx *Index) WithinRadius(lat, lon, radiusKm float64, keep func(int) bool) []Neighbor {
+	if radiusKm < 0 {
+		return nil
+	}
+	return idx.search(lat, lon, 0, radiusKm, keep)
+}
+
+func (idx *Index) search(lat, lon float64, k int, radiusKm float64, keep func(int) bool) []Neighbor {
+	s := &search{
+		idx:      idx,
+		lat:      lat,
+		lon:      lon,
+		target:   unitVector(lat, lon),
+		k:        k,
+		radiusKm: radiusKm,
+		keep:     keep,
+	}
+	s.visit(0, len(idx.order))
+
+	result := []Neighbor(s.found)
+	sort.Slice(result, func(a, b int) bool {
+		return closer(result[a], result[b])
+	})
+	return result
+}
+
+type search struct {
+	idx      *Index
+	lat      float64
+	lon      float64
+	target   [3]float64
+	k        int
+	radiusKm float64
+	keep     func(int) bool
+	found    neighborHeap
+}
+
+func (s *search) visit(lo, hi int) {
+	if lo >= hi {
+		return
+	}
+
+	mid := (lo + hi) / 2
+	i := s.idx.order[mid]
+	s.consider(i)
+
+	if hi-lo == 1 {
+		return
+	}
+
+	axis := s.idx.axes[mid]
+	diff := s.target[axis] - s.idx.vectors[i][axis]
+	nearLo, nearHi, farLo, farHi := lo, mid, mid+1, hi
+	if diff > 0 {
+		nearLo, nearHi, farLo, farHi = mid+1, hi, lo, mid
+	}
+
+	s.visit(nearLo, nearHi)
+	chord := chordForKm(s.boundKm())
+	if diff*diff <= chord*chord+searchSlack {
+		s.visit(farLo, farHi)
+	}
+}
+
+func (s *search) boundKm() float64 {
+	if s.k > 0 && len(s.found) == s.k {
+		return math.Min(s.radiusKm, s.found[0].DistanceKm)
+	}
+	return s.radiusKm
+}
+
+func (s *search) consider(i int) {
+	if s.keep != nil && !s.keep(i) {
+		return
+	}
+
+	p := s.idx.points[i]
+	candidate := Neighbor{Index: i, DistanceKm: Haversine(s.lat, s.lon, p.Lat, p.Long)}
+	if candidate.DistanceKm > s.radiusKm {
+		return
+	}
+
+	switch {
+	case s.k <= 0:
+		s.found = append(s.found, candidate)
+	case len(s.found) < s.k:
+		heap.Push(&s.found, candidate)
+	case closer(candidate, s.found[0]):
+		s.found[0] = candidate
+		heap.Fix(&s.found, 0)
+	}
+}
+
+func closer(a, b Neighbor) bool {
+	if a.DistanceKm != b.DistanceKm {
+		return a.DistanceKm < b.DistanceKm
+	}
+	return a.Index < b.Index
+}
+
+// neighborHeap keeps the farthest neighbor found so far on top.
+type neighborHeap []Neighbor
+
+func (h neighborHeap) Len() int            { return len(h) }
+func (h neighborHeap) Less(a, b int) bool  { return closer(h[b], h[a]) }
+func (h neighborHeap) Swap(a, b int)       { h[a], h[b] = h[b], h[a] }
+func (h *neighborHeap) Push(x interface{}) { *h = append(*h, x.(Neighbor)) }
+func (h *neighborHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,216 @@
+package geo
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// linearScan is the brute-force search the index replaced: every accepted
+// point within radiusKm, ordered by distance then input position, truncated
+// to k when k > 0.
+func linearScan(points []Point, lat, lon float64, k int, radiusKm float64, keep func(int) bool) []Neighbor {
+	var result []Neighbor
+	for i, p := range points {
+		if keep != nil && !keep(i) {
+			continue
+		}
+		if d := Haversine(lat, lon, p.Lat, p.Long); d <= radiusKm {
+			result = append(result, Neighbor{Index: i, DistanceKm: d})
+		}
+	}
+	sort.Slice(result, func(a, b int) bool {
+		return closer(result[a], result[b])
+	})
+	if k > 0 && len(result) > k {
+		result = result[:k]
+	}
+	return result
+}
+
+// linearNearest is the single-pass minimum the airport lookups used before
+// the index.
+func linearNearest(points []Point, lat, lon float64) (Neighbor, bool) {
+	best := Neighbor{Index: -1}
+	for i, p := range points {
+		candidate := Neighbor{Index: i, DistanceKm: Haversine(lat, lon, p.Lat, p.Long)}
+		if best.Index < 0 || closer(candidate, best) {
+			best = candidate
+		}
+	}
+	return best, best.Index >= 0
+}
+
+func randomPoints(r *rand.Rand, n int) []Point {
+	points := make([]Point, n)
+	for i := range points {
+		points[i] = Point{Lat: r.Float64()*180 - 90, Long: r.Float64()*360 - 180}
+	}
+	return points
+}
+
+// edgePoints clusters points around the antimeridian and both poles, where
+// naive latitude/longitude boxes go wrong, plus exact duplicates to exercise
+// tie-breaking.
+func edgePoints(r *rand.Rand) []Point {
+	var points []Point
+	for i := 0; i < 300; i++ {
+		points = append(points,
+			Point{Lat: r.Float64()*20 - 10, Long: 175 + r.Float64()*5},
+			Point{Lat: r.Float64()*20 - 10, Long: -180 + r.Float64()*5},
+			Point{Lat: 85 + r.Float64()*5, Long: r.Float64()*360 - 180},
+			Point{Lat: -85 - r.Float64()*5, Long: r.Float64()*360 - 180},
+		)
+	}
+	points = append(points, Point{Lat: 0, Long: 180}, Point{Lat: 0, Long: -180}, Point{Lat: 90, Long: 0}, Point{Lat: 90, Long: 120})
+	points = append(points, points[:20]...)
+	return points
+}
+
+type query struct {
+	name     string
+	lat, lon float64
+}
+
+var edgeQueries = []query{
+	{"antimeridian east", 0, 179.99},
+	{"antimeridian west", 0, -179.99},
+	{"antimeridian exact", 5, 180},
+	{"north pole", 90, 0},
+	{"north pole other longitude", 90, -77},
+	{"south pole", -90, 0},
+	{"near south pole", -89.5, 45},
+}
+
+func testPoints(t *testing.T) map[string][]Point {
+	t.Helper()
+	r := rand.New(rand.NewSource(1))
+	return map[string][]Point{
+		"random": randomPoints(r, 5000),
+		"edges":  edgePoints(r),
+		"single": {{Lat: 51.47, Long: -0.46}},
+	}
+}
+
+func queriesFor(r *rand.Rand) []query {
+	queries := append([]query(nil), edgeQueries...)
+	for i := 0; i < 50; i++ {
+		queries = append(queries, query{"random", r.Float64()*180 - 90, r.Float64()*360 - 180})
+	}
+	return queries
+}
+
+func TestNearestMatchesLinearScan(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for name, points := range testPoints(t) {
+		idx := NewIndex(points)
+		for _, q := range queriesFor(r) {
+			got, gotOK := idx.Nearest(q.lat, q.lon, nil)
+			want, wantOK := linearNearest(points, q.lat, q.lon)
+			if got != want || gotOK != wantOK {
+				t.Errorf("%s/%s (%v,%v): Nearest = %v %v, linear scan = %v %v", name, q.name, q.lat, q.lon, got, gotOK, want, wantOK)
+			}
+		}
+	}
+}
+
+func TestKNearestMatchesLinearScan(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	keepEven := func(i int) bool { return i%2 == 0 }
+	for name, points := range testPoints(t) {
+		idx := NewIndex(points)
+		for _, q := range queriesFor(r) {
+			for _, k := range []int{1, 5, 40} {
+				if got, want := idx.KNearest(q.lat, q.lon, k, nil), linearScan(points, q.lat, q.lon, k, 1e9, nil); !reflect.DeepEqual(got, want) {
+					t.Errorf("%s/%s (%v,%v): KNearest(k=%d) = %v, linear scan = %v", name, q.name, q.lat, q.lon, k, got, want)
+				}
+				if got, want := idx.KNearest(q.lat, q.lon, k, keepEven), linearScan(points, q.lat, q.lon, k, 1e9, keepEven); !reflect.DeepEqual(got, want) {
+					t.Errorf("%s/%s (%v,%v): KNearest(k=%d, even) = %v, linear scan = %v", name, q.name, q.lat, q.lon, k, got, want)
+				}
+				if got, want := idx.KNearestWithin(q.lat, q.lon, k, 500, nil), linearScan(points, q.lat, q.lon, k, 500, nil); !reflect.DeepEqual(got, want) {
+					t.Errorf("%s/%s (%v,%v): KNearestWithin(k=%d, 500km) = %v, linear scan = %v", name, q.name, q.lat, q.lon, k, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestWithinRadiusMatchesLinearScan(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	for name, points := range testPoints(t) {
+		idx := NewIndex(points)
+		for _, q := range queriesFor(r) {
+			for _, radiusKm := range []float64{0, 50, 300, 2000} {
+				got := idx.WithinRadius(q.lat, q.lon, radiusKm, nil)
+				want := linearScan(points, q.lat, q.lon, 0, radiusKm, nil)
+				if len(got) == 0 && len(want) == 0 {
+					continue
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("%s/%s (%v,%v): WithinRadius(%v) returned %d points, linear scan %d", name, q.name, q.lat, q.lon, radiusKm, len(got), len(want))
+				}
+			}
+		}
+	}
+}
+
+func TestEmptyAndInvalidQueries(t *testing.T) {
+	empty := NewIndex(nil)
+	if _, ok := empty.Nearest(0, 0, nil); ok {
+		t.Error("Nearest on an empty index reported a match")
+	}
+
+	idx := NewIndex([]Point{{Lat: 1, Long: 1}})
+	if got := idx.KNearest(0, 0, 0, nil); got != nil {
+		t.Errorf("KNearest(k=0) = %v, want nil", got)
+	}
+	if got := idx.WithinRadius(0, 0, -1, nil); got != nil {
+		t.Errorf("WithinRadius(-1) = %v, want nil", got)
+	}
+	if _, ok := idx.Nearest(0, 0, func(int) bool { return false }); ok {
+		t.Error("Nearest returned a point keep rejected")
+	}
+}
+
+func benchmarkData(b *testing.B) ([]Point, []query) {
+	b.Helper()
+	r := rand.New(rand.NewSource(5))
+	points := randomPoints(r, 70000)
+	queries := queriesFor(r)
+	return points, queries
+}
+
+func BenchmarkNearest(b *testing.B) {
+	points, queries := benchmarkData(b)
+	idx := NewIndex(points)
+	b.Run("index", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			q := queries[i%len(queries)]
+			idx.Nearest(q.lat, q.lon, nil)
+		}
+	})
+	b.Run("linear", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			q := queries[i%len(queries)]
+			linearNearest(points, q.lat, q.lon)
+		}
+	})
+}
+
+func BenchmarkKNearest(b *testing.B) {
+	points, queries := benchmarkData(b)
+	idx := NewIndex(points)
+	b.Run("index", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			q := queries[i%len(queries)]
+			idx.KNearest(q.lat, q.lon, 10, nil)
+		}
+	})
+	b.Run("linear", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			q := queries[i%len(queries)]
+			linearScan(points, q.lat, q.lon, 10, 1e9, nil)
+		}
+	})
+}
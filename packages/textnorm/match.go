@@ -0,0 +1,59 @@
+package textnorm
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// WordPrefixes reports whether every word of q starts one of words, so
+// "lon heath" matches the words of "london heathrow airport". Both are
+// expected to be folded already.
+func WordPrefixes(q string, words []string) bool {
+	for _, part := range strings.Fields(q) {
+		found := false
+		for _, word := range words {
+			if strings.HasPrefix(word, part) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// MaxEdits is how many edits a fuzzy match for q may take: none for fewer
+// than four runes, one for fewer than seven and two beyond that.
+func MaxEdits(q string) int {
+	switch n := utf8.RuneCountInString(q); {
+	case n < 4:
+		return 0
+	case n < 7:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// FewestEdits returns the smallest EditDistance between q and any of
+// candidates, or -1 when none is within MaxEdits(q).
+func FewestEdits(q string, candidates ...string) int {
+	budget := MaxEdits(q)
+	if budget == 0 {
+		return -1
+	}
+
+	best := -1
+	length := utf8.RuneCountInString(q)
+	for _, candidate := range candidates {
+		if diff := utf8.RuneCountInString(candidate) - length; diff > budget || -diff > budget {
+			continue
+		}
+		if d := EditDistance(q, candidate); d <= budget && (best < 0 || d < best) {
+			best = d
+		}
+	}
+	return best
+}
@@ -0,0 +1,39 @@
+package textnorm
+
+import "testing"
+
+func TestWordPrefixes(t *testing.T) {
+	words := []string{"london", "heathrow", "airport"}
+	for q, want := range map[string]bool{
+		"lon heath":   true,
+		"heath lon":   true,
+		"air":         true,
+		"lon gatwick": false,
+		"":            true,
+	} {
+		if got := WordPrefixes(q, words); got != want {
+			t.Errorf("WordPrefixes(%q) = %v, want %v", q, got, want)
+		}
+	}
+}
+
+func TestFewestEdits(t *testing.T) {
+	tests := []struct {
+		q          string
+		candidates []string
+		want       int
+	}{
+		{"lodn", []string{"london"}, -1},
+		{"londn", []string{"paris", "london"}, 1},
+		{"frankfrut", []string{"frankfurt am main", "frankfurt"}, 2},
+		{"mnchen", []string{"munchen"}, 1},
+		{"rom", []string{"rome"}, -1},
+		{"zurich", []string{"zürich"}, 1},
+		{"amsterdam", nil, -1},
+	}
+	for _, test := range tests {
+		if got := FewestEdits(test.q, test.candidates...); got != test.want {
+			t.Errorf("FewestEdits(%q, %q) = %d, want %d", test.q, test.candidates, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package timezones
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"backend.travel.intercogni.com/packages/geo"
+)
+
+// zone.tab is copied unchanged from the IANA tz database. It lists one
+// reference location, usually the principal city, for every zone.
+//
+//go:embed zone.tab
+var zoneTab []byte
+
+type Zone struct {
+	Name    string
+	Country string
+	Lat     float64
+	Long    float64
+	Comment string
+}
+
+var (
+	loadOnce  sync.Once
+	zones     []Zone
+	index     *geo.Index
+	byCountry map[string][]int
+)
+
+func load() {
+	loadOnce.Do(func() {
+		var err error
+		zones, err = parseZoneTab(zoneTab)
+		if err != nil {
+			panic("timezones: embedded zone.tab: " + err.Error())
+		}
+		points := make([]geo.Point, len(zones))
+		byCountry = make(map[string][]int)
+		for i, zone := range zones {
+			points[i] = geo.Point{Lat: zone.Lat, Long: zone.Long}
+			byCountry[zone.Country] = append(byCountry[zone.Country], i)
+		}
+		index = geo.NewIndex(points)
+	})
+}
+
+func parseZoneTab(data []byte) ([]Zone, error) {
+	var result []Zone
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Split(text, "\t")
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("line %d: expected at least 3 fields", line)
+		}
+		lat, long, err := parseISO6709(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		zone := Zone{Name: fields[2], Country: fields[0], Lat: lat, Long: long}
+		if len(fields) > 3 {
+			zone.Comment = fields[3]
+		}
+		result = append(result, zone)
+	}
+	return result, scanner.Err()
+}
+
+// parseISO6709 parses zone.tab coordinates such as "+4230+00131" or
+// "+404251-0740023": sign, degrees, minutes and optional seconds.
+func parseISO6709(s string) (float64, float64, error) {
+	split := strings.IndexAny(s[1:], "+-") + 1
+	if split <= 0 {
+		return 0, 0, fmt.Errorf("invalid coordinates %q", s)
+	}
+	lat, err := parseDMS(s[:split], 2)
+	if err != nil {
+		return 0, 0, err
+	}
+	long, err := parseDMS(s[split:], 3)
+	if err != nil {
+		return 0, 0, err
+	}
+	return lat, long, nil
+}
+
+func parseDMS(s string, degreeDigits int) (float64, error) {
+	digits := s[1:]
+	if len(digits) != degreeDigits+2 && len(digits) != degreeDigits+4 {
+		return 0, fmt.Errorf("invalid coordinate %q", s)
+	}
+	var parts []float64
+	for _, part := range []string{digits[:degreeDigits], digits[degreeDigits : degreeDigits+2], digits[degreeDigits+2:]} {
+		if part == "" {
+			parts = append(parts, 0)
+			continue
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, fmt.Errorf("invalid coordinate %q", s)
+		}
+		parts = append(parts, float64(n))
+	}
+	value := parts[0] + parts[1]/60 + parts[2]/3600
+	if s[0] == '-' {
+		value = -value
+	}
+	return value, nil
+}
+
+// Lookup returns the zone whose reference location is closest to lat/long.
+// A known ISO 3166-1 alpha-2 country limits the choice to that country's
+// zones, which keeps border towns on the right side; without one the
+// nearest zone anywhere is used. This is an approximation: inside countries
+// with several zones, a place near a zone boundary can get its neighbor.
+func Lookup(lat, long float64, country string) (Zone, bool) {
+	load()
+	candidates := byCountry[strings.ToUpper(strings.TrimSpace(country))]
+	if len(candidates) == 1 {
+		return zones[candidates[0]], true
+	}
+
+	var keep func(int) bool
+	if len(candidates) > 1 {
+		allowed := make(map[int]bool, len(candidates))
+		for _, i := range candidates {
+			allowed[i] = true
+		}
+		keep = func(i int) bool { return allowed[i] }
+	}
+	neighbor, ok := index.Nearest(lat, long, keep)
+	if !ok {
+		return Zone{}, false
+	}
+	return zones[neighbor.Index], true
+}
+
+// Name is Lookup returning just the IANA name, or "" when nothing matches.
+func Name(lat, long float64, country string) string {
+	zone, ok := Lookup(lat, long, country)
+	if !ok {
+		return ""
+	}
+	return zone.Name
+}
@@ -0,0 +1,108 @@
+package timezones
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseISO6709(t *testing.T) {
+	tests := []struct {
+		in        string
+		lat, long float64
+	}{
+		{"+4230+00131", 42.5, 1 + 31.0/60},
+		{"-3436-05827", -(34 + 36.0/60), -(58 + 27.0/60)},
+		{"+404251-0740023", 40 + 42.0/60 + 51.0/3600, -(74 + 0.0/60 + 23.0/3600)},
+		{"-0000+00000", 0, 0},
+	}
+	for _, test := range tests {
+		lat, long, err := parseISO6709(test.in)
+		if err != nil {
+			t.Errorf("parseISO6709(%q): %v", test.in, err)
+			continue
+		}
+		if math.Abs(lat-test.lat) > 1e-9 || math.Abs(long-test.long) > 1e-9 {
+			t.Errorf("parseISO6709(%q) = %v, %v, want %v, %v", test.in, lat, long, test.lat, test.long)
+		}
+	}
+
+	for _, in := range []string{"+4230", "+4230+0013", "+42x0+00131", "+4230+00131+"} {
+		if _, _, err := parseISO6709(in); err == nil {
+			t.Errorf("parseISO6709(%q) succeeded", in)
+		}
+	}
+}
+
+func TestParseDMS(t *testing.T) {
+	tests := []struct {
+		in           string
+		degreeDigits int
+		want         float64
+	}{
+		{"+4230", 2, 42.5},
+		{"-4230", 2, -42.5},
+		{"+423015", 2, 42 + 30.0/60 + 15.0/3600},
+		{"+00131", 3, 1 + 31.0/60},
+		{"-0740023", 3, -(74 + 23.0/3600)},
+		{"+1800000", 3, 180},
+	}
+	for _, test := range tests {
+		got, err := parseDMS(test.in, test.degreeDigits)
+		if err != nil {
+			t.Errorf("parseDMS(%q, %d): %v", test.in, test.degreeDigits, err)
+			continue
+		}
+		if math.Abs(got-test.want) > 1e-9 {
+			t.Errorf("parseDMS(%q, %d) = %v, want %v", test.in, test.degreeDigits, got, test.want)
+		}
+	}
+
+	for _, test := range []struct {
+		in           string
+		degreeDigits int
+	}{
+		{"+423", 2},
+		{"+42301", 2},
+		{"+4230", 3},
+		{"+42a0", 2},
+	} {
+		if _, err := parseDMS(test.in, test.degreeDigits); err == nil {
+			t.Errorf("parseDMS(%q, %d) succeeded", test.in, test.degreeDigits)
+		}
+	}
+}
+
+func TestLookupNearBorders(t *testing.T) {
+	tests := []struct {
+		place             string
+		lat, long         float64
+		country           string
+		nearest, domestic string
+	}{
+		// Closer to Büsingen's reference point than to Paris.
+		{"Strasbourg", 48.58, 7.75, "FR", "Europe/Busingen", "Europe/Paris"},
+		// Closer to Ljubljana than to Vienna.
+		{"Salzburg", 47.80, 13.04, "AT", "Europe/Ljubljana", "Europe/Vienna"},
+		// Across the river from Detroit.
+		{"Windsor", 42.30, -83.02, "CA", "America/Detroit", "America/Toronto"},
+	}
+	for _, test := range tests {
+		if zone, ok := Lookup(test.lat, test.long, ""); !ok || zone.Name != test.nearest {
+			t.Errorf("Lookup(%s) without a country = %q, want %q", test.place, zone.Name, test.nearest)
+		}
+		if zone, ok := Lookup(test.lat, test.long, test.country); !ok || zone.Name != test.domestic {
+			t.Errorf("Lookup(%s, %s) = %q, want %q", test.place, test.country, zone.Name, test.domestic)
+		}
+	}
+}
+
+func TestLookupCountry(t *testing.T) {
+	// A country with a single zone wins regardless of the coordinates.
+	if got := Name(0, 0, " fr "); got != "Europe/Paris" {
+		t.Errorf("Name(0, 0, fr) = %q, want Europe/Paris", got)
+	}
+	// An unknown country falls back to the nearest zone anywhere.
+	if got := Name(48.58, 7.75, "XX"); got != "Europe/Busingen" {
+		t.Errorf("Name(Strasbourg, XX) = %q, want Europe/Busingen", got)
+	}
+}
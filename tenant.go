@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const defaultTenantID = 1
+
+var (
+	errMissingTenant = errors.New("tenant-scoped query without a tenant in context")
+	tenantSlugFormat = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,48}$`)
+)
+
+type tenantContextKey struct{}
+
+type Tenant struct {
+	ID           uint   `gorm:"primaryKey"`
+	Slug         string `gorm:"type:varchar(50);uniqueIndex;not null"`
+	Name         string `gorm:"type:varchar(100);not null"`
+	Host         string `gorm:"type:varchar(200);index"`
+	APIKeyHash   string `gorm:"type:varchar(64);index"`
+	Currency     string `gorm:"type:varchar(3);not null;default:USD"`
+	ContactEmail string `gorm:"type:varchar(100)"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func seedDefaultTenant(db *gorm.DB) error {
+	tenant := Tenant{
+		ID:       defaultTenantID,
+		Slug:     "default",
+		Name:     "Intercogni Travel",
+		Currency: "USD",
+	}
+	return db.FirstOrCreate(&tenant, Tenant{ID: defaultTenantID}).Error
+}
+
+// registerTenantCallbacks makes every model with a TenantID field tenant
+// scoped: creates are stamped with the tenant from the statement context and
+// queries, updates and deletes are filtered by it. Statements on those models
+// without a tenant in their context fail instead of leaking across tenants.
+func registerTenantCallbacks(db *gorm.DB) error {
+	if err := db.Callback().Create().Before("gorm:create").Register("tenant:create", stampTenant); err != nil {
+		return err
+	}
+	if err := db.Callback().Query().Before("gorm:query").Register("tenant:query", scopeTenant); err != nil {
+		return err
+	}
+	if err := db.Callback().Update().Before("gorm:update").Register("tenant:update", scopeTenant); err != nil {
+		return err
+	}
+	if err := db.Callback().Delete().Before("gorm:delete").Register("tenant:delete", scopeTenant); err != nil {
+		return err
+	}
+	return db.Callback().Row().Before("gorm:row").Register("tenant:row", scopeTenant)
+}
+
+func isTenantScoped(tx *gorm.DB) bool {
+	return tx.Statement.Schema != nil && tx.Statement.Schema.LookUpField("TenantID") != nil
+}
+
+func stampTenant(tx *gorm.DB) {
+	if !isTenantScoped(tx) {
+		return
+	}
+	tenant, ok := tenantFromContext(tx.Statement.Context)
+	if !ok {
+		tx.AddError(errMissingTenant)
+		return
+	}
+	tx.Statement.SetColumn("TenantID", tenant.ID, true)
+}
+
+func scopeTenant(tx *gorm.DB) {
+	if !isTenantScoped(tx) {
+		return
+	}
+	tenant, ok := tenantFromContext(tx.Statement.Context)
+	if !ok {
+		tx.AddError(errMissingTenant)
+		return
+	}
+	tx.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: "tenant_id"}, Value: tenant.ID},
+	}})
+}
+
+func withTenant(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var tenant Tenant
+		var err error
+		if apiKey := r.Header.Get("X-API-Key"); apiKey != "" {
+			err = db.Where("api_key_hash = ?", hashToken(apiKey)).First(&tenant).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				http.Error(w, "invalid API key", http.StatusUnauthorized)
+				return
+			}
+		} else {
+			err = db.Where("host = ?", requestHost(r)).First(&tenant).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				err = db.First(&tenant, defaultTenantID).Error
+			}
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), tenantContextKey{}, tenant)))
+	})
+}
+
+func requestHost(r *http.Request) string {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.ToLower(host)
+}
+
+func tenantFromContext(ctx context.Context) (Tenant, bool) {
+	if ctx == nil {
+		return Tenant{}, false
+	}
+	tenant, ok := ctx.Value(tenantContextKey{}).(Tenant)
+	return tenant, ok
+}
+
+func getTenantSettings(w http.ResponseWriter, r *http.Request) {
+	tenant, _ := tenantFromContext(r.Context())
+
+	response := struct {
+		Slug         string `json:"slug"`
+		Name         string `json:"name"`
+		Currency     string `json:"currency"`
+		ContactEmail string `json:"contact_email"`
+	}{
+		Slug:         tenant.Slug,
+		Name:         tenant.Name,
+		Currency:     tenant.Currency,
+		ContactEmail: tenant.ContactEmail,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func createTenant(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Slug         string `json:"slug"`
+		Name         string `json:"name"`
+		Host         string `json:"host"`
+		Currency     string `json:"currency"`
+		ContactEmail string `json:"contact_email"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !tenantSlugFormat.MatchString(request.Slug) {
+		http.Error(w, "slug must be lowercase letters, digits and dashes", http.StatusBadRequest)
+		return
+	}
+	if request.Name == "" {
+		http.Error(w, "name is required", http.StatusBadRequest)
+		return
+	}
+	if request.Currency == "" {
+		request.Currency = "USD"
+	}
+	if len(request.Currency) != 3 {
+		http.Error(w, "currency must be an ISO 4217 code", http.StatusBadRequest)
+		return
+	}
+
+	apiKey, apiKeyHash, err := newToken()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	tenant := Tenant{
+		Slug:         request.Slug,
+		Name:         request.Name,
+		Host:         strings.ToLower(request.Host),
+		APIKeyHash:   apiKeyHash,
+		Currency:     strings.ToUpper(request.Currency),
+		ContactEmail: request.ContactEmail,
+	}
+	if err := db.Create(&tenant).Error; err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	response := struct {
+		ID     uint   `json:"id"`
+		Slug   string `json:"slug"`
+		APIKey string `json:"api_key"`
+	}{
+		ID:     tenant.ID,
+		Slug:   tenant.Slug,
+		APIKey: apiKey,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// newTestTenant stores a tenant reachable by apiKey.
+func newTestTenant(t *testing.T, slug, apiKey string) Tenant {
+	t.Helper()
+	tenant := Tenant{Slug: slug, Name: slug, APIKeyHash: hashToken(apiKey), Currency: "EUR"}
+	if err := db.Create(&tenant).Error; err != nil {
+		t.Fatal(err)
+	}
+	return tenant
+}
+
+func TestTenantsCannotSeeEachOthersBookings(t *testing.T) {
+	handler := newTestApp(t)
+	newTestTenant(t, "tenant-b", "key-b")
+	tenantB := map[string]string{"X-API-Key": "key-b"}
+
+	bookingA := exampleBooking(t)
+	idA := createBooking(t, handler, bookingA, nil)
+	bookingB := exampleBooking(t)
+	bookingB["total_price"] = 4321.0
+	idB := createBooking(t, handler, bookingB, tenantB)
+
+	email := bookingA["registrar_email"].(string)
+	for name, test := range map[string]struct {
+		headers map[string]string
+		want    uint
+	}{
+		"default tenant": {nil, idA},
+		"tenant b":       {tenantB, idB},
+	} {
+		w := call(t, handler, http.MethodPost, "/api/bookings/get-all", map[string]string{"email": email}, test.headers)
+		var bookings []Booking
+		decode(t, w, &bookings)
+		if len(bookings) != 1 || bookings[0].ID != test.want {
+			t.Errorf("%s lists %v, want only booking %d", name, bookings, test.want)
+		}
+	}
+
+	if w := call(t, handler, http.MethodPost, "/api/bookings/get-complex", map[string]uint{"booking_id": idB}, nil); w.Code != http.StatusNotFound {
+		t.Errorf("default tenant reading tenant b's booking: %d %s, want 404", w.Code, w.Body.String())
+	}
+	if w := call(t, handler, http.MethodPost, "/api/bookings/get-complex", map[string]uint{"booking_id": idB}, tenantB); w.Code != http.StatusOK {
+		t.Errorf("tenant b reading its own booking: %d %s, want 200", w.Code, w.Body.String())
+	}
+
+	session := map[string]string{"Authorization": "Bearer " + newTestSession(t, email)}
+	if w := call(t, handler, http.MethodPost, "/api/bookings/delete", map[string]uint{"booking_id": idB}, session); w.Code != http.StatusNotFound {
+		t.Errorf("default tenant deleting tenant b's booking: %d %s, want 404", w.Code, w.Body.String())
+	}
+	var count int64
+	if err := db.Table("bookings").Where("id = ?", idB).Count(&count).Error; err != nil || count != 1 {
+		t.Errorf("tenant b's booking is gone after a cross-tenant delete (count %d, %v)", count, err)
+	}
+}
+
+func TestUnknownAPIKeyIsRejected(t *testing.T) {
+	handler := newTestApp(t)
+	newTestTenant(t, "tenant-b", "key-b")
+
+	w := call(t, handler, http.MethodGet, "/api/tenant", nil, map[string]string{"X-API-Key": "not-a-key"})
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("unknown API key: %d %s, want 401", w.Code, w.Body.String())
+	}
+
+	w = call(t, handler, http.MethodGet, "/api/tenant", nil, map[string]string{"X-API-Key": "key-b"})
+	var settings struct {
+		Slug string `json:"slug"`
+	}
+	decode(t, w, &settings)
+	if settings.Slug != "tenant-b" {
+		t.Errorf("known API key resolved to %q, want tenant-b", settings.Slug)
+	}
+}
+
+func TestTenantScopedQueryWithoutTenantFails(t *testing.T) {
+	newTestApp(t)
+	var bookings []Booking
+	if err := db.Find(&bookings).Error; err == nil {
+		t.Error("querying bookings without a tenant in context succeeded")
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"backend.travel.intercogni.com/packages/cities"
+)
+
+func runValidateCities(args []string) error {
+	flags := flag.NewFlagSet("validate-cities", flag.ContinueOnError)
+	warnings := flags.Bool("warnings", true, "print warnings as well as errors")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var errorCount, warningCount int
+	for _, issue := range cities.Validate(cities.All()) {
+		if issue.Severity == cities.SeverityError {
+			errorCount++
+		} else {
+			warningCount++
+			if !*warnings {
+				continue
+			}
+		}
+		fmt.Println(issue)
+	}
+
+	fmt.Printf("checked %d cities: %d errors, %d warnings\n", len(cities.All()), errorCount, warningCount)
+	if errorCount > 0 {
+		return fmt.Errorf("cities dataset has %d errors", errorCount)
+	}
+	return nil
+}